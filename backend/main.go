@@ -7,21 +7,28 @@ import (
 	"api/pkg/pb"
 	"api/pkg/services"
 	"context"
-	"crypto/tls"
+	"crypto/subtle"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
+	"math"
 	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/spf13/viper"
 	"github.com/tealeg/xlsx"
-	"gopkg.in/mail.v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
@@ -35,14 +42,31 @@ func main() {
 	}
 
 	ChronexSvc := services.InitChronexService(database)
+	ChronexSvc.OrderLinkSecret = []byte(env.GetString("ORDER_LINK_SECRET"))
+	ChronexSvc.StorefrontURL = env.GetString("STOREFRONT_URL")
+	ChronexSvc.Mailer = getMailer(env)
+	ChronexSvc.OrderEmailEnabled = getOrderEmailEnabled(env)
+	ChronexSvc.ContactRecipient = env.GetString("CONTACT_EMAIL")
+	ChronexSvc.Captcha = getCaptcha(env)
+	ChronexSvc.PaymentProvider = getPaymentProvider(env)
+	ChronexSvc.Courier = getCourier(env)
+	ChronexSvc.Seller, ChronexSvc.VATRate = getSeller(env)
+	ChronexSvc.Blobs = getBlobStore(env)
 
 	log.Printf("Server is now listening on port %s", port)
 
 	// Initialize Gin router
 	router := gin.Default()
 
+	// Only believe X-Forwarded-For from our own proxies, so clients cannot
+	// pick the IP the rate limits see
+	if err := router.SetTrustedProxies(getTrustedProxies(env)); err != nil {
+		log.Fatalf("Invalid TRUSTED_PROXIES: %v", err)
+	}
+
 	// CORS middleware
 	router.Use(corsMiddleware())
+	router.Use(adminAuthMiddleware(env.GetString("ADMIN_API_TOKEN")))
 	//Product
 	router.POST("/admin/product", gin.Bind(binding.SaveProductRequest{}), SaveProductHandler(ChronexSvc))
 	router.GET("/admin/product-sort/:sort", GetAllProductHandler(ChronexSvc))
@@ -50,6 +74,14 @@ func main() {
 	router.PUT("/admin/product-update", gin.Bind(binding.UpdateProductRequest{}), UpdateProductHandler(ChronexSvc))
 	router.PUT("/admin/product-update-quantity", gin.Bind(binding.UpdateProductQuantityRequest{}), UpdateProductQuantityHandler(ChronexSvc))
 	router.PUT("/admin/product-update-status", gin.Bind(binding.UpdateProductStatusRequest{}), UpdateProductStatusHandler(ChronexSvc))
+	router.DELETE("/admin/product-delete/:productId", DeleteProductHandler(ChronexSvc))
+	router.GET("/product-compare", CompareProductsHandler(ChronexSvc))
+	//Product-Attributes
+	router.POST("/admin/product-attribute", gin.Bind(binding.SaveProductAttributeRequest{}), SaveProductAttributeHandler(ChronexSvc))
+	router.GET("/admin/product-attribute", GetAllProductAttributeHandler(ChronexSvc))
+	router.PUT("/admin/product-attribute-update", gin.Bind(binding.UpdateProductAttributeRequest{}), UpdateProductAttributeHandler(ChronexSvc))
+	router.PUT("/admin/product-attribute-update-status", gin.Bind(binding.UpdateProductAttributeStatusRequest{}), UpdateProductAttributeStatusHandler(ChronexSvc))
+	router.DELETE("/admin/product-attribute-delete/:attributeId", DeleteProductAttributeHandler(ChronexSvc))
 	//Freebies
 	router.POST("/admin/freebies", gin.Bind(binding.SaveFreebiesRequest{}), SaveFreebiesHandler(ChronexSvc))
 	router.GET("/admin/freebies-sort/:sort", GetAllFreebiesHandler(ChronexSvc))
@@ -58,22 +90,100 @@ func main() {
 	router.PUT("/admin/freebies-update", gin.Bind(binding.UpdateFreebiesRequest{}), UpdateFreebiesHandler(ChronexSvc))
 	router.PUT("/admin/freebies-update-quantity", gin.Bind(binding.UpdateFreebiesQuantityRequest{}), UpdateFreebiesQuantityHandler(ChronexSvc))
 	router.PUT("/admin/freebies-update-status", gin.Bind(binding.UpdateFreebiesStatusRequest{}), UpdateFreebiesStatusHandler(ChronexSvc))
+	router.DELETE("/admin/freebies-delete/:freebiesId", DeleteFreebiesHandler(ChronexSvc))
+	//Freebies-Rule
+	router.POST("/admin/freebies-rule", gin.Bind(binding.SaveFreebiesRuleRequest{}), SaveFreebiesRuleHandler(ChronexSvc))
+	router.GET("/admin/freebies-rule", GetAllFreebiesRuleHandler(ChronexSvc))
+	router.PUT("/admin/freebies-rule-update", gin.Bind(binding.UpdateFreebiesRuleRequest{}), UpdateFreebiesRuleHandler(ChronexSvc))
+	router.PUT("/admin/freebies-rule-update-status", gin.Bind(binding.UpdateFreebiesRuleStatusRequest{}), UpdateFreebiesRuleStatusHandler(ChronexSvc))
+	router.POST("/cart-preview", gin.Bind(binding.PreviewCartRequest{}), PreviewCartHandler(ChronexSvc))
+	//Voucher
+	router.POST("/admin/voucher", gin.Bind(binding.SaveVoucherRequest{}), SaveVoucherHandler(ChronexSvc))
+	router.GET("/admin/voucher", GetAllVoucherHandler(ChronexSvc))
+	router.PUT("/admin/voucher-update", gin.Bind(binding.UpdateVoucherRequest{}), UpdateVoucherHandler(ChronexSvc))
+	router.PUT("/admin/voucher-update-status", gin.Bind(binding.UpdateVoucherStatusRequest{}), UpdateVoucherStatusHandler(ChronexSvc))
+	//Shipping
+	router.POST("/admin/shipping-zone", gin.Bind(binding.SaveShippingZoneRequest{}), SaveShippingZoneHandler(ChronexSvc))
+	router.GET("/admin/shipping-zone", GetAllShippingZoneHandler(ChronexSvc))
+	router.PUT("/admin/shipping-zone-update", gin.Bind(binding.UpdateShippingZoneRequest{}), UpdateShippingZoneHandler(ChronexSvc))
+	router.PUT("/admin/shipping-zone-update-status", gin.Bind(binding.UpdateShippingZoneStatusRequest{}), UpdateShippingZoneStatusHandler(ChronexSvc))
+	router.DELETE("/admin/shipping-zone-delete/:shippingZoneId", DeleteShippingZoneHandler(ChronexSvc))
+	router.POST("/shipping-quote", gin.Bind(binding.QuoteShippingRequest{}), QuoteShippingHandler(ChronexSvc))
+	//Courier
+	router.POST("/admin/shipment", gin.Bind(binding.CreateShipmentRequest{}), CreateShipmentHandler(ChronexSvc))
+	router.PUT("/admin/shipment-cancel", gin.Bind(binding.CancelShipmentRequest{}), CancelShipmentHandler(ChronexSvc))
+	router.PUT("/admin/shipment-sync/:orderId", SyncTrackingHandler(ChronexSvc))
+	router.GET("/admin/order-timeline/:orderId", GetOrderTimelineHandler(ChronexSvc))
+	router.POST("/courier-webhook/:courier", HandleCourierWebhookHandler(ChronexSvc))
+	//Price-Rule
+	router.POST("/admin/price-rule", gin.Bind(binding.SavePriceRuleRequest{}), SavePriceRuleHandler(ChronexSvc))
+	router.GET("/admin/price-rule", GetAllPriceRuleHandler(ChronexSvc))
+	router.PUT("/admin/price-rule-update", gin.Bind(binding.UpdatePriceRuleRequest{}), UpdatePriceRuleHandler(ChronexSvc))
+	router.PUT("/admin/price-rule-update-status", gin.Bind(binding.UpdatePriceRuleStatusRequest{}), UpdatePriceRuleStatusHandler(ChronexSvc))
+	router.DELETE("/admin/price-rule-delete/:priceRuleId", DeletePriceRuleHandler(ChronexSvc))
+	router.GET("/admin/product-price-history/:productId", GetProductPriceHistoryHandler(ChronexSvc))
 	//Order
 	router.POST("/admin/order", gin.Bind(binding.SaveOrderRequest{}), SaveOrderHandler(ChronexSvc))
 	router.GET("/admin/order-sort/:sort", GetAllOrderHandler(ChronexSvc))
 	router.PUT("/admin/order-update", gin.Bind(binding.UpdateOrderRequest{}), UpdateOrderHandler(ChronexSvc))
 	router.PUT("/admin/order-update-status", gin.Bind(binding.UpdateOrderStatusRequest{}), UpdateOrderStatusHandler(ChronexSvc))
+	router.DELETE("/admin/order-delete/:orderId", DeleteOrderHandler(ChronexSvc))
 	router.GET("/admin/order-total-quantity", gin.Bind(binding.GetAllTotalOrderRequest{}), GetAllTotalOrderHandler(ChronexSvc))
 	router.GET("/admin/best-selling", gin.Bind(binding.GetBestSellingProductsRequest{}), GetBestSellingProductsHandler(ChronexSvc))
 	router.GET("/admin/order-revenue", GetTotalRevenueHandler(ChronexSvc))
+	//Bulk-Order
+	router.PUT("/admin/order-bulk-update-status", gin.Bind(binding.BulkUpdateOrderStatusRequest{}), BulkUpdateOrderStatusHandler(ChronexSvc))
+	router.PUT("/admin/order-bulk-tracking", BulkAssignTrackingHandler(ChronexSvc))
+	router.PUT("/admin/order-bulk-note", gin.Bind(binding.BulkAddOrderNoteRequest{}), BulkAddOrderNoteHandler(ChronexSvc))
+	//Order-Import-Export
+	router.GET("/admin/order-export", ExportOrdersHandler(ChronexSvc))
+	router.POST("/admin/order-import", ImportOrdersHandler(ChronexSvc))
+	//Catalogue-Import-Export
+	router.GET("/admin/product-export", ExportProductsHandler(ChronexSvc))
+	router.POST("/admin/product-import", ImportProductsHandler(ChronexSvc))
+	router.GET("/admin/freebies-export", ExportFreebiesHandler(ChronexSvc))
+	router.POST("/admin/freebies-import", ImportFreebiesHandler(ChronexSvc))
+	//Images
+	router.POST("/admin/image-upload", UploadImageHandler(ChronexSvc))
+	router.GET("/media/*key", GetImageHandler(ChronexSvc))
+	//Order-Documents
+	router.GET("/admin/order/:orderId/invoice.pdf", GetOrderDocumentHandler(ChronexSvc, services.OrderDocumentInvoice))
+	router.GET("/admin/order/:orderId/packing-slip.pdf", GetOrderDocumentHandler(ChronexSvc, services.OrderDocumentPackingSlip))
+	router.GET("/admin/order/:orderId/label.pdf", GetOrderDocumentHandler(ChronexSvc, services.OrderDocumentLabel))
+	router.POST("/admin/order-documents", gin.Bind(binding.GetBulkOrderDocumentRequest{}), GetBulkOrderDocumentHandler(ChronexSvc))
+	//Payment
+	router.GET("/admin/order-payment/:orderId", GetOrderPaymentHandler(ChronexSvc))
+	router.POST("/admin/payment", gin.Bind(binding.RecordPaymentRequest{}), RecordPaymentHandler(ChronexSvc))
+	router.PUT("/admin/payment-update-status", gin.Bind(binding.UpdatePaymentStatusRequest{}), UpdatePaymentStatusHandler(ChronexSvc))
+	router.POST("/payment-webhook/:provider", HandlePaymentWebhookHandler(ChronexSvc))
+	router.POST("/admin/refund", gin.Bind(binding.RefundPaymentRequest{}), RefundPaymentHandler(ChronexSvc))
+	//Return
+	router.POST("/admin/return", gin.Bind(binding.SaveReturnRequest{}), SaveReturnHandler(ChronexSvc))
+	router.GET("/admin/return", GetAllReturnHandler(ChronexSvc))
+	router.GET("/admin/return/:returnId", GetReturnByIdHandler(ChronexSvc))
+	router.PUT("/admin/return-update-status", gin.Bind(binding.UpdateReturnStatusRequest{}), UpdateReturnStatusHandler(ChronexSvc))
+	router.PUT("/admin/return-receive", gin.Bind(binding.ReceiveReturnRequest{}), ReceiveReturnHandler(ChronexSvc))
+	router.POST("/order-return", gin.Bind(binding.RequestReturnRequest{}), RequestReturnHandler(ChronexSvc))
+	//Customer
+	router.GET("/admin/customer", GetAllCustomerHandler(ChronexSvc))
+	router.GET("/admin/customer/:customerId", GetCustomerByIdHandler(ChronexSvc))
+	//ORDER-LOOKUP
+	orderLookupRateLimit := rateLimitByIP(getOrderLookupRateLimit(env))
+	router.GET("/order-lookup/:orderId", orderLookupRateLimit, LookupOrderHandler(ChronexSvc))
+	router.PUT("/order-cancel", orderLookupRateLimit, gin.Bind(binding.CancelOrderRequest{}), CancelOrderHandler(ChronexSvc))
 	//Reviews
 	router.POST("/admin/reviews", gin.Bind(binding.SaveReviewsRequest{}), SaveReviewsHandler(ChronexSvc))
 	router.GET("/admin/reviews-sort/:sort", GetAllReviewsHandler(ChronexSvc))
 	router.GET("/admin/reviews/:reviewsId", GetAllReviewsByIdHandler(ChronexSvc))
 	router.PUT("/admin/reviews-update", gin.Bind(binding.UpdateReviewsRequest{}), UpdateReviewsHandler(ChronexSvc))
 	router.PUT("/admin/reviews-update-status", gin.Bind(binding.UpdateReviewsStatusRequest{}), UpdateReviewsStatusHandler(ChronexSvc))
+	router.DELETE("/admin/reviews-delete/:reviewsId", DeleteReviewsHandler(ChronexSvc))
 	//EMAIL-SENDING
-	router.POST("/send-email", sendEmailHandler(env))
+	router.POST("/contact", rateLimitByIP(getContactRateLimit(env)), gin.Bind(binding.SubmitContactFormRequest{}), SubmitContactFormHandler(ChronexSvc))
+	router.POST("/admin/order-email-send", gin.Bind(binding.SendOrderEmailRequest{}), SendOrderEmailHandler(ChronexSvc))
+	router.GET("/admin/order-email-preview/:event/:orderId", PreviewOrderEmailHandler(ChronexSvc))
+	router.GET("/admin/email-outbox", GetAllEmailOutboxHandler(ChronexSvc))
+	router.PUT("/admin/email-outbox-resend/:emailOutboxId", ResendEmailOutboxHandler(ChronexSvc))
 	//GENERATE-REPORT
 	router.GET("/generate-revenue", func(c *gin.Context) {
 		generateExcelRevenue(c, database) // Pass only the database instance here
@@ -92,6 +202,10 @@ func main() {
 	router.GET("/admin/home-images-get", GetAllHomeImages(ChronexSvc))
 	router.PUT("/admin/home-images-update", gin.Bind(binding.UpdateHomeImagesRequest{}), UpdateHomeImagesHandler(ChronexSvc))
 	router.DELETE("/admin/home-images-delete/:homeImagesId", DeleteHomeImagesHandler(ChronexSvc))
+	//TRASH
+	router.GET("/admin/trash/:entity", GetAllTrashHandler(ChronexSvc))
+	router.PUT("/admin/trash-restore/:entity/:id", RestoreTrashHandler(ChronexSvc))
+	router.DELETE("/admin/trash-purge/:entity/:id", PurgeTrashHandler(ChronexSvc))
 
 	// Create a new HTTP server
 	httpServer := &http.Server{
@@ -103,6 +217,27 @@ func main() {
 	shutdownChannel := make(chan os.Signal, 1)
 	signal.Notify(shutdownChannel, syscall.SIGINT, syscall.SIGTERM)
 
+	// Start and end scheduled price rules in the background
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	ChronexSvc.StartPriceScheduler(schedulerCtx, getPriceSchedulerInterval(env))
+
+	// Deliver queued emails in the background
+	ChronexSvc.StartEmailWorker(schedulerCtx, getEmailWorkerInterval(env))
+
+	// Poll the courier for shipments whose webhooks went missing
+	ChronexSvc.StartTrackingSync(schedulerCtx, getTrackingSyncInterval(env))
+
+	// Move freebies images still kept in the database to the blob store
+	go func() {
+		migrated, err := ChronexSvc.MigrateFreebiesImages(schedulerCtx)
+		if err != nil {
+			log.Printf("Error migrating freebies images: %v", err)
+		}
+		if migrated > 0 {
+			log.Printf("Moved %d freebies images to the blob store", migrated)
+		}
+	}()
+
 	// Start the HTTP server
 	go func() {
 		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -112,6 +247,7 @@ func main() {
 
 	// Wait for shutdown signal
 	<-shutdownChannel
+	stopScheduler()
 
 	if err := httpServer.Shutdown(context.Background()); err != nil {
 		log.Fatalf("Failed to stop HTTP server gracefully: %v", err)
@@ -139,6 +275,13 @@ func SaveProductHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFun
 			ProductStatus:    productDetails.ProductStatus,
 			ProductSold:      productDetails.ProductSold,
 			ProductFreebies:  string(productDetails.ProductFreebies),
+			Category:         productDetails.Category,
+			WeightGrams:      productDetails.WeightGrams,
+			LengthCm:         productDetails.LengthCm,
+			WidthCm:          productDetails.WidthCm,
+			HeightCm:         productDetails.HeightCm,
+			Sku:              productDetails.Sku,
+			Attributes:       string(productDetails.Attributes),
 		})
 
 		if err != nil {
@@ -148,6 +291,7 @@ func SaveProductHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFun
 			return
 		}
 
+		setETag(c, productDetailsRes.ProductData.GetVersion())
 		c.JSON(http.StatusOK, productDetailsRes)
 	}
 }
@@ -157,10 +301,41 @@ func GetAllProductHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerF
 		sort := c.Param("sort")
 		search := c.Query("search")
 
-		productDetailsRes, err := ChronexSvc.GetAllProduct(c, &pb.GetAllProductRequest{
+		// Filters are optional; a number that does not parse is refused
+		var parseErr error
+		number := func(name string) float64 {
+			value, err := queryNumber(c, name)
+			if err != nil && parseErr == nil {
+				parseErr = err
+			}
+			return value
+		}
+		inStock, _ := strconv.ParseBool(c.Query("inStock"))
+		includeFacets, _ := strconv.ParseBool(c.Query("facets"))
+
+		productReq := &pb.GetAllProductRequest{
 			Search:            search,
 			SortOptionProduct: sort,
-		})
+			MinPrice:          number("minPrice"),
+			MaxPrice:          number("maxPrice"),
+			Categories:        c.QueryArray("category"),
+			InStock:           inStock,
+			MinDiscount:       number("minDiscount"),
+			MinRating:         number("minRating"),
+			IncludeFacets:     includeFacets,
+			Limit:             int32(number("limit")),
+			Offset:            int32(number("offset")),
+			ProductStatus:     c.Query("productStatus"),
+			Attributes:        c.Query("attributes"),
+		}
+		if parseErr != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": parseErr.Error(),
+			})
+			return
+		}
+
+		productDetailsRes, err := ChronexSvc.GetAllProduct(c, productReq)
 
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{
@@ -190,6 +365,9 @@ func GetAllProductByIdHandler(ChronexSvc *services.ChronexAdminService) gin.Hand
 			return
 		}
 
+		if len(productDetailsRes.ProductData) == 1 {
+			setETag(c, productDetailsRes.ProductData[0].GetVersion())
+		}
 		c.JSON(http.StatusOK, productDetailsRes)
 	}
 }
@@ -198,6 +376,14 @@ func UpdateProductHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerF
 	return func(c *gin.Context) {
 		productDetails := c.MustGet(gin.BindKey).(*binding.UpdateProductRequest)
 
+		expectedVersion, err := ifMatchVersion(c, productDetails.ExpectedVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
 		productDetailsRes, err := ChronexSvc.UpdateProduct(c, &pb.UpdateProductRequest{
 			ProductId:       productDetails.ProductId,
 			ProductName:     productDetails.ProductName,
@@ -211,15 +397,25 @@ func UpdateProductHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerF
 			ProductStatus:   productDetails.ProductStatus,
 			ProductSold:     productDetails.ProductSold,
 			ProductFreebies: productDetails.ProductFreebies,
+			Category:        productDetails.Category,
+			WeightGrams:     productDetails.WeightGrams,
+			LengthCm:        productDetails.LengthCm,
+			WidthCm:         productDetails.WidthCm,
+			HeightCm:        productDetails.HeightCm,
+			Sku:             productDetails.Sku,
+			Attributes:      string(productDetails.Attributes),
+			UpdateMask:      productDetails.UpdateMask,
+			ExpectedVersion: expectedVersion,
 		})
 
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{
+			c.JSON(updateErrorStatus(err), gin.H{
 				"error": err.Error(),
 			})
 			return
 		}
 
+		setETag(c, productDetailsRes.ProductData.GetVersion())
 		c.JSON(http.StatusOK, productDetailsRes)
 	}
 }
@@ -228,19 +424,30 @@ func UpdateProductQuantityHandler(ChronexSvc *services.ChronexAdminService) gin.
 	return func(c *gin.Context) {
 		productDetails := c.MustGet(gin.BindKey).(*binding.UpdateProductQuantityRequest)
 
+		expectedVersion, err := ifMatchVersion(c, productDetails.ExpectedVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
 		productDetailsRes, err := ChronexSvc.UpdateProductQuantity(c, &pb.UpdateProductQuantityRequest{
 			ProductId:        productDetails.ProductId,
 			OriginalQuantity: productDetails.OriginalQuantity,
 			CurrentQuantity:  productDetails.CurrentQuantity,
+			UpdateMask:       productDetails.UpdateMask,
+			ExpectedVersion:  expectedVersion,
 		})
 
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{
+			c.JSON(updateErrorStatus(err), gin.H{
 				"error": err.Error(),
 			})
 			return
 		}
 
+		setETag(c, productDetailsRes.ProductData.GetVersion())
 		c.JSON(http.StatusOK, productDetailsRes)
 	}
 }
@@ -249,9 +456,38 @@ func UpdateProductStatusHandler(ChronexSvc *services.ChronexAdminService) gin.Ha
 	return func(c *gin.Context) {
 		productDetails := c.MustGet(gin.BindKey).(*binding.UpdateProductStatusRequest)
 
+		expectedVersion, err := ifMatchVersion(c, productDetails.ExpectedVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
 		productDetailsRes, err := ChronexSvc.UpdateProductStatus(c, &pb.UpdateProductStatusRequest{
-			ProductId:     productDetails.ProductId,
-			ProductStatus: productDetails.ProductStatus,
+			ProductId:       productDetails.ProductId,
+			ProductStatus:   productDetails.ProductStatus,
+			ExpectedVersion: expectedVersion,
+		})
+
+		if err != nil {
+			c.JSON(updateErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		setETag(c, productDetailsRes.ProductData.GetVersion())
+		c.JSON(http.StatusOK, productDetailsRes)
+	}
+}
+
+func DeleteProductHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		productId := c.Param("productId")
+
+		productDetailsRes, err := ChronexSvc.DeleteProduct(c, &pb.DeleteProductRequest{
+			ProductId: productId,
 		})
 
 		if err != nil {
@@ -265,6 +501,147 @@ func UpdateProductStatusHandler(ChronexSvc *services.ChronexAdminService) gin.Ha
 	}
 }
 
+func CompareProductsHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Products are given as repeated productId parameters or one
+		// comma-separated list
+		var productIds []string
+		for _, value := range c.QueryArray("productId") {
+			productIds = append(productIds, strings.Split(value, ",")...)
+		}
+
+		compareDetailsRes, err := ChronexSvc.CompareProducts(c, &pb.CompareProductsRequest{
+			ProductIds: productIds,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, compareDetailsRes)
+	}
+}
+
+// Product Attribute Handler
+func SaveProductAttributeHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		attributeDetails := c.MustGet(gin.BindKey).(*binding.SaveProductAttributeRequest)
+
+		attributeDetailsRes, err := ChronexSvc.SaveProductAttribute(c, &pb.SaveProductAttributeRequest{
+			AttributeCode:   attributeDetails.AttributeCode,
+			AttributeName:   attributeDetails.AttributeName,
+			AttributeType:   attributeDetails.AttributeType,
+			Unit:            attributeDetails.Unit,
+			Options:         string(attributeDetails.Options),
+			Categories:      string(attributeDetails.Categories),
+			IsRequired:      attributeDetails.IsRequired,
+			SortOrder:       attributeDetails.SortOrder,
+			AttributeStatus: attributeDetails.AttributeStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, attributeDetailsRes)
+	}
+}
+
+func GetAllProductAttributeHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		search := c.Query("search")
+		attributeStatus := c.Query("attributeStatus")
+		category := c.Query("category")
+
+		attributeDetailsRes, err := ChronexSvc.GetAllProductAttribute(c, &pb.GetAllProductAttributeRequest{
+			Search:          search,
+			AttributeStatus: attributeStatus,
+			Category:        category,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, attributeDetailsRes)
+	}
+}
+
+func UpdateProductAttributeHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		attributeDetails := c.MustGet(gin.BindKey).(*binding.UpdateProductAttributeRequest)
+
+		attributeDetailsRes, err := ChronexSvc.UpdateProductAttribute(c, &pb.UpdateProductAttributeRequest{
+			AttributeId:   attributeDetails.AttributeId,
+			AttributeName: attributeDetails.AttributeName,
+			AttributeType: attributeDetails.AttributeType,
+			Unit:          attributeDetails.Unit,
+			Options:       string(attributeDetails.Options),
+			Categories:    string(attributeDetails.Categories),
+			IsRequired:    attributeDetails.IsRequired,
+			SortOrder:     attributeDetails.SortOrder,
+			UpdateMask:    attributeDetails.UpdateMask,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, attributeDetailsRes)
+	}
+}
+
+func UpdateProductAttributeStatusHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		attributeDetails := c.MustGet(gin.BindKey).(*binding.UpdateProductAttributeStatusRequest)
+
+		attributeDetailsRes, err := ChronexSvc.UpdateProductAttributeStatus(c, &pb.UpdateProductAttributeStatusRequest{
+			AttributeId:     attributeDetails.AttributeId,
+			AttributeStatus: attributeDetails.AttributeStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, attributeDetailsRes)
+	}
+}
+
+func DeleteProductAttributeHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		attributeId := c.Param("attributeId")
+
+		attributeDetailsRes, err := ChronexSvc.DeleteProductAttribute(c, &pb.DeleteProductAttributeRequest{
+			AttributeId: attributeId,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, attributeDetailsRes)
+	}
+}
+
 // Freebies Handler
 func SaveFreebiesHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -273,10 +650,12 @@ func SaveFreebiesHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFu
 		freebiesDetailsRes, err := ChronexSvc.SaveFreebies(c, &pb.SaveFreebiesRequest{
 			FreebiesName:             freebiesDetails.FreebiesName,
 			FreebiesImg:              freebiesDetails.FreebiesImg,
+			FreebiesImgKey:           freebiesDetails.FreebiesImgKey,
 			FreebiesStorePrice:       freebiesDetails.FreebiesStorePrice,
 			FreebiesOriginalQuantity: freebiesDetails.FreebiesOriginalQuantity,
 			FreebiesCurrentQuantity:  freebiesDetails.FreebiesCurrentQuantity,
 			FreebiesStatus:           freebiesDetails.FreebiesStatus,
+			Sku:                      freebiesDetails.Sku,
 		})
 
 		if err != nil {
@@ -286,6 +665,7 @@ func SaveFreebiesHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFu
 			return
 		}
 
+		setETag(c, freebiesDetailsRes.FreebiesData.GetVersion())
 		c.JSON(http.StatusOK, freebiesDetailsRes)
 	}
 }
@@ -343,6 +723,9 @@ func GetAllFreebiesByIdHandler(ChronexSvc *services.ChronexAdminService) gin.Han
 			return
 		}
 
+		if len(freebiesDetailsRes.FreebiesData) == 1 {
+			setETag(c, freebiesDetailsRes.FreebiesData[0].GetVersion())
+		}
 		c.JSON(http.StatusOK, freebiesDetailsRes)
 	}
 }
@@ -351,21 +734,34 @@ func UpdateFreebiesHandler(ChronexSvc *services.ChronexAdminService) gin.Handler
 	return func(c *gin.Context) {
 		freebiesDetails := c.MustGet(gin.BindKey).(*binding.UpdateFreebiesRequest)
 
+		expectedVersion, err := ifMatchVersion(c, freebiesDetails.ExpectedVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
 		freebiesDetailsRes, err := ChronexSvc.UpdateFreebies(c, &pb.UpdateFreebiesRequest{
 			FreebiesId:         freebiesDetails.FreebiesId,
 			FreebiesName:       freebiesDetails.FreebiesName,
 			FreebiesImg:        freebiesDetails.FreebiesImg,
+			FreebiesImgKey:     freebiesDetails.FreebiesImgKey,
 			FreebiesStorePrice: freebiesDetails.FreebiesStorePrice,
 			FreebiesStatus:     freebiesDetails.FreebiesStatus,
+			Sku:                freebiesDetails.Sku,
+			UpdateMask:         freebiesDetails.UpdateMask,
+			ExpectedVersion:    expectedVersion,
 		})
 
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{
+			c.JSON(updateErrorStatus(err), gin.H{
 				"error": err.Error(),
 			})
 			return
 		}
 
+		setETag(c, freebiesDetailsRes.FreebiesData.GetVersion())
 		c.JSON(http.StatusOK, freebiesDetailsRes)
 	}
 }
@@ -374,19 +770,30 @@ func UpdateFreebiesQuantityHandler(ChronexSvc *services.ChronexAdminService) gin
 	return func(c *gin.Context) {
 		freebiesDetails := c.MustGet(gin.BindKey).(*binding.UpdateFreebiesQuantityRequest)
 
+		expectedVersion, err := ifMatchVersion(c, freebiesDetails.ExpectedVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
 		freebiesDetailsRes, err := ChronexSvc.UpdateFreebiesQuantity(c, &pb.UpdateFreebiesQuantityRequest{
 			FreebiesId:               freebiesDetails.FreebiesId,
 			FreebiesOriginalQuantity: freebiesDetails.FreebiesOriginalQuantity,
 			FreebiesCurrentQuantity:  freebiesDetails.FreebiesCurrentQuantity,
+			UpdateMask:               freebiesDetails.UpdateMask,
+			ExpectedVersion:          expectedVersion,
 		})
 
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{
+			c.JSON(updateErrorStatus(err), gin.H{
 				"error": err.Error(),
 			})
 			return
 		}
 
+		setETag(c, freebiesDetailsRes.FreebiesData.GetVersion())
 		c.JSON(http.StatusOK, freebiesDetailsRes)
 	}
 }
@@ -395,33 +802,38 @@ func UpdateFreebiesStatusHandler(ChronexSvc *services.ChronexAdminService) gin.H
 	return func(c *gin.Context) {
 		freebiesDetails := c.MustGet(gin.BindKey).(*binding.UpdateFreebiesStatusRequest)
 
+		expectedVersion, err := ifMatchVersion(c, freebiesDetails.ExpectedVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
 		freebiesDetailsRes, err := ChronexSvc.UpdateFreebiesStatus(c, &pb.UpdateFreebiesStatusRequest{
-			FreebiesId:     freebiesDetails.FreebiesId,
-			FreebiesStatus: freebiesDetails.FreebiesStatus,
+			FreebiesId:      freebiesDetails.FreebiesId,
+			FreebiesStatus:  freebiesDetails.FreebiesStatus,
+			ExpectedVersion: expectedVersion,
 		})
 
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{
+			c.JSON(updateErrorStatus(err), gin.H{
 				"error": err.Error(),
 			})
 			return
 		}
 
+		setETag(c, freebiesDetailsRes.FreebiesData.GetVersion())
 		c.JSON(http.StatusOK, freebiesDetailsRes)
 	}
 }
 
-// Order Handler
-func SaveOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+func DeleteFreebiesHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		orderDetails := c.MustGet(gin.BindKey).(*binding.SaveOrderRequest)
+		freebiesId := c.Param("freebiesId")
 
-		orderDetailsRes, err := ChronexSvc.SaveOrder(c, &pb.SaveOrderRequest{
-			Customer:        string(orderDetails.Customer),
-			CompleteAddress: string(orderDetails.CompleteAddress),
-			Product:         string(orderDetails.Product),
-			Total:           orderDetails.Total,
-			OrderStatus:     orderDetails.OrderStatus,
+		freebiesDetailsRes, err := ChronexSvc.DeleteFreebies(c, &pb.DeleteFreebiesRequest{
+			FreebiesId: freebiesId,
 		})
 
 		if err != nil {
@@ -431,20 +843,30 @@ func SaveOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc
 			return
 		}
 
-		c.JSON(http.StatusOK, orderDetailsRes)
+		c.JSON(http.StatusOK, freebiesDetailsRes)
 	}
 }
 
-func GetAllOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+// Freebies Rule Handler
+func SaveFreebiesRuleHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		sort := c.Param("sort")
-		search := c.Query("search")
-		orderStatus := c.Query("orderStatus")
-
-		orderDetailsRes, err := ChronexSvc.GetAllOrder(c, &pb.GetAllOrderRequest{
-			Search:          search,
-			SortOptionOrder: sort,
-			OrderStatus:     orderStatus,
+		freebiesRuleDetails := c.MustGet(gin.BindKey).(*binding.SaveFreebiesRuleRequest)
+
+		freebiesRuleDetailsRes, err := ChronexSvc.SaveFreebiesRule(c, &pb.SaveFreebiesRuleRequest{
+			RuleName:            freebiesRuleDetails.RuleName,
+			MinSpend:            freebiesRuleDetails.MinSpend,
+			MinQuantity:         freebiesRuleDetails.MinQuantity,
+			Category:            freebiesRuleDetails.Category,
+			ProductId:           freebiesRuleDetails.ProductId,
+			FreebiesId:          freebiesRuleDetails.FreebiesId,
+			AlternateFreebiesId: freebiesRuleDetails.AlternateFreebiesId,
+			FreebiesQuantity:    freebiesRuleDetails.FreebiesQuantity,
+			PerCustomerLimit:    freebiesRuleDetails.PerCustomerLimit,
+			Priority:            freebiesRuleDetails.Priority,
+			Exclusive:           freebiesRuleDetails.Exclusive,
+			StartAt:             freebiesRuleDetails.StartAt,
+			EndAt:               freebiesRuleDetails.EndAt,
+			RuleStatus:          freebiesRuleDetails.RuleStatus,
 		})
 
 		if err != nil {
@@ -454,23 +876,16 @@ func GetAllOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFun
 			return
 		}
 
-		c.JSON(http.StatusOK, orderDetailsRes)
+		c.JSON(http.StatusOK, freebiesRuleDetailsRes)
 	}
 }
 
-func UpdateOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+func GetAllFreebiesRuleHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		orderDetails := c.MustGet(gin.BindKey).(*binding.UpdateOrderRequest)
+		ruleStatus := c.Query("ruleStatus")
 
-		orderDetailsRes, err := ChronexSvc.UpdateOrder(c, &pb.UpdateOrderRequest{
-			OrderId:         orderDetails.OrderId,
-			Customer:        string(orderDetails.Customer),
-			CompleteAddress: string(orderDetails.CompleteAddress),
-			Product:         string(orderDetails.Product),
-			Total:           orderDetails.Total,
-			OrderStatus:     orderDetails.OrderStatus,
-			TrackingId:      orderDetails.TrackingId,
-			StickyNotes:     string(orderDetails.StickyNotes),
+		freebiesRuleDetailsRes, err := ChronexSvc.GetAllFreebiesRule(c, &pb.GetAllFreebiesRuleRequest{
+			RuleStatus: ruleStatus,
 		})
 
 		if err != nil {
@@ -480,17 +895,30 @@ func UpdateOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFun
 			return
 		}
 
-		c.JSON(http.StatusOK, orderDetailsRes)
+		c.JSON(http.StatusOK, freebiesRuleDetailsRes)
 	}
 }
 
-func UpdateOrderStatusHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+func UpdateFreebiesRuleHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		orderDetails := c.MustGet(gin.BindKey).(*binding.UpdateOrderStatusRequest)
-
-		orderDetailsRes, err := ChronexSvc.UpdateOrderStatus(c, &pb.UpdateOrderStatusRequest{
-			OrderId:     orderDetails.OrderId,
-			OrderStatus: orderDetails.OrderStatus,
+		freebiesRuleDetails := c.MustGet(gin.BindKey).(*binding.UpdateFreebiesRuleRequest)
+
+		freebiesRuleDetailsRes, err := ChronexSvc.UpdateFreebiesRule(c, &pb.UpdateFreebiesRuleRequest{
+			FreebiesRuleId:      freebiesRuleDetails.FreebiesRuleId,
+			RuleName:            freebiesRuleDetails.RuleName,
+			MinSpend:            freebiesRuleDetails.MinSpend,
+			MinQuantity:         freebiesRuleDetails.MinQuantity,
+			Category:            freebiesRuleDetails.Category,
+			ProductId:           freebiesRuleDetails.ProductId,
+			FreebiesId:          freebiesRuleDetails.FreebiesId,
+			AlternateFreebiesId: freebiesRuleDetails.AlternateFreebiesId,
+			FreebiesQuantity:    freebiesRuleDetails.FreebiesQuantity,
+			PerCustomerLimit:    freebiesRuleDetails.PerCustomerLimit,
+			Priority:            freebiesRuleDetails.Priority,
+			Exclusive:           freebiesRuleDetails.Exclusive,
+			StartAt:             freebiesRuleDetails.StartAt,
+			EndAt:               freebiesRuleDetails.EndAt,
+			UpdateMask:          freebiesRuleDetails.UpdateMask,
 		})
 
 		if err != nil {
@@ -500,16 +928,17 @@ func UpdateOrderStatusHandler(ChronexSvc *services.ChronexAdminService) gin.Hand
 			return
 		}
 
-		c.JSON(http.StatusOK, orderDetailsRes)
+		c.JSON(http.StatusOK, freebiesRuleDetailsRes)
 	}
 }
 
-func GetTotalRevenueHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+func UpdateFreebiesRuleStatusHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		orderStatus := c.Query("orderStatus")
+		freebiesRuleDetails := c.MustGet(gin.BindKey).(*binding.UpdateFreebiesRuleStatusRequest)
 
-		orderDetailsRes, err := ChronexSvc.GetAllOrderRevenue(c, &pb.GetAllOrderRevenueRequest{
-			OrderStatus: orderStatus,
+		freebiesRuleDetailsRes, err := ChronexSvc.UpdateFreebiesRuleStatus(c, &pb.UpdateFreebiesRuleStatusRequest{
+			FreebiesRuleId: freebiesRuleDetails.FreebiesRuleId,
+			RuleStatus:     freebiesRuleDetails.RuleStatus,
 		})
 
 		if err != nil {
@@ -519,16 +948,19 @@ func GetTotalRevenueHandler(ChronexSvc *services.ChronexAdminService) gin.Handle
 			return
 		}
 
-		c.JSON(http.StatusOK, orderDetailsRes)
+		c.JSON(http.StatusOK, freebiesRuleDetailsRes)
 	}
 }
 
-func GetAllTotalOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+func PreviewCartHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		orderStatus := c.Query("orderStatus")
+		cartDetails := c.MustGet(gin.BindKey).(*binding.PreviewCartRequest)
 
-		orderDetailsRes, err := ChronexSvc.GetAllTotalOrder(c, &pb.GetAllTotalOrderRequest{
-			OrderStatus: orderStatus,
+		cartDetailsRes, err := ChronexSvc.PreviewCart(c, &pb.PreviewCartRequest{
+			Customer:        string(cartDetails.Customer),
+			Product:         string(cartDetails.Product),
+			VoucherCode:     cartDetails.VoucherCode,
+			CompleteAddress: string(cartDetails.CompleteAddress),
 		})
 
 		if err != nil {
@@ -538,16 +970,28 @@ func GetAllTotalOrderHandler(ChronexSvc *services.ChronexAdminService) gin.Handl
 			return
 		}
 
-		c.JSON(http.StatusOK, orderDetailsRes)
+		c.JSON(http.StatusOK, cartDetailsRes)
 	}
 }
 
-func GetBestSellingProductsHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+// Voucher Handler
+func SaveVoucherHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		orderStatus := c.Query("orderStatus")
-
-		orderDetailsRes, err := ChronexSvc.GetBestSellingProducts(c, &pb.GetBestSellingProductsRequest{
-			OrderStatus: orderStatus,
+		voucherDetails := c.MustGet(gin.BindKey).(*binding.SaveVoucherRequest)
+
+		voucherDetailsRes, err := ChronexSvc.SaveVoucher(c, &pb.SaveVoucherRequest{
+			VoucherCode:      voucherDetails.VoucherCode,
+			DiscountType:     voucherDetails.DiscountType,
+			DiscountValue:    voucherDetails.DiscountValue,
+			MaxDiscount:      voucherDetails.MaxDiscount,
+			MinSpend:         voucherDetails.MinSpend,
+			Category:         voucherDetails.Category,
+			ProductId:        voucherDetails.ProductId,
+			UsageLimit:       voucherDetails.UsageLimit,
+			PerCustomerLimit: voucherDetails.PerCustomerLimit,
+			StartAt:          voucherDetails.StartAt,
+			EndAt:            voucherDetails.EndAt,
+			VoucherStatus:    voucherDetails.VoucherStatus,
 		})
 
 		if err != nil {
@@ -557,21 +1001,48 @@ func GetBestSellingProductsHandler(ChronexSvc *services.ChronexAdminService) gin
 			return
 		}
 
-		c.JSON(http.StatusOK, orderDetailsRes)
+		c.JSON(http.StatusOK, voucherDetailsRes)
 	}
 }
 
-// Reviews Handler
-func SaveReviewsHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+func GetAllVoucherHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		reviewsDetails := c.MustGet(gin.BindKey).(*binding.SaveReviewsRequest)
+		search := c.Query("search")
+		voucherStatus := c.Query("voucherStatus")
 
-		reviewsDetailsRes, err := ChronexSvc.SaveReviews(c, &pb.SaveReviewsRequest{
-			ProductId:         reviewsDetails.ProductId,
-			ReviewsName:       reviewsDetails.ReviewsName,
-			ReviewsSubject:    reviewsDetails.ReviewsSubject,
-			ReviewsMessage:    reviewsDetails.ReviewsMessage,
-			ReviewsStarRating: reviewsDetails.ReviewsStarRating,
+		voucherDetailsRes, err := ChronexSvc.GetAllVoucher(c, &pb.GetAllVoucherRequest{
+			Search:        search,
+			VoucherStatus: voucherStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, voucherDetailsRes)
+	}
+}
+
+func UpdateVoucherHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		voucherDetails := c.MustGet(gin.BindKey).(*binding.UpdateVoucherRequest)
+
+		voucherDetailsRes, err := ChronexSvc.UpdateVoucher(c, &pb.UpdateVoucherRequest{
+			VoucherId:        voucherDetails.VoucherId,
+			DiscountType:     voucherDetails.DiscountType,
+			DiscountValue:    voucherDetails.DiscountValue,
+			MaxDiscount:      voucherDetails.MaxDiscount,
+			MinSpend:         voucherDetails.MinSpend,
+			Category:         voucherDetails.Category,
+			ProductId:        voucherDetails.ProductId,
+			UsageLimit:       voucherDetails.UsageLimit,
+			PerCustomerLimit: voucherDetails.PerCustomerLimit,
+			StartAt:          voucherDetails.StartAt,
+			EndAt:            voucherDetails.EndAt,
+			UpdateMask:       voucherDetails.UpdateMask,
 		})
 
 		if err != nil {
@@ -581,62 +1052,1457 @@ func SaveReviewsHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFun
 			return
 		}
 
-		c.JSON(http.StatusOK, reviewsDetailsRes)
+		c.JSON(http.StatusOK, voucherDetailsRes)
+	}
+}
+
+func UpdateVoucherStatusHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		voucherDetails := c.MustGet(gin.BindKey).(*binding.UpdateVoucherStatusRequest)
+
+		voucherDetailsRes, err := ChronexSvc.UpdateVoucherStatus(c, &pb.UpdateVoucherStatusRequest{
+			VoucherId:     voucherDetails.VoucherId,
+			VoucherStatus: voucherDetails.VoucherStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, voucherDetailsRes)
+	}
+}
+
+// Shipping Handler
+func SaveShippingZoneHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		shippingZoneDetails := c.MustGet(gin.BindKey).(*binding.SaveShippingZoneRequest)
+
+		shippingZoneDetailsRes, err := ChronexSvc.SaveShippingZone(c, &pb.SaveShippingZoneRequest{
+			ZoneName:              shippingZoneDetails.ZoneName,
+			Provinces:             string(shippingZoneDetails.Provinces),
+			Cities:                string(shippingZoneDetails.Cities),
+			IsDefault:             shippingZoneDetails.IsDefault,
+			RateType:              shippingZoneDetails.RateType,
+			FlatFee:               shippingZoneDetails.FlatFee,
+			WeightTiers:           string(shippingZoneDetails.WeightTiers),
+			ExtraFeePerKg:         shippingZoneDetails.ExtraFeePerKg,
+			FreeShippingThreshold: shippingZoneDetails.FreeShippingThreshold,
+			ZoneStatus:            shippingZoneDetails.ZoneStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, shippingZoneDetailsRes)
+	}
+}
+
+func GetAllShippingZoneHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		search := c.Query("search")
+		zoneStatus := c.Query("zoneStatus")
+
+		shippingZoneDetailsRes, err := ChronexSvc.GetAllShippingZone(c, &pb.GetAllShippingZoneRequest{
+			Search:     search,
+			ZoneStatus: zoneStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, shippingZoneDetailsRes)
+	}
+}
+
+func UpdateShippingZoneHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		shippingZoneDetails := c.MustGet(gin.BindKey).(*binding.UpdateShippingZoneRequest)
+
+		shippingZoneDetailsRes, err := ChronexSvc.UpdateShippingZone(c, &pb.UpdateShippingZoneRequest{
+			ShippingZoneId:        shippingZoneDetails.ShippingZoneId,
+			ZoneName:              shippingZoneDetails.ZoneName,
+			Provinces:             string(shippingZoneDetails.Provinces),
+			Cities:                string(shippingZoneDetails.Cities),
+			IsDefault:             shippingZoneDetails.IsDefault,
+			RateType:              shippingZoneDetails.RateType,
+			FlatFee:               shippingZoneDetails.FlatFee,
+			WeightTiers:           string(shippingZoneDetails.WeightTiers),
+			ExtraFeePerKg:         shippingZoneDetails.ExtraFeePerKg,
+			FreeShippingThreshold: shippingZoneDetails.FreeShippingThreshold,
+			UpdateMask:            shippingZoneDetails.UpdateMask,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, shippingZoneDetailsRes)
+	}
+}
+
+func UpdateShippingZoneStatusHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		shippingZoneDetails := c.MustGet(gin.BindKey).(*binding.UpdateShippingZoneStatusRequest)
+
+		shippingZoneDetailsRes, err := ChronexSvc.UpdateShippingZoneStatus(c, &pb.UpdateShippingZoneStatusRequest{
+			ShippingZoneId: shippingZoneDetails.ShippingZoneId,
+			ZoneStatus:     shippingZoneDetails.ZoneStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, shippingZoneDetailsRes)
+	}
+}
+
+func DeleteShippingZoneHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		shippingZoneId := c.Param("shippingZoneId")
+
+		shippingZoneDetailsRes, err := ChronexSvc.DeleteShippingZone(c, &pb.DeleteShippingZoneRequest{
+			ShippingZoneId: shippingZoneId,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, shippingZoneDetailsRes)
+	}
+}
+
+func QuoteShippingHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		quoteDetails := c.MustGet(gin.BindKey).(*binding.QuoteShippingRequest)
+
+		quoteDetailsRes, err := ChronexSvc.QuoteShipping(c, &pb.QuoteShippingRequest{
+			Product:         string(quoteDetails.Product),
+			CompleteAddress: string(quoteDetails.CompleteAddress),
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, quoteDetailsRes)
+	}
+}
+
+// Bulk Order Handler
+func BulkUpdateOrderStatusHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		bulkDetails := c.MustGet(gin.BindKey).(*binding.BulkUpdateOrderStatusRequest)
+
+		bulkRes, err := ChronexSvc.BulkUpdateOrderStatus(c, &pb.BulkUpdateOrderStatusRequest{
+			OrderIds:    bulkDetails.OrderIds,
+			OrderStatus: bulkDetails.OrderStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, bulkRes)
+	}
+}
+
+// BulkAssignTrackingHandler takes a multipart upload with the CSV or XLSX in
+// "file" and an optional "markShipped" flag.
+func BulkAssignTrackingHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		fileName, data, err := readUpload(c, "file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		markShipped, _ := strconv.ParseBool(c.PostForm("markShipped"))
+
+		bulkRes, err := ChronexSvc.BulkAssignTracking(c, &pb.BulkAssignTrackingRequest{
+			File:        data,
+			FileName:    fileName,
+			MarkShipped: markShipped,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, bulkRes)
+	}
+}
+
+func BulkAddOrderNoteHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		bulkDetails := c.MustGet(gin.BindKey).(*binding.BulkAddOrderNoteRequest)
+
+		bulkRes, err := ChronexSvc.BulkAddOrderNote(c, &pb.BulkAddOrderNoteRequest{
+			OrderIds: bulkDetails.OrderIds,
+			Note:     bulkDetails.Note,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, bulkRes)
+	}
+}
+
+// Order Document Handler
+func GetOrderDocumentHandler(ChronexSvc *services.ChronexAdminService, document string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		documentRes, err := ChronexSvc.GetOrderDocument(c, &pb.GetOrderDocumentRequest{
+			OrderId:  c.Param("orderId"),
+			Document: document,
+		})
+
+		if err != nil {
+			c.JSON(lookupErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.Header("Content-Disposition", fmt.Sprintf("inline; filename=%s", documentRes.FileName))
+		c.Data(http.StatusOK, "application/pdf", documentRes.Pdf)
+	}
+}
+
+func GetBulkOrderDocumentHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		documentDetails := c.MustGet(gin.BindKey).(*binding.GetBulkOrderDocumentRequest)
+
+		documentRes, err := ChronexSvc.GetBulkOrderDocument(c, &pb.GetBulkOrderDocumentRequest{
+			OrderIds: documentDetails.OrderIds,
+			Document: documentDetails.Document,
+		})
+
+		if err != nil {
+			c.JSON(lookupErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", documentRes.FileName))
+		c.Data(http.StatusOK, "application/pdf", documentRes.Pdf)
+	}
+}
+
+// Order Import Export Handler
+func ExportOrdersHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		format, err := services.ParseSpreadsheetFormat(c.Query("format"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		filter := services.OrderExportFilter{
+			Search:          c.Query("search"),
+			OrderStatus:     strings.ToUpper(c.Query("orderStatus")),
+			SortOptionOrder: c.Query("sort"),
+		}
+		// Dates are inclusive, so the range ends at the start of the day after "to"
+		if from := c.Query("from"); from != "" {
+			if filter.CreatedFrom, err = time.ParseInLocation("2006-01-02", from, time.Local); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"error": "from must be a date like 2006-01-02",
+				})
+				return
+			}
+		}
+		if to := c.Query("to"); to != "" {
+			createdTo, err := time.ParseInLocation("2006-01-02", to, time.Local)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"error": "to must be a date like 2006-01-02",
+				})
+				return
+			}
+			filter.CreatedTo = createdTo.AddDate(0, 0, 1)
+		}
+
+		streamSpreadsheet(c, "orders", format, func(out io.Writer) error {
+			return ChronexSvc.ExportOrders(filter, format, out)
+		})
+	}
+}
+
+func ImportOrdersHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		fileName, data, err := readUpload(c, "file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		dryRun, _ := strconv.ParseBool(c.PostForm("dryRun"))
+
+		importRes, err := ChronexSvc.ImportOrders(c, &pb.ImportOrdersRequest{
+			File:          data,
+			FileName:      fileName,
+			Channel:       c.PostForm("channel"),
+			Preset:        c.PostForm("preset"),
+			ColumnMapping: c.PostForm("columnMapping"),
+			DryRun:        dryRun,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, importRes)
+	}
+}
+
+// Catalogue Import Export Handler
+func ExportProductsHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		format, err := services.ParseSpreadsheetFormat(c.Query("format"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		streamSpreadsheet(c, "products", format, func(out io.Writer) error {
+			return ChronexSvc.ExportProducts(format, out)
+		})
+	}
+}
+
+func ExportFreebiesHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		format, err := services.ParseSpreadsheetFormat(c.Query("format"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		streamSpreadsheet(c, "freebies", format, func(out io.Writer) error {
+			return ChronexSvc.ExportFreebies(format, out)
+		})
+	}
+}
+
+func ImportProductsHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		fileName, data, err := readUpload(c, "file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		dryRun, _ := strconv.ParseBool(c.PostForm("dryRun"))
+
+		importRes, err := ChronexSvc.ImportProducts(c, &pb.ImportCatalogueRequest{
+			File:     data,
+			FileName: fileName,
+			DryRun:   dryRun,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, importRes)
+	}
+}
+
+func ImportFreebiesHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		fileName, data, err := readUpload(c, "file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		dryRun, _ := strconv.ParseBool(c.PostForm("dryRun"))
+
+		importRes, err := ChronexSvc.ImportFreebies(c, &pb.ImportCatalogueRequest{
+			File:     data,
+			FileName: fileName,
+			DryRun:   dryRun,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, importRes)
+	}
+}
+
+// Image Handler
+func UploadImageHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		fileName, data, err := readUpload(c, "file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		uploadRes, err := ChronexSvc.UploadImage(c, &pb.UploadImageRequest{
+			File:     data,
+			FileName: fileName,
+			Kind:     c.PostForm("kind"),
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, uploadRes)
+	}
+}
+
+// GetImageHandler serves the images kept in a LocalBlobStore.
+func GetImageHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		data, contentType, err := ChronexSvc.GetImage(c, strings.TrimPrefix(c.Param("key"), "/"))
+		if errors.Is(err, services.ErrBlobNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "Image not found",
+			})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		// Keys are never reused, so an image can be cached for good
+		c.Header("Cache-Control", "public, max-age=31536000, immutable")
+		c.Data(http.StatusOK, contentType, data)
+	}
+}
+
+// Courier Handler
+func CreateShipmentHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		shipmentDetails := c.MustGet(gin.BindKey).(*binding.CreateShipmentRequest)
+
+		shipmentRes, err := ChronexSvc.CreateShipment(c, &pb.CreateShipmentRequest{
+			OrderId:         shipmentDetails.OrderId,
+			ExpectedVersion: shipmentDetails.ExpectedVersion,
+		})
+
+		if err != nil {
+			c.JSON(lookupErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, shipmentRes)
+	}
+}
+
+func CancelShipmentHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		shipmentDetails := c.MustGet(gin.BindKey).(*binding.CancelShipmentRequest)
+
+		shipmentRes, err := ChronexSvc.CancelShipment(c, &pb.CancelShipmentRequest{
+			OrderId:         shipmentDetails.OrderId,
+			ExpectedVersion: shipmentDetails.ExpectedVersion,
+		})
+
+		if err != nil {
+			c.JSON(lookupErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, shipmentRes)
+	}
+}
+
+func SyncTrackingHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		trackingRes, err := ChronexSvc.SyncTracking(c, &pb.SyncTrackingRequest{
+			OrderId: c.Param("orderId"),
+		})
+
+		if err != nil {
+			c.JSON(lookupErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, trackingRes)
+	}
+}
+
+func GetOrderTimelineHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timelineRes, err := ChronexSvc.GetOrderTimeline(c, &pb.GetOrderTimelineRequest{
+			OrderId: c.Param("orderId"),
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, timelineRes)
+	}
+}
+
+// HandleCourierWebhookHandler passes the raw body through so the courier can
+// verify its signature over the exact bytes it sent.
+func HandleCourierWebhookHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		payload, err := c.GetRawData()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		trackingRes, err := ChronexSvc.HandleCourierWebhook(c, &pb.HandleCourierWebhookRequest{
+			Courier:   c.Param("courier"),
+			Payload:   payload,
+			Signature: c.GetHeader("X-Signature"),
+		})
+
+		if err != nil {
+			code := lookupErrorStatus(err)
+			if status.Code(err) == codes.Unauthenticated {
+				code = http.StatusUnauthorized
+			}
+			c.JSON(code, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, trackingRes)
+	}
+}
+
+// Price Rule Handler
+func SavePriceRuleHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		priceRuleDetails := c.MustGet(gin.BindKey).(*binding.SavePriceRuleRequest)
+
+		priceRuleDetailsRes, err := ChronexSvc.SavePriceRule(c, &pb.SavePriceRuleRequest{
+			RuleName:   priceRuleDetails.RuleName,
+			Category:   priceRuleDetails.Category,
+			ProductId:  priceRuleDetails.ProductId,
+			SalePrice:  priceRuleDetails.SalePrice,
+			PercentOff: priceRuleDetails.PercentOff,
+			StartAt:    priceRuleDetails.StartAt,
+			EndAt:      priceRuleDetails.EndAt,
+			RuleStatus: priceRuleDetails.RuleStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, priceRuleDetailsRes)
+	}
+}
+
+func GetAllPriceRuleHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ruleStatus := c.Query("ruleStatus")
+
+		priceRuleDetailsRes, err := ChronexSvc.GetAllPriceRule(c, &pb.GetAllPriceRuleRequest{
+			RuleStatus: ruleStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, priceRuleDetailsRes)
+	}
+}
+
+func UpdatePriceRuleHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		priceRuleDetails := c.MustGet(gin.BindKey).(*binding.UpdatePriceRuleRequest)
+
+		priceRuleDetailsRes, err := ChronexSvc.UpdatePriceRule(c, &pb.UpdatePriceRuleRequest{
+			PriceRuleId: priceRuleDetails.PriceRuleId,
+			RuleName:    priceRuleDetails.RuleName,
+			Category:    priceRuleDetails.Category,
+			ProductId:   priceRuleDetails.ProductId,
+			SalePrice:   priceRuleDetails.SalePrice,
+			PercentOff:  priceRuleDetails.PercentOff,
+			StartAt:     priceRuleDetails.StartAt,
+			EndAt:       priceRuleDetails.EndAt,
+			UpdateMask:  priceRuleDetails.UpdateMask,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, priceRuleDetailsRes)
+	}
+}
+
+func UpdatePriceRuleStatusHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		priceRuleDetails := c.MustGet(gin.BindKey).(*binding.UpdatePriceRuleStatusRequest)
+
+		priceRuleDetailsRes, err := ChronexSvc.UpdatePriceRuleStatus(c, &pb.UpdatePriceRuleStatusRequest{
+			PriceRuleId: priceRuleDetails.PriceRuleId,
+			RuleStatus:  priceRuleDetails.RuleStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, priceRuleDetailsRes)
+	}
+}
+
+func DeletePriceRuleHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		priceRuleId := c.Param("priceRuleId")
+
+		priceRuleDetailsRes, err := ChronexSvc.DeletePriceRule(c, &pb.DeletePriceRuleRequest{
+			PriceRuleId: priceRuleId,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, priceRuleDetailsRes)
+	}
+}
+
+func GetProductPriceHistoryHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		productId := c.Param("productId")
+
+		priceHistoryRes, err := ChronexSvc.GetProductPriceHistory(c, &pb.GetProductPriceHistoryRequest{
+			ProductId: productId,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, priceHistoryRes)
+	}
+}
+
+// Order Handler
+func SaveOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		orderDetails := c.MustGet(gin.BindKey).(*binding.SaveOrderRequest)
+
+		orderDetailsRes, err := ChronexSvc.SaveOrder(c, &pb.SaveOrderRequest{
+			Customer:        string(orderDetails.Customer),
+			CompleteAddress: string(orderDetails.CompleteAddress),
+			Product:         string(orderDetails.Product),
+			Total:           orderDetails.Total,
+			OrderStatus:     orderDetails.OrderStatus,
+			VoucherCode:     orderDetails.VoucherCode,
+			PaymentMethod:   orderDetails.PaymentMethod,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		setETag(c, orderDetailsRes.OrderData.GetVersion())
+		c.JSON(http.StatusOK, orderDetailsRes)
+	}
+}
+
+func GetAllOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sort := c.Param("sort")
+		search := c.Query("search")
+		orderStatus := c.Query("orderStatus")
+
+		orderDetailsRes, err := ChronexSvc.GetAllOrder(c, &pb.GetAllOrderRequest{
+			Search:          search,
+			SortOptionOrder: sort,
+			OrderStatus:     orderStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, orderDetailsRes)
+	}
+}
+
+func UpdateOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		orderDetails := c.MustGet(gin.BindKey).(*binding.UpdateOrderRequest)
+
+		expectedVersion, err := ifMatchVersion(c, orderDetails.ExpectedVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		orderDetailsRes, err := ChronexSvc.UpdateOrder(c, &pb.UpdateOrderRequest{
+			OrderId:         orderDetails.OrderId,
+			Customer:        string(orderDetails.Customer),
+			CompleteAddress: string(orderDetails.CompleteAddress),
+			Product:         string(orderDetails.Product),
+			Total:           orderDetails.Total,
+			OrderStatus:     orderDetails.OrderStatus,
+			TrackingId:      orderDetails.TrackingId,
+			StickyNotes:     string(orderDetails.StickyNotes),
+			UpdateMask:      orderDetails.UpdateMask,
+			ExpectedVersion: expectedVersion,
+		})
+
+		if err != nil {
+			c.JSON(updateErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		setETag(c, orderDetailsRes.OrderData.GetVersion())
+		c.JSON(http.StatusOK, orderDetailsRes)
+	}
+}
+
+func UpdateOrderStatusHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		orderDetails := c.MustGet(gin.BindKey).(*binding.UpdateOrderStatusRequest)
+
+		expectedVersion, err := ifMatchVersion(c, orderDetails.ExpectedVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		orderDetailsRes, err := ChronexSvc.UpdateOrderStatus(c, &pb.UpdateOrderStatusRequest{
+			OrderId:         orderDetails.OrderId,
+			OrderStatus:     orderDetails.OrderStatus,
+			ExpectedVersion: expectedVersion,
+		})
+
+		if err != nil {
+			c.JSON(updateErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		setETag(c, orderDetailsRes.OrderData.GetVersion())
+		c.JSON(http.StatusOK, orderDetailsRes)
+	}
+}
+
+func DeleteOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		orderId := c.Param("orderId")
+
+		orderDetailsRes, err := ChronexSvc.DeleteOrder(c, &pb.DeleteOrderRequest{
+			OrderId: orderId,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, orderDetailsRes)
+	}
+}
+
+func GetTotalRevenueHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		orderStatus := c.Query("orderStatus")
+
+		orderDetailsRes, err := ChronexSvc.GetAllOrderRevenue(c, &pb.GetAllOrderRevenueRequest{
+			OrderStatus: orderStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, orderDetailsRes)
+	}
+}
+
+func GetAllTotalOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		orderStatus := c.Query("orderStatus")
+
+		orderDetailsRes, err := ChronexSvc.GetAllTotalOrder(c, &pb.GetAllTotalOrderRequest{
+			OrderStatus: orderStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, orderDetailsRes)
+	}
+}
+
+func GetBestSellingProductsHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		orderStatus := c.Query("orderStatus")
+
+		orderDetailsRes, err := ChronexSvc.GetBestSellingProducts(c, &pb.GetBestSellingProductsRequest{
+			OrderStatus: orderStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, orderDetailsRes)
+	}
+}
+
+// Order Lookup Handler
+func LookupOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		orderId := c.Param("orderId")
+		email := c.Query("email")
+		token := c.Query("token")
+
+		orderDetailsRes, err := ChronexSvc.LookupOrder(c, &pb.LookupOrderRequest{
+			OrderId:      orderId,
+			EmailAddress: email,
+			Token:        token,
+		})
+
+		if err != nil {
+			c.JSON(lookupErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, orderDetailsRes)
+	}
+}
+
+func CancelOrderHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		orderDetails := c.MustGet(gin.BindKey).(*binding.CancelOrderRequest)
+
+		orderDetailsRes, err := ChronexSvc.CancelOrder(c, &pb.CancelOrderRequest{
+			OrderId:      orderDetails.OrderId,
+			EmailAddress: orderDetails.EmailAddress,
+			Token:        orderDetails.Token,
+		})
+
+		if err != nil {
+			c.JSON(lookupErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, orderDetailsRes)
+	}
+}
+
+// Customer Handler
+func GetAllCustomerHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		search := c.Query("search")
+		sort := c.Query("sort")
+
+		customerDetailsRes, err := ChronexSvc.GetAllCustomer(c, &pb.GetAllCustomerRequest{
+			Search:     search,
+			SortOption: sort,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, customerDetailsRes)
+	}
+}
+
+func GetCustomerByIdHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		customerId := c.Param("customerId")
+
+		customerDetailsRes, err := ChronexSvc.GetCustomerById(c, &pb.GetCustomerByIdRequest{
+			CustomerId: customerId,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, customerDetailsRes)
+	}
+}
+
+// Reviews Handler
+func SaveReviewsHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reviewsDetails := c.MustGet(gin.BindKey).(*binding.SaveReviewsRequest)
+
+		reviewsDetailsRes, err := ChronexSvc.SaveReviews(c, &pb.SaveReviewsRequest{
+			ProductId:         reviewsDetails.ProductId,
+			ReviewsName:       reviewsDetails.ReviewsName,
+			ReviewsSubject:    reviewsDetails.ReviewsSubject,
+			ReviewsMessage:    reviewsDetails.ReviewsMessage,
+			ReviewsStarRating: reviewsDetails.ReviewsStarRating,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		setETag(c, reviewsDetailsRes.ReviewsData.GetVersion())
+		c.JSON(http.StatusOK, reviewsDetailsRes)
+	}
+}
+
+func GetAllReviewsHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sort := c.Param("sort")
+		search := c.Query("search")
+
+		reviewsDetailsRes, err := ChronexSvc.GetAllReviews(c, &pb.GetAllReviewsRequest{
+			Search:            search,
+			SortOptionReviews: sort,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, reviewsDetailsRes)
+	}
+}
+
+func UpdateReviewsHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reviewsDetails := c.MustGet(gin.BindKey).(*binding.UpdateReviewsRequest)
+
+		expectedVersion, err := ifMatchVersion(c, reviewsDetails.ExpectedVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		reviewsDetailsRes, err := ChronexSvc.UpdateReviews(c, &pb.UpdateReviewsRequest{
+			ReviewsId:         reviewsDetails.ReviewsId,
+			ProductId:         reviewsDetails.ProductId,
+			ReviewsName:       reviewsDetails.ReviewsName,
+			ReviewsSubject:    reviewsDetails.ReviewsSubject,
+			ReviewsMessage:    reviewsDetails.ReviewsMessage,
+			ReviewsStarRating: reviewsDetails.ReviewsStarRating,
+			UpdateMask:        reviewsDetails.UpdateMask,
+			ExpectedVersion:   expectedVersion,
+		})
+
+		if err != nil {
+			c.JSON(updateErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		setETag(c, reviewsDetailsRes.ReviewsData.GetVersion())
+		c.JSON(http.StatusOK, reviewsDetailsRes)
+	}
+}
+
+func UpdateReviewsStatusHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reviewsDetails := c.MustGet(gin.BindKey).(*binding.UpdateReviewsStatusRequest)
+
+		expectedVersion, err := ifMatchVersion(c, reviewsDetails.ExpectedVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		reviewsDetailsRes, err := ChronexSvc.UpdateReviewsStatus(c, &pb.UpdateReviewsStatusRequest{
+			ReviewsId:       reviewsDetails.ReviewsId,
+			ReviewsStatus:   reviewsDetails.ReviewsStatus,
+			ExpectedVersion: expectedVersion,
+		})
+
+		if err != nil {
+			c.JSON(updateErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		setETag(c, reviewsDetailsRes.ReviewsData.GetVersion())
+		c.JSON(http.StatusOK, reviewsDetailsRes)
+	}
+}
+
+func DeleteReviewsHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reviewsId := c.Param("reviewsId")
+
+		reviewsDetailsRes, err := ChronexSvc.DeleteReviews(c, &pb.DeleteReviewsRequest{
+			ReviewsId: reviewsId,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, reviewsDetailsRes)
+	}
+}
+
+func GetAllReviewsByIdHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reviewsId := c.Param("reviewsId")
+
+		// Call the company service
+		reviewsDetailsRes, err := ChronexSvc.GetAllReviewsById(c, &pb.GetAllReviewsRequestById{
+			ReviewsId: reviewsId,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		if len(reviewsDetailsRes.ReviewsData) == 1 {
+			setETag(c, reviewsDetailsRes.ReviewsData[0].GetVersion())
+		}
+		c.JSON(http.StatusOK, reviewsDetailsRes)
+	}
+}
+
+// EMAIL-SENDING
+func SubmitContactFormHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		contactDetails := c.MustGet(gin.BindKey).(*binding.SubmitContactFormRequest)
+
+		contactRes, err := ChronexSvc.SubmitContactForm(c, &pb.SubmitContactFormRequest{
+			Name:         contactDetails.Name,
+			EmailAddress: contactDetails.EmailAddress,
+			Subject:      contactDetails.Subject,
+			Message:      contactDetails.Message,
+			Website:      contactDetails.Website,
+			CaptchaToken: contactDetails.CaptchaToken,
+			RemoteIp:     c.ClientIP(),
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, contactRes)
+	}
+}
+
+func SendOrderEmailHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		emailDetails := c.MustGet(gin.BindKey).(*binding.SendOrderEmailRequest)
+
+		emailOutboxRes, err := ChronexSvc.SendOrderEmail(c, &pb.SendOrderEmailRequest{
+			OrderId: emailDetails.OrderId,
+			Event:   emailDetails.Event,
+			Subject: emailDetails.Subject,
+			Message: emailDetails.Message,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, emailOutboxRes)
+	}
+}
+
+func PreviewOrderEmailHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		event := c.Param("event")
+		orderId := c.Param("orderId")
+
+		previewRes, err := ChronexSvc.PreviewOrderEmail(c, &pb.PreviewOrderEmailRequest{
+			Event:   event,
+			OrderId: orderId,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		// Render the email itself unless the caller asks for the JSON envelope
+		if c.Query("format") == "json" {
+			c.JSON(http.StatusOK, previewRes)
+			return
+		}
+
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(previewRes.Html))
+	}
+}
+
+func GetAllEmailOutboxHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		search := c.Query("search")
+		emailStatus := c.Query("emailStatus")
+		orderId := c.Query("orderId")
+
+		emailOutboxRes, err := ChronexSvc.GetAllEmailOutbox(c, &pb.GetAllEmailOutboxRequest{
+			Search:      search,
+			EmailStatus: emailStatus,
+			OrderId:     orderId,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, emailOutboxRes)
+	}
+}
+
+func ResendEmailOutboxHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		emailOutboxId := c.Param("emailOutboxId")
+
+		emailOutboxRes, err := ChronexSvc.ResendEmailOutbox(c, &pb.ResendEmailOutboxRequest{
+			EmailOutboxId: emailOutboxId,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, emailOutboxRes)
+	}
+}
+
+// PAYMENT
+func GetOrderPaymentHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		orderId := c.Param("orderId")
+
+		paymentRes, err := ChronexSvc.GetOrderPayment(c, &pb.GetOrderPaymentRequest{
+			OrderId: orderId,
+		})
+
+		if err != nil {
+			c.JSON(lookupErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, paymentRes)
+	}
+}
+
+func RecordPaymentHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		paymentDetails := c.MustGet(gin.BindKey).(*binding.RecordPaymentRequest)
+
+		paymentRes, err := ChronexSvc.RecordPayment(c, &pb.RecordPaymentRequest{
+			OrderId:           paymentDetails.OrderId,
+			PaymentMethod:     paymentDetails.PaymentMethod,
+			Amount:            paymentDetails.Amount,
+			ProviderReference: paymentDetails.ProviderReference,
+			PaymentStatus:     paymentDetails.PaymentStatus,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, paymentRes)
+	}
+}
+
+func UpdatePaymentStatusHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		paymentDetails := c.MustGet(gin.BindKey).(*binding.UpdatePaymentStatusRequest)
+
+		paymentRes, err := ChronexSvc.UpdatePaymentStatus(c, &pb.UpdatePaymentStatusRequest{
+			PaymentId:         paymentDetails.PaymentId,
+			PaymentStatus:     paymentDetails.PaymentStatus,
+			ProviderReference: paymentDetails.ProviderReference,
+			FailureReason:     paymentDetails.FailureReason,
+		})
+
+		if err != nil {
+			c.JSON(lookupErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, paymentRes)
+	}
+}
+
+// HandlePaymentWebhookHandler passes the raw body through so the provider can
+// verify its signature over the exact bytes it sent.
+func HandlePaymentWebhookHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		payload, err := c.GetRawData()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		paymentRes, err := ChronexSvc.HandlePaymentWebhook(c, &pb.HandlePaymentWebhookRequest{
+			Provider:  c.Param("provider"),
+			Payload:   payload,
+			Signature: c.GetHeader("X-Signature"),
+		})
+
+		if err != nil {
+			code := lookupErrorStatus(err)
+			if status.Code(err) == codes.Unauthenticated {
+				code = http.StatusUnauthorized
+			}
+			c.JSON(code, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, paymentRes)
+	}
+}
+
+func RefundPaymentHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		refundDetails := c.MustGet(gin.BindKey).(*binding.RefundPaymentRequest)
+
+		refundRes, err := ChronexSvc.RefundPayment(c, &pb.RefundPaymentRequest{
+			PaymentId:         refundDetails.PaymentId,
+			Amount:            refundDetails.Amount,
+			Reason:            refundDetails.Reason,
+			ReturnId:          refundDetails.ReturnId,
+			ProviderReference: refundDetails.ProviderReference,
+		})
+
+		if err != nil {
+			c.JSON(lookupErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, refundRes)
 	}
 }
 
-func GetAllReviewsHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+// RETURN
+func SaveReturnHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		sort := c.Param("sort")
-		search := c.Query("search")
+		returnDetails := c.MustGet(gin.BindKey).(*binding.SaveReturnRequest)
 
-		reviewsDetailsRes, err := ChronexSvc.GetAllReviews(c, &pb.GetAllReviewsRequest{
-			Search:            search,
-			SortOptionReviews: sort,
+		returnRes, err := ChronexSvc.SaveReturn(c, &pb.SaveReturnRequest{
+			OrderId: returnDetails.OrderId,
+			Items:   string(returnDetails.Items),
+			Reason:  returnDetails.Reason,
 		})
 
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{
+			c.JSON(lookupErrorStatus(err), gin.H{
 				"error": err.Error(),
 			})
 			return
 		}
 
-		c.JSON(http.StatusOK, reviewsDetailsRes)
+		c.JSON(http.StatusOK, returnRes)
 	}
 }
 
-func UpdateReviewsHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+func RequestReturnHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		reviewsDetails := c.MustGet(gin.BindKey).(*binding.UpdateReviewsRequest)
-
-		reviewsDetailsRes, err := ChronexSvc.UpdateReviews(c, &pb.UpdateReviewsRequest{
-			ReviewsId:         reviewsDetails.ReviewsId,
-			ProductId:         reviewsDetails.ProductId,
-			ReviewsName:       reviewsDetails.ReviewsName,
-			ReviewsSubject:    reviewsDetails.ReviewsSubject,
-			ReviewsMessage:    reviewsDetails.ReviewsMessage,
-			ReviewsStarRating: reviewsDetails.ReviewsStarRating,
+		returnDetails := c.MustGet(gin.BindKey).(*binding.RequestReturnRequest)
+
+		returnRes, err := ChronexSvc.RequestReturn(c, &pb.RequestReturnRequest{
+			OrderId:      returnDetails.OrderId,
+			EmailAddress: returnDetails.EmailAddress,
+			Token:        returnDetails.Token,
+			Items:        string(returnDetails.Items),
+			Reason:       returnDetails.Reason,
 		})
 
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{
+			c.JSON(lookupErrorStatus(err), gin.H{
 				"error": err.Error(),
 			})
 			return
 		}
 
-		c.JSON(http.StatusOK, reviewsDetailsRes)
+		c.JSON(http.StatusOK, returnRes)
 	}
 }
 
-func UpdateReviewsStatusHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+func GetAllReturnHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		reviewsDetails := c.MustGet(gin.BindKey).(*binding.UpdateReviewsStatusRequest)
+		search := c.Query("search")
+		returnStatus := c.Query("returnStatus")
+		orderId := c.Query("orderId")
 
-		reviewsDetailsRes, err := ChronexSvc.UpdateReviewsStatus(c, &pb.UpdateReviewsStatusRequest{
-			ReviewsId:     reviewsDetails.ReviewsId,
-			ReviewsStatus: reviewsDetails.ReviewsStatus,
+		returnRes, err := ChronexSvc.GetAllReturn(c, &pb.GetAllReturnRequest{
+			Search:       search,
+			ReturnStatus: returnStatus,
+			OrderId:      orderId,
 		})
 
 		if err != nil {
@@ -646,17 +2512,16 @@ func UpdateReviewsStatusHandler(ChronexSvc *services.ChronexAdminService) gin.Ha
 			return
 		}
 
-		c.JSON(http.StatusOK, reviewsDetailsRes)
+		c.JSON(http.StatusOK, returnRes)
 	}
 }
 
-func GetAllReviewsByIdHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+func GetReturnByIdHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		reviewsId := c.Param("reviewsId")
+		returnId := c.Param("returnId")
 
-		// Call the company service
-		reviewsDetailsRes, err := ChronexSvc.GetAllReviewsById(c, &pb.GetAllReviewsRequestById{
-			ReviewsId: reviewsId,
+		returnRes, err := ChronexSvc.GetReturnById(c, &pb.GetReturnByIdRequest{
+			ReturnId: returnId,
 		})
 
 		if err != nil {
@@ -666,54 +2531,51 @@ func GetAllReviewsByIdHandler(ChronexSvc *services.ChronexAdminService) gin.Hand
 			return
 		}
 
-		c.JSON(http.StatusOK, reviewsDetailsRes)
+		c.JSON(http.StatusOK, returnRes)
 	}
 }
 
-// EMAIL-SENDING
-func sendEmailHandler(vi *viper.Viper) gin.HandlerFunc {
+func UpdateReturnStatusHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Parse request body to get email details
-		USER := vi.GetString("EMAIL_USER")
-		PASS := vi.GetString("EMAIL_PASS")
+		returnDetails := c.MustGet(gin.BindKey).(*binding.UpdateReturnStatusRequest)
 
-		var emailData struct {
-			To      string `json:"to"`
-			Subject string `json:"subject"`
-			Body    string `json:"body"`
-		}
-		if err := c.BindJSON(&emailData); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		returnRes, err := ChronexSvc.UpdateReturnStatus(c, &pb.UpdateReturnStatusRequest{
+			ReturnId:        returnDetails.ReturnId,
+			ReturnStatus:    returnDetails.ReturnStatus,
+			Note:            returnDetails.Note,
+			ExpectedVersion: returnDetails.ExpectedVersion,
+		})
+
+		if err != nil {
+			c.JSON(updateErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
 			return
 		}
 
-		// Set up your email authentication credentials
-		email := USER
-		password := PASS
-
-		// Initialize a new SMTP dialer
-		d := mail.NewDialer("smtp.gmail.com", 587, email, password)
+		c.JSON(http.StatusOK, returnRes)
+	}
+}
 
-		// Set up TLS configuration to avoid certificate verification errors
-		d.TLSConfig = &tls.Config{InsecureSkipVerify: true}
+func ReceiveReturnHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		returnDetails := c.MustGet(gin.BindKey).(*binding.ReceiveReturnRequest)
 
-		// Create a new email message
-		m := mail.NewMessage()
-		m.SetHeader("From", email)
-		m.SetHeader("To", emailData.To)
-		m.SetHeader("Subject", emailData.Subject)
-		// Set the email body as HTML
-		m.SetBody("text/html", emailData.Body)
+		returnRes, err := ChronexSvc.ReceiveReturn(c, &pb.ReceiveReturnRequest{
+			ReturnId:        returnDetails.ReturnId,
+			Items:           string(returnDetails.Items),
+			Note:            returnDetails.Note,
+			ExpectedVersion: returnDetails.ExpectedVersion,
+		})
 
-		// Dial and send the email
-		if err := d.DialAndSend(m); err != nil {
-			log.Printf("Failed to send email: %v", err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send email"})
+		if err != nil {
+			c.JSON(updateErrorStatus(err), gin.H{
+				"error": err.Error(),
+			})
 			return
 		}
 
-		log.Println("Email sent successfully!")
-		c.JSON(http.StatusOK, gin.H{"message": "Email sent successfully"})
+		c.JSON(http.StatusOK, returnRes)
 	}
 }
 
@@ -752,10 +2614,23 @@ func generateExcelRevenue(c *gin.Context, db *gorm.DB) {
 		year = time.Now().Year()
 	}
 
-	// Filter orders by month, year, and order status
-	db.Where("extract(month from created_at) = ? AND extract(year from created_at) = ? AND order_status = ?", month, year, "DLV").
-		Order("created_at asc").
-		Find(&results)
+	// Revenue is the payments captured in the month, with the order each one paid for
+	var payments []models.PaymentData
+	db.Where("extract(month from captured_at) = ? AND extract(year from captured_at) = ? AND payment_status = ?", month, year, "CAP").
+		Order("captured_at asc").
+		Find(&payments)
+
+	orderIds := []uuid.UUID{}
+	for _, payment := range payments {
+		orderIds = append(orderIds, payment.OrderId)
+	}
+	orderById := map[uuid.UUID]models.OrderData{}
+	if len(orderIds) > 0 {
+		db.Unscoped().Where("order_id IN ?", orderIds).Find(&results)
+	}
+	for _, result := range results {
+		orderById[result.OrderId] = result
+	}
 
 	// Create new Excel file
 	file := xlsx.NewFile()
@@ -769,27 +2644,80 @@ func generateExcelRevenue(c *gin.Context, db *gorm.DB) {
 	header := sheet.AddRow()
 	header.AddCell().SetValue("DATE")
 	header.AddCell().SetValue("ORDER ID")
+	header.AddCell().SetValue("ORDER NUMBER")
 	header.AddCell().SetValue("TRACKING ID")
 	header.AddCell().SetValue("CUSTOMER")
 	header.AddCell().SetValue("COMPLETE ADDRESS")
 	header.AddCell().SetValue("PRODUCT")
 	header.AddCell().SetValue("ORDER STATUS")
+	header.AddCell().SetValue("PAYMENT METHOD")
+	header.AddCell().SetValue("PAYMENT REFERENCE")
+	header.AddCell().SetValue("VOUCHER")
+	header.AddCell().SetValue("GROSS")
+	header.AddCell().SetValue("DISCOUNT")
 	header.AddCell().SetValue("TOTAL")
 
-	// Add data rows
-	var grandTotal float64
-	for _, result := range results {
+	// Add data rows, splitting the order's voucher discount across its payments
+	var grandGross, grandDiscount, grandTotal float64
+	for _, payment := range payments {
+		result := orderById[payment.OrderId]
+		discount := result.VoucherDiscount
+		if result.Total > 0 {
+			discount = result.VoucherDiscount * payment.Amount / result.Total
+		}
+
+		row := sheet.AddRow()
+		row.AddCell().SetValue(payment.CapturedAt.String())
+		row.AddCell().SetValue(result.OrderId.String())
+		row.AddCell().SetValue(result.OrderNumber)
+		row.AddCell().SetValue(result.TrackingId)
+		row.AddCell().SetValue(string(result.Customer))
+		row.AddCell().SetValue(string(result.CompleteAddress))
+		row.AddCell().SetValue(string(result.Product))
+		row.AddCell().SetValue(result.OrderStatus)
+		row.AddCell().SetValue(payment.PaymentMethod)
+		row.AddCell().SetValue(payment.ProviderReference)
+		row.AddCell().SetValue(result.VoucherCode)
+		row.AddCell().SetValue(fmt.Sprintf("%.2f", payment.Amount+discount))
+		row.AddCell().SetValue(fmt.Sprintf("%.2f", discount))
+		row.AddCell().SetValue(fmt.Sprintf("%.2f", payment.Amount))
+		// Accumulate totals for grand total
+		grandGross += payment.Amount + discount
+		grandDiscount += discount
+		grandTotal += payment.Amount
+	}
+
+	// Refunds given in the month come off as negative rows
+	var refunds []models.RefundData
+	db.Where("extract(month from created_at) = ? AND extract(year from created_at) = ?", month, year).
+		Order("created_at asc").
+		Find(&refunds)
+
+	for _, refund := range refunds {
+		result, ok := orderById[refund.OrderId]
+		if !ok {
+			db.Unscoped().First(&result, "order_id = ?", refund.OrderId)
+			orderById[refund.OrderId] = result
+		}
+
 		row := sheet.AddRow()
-		row.AddCell().SetValue(result.CreatedAt.String())
+		row.AddCell().SetValue(refund.CreatedAt.String())
 		row.AddCell().SetValue(result.OrderId.String())
+		row.AddCell().SetValue(result.OrderNumber)
 		row.AddCell().SetValue(result.TrackingId)
 		row.AddCell().SetValue(string(result.Customer))
 		row.AddCell().SetValue(string(result.CompleteAddress))
 		row.AddCell().SetValue(string(result.Product))
 		row.AddCell().SetValue(result.OrderStatus)
-		row.AddCell().SetValue(fmt.Sprintf("%.2f", result.Total))
-		// Accumulate total for grand total
-		grandTotal += result.Total
+		row.AddCell().SetValue("REFUND")
+		row.AddCell().SetValue(refund.ProviderReference)
+		row.AddCell().SetValue(result.VoucherCode)
+		row.AddCell().SetValue(fmt.Sprintf("%.2f", -refund.Amount))
+		row.AddCell().SetValue(fmt.Sprintf("%.2f", 0.0))
+		row.AddCell().SetValue(fmt.Sprintf("%.2f", -refund.Amount))
+		// Accumulate totals for grand total
+		grandGross -= refund.Amount
+		grandTotal -= refund.Amount
 	}
 
 	// Add two empty rows
@@ -798,13 +2726,12 @@ func generateExcelRevenue(c *gin.Context, db *gorm.DB) {
 
 	// Add grand total row
 	grandTotalRow := sheet.AddRow()
-	grandTotalRow.AddCell().SetValue("")
-	grandTotalRow.AddCell().SetValue("")
-	grandTotalRow.AddCell().SetValue("")
-	grandTotalRow.AddCell().SetValue("")
-	grandTotalRow.AddCell().SetValue("")
-	grandTotalRow.AddCell().SetValue("")
+	for i := 0; i < 10; i++ {
+		grandTotalRow.AddCell().SetValue("")
+	}
 	grandTotalRow.AddCell().SetValue("Grand Total:")
+	grandTotalRow.AddCell().SetValue(fmt.Sprintf("%.2f", grandGross))
+	grandTotalRow.AddCell().SetValue(fmt.Sprintf("%.2f", grandDiscount))
 	grandTotalRow.AddCell().SetValue(fmt.Sprintf("%.2f", grandTotal))
 
 	// Create a temporary file to store the Excel
@@ -973,6 +2900,7 @@ func generateExcelBestSellingProducts(c *gin.Context, db *gorm.DB) {
 			chronex_product_order
 		WHERE
 			order_status = ? 
+			AND deleted_at IS NULL
 			AND EXTRACT(YEAR FROM created_at) = ? 
 			AND EXTRACT(MONTH FROM created_at) = ?
 		)
@@ -1181,6 +3109,7 @@ func SaveHomeImages(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
 			return
 		}
 
+		setETag(c, homeImagesDetailsRes.HomeImagesData.GetVersion())
 		c.JSON(http.StatusOK, homeImagesDetailsRes)
 	}
 }
@@ -1205,18 +3134,28 @@ func UpdateHomeImagesHandler(ChronexSvc *services.ChronexAdminService) gin.Handl
 	return func(c *gin.Context) {
 		homeImagesDetails := c.MustGet(gin.BindKey).(*binding.UpdateHomeImagesRequest)
 
+		expectedVersion, err := ifMatchVersion(c, homeImagesDetails.ExpectedVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
 		homeImagesDetailsRes, err := ChronexSvc.UpdateHomeImages(c, &pb.UpdateHomeImagesRequest{
-			HomeImagesId: homeImagesDetails.HomeImagesId,
-			HomeImg:      homeImagesDetails.HomeImg,
+			HomeImagesId:    homeImagesDetails.HomeImagesId,
+			HomeImg:         homeImagesDetails.HomeImg,
+			ExpectedVersion: expectedVersion,
 		})
 
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{
+			c.JSON(updateErrorStatus(err), gin.H{
 				"error": err.Error(),
 			})
 			return
 		}
 
+		setETag(c, homeImagesDetailsRes.HomeImagesData.GetVersion())
 		c.JSON(http.StatusOK, homeImagesDetailsRes)
 	}
 }
@@ -1240,12 +3179,75 @@ func DeleteHomeImagesHandler(ChronexSvc *services.ChronexAdminService) gin.Handl
 	}
 }
 
+// Trash Handler
+func GetAllTrashHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		entity := c.Param("entity")
+
+		trashDetailsRes, err := ChronexSvc.GetAllTrash(c, &pb.GetAllTrashRequest{
+			Entity: entity,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, trashDetailsRes)
+	}
+}
+
+func RestoreTrashHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		entity := c.Param("entity")
+		id := c.Param("id")
+
+		trashDetailsRes, err := ChronexSvc.RestoreTrash(c, &pb.RestoreTrashRequest{
+			Entity: entity,
+			Id:     id,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, trashDetailsRes)
+	}
+}
+
+func PurgeTrashHandler(ChronexSvc *services.ChronexAdminService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		entity := c.Param("entity")
+		id := c.Param("id")
+
+		trashDetailsRes, err := ChronexSvc.PurgeTrash(c, &pb.PurgeTrashRequest{
+			Entity: entity,
+			Id:     id,
+		})
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, trashDetailsRes)
+	}
+}
+
 // CORS Middleware
 func corsMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, If-Match")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "ETag")
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(http.StatusOK)
@@ -1256,6 +3258,27 @@ func corsMiddleware() gin.HandlerFunc {
 	}
 }
 
+// adminAuthMiddleware requires the bearer token on every /admin/ route. With
+// no token configured the admin routes stay open, as they were before.
+func adminAuthMiddleware(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token == "" || !strings.HasPrefix(c.Request.URL.Path, "/admin/") {
+			c.Next()
+			return
+		}
+
+		bearer := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": "Unauthorized",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
 func getDatabase(env *viper.Viper) (*gorm.DB, error) {
 	database, err := config.InitDatabase(env)
 
@@ -1266,6 +3289,390 @@ func getDatabase(env *viper.Viper) (*gorm.DB, error) {
 	return database, nil
 }
 
+// ifMatchVersion returns the version named by the If-Match header, falling
+// back to the expectedVersion sent in the request body when there is no
+// header. A header that does not name a version is an error.
+func ifMatchVersion(c *gin.Context, fallback int64) (int64, error) {
+	ifMatch := strings.TrimSpace(c.GetHeader("If-Match"))
+	if ifMatch == "" {
+		return fallback, nil
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), "\""), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid If-Match header %q", ifMatch)
+	}
+
+	return version, nil
+}
+
+func setETag(c *gin.Context, version int64) {
+	if version > 0 {
+		c.Header("ETag", fmt.Sprintf("\"%d\"", version))
+	}
+}
+
+// updateErrorStatus answers a lost optimistic-lock race with 412 Precondition Failed.
+func updateErrorStatus(err error) int {
+	if status.Code(err) == codes.Aborted {
+		return http.StatusPreconditionFailed
+	}
+
+	return http.StatusBadRequest
+}
+
+// lookupErrorStatus maps errors from the public order endpoints to HTTP status codes.
+func lookupErrorStatus(err error) int {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition, codes.Aborted:
+		return http.StatusConflict
+	}
+
+	return http.StatusBadRequest
+}
+
+// streamSpreadsheet sends an export as a CSV or XLSX download named after
+// name and the current time.
+func streamSpreadsheet(c *gin.Context, name string, format string, export func(out io.Writer) error) {
+	contentType := "text/csv; charset=utf-8"
+	if format == services.SpreadsheetXLSX {
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s-%s.%s", name, time.Now().Format("20060102-150405"), format))
+	c.Header("Content-Type", contentType)
+
+	if err := export(c.Writer); err != nil {
+		// Once rows have been streamed the status line is gone, so only log
+		if !c.Writer.Written() {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		log.Printf("Error exporting %s: %v", name, err)
+	}
+}
+
+// maxUploadBytes caps spreadsheet uploads.
+const maxUploadBytes = 10 << 20
+
+// readUpload reads the multipart file in field, up to maxUploadBytes.
+func readUpload(c *gin.Context, field string) (string, []byte, error) {
+	header, err := c.FormFile(field)
+	if err != nil {
+		return "", nil, fmt.Errorf("missing %s upload: %w", field, err)
+	}
+	if header.Size > maxUploadBytes {
+		return "", nil, fmt.Errorf("%s is larger than %d MB", header.Filename, maxUploadBytes>>20)
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return "", nil, err
+	}
+	defer file.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(file, maxUploadBytes))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return header.Filename, data, nil
+}
+
+// queryNumber reads an optional number from the query string, or 0.
+func queryNumber(c *gin.Context, name string) (float64, error) {
+	value := c.Query(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a number", name)
+	}
+	return number, nil
+}
+
 func getPort(env *viper.Viper) string {
 	return env.GetString("PORT")
 }
+
+// getMailer builds the email transport from EMAIL_TRANSPORT: "smtp" (the
+// default when EMAIL_USER is set), "file" to write emails to EMAIL_FILE_DIR,
+// or "log" to only log them. Logging drops the emails, so it has to be asked
+// for; the server will not start without a transport.
+func getMailer(env *viper.Viper) services.Mailer {
+	transport := strings.ToLower(env.GetString("EMAIL_TRANSPORT"))
+	if transport == "" && env.GetString("EMAIL_USER") != "" {
+		transport = "smtp"
+	}
+
+	switch transport {
+	case "smtp":
+		env.SetDefault("SMTP_HOST", "smtp.gmail.com")
+		env.SetDefault("SMTP_PORT", 587)
+		env.SetDefault("SMTP_TLS", "starttls")
+		env.SetDefault("EMAIL_FROM", env.GetString("EMAIL_USER"))
+
+		return services.SMTPMailer{
+			Host:               env.GetString("SMTP_HOST"),
+			Port:               env.GetInt("SMTP_PORT"),
+			Username:           env.GetString("EMAIL_USER"),
+			Password:           env.GetString("EMAIL_PASS"),
+			From:               env.GetString("EMAIL_FROM"),
+			TLSMode:            strings.ToLower(env.GetString("SMTP_TLS")),
+			InsecureSkipVerify: env.GetBool("SMTP_TLS_SKIP_VERIFY"),
+		}
+	case "file":
+		env.SetDefault("EMAIL_FILE_DIR", "outbox")
+
+		return services.FileMailer{Dir: env.GetString("EMAIL_FILE_DIR")}
+	case "log":
+		return services.LogMailer{}
+	case "":
+		log.Fatalf("No email transport configured, set EMAIL_USER or EMAIL_TRANSPORT")
+		return nil
+	default:
+		log.Fatalf("Unknown EMAIL_TRANSPORT %q", transport)
+		return nil
+	}
+}
+
+// getOrderEmailEnabled reads the EMAIL_<EVENT>_ENABLED switches. Every order
+// email is on unless switched off.
+func getOrderEmailEnabled(env *viper.Viper) map[string]bool {
+	enabled := map[string]bool{}
+	for _, event := range []string{services.OrderEmailConfirmation, services.OrderEmailShipped, services.OrderEmailDelivered} {
+		key := "EMAIL_" + event + "_ENABLED"
+		env.SetDefault(key, true)
+		enabled[event] = env.GetBool(key)
+	}
+
+	return enabled
+}
+
+// getCaptcha returns the captcha verifier for public forms, or nil when no
+// CAPTCHA_SECRET is configured. CAPTCHA_VERIFY_URL defaults to reCAPTCHA.
+func getCaptcha(env *viper.Viper) services.CaptchaVerifier {
+	secret := env.GetString("CAPTCHA_SECRET")
+	if secret == "" {
+		return nil
+	}
+
+	env.SetDefault("CAPTCHA_VERIFY_URL", "https://www.google.com/recaptcha/api/siteverify")
+
+	return services.SiteVerifyCaptcha{
+		URL:    env.GetString("CAPTCHA_VERIFY_URL"),
+		Secret: secret,
+	}
+}
+
+// getPaymentProvider returns the provider for card and e-wallet payments.
+// PAYMENT_PROVIDER defaults to "none", which turns online payments off;
+// "fake" is the in-process provider for local testing. Webhooks are verified
+// with PAYMENT_WEBHOOK_SECRET.
+func getPaymentProvider(env *viper.Viper) services.PaymentProvider {
+	env.SetDefault("PAYMENT_PROVIDER", "none")
+
+	switch provider := env.GetString("PAYMENT_PROVIDER"); provider {
+	case "fake":
+		return services.FakePaymentProvider{WebhookSecret: []byte(env.GetString("PAYMENT_WEBHOOK_SECRET"))}
+	case "", "none":
+		return nil
+	default:
+		log.Fatalf("Unknown PAYMENT_PROVIDER %q", provider)
+		return nil
+	}
+}
+
+// getCourier returns the courier shipments are booked with. COURIER defaults
+// to "none", which turns booking off; "fake" is the in-process courier for
+// local testing. COURIER_WEBHOOK_SECRET verifies its status pushes.
+func getCourier(env *viper.Viper) services.Courier {
+	env.SetDefault("COURIER", "none")
+
+	switch courier := env.GetString("COURIER"); courier {
+	case "fake":
+		return services.NewFakeCourier([]byte(env.GetString("COURIER_WEBHOOK_SECRET")))
+	case "", "none":
+		return nil
+	default:
+		log.Fatalf("Unknown COURIER %q", courier)
+		return nil
+	}
+}
+
+// getBlobStore picks where uploaded images are kept: files under BLOB_DIR,
+// served from BLOB_BASE_URL, or an S3-compatible bucket.
+func getBlobStore(env *viper.Viper) services.BlobStore {
+	env.SetDefault("BLOB_STORE", "local")
+	env.SetDefault("BLOB_DIR", "uploads")
+	env.SetDefault("BLOB_BASE_URL", "/media")
+	env.SetDefault("S3_REGION", "us-east-1")
+
+	switch store := env.GetString("BLOB_STORE"); store {
+	case "local":
+		return services.LocalBlobStore{
+			Dir:     env.GetString("BLOB_DIR"),
+			BaseURL: env.GetString("BLOB_BASE_URL"),
+		}
+	case "s3":
+		// Without an endpoint the bucket is on AWS itself
+		endpoint := env.GetString("S3_ENDPOINT")
+		if endpoint == "" {
+			endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", env.GetString("S3_REGION"))
+		}
+		return services.S3BlobStore{
+			Endpoint:        endpoint,
+			Region:          env.GetString("S3_REGION"),
+			Bucket:          env.GetString("S3_BUCKET"),
+			AccessKeyId:     env.GetString("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: env.GetString("S3_SECRET_ACCESS_KEY"),
+			PathStyle:       env.GetBool("S3_PATH_STYLE"),
+			PublicURL:       env.GetString("S3_PUBLIC_URL"),
+		}
+	case "", "none":
+		return nil
+	default:
+		log.Fatalf("Unknown BLOB_STORE %q", store)
+		return nil
+	}
+}
+
+// getSeller reads the business printed on invoices and labels, and the VAT
+// rate included in prices, defaulting to 12%.
+func getSeller(env *viper.Viper) (services.SellerInfo, float64) {
+	env.SetDefault("SELLER_NAME", "Chronex")
+	env.SetDefault("VAT_RATE", 0.12)
+
+	seller := services.SellerInfo{
+		Name:          env.GetString("SELLER_NAME"),
+		Address:       env.GetString("SELLER_ADDRESS"),
+		TaxId:         env.GetString("SELLER_TIN"),
+		ContactNumber: env.GetString("SELLER_CONTACT_NUMBER"),
+	}
+
+	return seller, env.GetFloat64("VAT_RATE")
+}
+
+// getTrustedProxies reads the comma-separated addresses or CIDR ranges of the
+// reverse proxies in front of the server. None are trusted by default, so
+// client IPs come from the connection itself.
+func getTrustedProxies(env *viper.Viper) []string {
+	var proxies []string
+	for _, proxy := range strings.Split(env.GetString("TRUSTED_PROXIES"), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+
+	return proxies
+}
+
+// getContactRateLimit reads how many contact forms one IP may send per
+// window, defaulting to 5 per hour.
+func getContactRateLimit(env *viper.Viper) (int, time.Duration) {
+	limit := env.GetInt("CONTACT_RATE_LIMIT")
+	if limit <= 0 {
+		limit = 5
+	}
+	seconds := env.GetInt("CONTACT_RATE_WINDOW")
+	if seconds <= 0 {
+		seconds = 3600
+	}
+
+	return limit, time.Duration(seconds) * time.Second
+}
+
+// getOrderLookupRateLimit reads how many order lookups and cancellations one
+// IP may make per window, defaulting to 20 per 10 minutes, so order ids and
+// emails cannot be guessed at speed.
+func getOrderLookupRateLimit(env *viper.Viper) (int, time.Duration) {
+	limit := env.GetInt("ORDER_LOOKUP_RATE_LIMIT")
+	if limit <= 0 {
+		limit = 20
+	}
+	seconds := env.GetInt("ORDER_LOOKUP_RATE_WINDOW")
+	if seconds <= 0 {
+		seconds = 600
+	}
+
+	return limit, time.Duration(seconds) * time.Second
+}
+
+// rateLimitByIP allows each client IP limit requests per fixed window and
+// answers the rest with 429.
+func rateLimitByIP(limit int, window time.Duration) gin.HandlerFunc {
+	type bucket struct {
+		start time.Time
+		count int
+	}
+
+	var mu sync.Mutex
+	buckets := map[string]*bucket{}
+
+	return func(c *gin.Context) {
+		now := time.Now()
+		ip := c.ClientIP()
+
+		mu.Lock()
+		// Drop expired windows so the map does not grow without bound
+		for key, b := range buckets {
+			if now.Sub(b.start) >= window {
+				delete(buckets, key)
+			}
+		}
+
+		b, ok := buckets[ip]
+		if !ok {
+			b = &bucket{start: now}
+			buckets[ip] = b
+		}
+		b.count++
+		allowed := b.count <= limit
+		retryAfter := window - now.Sub(b.start)
+		mu.Unlock()
+
+		if !allowed {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error": "Too many requests, please try again later",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func getEmailWorkerInterval(env *viper.Viper) time.Duration {
+	seconds := env.GetInt("EMAIL_WORKER_INTERVAL")
+	if seconds <= 0 {
+		seconds = 30
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// getTrackingSyncInterval reads how often shipped orders are polled,
+// defaulting to every 15 minutes.
+func getTrackingSyncInterval(env *viper.Viper) time.Duration {
+	seconds := env.GetInt("TRACKING_SYNC_INTERVAL")
+	if seconds <= 0 {
+		seconds = 900
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+func getPriceSchedulerInterval(env *viper.Viper) time.Duration {
+	seconds := env.GetInt("PRICE_SCHEDULER_INTERVAL")
+	if seconds <= 0 {
+		seconds = 60
+	}
+
+	return time.Duration(seconds) * time.Second
+}
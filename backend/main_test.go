@@ -0,0 +1,152 @@
+package main
+
+import (
+	"api/pkg/services"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIfMatchVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		ifMatch  string
+		fallback int64
+		want     int64
+		wantErr  bool
+	}{
+		{name: "no header", ifMatch: "", fallback: 4, want: 4},
+		{name: "strong etag", ifMatch: `"7"`, fallback: 4, want: 7},
+		{name: "weak etag", ifMatch: `W/"7"`, fallback: 4, want: 7},
+		{name: "bare version", ifMatch: "7", fallback: 4, want: 7},
+		{name: "not a version", ifMatch: `"abc"`, fallback: 4, wantErr: true},
+		{name: "any version", ifMatch: "*", fallback: 4, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodPut, "/admin/product-update", nil)
+			if tt.ifMatch != "" {
+				c.Request.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			got, err := ifMatchVersion(c, tt.fallback)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ifMatchVersion(%q) error = %v, want error %v", tt.ifMatch, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ifMatchVersion(%q) = %d, want %d", tt.ifMatch, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateErrorStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "stale version", err: status.Error(codes.Aborted, "changed"), want: http.StatusPreconditionFailed},
+		{name: "missing version", err: status.Error(codes.InvalidArgument, "required"), want: http.StatusBadRequest},
+		{name: "not found", err: status.Error(codes.NotFound, "missing"), want: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := updateErrorStatus(tt.err); got != tt.want {
+				t.Errorf("updateErrorStatus(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetTrustedProxies(t *testing.T) {
+	tests := []struct {
+		name    string
+		proxies string
+		want    []string
+	}{
+		{name: "none by default", proxies: "", want: nil},
+		{name: "one address", proxies: "10.0.0.1", want: []string{"10.0.0.1"}},
+		{name: "list with blanks", proxies: " 10.0.0.1, ,192.168.0.0/16 ", want: []string{"10.0.0.1", "192.168.0.0/16"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := viper.New()
+			env.Set("TRUSTED_PROXIES", tt.proxies)
+
+			if got := getTrustedProxies(env); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getTrustedProxies(%q) = %q, want %q", tt.proxies, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdminAuthMiddleware(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         string
+		path          string
+		authorization string
+		want          int
+	}{
+		{name: "no token configured", path: "/admin/product-sort/asc", want: http.StatusOK},
+		{name: "valid bearer", token: "secret", path: "/admin/product-sort/asc", authorization: "Bearer secret", want: http.StatusOK},
+		{name: "missing bearer", token: "secret", path: "/admin/product-sort/asc", want: http.StatusUnauthorized},
+		{name: "wrong bearer", token: "secret", path: "/admin/product-sort/asc", authorization: "Bearer guess", want: http.StatusUnauthorized},
+		{name: "public route", token: "secret", path: "/product-compare", want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(adminAuthMiddleware(tt.token))
+			router.GET(tt.path, func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.want {
+				t.Errorf("GET %s = %d, want %d", tt.path, recorder.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetMailer(t *testing.T) {
+	tests := []struct {
+		name      string
+		transport string
+		user      string
+		want      interface{}
+	}{
+		{name: "smtp when a user is set", user: "shop@example.com", want: services.SMTPMailer{}},
+		{name: "file", transport: "file", want: services.FileMailer{}},
+		{name: "log only when asked for", transport: "LOG", want: services.LogMailer{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := viper.New()
+			env.Set("EMAIL_TRANSPORT", tt.transport)
+			env.Set("EMAIL_USER", tt.user)
+
+			if got := getMailer(env); reflect.TypeOf(got) != reflect.TypeOf(tt.want) {
+				t.Errorf("getMailer() = %T, want %T", got, tt.want)
+			}
+		})
+	}
+}
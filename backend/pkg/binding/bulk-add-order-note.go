@@ -0,0 +1,6 @@
+package binding
+
+type BulkAddOrderNoteRequest struct {
+	OrderIds []string `json:"orderIds"`
+	Note     string   `json:"note"`
+}
@@ -0,0 +1,6 @@
+package binding
+
+type BulkUpdateOrderStatusRequest struct {
+	OrderIds    []string `json:"orderIds"`
+	OrderStatus string   `json:"orderStatus"`
+}
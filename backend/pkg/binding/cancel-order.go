@@ -0,0 +1,7 @@
+package binding
+
+type CancelOrderRequest struct {
+	OrderId      string `json:"orderId"`
+	EmailAddress string `json:"emailAddress"`
+	Token        string `json:"token"`
+}
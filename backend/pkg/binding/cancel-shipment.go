@@ -0,0 +1,6 @@
+package binding
+
+type CancelShipmentRequest struct {
+	OrderId         string `json:"orderId"`
+	ExpectedVersion int64  `json:"expectedVersion"`
+}
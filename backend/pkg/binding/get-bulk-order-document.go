@@ -0,0 +1,6 @@
+package binding
+
+type GetBulkOrderDocumentRequest struct {
+	OrderIds []string `json:"orderIds"`
+	Document string   `json:"document"`
+}
@@ -0,0 +1,10 @@
+package binding
+
+import "encoding/json"
+
+type PreviewCartRequest struct {
+	Customer        json.RawMessage `json:"customer"`
+	Product         json.RawMessage `json:"product" binding:"required"`
+	VoucherCode     string          `json:"voucherCode"`
+	CompleteAddress json.RawMessage `json:"completeAddress"`
+}
@@ -0,0 +1,8 @@
+package binding
+
+import "encoding/json"
+
+type QuoteShippingRequest struct {
+	Product         json.RawMessage `json:"product" binding:"required"`
+	CompleteAddress json.RawMessage `json:"completeAddress" binding:"required"`
+}
@@ -0,0 +1,10 @@
+package binding
+
+import "encoding/json"
+
+type ReceiveReturnRequest struct {
+	ReturnId        string          `json:"returnId"`
+	Items           json.RawMessage `json:"items"`
+	Note            string          `json:"note"`
+	ExpectedVersion int64           `json:"expectedVersion"`
+}
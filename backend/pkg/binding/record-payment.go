@@ -0,0 +1,9 @@
+package binding
+
+type RecordPaymentRequest struct {
+	OrderId           string  `json:"orderId"`
+	PaymentMethod     string  `json:"paymentMethod"`
+	Amount            float64 `json:"amount"`
+	ProviderReference string  `json:"providerReference"`
+	PaymentStatus     string  `json:"paymentStatus"`
+}
@@ -0,0 +1,9 @@
+package binding
+
+type RefundPaymentRequest struct {
+	PaymentId         string  `json:"paymentId"`
+	Amount            float64 `json:"amount"`
+	Reason            string  `json:"reason"`
+	ReturnId          string  `json:"returnId"`
+	ProviderReference string  `json:"providerReference"`
+}
@@ -0,0 +1,11 @@
+package binding
+
+import "encoding/json"
+
+type RequestReturnRequest struct {
+	OrderId      string          `json:"orderId"`
+	EmailAddress string          `json:"emailAddress"`
+	Token        string          `json:"token"`
+	Items        json.RawMessage `json:"items"`
+	Reason       string          `json:"reason"`
+}
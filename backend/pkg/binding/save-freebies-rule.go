@@ -0,0 +1,18 @@
+package binding
+
+type SaveFreebiesRuleRequest struct {
+	RuleName            string  `json:"ruleName" binding:"required"`
+	MinSpend            float64 `json:"minSpend"`
+	MinQuantity         int64   `json:"minQuantity"`
+	Category            string  `json:"category"`
+	ProductId           string  `json:"productId"`
+	FreebiesId          string  `json:"freebiesId" binding:"required"`
+	AlternateFreebiesId string  `json:"alternateFreebiesId"`
+	FreebiesQuantity    int64   `json:"freebiesQuantity"`
+	PerCustomerLimit    int64   `json:"perCustomerLimit"`
+	Priority            int64   `json:"priority"`
+	Exclusive           bool    `json:"exclusive"`
+	StartAt             int64   `json:"startAt"`
+	EndAt               int64   `json:"endAt"`
+	RuleStatus          string  `json:"ruleStatus"`
+}
@@ -2,7 +2,9 @@ package binding
 
 type SaveFreebiesRequest struct {
 	FreebiesName             string  `json:"freebiesName" binding:"required"`
+	Sku                      string  `json:"sku"`
 	FreebiesImg              []byte  `json:"freebiesImg"`
+	FreebiesImgKey           string  `json:"freebiesImgKey"`
 	FreebiesStorePrice       float64 `json:"freebiesStorePrice"`
 	FreebiesOriginalQuantity float64 `json:"freebiesOriginalQuantity" binding:"required"`
 	FreebiesCurrentQuantity  float64 `json:"freebiesCurrentQuantity" binding:"required"`
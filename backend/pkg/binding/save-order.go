@@ -8,4 +8,6 @@ type SaveOrderRequest struct {
 	Product         json.RawMessage `json:"product"`
 	Total           float64         `json:"total"`
 	OrderStatus     string          `json:"orderStatus"`
+	VoucherCode     string          `json:"voucherCode"`
+	PaymentMethod   string          `json:"paymentMethod"`
 }
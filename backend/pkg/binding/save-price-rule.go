@@ -0,0 +1,12 @@
+package binding
+
+type SavePriceRuleRequest struct {
+	RuleName   string  `json:"ruleName" binding:"required"`
+	Category   string  `json:"category"`
+	ProductId  string  `json:"productId"`
+	SalePrice  float64 `json:"salePrice"`
+	PercentOff float64 `json:"percentOff"`
+	StartAt    int64   `json:"startAt" binding:"required"`
+	EndAt      int64   `json:"endAt" binding:"required"`
+	RuleStatus string  `json:"ruleStatus"`
+}
@@ -0,0 +1,15 @@
+package binding
+
+import "encoding/json"
+
+type SaveProductAttributeRequest struct {
+	AttributeCode   string          `json:"attributeCode" binding:"required"`
+	AttributeName   string          `json:"attributeName" binding:"required"`
+	AttributeType   string          `json:"attributeType" binding:"required"`
+	Unit            string          `json:"unit"`
+	Options         json.RawMessage `json:"options"`
+	Categories      json.RawMessage `json:"categories"`
+	IsRequired      bool            `json:"isRequired"`
+	SortOrder       int64           `json:"sortOrder"`
+	AttributeStatus string          `json:"attributeStatus"`
+}
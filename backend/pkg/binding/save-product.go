@@ -4,6 +4,7 @@ import "encoding/json"
 
 type SaveProductRequest struct {
 	ProductName      string          `json:"productName" binding:"required"`
+	Sku              string          `json:"sku"`
 	Img              json.RawMessage `json:"img"`
 	Discount         float64         `json:"discount"`
 	SupplierPrice    float64         `json:"supplierPrice" binding:"required"`
@@ -16,4 +17,10 @@ type SaveProductRequest struct {
 	ProductSold      float64         `json:"productSold" binding:"required"`
 	ProductFreebies  json.RawMessage `json:"productFreebies"`
 	ProductStatus    string          `json:"productStatus"`
+	Category         string          `json:"category"`
+	WeightGrams      float64         `json:"weightGrams"`
+	LengthCm         float64         `json:"lengthCm"`
+	WidthCm          float64         `json:"widthCm"`
+	HeightCm         float64         `json:"heightCm"`
+	Attributes       json.RawMessage `json:"attributes"`
 }
@@ -0,0 +1,9 @@
+package binding
+
+import "encoding/json"
+
+type SaveReturnRequest struct {
+	OrderId string          `json:"orderId"`
+	Items   json.RawMessage `json:"items"`
+	Reason  string          `json:"reason"`
+}
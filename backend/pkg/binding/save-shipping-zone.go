@@ -0,0 +1,16 @@
+package binding
+
+import "encoding/json"
+
+type SaveShippingZoneRequest struct {
+	ZoneName              string          `json:"zoneName" binding:"required"`
+	Provinces             json.RawMessage `json:"provinces"`
+	Cities                json.RawMessage `json:"cities"`
+	IsDefault             bool            `json:"isDefault"`
+	RateType              string          `json:"rateType"`
+	FlatFee               float64         `json:"flatFee"`
+	WeightTiers           json.RawMessage `json:"weightTiers"`
+	ExtraFeePerKg         float64         `json:"extraFeePerKg"`
+	FreeShippingThreshold float64         `json:"freeShippingThreshold"`
+	ZoneStatus            string          `json:"zoneStatus"`
+}
@@ -0,0 +1,16 @@
+package binding
+
+type SaveVoucherRequest struct {
+	VoucherCode      string  `json:"voucherCode" binding:"required"`
+	DiscountType     string  `json:"discountType" binding:"required"`
+	DiscountValue    float64 `json:"discountValue" binding:"required"`
+	MaxDiscount      float64 `json:"maxDiscount"`
+	MinSpend         float64 `json:"minSpend"`
+	Category         string  `json:"category"`
+	ProductId        string  `json:"productId"`
+	UsageLimit       int64   `json:"usageLimit"`
+	PerCustomerLimit int64   `json:"perCustomerLimit"`
+	StartAt          int64   `json:"startAt"`
+	EndAt            int64   `json:"endAt"`
+	VoucherStatus    string  `json:"voucherStatus"`
+}
@@ -0,0 +1,8 @@
+package binding
+
+type SendOrderEmailRequest struct {
+	OrderId string `json:"orderId"`
+	Event   string `json:"event"`
+	Subject string `json:"subject"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,10 @@
+package binding
+
+type SubmitContactFormRequest struct {
+	Name         string `json:"name"`
+	EmailAddress string `json:"emailAddress"`
+	Subject      string `json:"subject"`
+	Message      string `json:"message"`
+	Website      string `json:"website"`
+	CaptchaToken string `json:"captchaToken"`
+}
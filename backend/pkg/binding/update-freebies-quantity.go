@@ -1,7 +1,9 @@
 package binding
 
 type UpdateFreebiesQuantityRequest struct {
-	FreebiesId               string  `json:"freebiesId"`
-	FreebiesOriginalQuantity float64 `json:"freebiesOriginalQuantity"`
-	FreebiesCurrentQuantity  float64 `json:"freebiesCurrentQuantity"`
+	FreebiesId               string   `json:"freebiesId"`
+	FreebiesOriginalQuantity float64  `json:"freebiesOriginalQuantity"`
+	FreebiesCurrentQuantity  float64  `json:"freebiesCurrentQuantity"`
+	UpdateMask               []string `json:"updateMask"`
+	ExpectedVersion          int64    `json:"expectedVersion"`
 }
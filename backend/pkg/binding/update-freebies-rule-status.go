@@ -0,0 +1,6 @@
+package binding
+
+type UpdateFreebiesRuleStatusRequest struct {
+	FreebiesRuleId string `json:"freebiesRuleId"`
+	RuleStatus     string `json:"ruleStatus"`
+}
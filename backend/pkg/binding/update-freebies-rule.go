@@ -0,0 +1,19 @@
+package binding
+
+type UpdateFreebiesRuleRequest struct {
+	FreebiesRuleId      string   `json:"freebiesRuleId"`
+	RuleName            string   `json:"ruleName"`
+	MinSpend            float64  `json:"minSpend"`
+	MinQuantity         int64    `json:"minQuantity"`
+	Category            string   `json:"category"`
+	ProductId           string   `json:"productId"`
+	FreebiesId          string   `json:"freebiesId"`
+	AlternateFreebiesId string   `json:"alternateFreebiesId"`
+	FreebiesQuantity    int64    `json:"freebiesQuantity"`
+	PerCustomerLimit    int64    `json:"perCustomerLimit"`
+	Priority            int64    `json:"priority"`
+	Exclusive           bool     `json:"exclusive"`
+	StartAt             int64    `json:"startAt"`
+	EndAt               int64    `json:"endAt"`
+	UpdateMask          []string `json:"updateMask"`
+}
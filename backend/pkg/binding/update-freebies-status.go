@@ -1,6 +1,7 @@
 package binding
 
 type UpdateFreebiesStatusRequest struct {
-	FreebiesId     string `json:"freebiesId"`
-	FreebiesStatus string `json:"freebiesStatus"`
+	FreebiesId      string `json:"freebiesId"`
+	FreebiesStatus  string `json:"freebiesStatus"`
+	ExpectedVersion int64  `json:"expectedVersion"`
 }
@@ -1,9 +1,13 @@
 package binding
 
 type UpdateFreebiesRequest struct {
-	FreebiesId         string  `json:"freebiesId"`
-	FreebiesName       string  `json:"freebiesName"`
-	FreebiesImg        []byte  `json:"freebiesImg"`
-	FreebiesStorePrice float64 `json:"freebiesStorePrice"`
-	FreebiesStatus     string  `json:"freebiesStatus"`
+	FreebiesId         string   `json:"freebiesId"`
+	FreebiesName       string   `json:"freebiesName"`
+	Sku                string   `json:"sku"`
+	FreebiesImg        []byte   `json:"freebiesImg"`
+	FreebiesImgKey     string   `json:"freebiesImgKey"`
+	FreebiesStorePrice float64  `json:"freebiesStorePrice"`
+	FreebiesStatus     string   `json:"freebiesStatus"`
+	UpdateMask         []string `json:"updateMask"`
+	ExpectedVersion    int64    `json:"expectedVersion"`
 }
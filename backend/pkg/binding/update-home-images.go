@@ -1,6 +1,7 @@
 package binding
 
 type UpdateHomeImagesRequest struct {
-	HomeImagesId string `json:"homeImagesId"`
-	HomeImg      string `json:"homeImg"`
+	HomeImagesId    string `json:"homeImagesId"`
+	HomeImg         string `json:"homeImg"`
+	ExpectedVersion int64  `json:"expectedVersion"`
 }
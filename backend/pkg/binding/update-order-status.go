@@ -1,6 +1,7 @@
 package binding
 
 type UpdateOrderStatusRequest struct {
-	OrderId     string `json:"orderId"`
-	OrderStatus string `json:"orderStatus"`
+	OrderId         string `json:"orderId"`
+	OrderStatus     string `json:"orderStatus"`
+	ExpectedVersion int64  `json:"expectedVersion"`
 }
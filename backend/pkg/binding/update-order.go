@@ -11,4 +11,6 @@ type UpdateOrderRequest struct {
 	OrderStatus     string          `json:"orderStatus"`
 	TrackingId      string          `json:"trackingId"`
 	StickyNotes     string          `json:"stickyNotes"`
+	UpdateMask      []string        `json:"updateMask"`
+	ExpectedVersion int64           `json:"expectedVersion"`
 }
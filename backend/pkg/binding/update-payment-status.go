@@ -0,0 +1,8 @@
+package binding
+
+type UpdatePaymentStatusRequest struct {
+	PaymentId         string `json:"paymentId"`
+	PaymentStatus     string `json:"paymentStatus"`
+	ProviderReference string `json:"providerReference"`
+	FailureReason     string `json:"failureReason"`
+}
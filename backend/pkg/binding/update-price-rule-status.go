@@ -0,0 +1,6 @@
+package binding
+
+type UpdatePriceRuleStatusRequest struct {
+	PriceRuleId string `json:"priceRuleId"`
+	RuleStatus  string `json:"ruleStatus"`
+}
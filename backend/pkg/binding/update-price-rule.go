@@ -0,0 +1,13 @@
+package binding
+
+type UpdatePriceRuleRequest struct {
+	PriceRuleId string   `json:"priceRuleId"`
+	RuleName    string   `json:"ruleName"`
+	Category    string   `json:"category"`
+	ProductId   string   `json:"productId"`
+	SalePrice   float64  `json:"salePrice"`
+	PercentOff  float64  `json:"percentOff"`
+	StartAt     int64    `json:"startAt"`
+	EndAt       int64    `json:"endAt"`
+	UpdateMask  []string `json:"updateMask"`
+}
@@ -0,0 +1,6 @@
+package binding
+
+type UpdateProductAttributeStatusRequest struct {
+	AttributeId     string `json:"attributeId"`
+	AttributeStatus string `json:"attributeStatus"`
+}
@@ -0,0 +1,15 @@
+package binding
+
+import "encoding/json"
+
+type UpdateProductAttributeRequest struct {
+	AttributeId   string          `json:"attributeId"`
+	AttributeName string          `json:"attributeName"`
+	AttributeType string          `json:"attributeType"`
+	Unit          string          `json:"unit"`
+	Options       json.RawMessage `json:"options"`
+	Categories    json.RawMessage `json:"categories"`
+	IsRequired    bool            `json:"isRequired"`
+	SortOrder     int64           `json:"sortOrder"`
+	UpdateMask    []string        `json:"updateMask"`
+}
@@ -1,7 +1,9 @@
 package binding
 
 type UpdateProductQuantityRequest struct {
-	ProductId        string  `json:"productId"`
-	OriginalQuantity float64 `json:"productOriginalQuantity"`
-	CurrentQuantity  float64 `json:"productCurrentQuantity"`
+	ProductId        string   `json:"productId"`
+	OriginalQuantity float64  `json:"productOriginalQuantity"`
+	CurrentQuantity  float64  `json:"productCurrentQuantity"`
+	UpdateMask       []string `json:"updateMask"`
+	ExpectedVersion  int64    `json:"expectedVersion"`
 }
@@ -1,6 +1,7 @@
 package binding
 
 type UpdateProductStatusRequest struct {
-	ProductId     string `json:"productId"`
-	ProductStatus string `json:"productStatus"`
+	ProductId       string `json:"productId"`
+	ProductStatus   string `json:"productStatus"`
+	ExpectedVersion int64  `json:"expectedVersion"`
 }
@@ -1,16 +1,27 @@
 package binding
 
+import "encoding/json"
+
 type UpdateProductRequest struct {
-	ProductId       string  `json:"productId"`
-	ProductName     string  `json:"productName"`
-	Img             string  `json:"img"`
-	Discount        float64 `json:"discount"`
-	SupplierPrice   float64 `json:"supplierPrice"`
-	OriginalPrice   float64 `json:"originalPrice"`
-	DiscountedPrice float64 `json:"discountedPrice"`
-	Description1    string  `json:"description1"`
-	Description2    string  `json:"description2"`
-	ProductStatus   string  `json:"productStatus"`
-	ProductSold     float64 `json:"productSold"`
-	ProductFreebies string  `json:"productFreebies"`
+	ProductId       string          `json:"productId"`
+	ProductName     string          `json:"productName"`
+	Sku             string          `json:"sku"`
+	Img             string          `json:"img"`
+	Discount        float64         `json:"discount"`
+	SupplierPrice   float64         `json:"supplierPrice"`
+	OriginalPrice   float64         `json:"originalPrice"`
+	DiscountedPrice float64         `json:"discountedPrice"`
+	Description1    string          `json:"description1"`
+	Description2    string          `json:"description2"`
+	ProductStatus   string          `json:"productStatus"`
+	ProductSold     float64         `json:"productSold"`
+	ProductFreebies string          `json:"productFreebies"`
+	Category        string          `json:"category"`
+	WeightGrams     float64         `json:"weightGrams"`
+	LengthCm        float64         `json:"lengthCm"`
+	WidthCm         float64         `json:"widthCm"`
+	HeightCm        float64         `json:"heightCm"`
+	Attributes      json.RawMessage `json:"attributes"`
+	UpdateMask      []string        `json:"updateMask"`
+	ExpectedVersion int64           `json:"expectedVersion"`
 }
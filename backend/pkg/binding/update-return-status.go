@@ -0,0 +1,8 @@
+package binding
+
+type UpdateReturnStatusRequest struct {
+	ReturnId        string `json:"returnId"`
+	ReturnStatus    string `json:"returnStatus"`
+	Note            string `json:"note"`
+	ExpectedVersion int64  `json:"expectedVersion"`
+}
@@ -1,6 +1,7 @@
 package binding
 
 type UpdateReviewsStatusRequest struct {
-	ReviewsId     string `json:"reviewsId"`
-	ReviewsStatus string `json:"reviewsStatus"`
+	ReviewsId       string `json:"reviewsId"`
+	ReviewsStatus   string `json:"reviewsStatus"`
+	ExpectedVersion int64  `json:"expectedVersion"`
 }
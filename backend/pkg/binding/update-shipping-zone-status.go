@@ -0,0 +1,6 @@
+package binding
+
+type UpdateShippingZoneStatusRequest struct {
+	ShippingZoneId string `json:"shippingZoneId"`
+	ZoneStatus     string `json:"zoneStatus"`
+}
@@ -0,0 +1,17 @@
+package binding
+
+import "encoding/json"
+
+type UpdateShippingZoneRequest struct {
+	ShippingZoneId        string          `json:"shippingZoneId"`
+	ZoneName              string          `json:"zoneName"`
+	Provinces             json.RawMessage `json:"provinces"`
+	Cities                json.RawMessage `json:"cities"`
+	IsDefault             bool            `json:"isDefault"`
+	RateType              string          `json:"rateType"`
+	FlatFee               float64         `json:"flatFee"`
+	WeightTiers           json.RawMessage `json:"weightTiers"`
+	ExtraFeePerKg         float64         `json:"extraFeePerKg"`
+	FreeShippingThreshold float64         `json:"freeShippingThreshold"`
+	UpdateMask            []string        `json:"updateMask"`
+}
@@ -0,0 +1,6 @@
+package binding
+
+type UpdateVoucherStatusRequest struct {
+	VoucherId     string `json:"voucherId"`
+	VoucherStatus string `json:"voucherStatus"`
+}
@@ -0,0 +1,16 @@
+package binding
+
+type UpdateVoucherRequest struct {
+	VoucherId        string   `json:"voucherId"`
+	DiscountType     string   `json:"discountType"`
+	DiscountValue    float64  `json:"discountValue"`
+	MaxDiscount      float64  `json:"maxDiscount"`
+	MinSpend         float64  `json:"minSpend"`
+	Category         string   `json:"category"`
+	ProductId        string   `json:"productId"`
+	UsageLimit       int64    `json:"usageLimit"`
+	PerCustomerLimit int64    `json:"perCustomerLimit"`
+	StartAt          int64    `json:"startAt"`
+	EndAt            int64    `json:"endAt"`
+	UpdateMask       []string `json:"updateMask"`
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CustomerData is a shopper matched from their orders. EmailAddress and
+// ContactNumber are stored normalized so repeat orders dedupe onto one row;
+// Addresses holds every distinct delivery address the customer has used.
+type CustomerData struct {
+	CustomerId    uuid.UUID       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	FirstName     string          `gorm:"type:text"`
+	LastName      string          `gorm:"type:text"`
+	EmailAddress  string          `gorm:"type:text"`
+	ContactNumber string          `gorm:"type:text"`
+	Addresses     json.RawMessage `gorm:"type:jsonb"`
+	CreatedBy     uuid.UUID       `gorm:"type:uuid"`
+	CreatedAt     time.Time       `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy     uuid.UUID       `gorm:"type:uuid"`
+	UpdatedAt     time.Time       `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt     gorm.DeletedAt  `gorm:"softDelete: true"`
+}
+
+func (CustomerData) TableName() string {
+	return "chronex_customer"
+}
+
+func (p CustomerData) GetCustomerId() uuid.UUID {
+	if p.CustomerId == uuid.Nil {
+		return uuid.UUID{}
+	}
+	return p.CustomerId
+}
+
+func (p CustomerData) GetFirstName() string {
+	if p.FirstName == "" {
+		return ""
+	}
+
+	return p.FirstName
+}
+
+func (p CustomerData) GetLastName() string {
+	if p.LastName == "" {
+		return ""
+	}
+
+	return p.LastName
+}
+
+func (p CustomerData) GetEmailAddress() string {
+	if p.EmailAddress == "" {
+		return ""
+	}
+
+	return p.EmailAddress
+}
+
+func (p CustomerData) GetContactNumber() string {
+	if p.ContactNumber == "" {
+		return ""
+	}
+
+	return p.ContactNumber
+}
+
+func (p CustomerData) GetAddresses() json.RawMessage {
+	return p.Addresses
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EmailOutboxData is a queued email. EmailStatus is PEN while it waits for
+// its next attempt, SNT once delivered and DLQ when it ran out of attempts.
+type EmailOutboxData struct {
+	EmailOutboxId uuid.UUID      `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	EmailEvent    string         `gorm:"type:text"`
+	OrderId       *uuid.UUID     `gorm:"type:uuid"`
+	Recipient     string         `gorm:"type:text"`
+	Subject       string         `gorm:"type:text"`
+	HtmlBody      string         `gorm:"type:text"`
+	EmailStatus   string         `gorm:"type:text"`
+	Attempts      int64          `gorm:"type:int"`
+	NextAttemptAt time.Time      `gorm:"type:timestamptz"`
+	LastError     string         `gorm:"type:text"`
+	SentAt        *time.Time     `gorm:"type:timestamptz"`
+	CreatedBy     uuid.UUID      `gorm:"type:uuid"`
+	CreatedAt     time.Time      `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy     uuid.UUID      `gorm:"type:uuid"`
+	UpdatedAt     time.Time      `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt     gorm.DeletedAt `gorm:"softDelete: true"`
+}
+
+func (EmailOutboxData) TableName() string {
+	return "chronex_email_outbox"
+}
+
+func (p EmailOutboxData) GetEmailOutboxId() uuid.UUID {
+	if p.EmailOutboxId == uuid.Nil {
+		return uuid.UUID{}
+	}
+	return p.EmailOutboxId
+}
+
+func (p EmailOutboxData) GetOrderId() string {
+	if p.OrderId == nil {
+		return ""
+	}
+
+	return p.OrderId.String()
+}
+
+func (p EmailOutboxData) GetSentAt() int64 {
+	if p.SentAt == nil {
+		return 0
+	}
+
+	return p.SentAt.Unix()
+}
@@ -8,13 +8,21 @@ import (
 )
 
 type FreebiesData struct {
-	FreebiesId               uuid.UUID      `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
-	FreebiesName             string         `gorm:"type:text"`
-	FreebiesImg              []byte         `gorm:"type:bytea"`
+	FreebiesId   uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	FreebiesName string    `gorm:"type:text"`
+	Sku          string    `gorm:"type:text"`
+	// FreebiesImg held the image itself before images moved to the
+	// BlobStore. It is neither read nor written any more, so listing
+	// freebies does not load the images; MigrateFreebiesImages empties it.
+	FreebiesImg []byte `gorm:"type:bytea;->:false;<-:false"`
+	// FreebiesImgKey is the BlobStore key of the image, or the link of an
+	// image hosted elsewhere.
+	FreebiesImgKey           string         `gorm:"type:text"`
 	FreebiesStorePrice       float64        `gorm:"type:decimal(10, 2);"`
 	FreebiesOriginalQuantity float64        `gorm:"type:decimal(10, 2);"`
 	FreebiesCurrentQuantity  float64        `gorm:"type:decimal(10, 2);"`
 	FreebiesStatus           string         `gorm:"type:text"`
+	Version                  int64          `gorm:"type:bigint;default:1"`
 	CreatedBy                uuid.UUID      `gorm:"type:uuid"`
 	CreatedAt                time.Time      `gorm:"type:timestamptz;autoCreateTime"`
 	UpdatedBy                uuid.UUID      `gorm:"type:uuid"`
@@ -33,6 +41,10 @@ func (p FreebiesData) GetFreebiesId() uuid.UUID {
 	return p.FreebiesId
 }
 
+func (p FreebiesData) GetVersion() int64 {
+	return p.Version
+}
+
 func (p FreebiesData) GetFreebiesName() string {
 	if p.FreebiesName == "" {
 		return ""
@@ -41,6 +53,10 @@ func (p FreebiesData) GetFreebiesName() string {
 	return p.FreebiesName
 }
 
+func (p FreebiesData) GetSku() string {
+	return p.Sku
+}
+
 func (p FreebiesData) GetFreebiesImg() string {
 	if len(p.FreebiesImg) == 0 {
 		return ""
@@ -50,6 +66,10 @@ func (p FreebiesData) GetFreebiesImg() string {
 	return string(p.FreebiesImg)
 }
 
+func (p FreebiesData) GetFreebiesImgKey() string {
+	return p.FreebiesImgKey
+}
+
 func (p FreebiesData) GetFreebiesStorePrice() float64 {
 	if p.FreebiesStorePrice == 0 {
 		p.FreebiesStorePrice = 0
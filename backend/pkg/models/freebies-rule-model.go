@@ -0,0 +1,104 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// FreebiesRuleData is a gift-with-purchase rule. Every non-zero condition
+// (MinSpend, MinQuantity) must hold for the rule to match, measured only over
+// the cart lines inside the rule's Category/ProductId scope when one is set.
+type FreebiesRuleData struct {
+	FreebiesRuleId      uuid.UUID      `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	RuleName            string         `gorm:"type:text"`
+	MinSpend            float64        `gorm:"type:decimal(10, 2);"`
+	MinQuantity         int64          `gorm:"type:int"`
+	Category            string         `gorm:"type:text"`
+	ProductId           string         `gorm:"type:text"`
+	FreebiesId          uuid.UUID      `gorm:"type:uuid"`
+	AlternateFreebiesId *uuid.UUID     `gorm:"type:uuid"`
+	FreebiesQuantity    int64          `gorm:"type:int"`
+	PerCustomerLimit    int64          `gorm:"type:int"`
+	Priority            int64          `gorm:"type:int"`
+	Exclusive           bool           `gorm:"type:boolean"`
+	StartAt             *time.Time     `gorm:"type:timestamptz"`
+	EndAt               *time.Time     `gorm:"type:timestamptz"`
+	RuleStatus          string         `gorm:"type:text"`
+	CreatedBy           uuid.UUID      `gorm:"type:uuid"`
+	CreatedAt           time.Time      `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy           uuid.UUID      `gorm:"type:uuid"`
+	UpdatedAt           time.Time      `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt           gorm.DeletedAt `gorm:"softDelete: true"`
+}
+
+func (FreebiesRuleData) TableName() string {
+	return "chronex_freebies_rule"
+}
+
+func (p FreebiesRuleData) GetFreebiesRuleId() uuid.UUID {
+	if p.FreebiesRuleId == uuid.Nil {
+		return uuid.UUID{}
+	}
+	return p.FreebiesRuleId
+}
+
+func (p FreebiesRuleData) GetAlternateFreebiesId() string {
+	if p.AlternateFreebiesId == nil {
+		return ""
+	}
+
+	return p.AlternateFreebiesId.String()
+}
+
+func (p FreebiesRuleData) GetStartAt() int64 {
+	if p.StartAt == nil {
+		return 0
+	}
+
+	return p.StartAt.Unix()
+}
+
+func (p FreebiesRuleData) GetEndAt() int64 {
+	if p.EndAt == nil {
+		return 0
+	}
+
+	return p.EndAt.Unix()
+}
+
+// ActiveAt reports whether the rule is switched on and inside its date window.
+func (p FreebiesRuleData) ActiveAt(now time.Time) bool {
+	if p.RuleStatus != "ACT" {
+		return false
+	}
+	if p.StartAt != nil && now.Before(*p.StartAt) {
+		return false
+	}
+	if p.EndAt != nil && now.After(*p.EndAt) {
+		return false
+	}
+
+	return true
+}
+
+// FreebiesAllocationData records a freebie granted to an order by a rule. It is
+// what per-customer limits are counted against.
+type FreebiesAllocationData struct {
+	FreebiesAllocationId uuid.UUID      `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	OrderId              uuid.UUID      `gorm:"type:uuid"`
+	FreebiesRuleId       uuid.UUID      `gorm:"type:uuid"`
+	FreebiesId           uuid.UUID      `gorm:"type:uuid"`
+	CustomerEmail        string         `gorm:"type:text"`
+	Quantity             int64          `gorm:"type:int"`
+	CreatedBy            uuid.UUID      `gorm:"type:uuid"`
+	CreatedAt            time.Time      `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy            uuid.UUID      `gorm:"type:uuid"`
+	UpdatedAt            time.Time      `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt            gorm.DeletedAt `gorm:"softDelete: true"`
+}
+
+func (FreebiesAllocationData) TableName() string {
+	return "chronex_freebies_allocation"
+}
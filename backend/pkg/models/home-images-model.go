@@ -11,6 +11,7 @@ import (
 type HomeImagesData struct {
 	HomeImagesId uuid.UUID       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
 	HomeImg      json.RawMessage `gorm:"type:jsonb"`
+	Version      int64           `gorm:"type:bigint;default:1"`
 	CreatedBy    uuid.UUID       `gorm:"type:uuid"`
 	CreatedAt    time.Time       `gorm:"type:timestamptz;autoCreateTime"`
 	UpdatedBy    uuid.UUID       `gorm:"type:uuid"`
@@ -29,6 +30,10 @@ func (p HomeImagesData) GetHomeImagesId() uuid.UUID {
 	return p.HomeImagesId
 }
 
+func (p HomeImagesData) GetVersion() int64 {
+	return p.Version
+}
+
 func (p HomeImagesData) GetHomeImg() json.RawMessage {
 	return p.HomeImg
 }
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OrderEventData is one entry on an order's timeline. EventType is STATUS for
+// order status changes, TRACKING for courier scans and NOTE for admin notes;
+// Source is who recorded it (ADMIN, CUSTOMER or COURIER).
+type OrderEventData struct {
+	OrderEventId uuid.UUID      `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	OrderId      uuid.UUID      `gorm:"type:uuid"`
+	EventType    string         `gorm:"type:text"`
+	EventStatus  string         `gorm:"type:text"`
+	Description  string         `gorm:"type:text"`
+	Location     string         `gorm:"type:text"`
+	Source       string         `gorm:"type:text"`
+	OccurredAt   time.Time      `gorm:"type:timestamptz"`
+	CreatedBy    uuid.UUID      `gorm:"type:uuid"`
+	CreatedAt    time.Time      `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy    uuid.UUID      `gorm:"type:uuid"`
+	UpdatedAt    time.Time      `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt    gorm.DeletedAt `gorm:"softDelete: true"`
+}
+
+func (OrderEventData) TableName() string {
+	return "chronex_order_event"
+}
+
+func (p OrderEventData) GetOrderEventId() uuid.UUID {
+	if p.OrderEventId == uuid.Nil {
+		return uuid.UUID{}
+	}
+	return p.OrderEventId
+}
@@ -10,13 +10,25 @@ import (
 
 type OrderData struct {
 	OrderId         uuid.UUID       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	OrderNumber     string          `gorm:"type:text"`
+	CustomerId      *uuid.UUID      `gorm:"type:uuid"`
 	Customer        json.RawMessage `gorm:"type:jsonb"`
 	CompleteAddress json.RawMessage `gorm:"type:jsonb"`
 	Product         json.RawMessage `gorm:"type:jsonb"`
 	Total           float64         `gorm:"type:decimal(10, 2);"`
 	OrderStatus     string          `gorm:"type:text"`
 	TrackingId      string          `gorm:"type:text"`
+	Courier         string          `gorm:"type:text"`
+	Channel         string          `gorm:"type:text"`
+	ExternalOrderId string          `gorm:"type:text"`
 	StickyNotes     json.RawMessage `gorm:"type:jsonb"`
+	Freebies        json.RawMessage `gorm:"type:jsonb"`
+	VoucherCode     string          `gorm:"type:text"`
+	VoucherDiscount float64         `gorm:"type:decimal(10, 2);"`
+	ShippingFee     float64         `gorm:"type:decimal(10, 2);"`
+	PaymentStatus   string          `gorm:"type:text;default:UNP"`
+	PaidAt          *time.Time      `gorm:"type:timestamptz"`
+	Version         int64           `gorm:"type:bigint;default:1"`
 	CreatedBy       uuid.UUID       `gorm:"type:uuid"`
 	CreatedAt       time.Time       `gorm:"type:timestamptz;autoCreateTime"`
 	UpdatedBy       uuid.UUID       `gorm:"type:uuid"`
@@ -35,6 +47,18 @@ func (p OrderData) GetOrderId() uuid.UUID {
 	return p.OrderId
 }
 
+func (p OrderData) GetCustomerId() string {
+	if p.CustomerId == nil {
+		return ""
+	}
+
+	return p.CustomerId.String()
+}
+
+func (p OrderData) GetVersion() int64 {
+	return p.Version
+}
+
 func (p OrderData) GetCustomer() json.RawMessage {
 	return p.Customer
 }
@@ -75,30 +99,57 @@ func (p OrderData) GetStickyNotes() json.RawMessage {
 	return p.StickyNotes
 }
 
-// GetTotalSalesPerDayWithStatus retrieves total sales per day for a specific month and order status
-func GetTotalSalesPerDayWithStatus(db *gorm.DB, status string, year int, month time.Month) (map[string]float64, error) {
-	var results []struct {
-		Date  time.Time
-		Total float64
+func (p OrderData) GetFreebies() json.RawMessage {
+	return p.Freebies
+}
+
+func (p OrderData) GetVoucherCode() string {
+	if p.VoucherCode == "" {
+		return ""
 	}
 
-	// Filter results by order status and month
-	err := db.Model(&OrderData{}).
-		Select("DATE(created_at) AS date, SUM(total) AS total").
-		Where("order_status = ? AND EXTRACT(YEAR FROM created_at) = ? AND EXTRACT(MONTH FROM created_at) = ?", status, year, month).
-		Group("date").
-		Find(&results).Error
+	return p.VoucherCode
+}
 
-	if err != nil {
-		return nil, err
+func (p OrderData) GetVoucherDiscount() float64 {
+	if p.VoucherDiscount == 0 {
+		p.VoucherDiscount = 0
 	}
 
-	totalSalesPerDay := make(map[string]float64)
-	for _, result := range results {
-		totalSalesPerDay[result.Date.Format("2006-01-02")] = result.Total
+	return p.VoucherDiscount
+}
+
+// GetOrderNumber returns the order number, or the order id for orders that
+// have none.
+func (p OrderData) GetOrderNumber() string {
+	if p.OrderNumber == "" {
+		return p.GetOrderId().String()
+	}
+	return p.OrderNumber
+}
+
+func (p OrderData) GetCourier() string {
+	return p.Courier
+}
+
+func (p OrderData) GetShippingFee() float64 {
+	return p.ShippingFee
+}
+
+func (p OrderData) GetPaymentStatus() string {
+	if p.PaymentStatus == "" {
+		return "UNP"
+	}
+
+	return p.PaymentStatus
+}
+
+func (p OrderData) GetPaidAt() int64 {
+	if p.PaidAt == nil {
+		return 0
 	}
 
-	return totalSalesPerDay, nil
+	return p.PaidAt.Unix()
 }
 
 // GetAllTotalOrder retrieves total order quantity per day for a specific month and order status
@@ -158,6 +209,7 @@ func GetBestSellingProducts(db *gorm.DB, status string, year int, month time.Mon
 			chronex_product_order
 		WHERE
 			order_status = ? 
+			AND deleted_at IS NULL
 			AND EXTRACT(YEAR FROM created_at) = ? 
 			AND EXTRACT(MONTH FROM created_at) = ?
 		)
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// OrderNumberPrefix starts every order number, as in CHX-2026-000123.
+const OrderNumberPrefix = "CHX"
+
+// OrderSequenceData holds the last order number issued in a year.
+type OrderSequenceData struct {
+	SequenceYear int   `gorm:"type:integer;primaryKey"`
+	LastNumber   int64 `gorm:"type:bigint"`
+}
+
+func (OrderSequenceData) TableName() string {
+	return "chronex_order_sequence"
+}
+
+// NextOrderNumber issues the next order number for year. The counter row stays
+// locked until tx ends, so concurrent orders take turns and a rolled back
+// order gives its number back, keeping the sequence free of gaps.
+func NextOrderNumber(tx *gorm.DB, year int) (string, error) {
+	var next int64
+	err := tx.Raw(`INSERT INTO chronex_order_sequence (sequence_year, last_number) VALUES (?, 1)
+		ON CONFLICT (sequence_year) DO UPDATE SET last_number = chronex_order_sequence.last_number + 1
+		RETURNING last_number`, year).Scan(&next).Error
+	if err != nil {
+		return "", err
+	}
+
+	return FormatOrderNumber(year, next), nil
+}
+
+func FormatOrderNumber(year int, number int64) string {
+	return fmt.Sprintf("%s-%d-%06d", OrderNumberPrefix, year, number)
+}
@@ -0,0 +1,24 @@
+package models
+
+import "testing"
+
+func TestFormatOrderNumber(t *testing.T) {
+	tests := []struct {
+		year   int
+		number int64
+		want   string
+	}{
+		{year: 2026, number: 1, want: "CHX-2026-000001"},
+		{year: 2026, number: 123, want: "CHX-2026-000123"},
+		{year: 2027, number: 999999, want: "CHX-2027-999999"},
+		{year: 2027, number: 1000000, want: "CHX-2027-1000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FormatOrderNumber(tt.year, tt.number); got != tt.want {
+				t.Errorf("FormatOrderNumber(%d, %d) = %q, want %q", tt.year, tt.number, got, tt.want)
+			}
+		})
+	}
+}
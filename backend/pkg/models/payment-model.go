@@ -0,0 +1,91 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PaymentData is one payment against an order. PaymentMethod is COD, BANK,
+// EWALLET, CARD or MARKETPLACE (collected by the channel an order was imported
+// from); PaymentStatus is PEN until the money is captured (CAP),
+// the attempt fails (FAI) or it is voided (VOI). Provider is the gateway that
+// handled it, or "manual" for payments recorded by an admin.
+type PaymentData struct {
+	PaymentId         uuid.UUID      `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	OrderId           uuid.UUID      `gorm:"type:uuid"`
+	PaymentMethod     string         `gorm:"type:text"`
+	Provider          string         `gorm:"type:text"`
+	Amount            float64        `gorm:"type:decimal(10, 2);"`
+	PaymentStatus     string         `gorm:"type:text"`
+	ProviderReference string         `gorm:"type:text"`
+	CheckoutUrl       string         `gorm:"type:text"`
+	FailureReason     string         `gorm:"type:text"`
+	CapturedAt        *time.Time     `gorm:"type:timestamptz"`
+	CreatedBy         uuid.UUID      `gorm:"type:uuid"`
+	CreatedAt         time.Time      `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy         uuid.UUID      `gorm:"type:uuid"`
+	UpdatedAt         time.Time      `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt         gorm.DeletedAt `gorm:"softDelete: true"`
+}
+
+func (PaymentData) TableName() string {
+	return "chronex_payment"
+}
+
+func (p PaymentData) GetPaymentId() uuid.UUID {
+	if p.PaymentId == uuid.Nil {
+		return uuid.UUID{}
+	}
+	return p.PaymentId
+}
+
+func (p PaymentData) GetCapturedAt() int64 {
+	if p.CapturedAt == nil {
+		return 0
+	}
+
+	return p.CapturedAt.Unix()
+}
+
+// GetCapturedPaymentsPerDay retrieves captured payment totals per day for a
+// specific month, net of voucher discounts.
+func GetCapturedPaymentsPerDay(db *gorm.DB, year int, month time.Month) (map[string]float64, error) {
+	return capturedPaymentsPerDay(db, "SUM(p.amount)", year, month)
+}
+
+// GetCapturedGrossPerDay retrieves captured payment totals per day for a
+// specific month with each order's voucher discount added back in proportion
+// to the share of the order the payment covers.
+func GetCapturedGrossPerDay(db *gorm.DB, year int, month time.Month) (map[string]float64, error) {
+	return capturedPaymentsPerDay(db,
+		"SUM(CASE WHEN o.total > 0 THEN p.amount * (o.total + COALESCE(o.voucher_discount, 0)) / o.total "+
+			"ELSE p.amount + COALESCE(o.voucher_discount, 0) END)", year, month)
+}
+
+func capturedPaymentsPerDay(db *gorm.DB, sum string, year int, month time.Month) (map[string]float64, error) {
+	var results []struct {
+		Date  time.Time
+		Total float64
+	}
+
+	// Filter captured payments by the month they were captured in
+	err := db.Table("chronex_payment p").
+		Select("DATE(p.captured_at) AS date, "+sum+" AS total").
+		Joins("JOIN chronex_product_order o ON o.order_id = p.order_id AND o.deleted_at IS NULL").
+		Where("p.payment_status = ? AND p.deleted_at IS NULL AND EXTRACT(YEAR FROM p.captured_at) = ? AND EXTRACT(MONTH FROM p.captured_at) = ?", "CAP", year, month).
+		Group("date").
+		Find(&results).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	totalPerDay := make(map[string]float64)
+	for _, result := range results {
+		totalPerDay[result.Date.Format("2006-01-02")] = result.Total
+	}
+
+	return totalPerDay, nil
+}
@@ -0,0 +1,129 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PriceRuleData is a scheduled price change. Inside its StartAt/EndAt window
+// it prices every product in scope at SalePrice, or PercentOff below the
+// product's regular DiscountedPrice when no sale price is set.
+type PriceRuleData struct {
+	PriceRuleId uuid.UUID      `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	RuleName    string         `gorm:"type:text"`
+	Category    string         `gorm:"type:text"`
+	ProductId   string         `gorm:"type:text"`
+	SalePrice   float64        `gorm:"type:decimal(10, 2);"`
+	PercentOff  float64        `gorm:"type:decimal(10, 2);"`
+	StartAt     time.Time      `gorm:"type:timestamptz"`
+	EndAt       time.Time      `gorm:"type:timestamptz"`
+	StartedAt   *time.Time     `gorm:"type:timestamptz"`
+	EndedAt     *time.Time     `gorm:"type:timestamptz"`
+	RuleStatus  string         `gorm:"type:text"`
+	CreatedBy   uuid.UUID      `gorm:"type:uuid"`
+	CreatedAt   time.Time      `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy   uuid.UUID      `gorm:"type:uuid"`
+	UpdatedAt   time.Time      `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"softDelete: true"`
+}
+
+func (PriceRuleData) TableName() string {
+	return "chronex_price_rule"
+}
+
+func (p PriceRuleData) GetPriceRuleId() uuid.UUID {
+	if p.PriceRuleId == uuid.Nil {
+		return uuid.UUID{}
+	}
+	return p.PriceRuleId
+}
+
+// ActiveAt reports whether the rule is switched on and inside its window.
+func (p PriceRuleData) ActiveAt(now time.Time) bool {
+	return p.RuleStatus == "ACT" && !now.Before(p.StartAt) && now.Before(p.EndAt)
+}
+
+// Covers reports whether the product falls inside the rule's scope. A rule
+// with neither a category nor a product applies to the whole catalogue.
+func (p PriceRuleData) Covers(product ProductData) bool {
+	if p.ProductId != "" && p.ProductId != product.ProductId.String() {
+		return false
+	}
+	if p.Category != "" && !strings.EqualFold(p.Category, product.Category) {
+		return false
+	}
+
+	return true
+}
+
+// PriceFor returns the price the rule sets for a product.
+func (p PriceRuleData) PriceFor(product ProductData) float64 {
+	if p.SalePrice > 0 {
+		return p.SalePrice
+	}
+
+	return product.DiscountedPrice * (1 - p.PercentOff/100)
+}
+
+// EffectivePrice returns the lowest price any active rule gives the product,
+// falling back to its regular DiscountedPrice, along with the winning rule.
+func EffectivePrice(product ProductData, rules []PriceRuleData, now time.Time) (float64, *PriceRuleData) {
+	price := product.DiscountedPrice
+	var winner *PriceRuleData
+
+	for i := range rules {
+		if !rules[i].ActiveAt(now) || !rules[i].Covers(product) {
+			continue
+		}
+		if rulePrice := rules[i].PriceFor(product); rulePrice < price {
+			price = rulePrice
+			winner = &rules[i]
+		}
+	}
+
+	return price, winner
+}
+
+// ProductPriceHistoryData is an append-only log of the prices a product has
+// been sold at, written whenever the effective price may have changed.
+type ProductPriceHistoryData struct {
+	PriceHistoryId  uuid.UUID      `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ProductId       uuid.UUID      `gorm:"type:uuid"`
+	OriginalPrice   float64        `gorm:"type:decimal(10, 2);"`
+	DiscountedPrice float64        `gorm:"type:decimal(10, 2);"`
+	EffectivePrice  float64        `gorm:"type:decimal(10, 2);"`
+	PriceRuleId     *uuid.UUID     `gorm:"type:uuid"`
+	Source          string         `gorm:"type:text"`
+	EffectiveFrom   time.Time      `gorm:"type:timestamptz"`
+	CreatedBy       uuid.UUID      `gorm:"type:uuid"`
+	CreatedAt       time.Time      `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy       uuid.UUID      `gorm:"type:uuid"`
+	UpdatedAt       time.Time      `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt       gorm.DeletedAt `gorm:"softDelete: true"`
+}
+
+func (ProductPriceHistoryData) TableName() string {
+	return "chronex_product_price_history"
+}
+
+func (p ProductPriceHistoryData) GetPriceRuleId() string {
+	if p.PriceRuleId == nil {
+		return ""
+	}
+
+	return p.PriceRuleId.String()
+}
+
+// GetPriceInEffect returns the price history entry that was current for a
+// product at the given time.
+func GetPriceInEffect(db *gorm.DB, productId string, at time.Time) (ProductPriceHistoryData, error) {
+	var history ProductPriceHistoryData
+	err := db.Where("product_id = ? AND effective_from <= ?", productId, at).
+		Order("effective_from DESC").
+		First(&history).Error
+
+	return history, err
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestEffectivePrice(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	watchId := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	watch := ProductData{ProductId: watchId, Category: "Watches", DiscountedPrice: 2000}
+
+	running := func(rule PriceRuleData) PriceRuleData {
+		rule.RuleStatus = "ACT"
+		rule.StartAt = now.Add(-time.Hour)
+		rule.EndAt = now.Add(time.Hour)
+		return rule
+	}
+	wholeSale := running(PriceRuleData{RuleName: "Whole shop", PercentOff: 10})
+	watchSale := running(PriceRuleData{RuleName: "Watches", Category: "watches", SalePrice: 1500})
+
+	tests := []struct {
+		name       string
+		rules      []PriceRuleData
+		want       float64
+		wantWinner string
+	}{
+		{name: "no rules", want: 2000},
+		{name: "percent off the regular price", rules: []PriceRuleData{wholeSale}, want: 1800, wantWinner: "Whole shop"},
+		{name: "lowest price wins", rules: []PriceRuleData{wholeSale, watchSale}, want: 1500, wantWinner: "Watches"},
+		{name: "category in any case", rules: []PriceRuleData{watchSale}, want: 1500, wantWinner: "Watches"},
+		{name: "other category", rules: []PriceRuleData{running(PriceRuleData{RuleName: "Straps", Category: "Straps", SalePrice: 100})}, want: 2000},
+		{name: "this product", rules: []PriceRuleData{running(PriceRuleData{RuleName: "Diver", ProductId: watchId.String(), SalePrice: 1900})}, want: 1900, wantWinner: "Diver"},
+		{name: "other product", rules: []PriceRuleData{running(PriceRuleData{RuleName: "Other", ProductId: uuid.NewString(), SalePrice: 100})}, want: 2000},
+		{name: "dearer than the regular price", rules: []PriceRuleData{running(PriceRuleData{RuleName: "Dear", SalePrice: 2500})}, want: 2000},
+		{name: "switched off", rules: []PriceRuleData{{RuleName: "Off", RuleStatus: "INA", StartAt: now.Add(-time.Hour), EndAt: now.Add(time.Hour), SalePrice: 100}}, want: 2000},
+		{name: "not started", rules: []PriceRuleData{{RuleName: "Soon", RuleStatus: "ACT", StartAt: now.Add(time.Minute), EndAt: now.Add(time.Hour), SalePrice: 100}}, want: 2000},
+		{name: "ends exactly now", rules: []PriceRuleData{{RuleName: "Over", RuleStatus: "ACT", StartAt: now.Add(-time.Hour), EndAt: now, SalePrice: 100}}, want: 2000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, winner := EffectivePrice(watch, tt.rules, now)
+			if got != tt.want {
+				t.Errorf("EffectivePrice() = %v, want %v", got, tt.want)
+			}
+			winnerName := ""
+			if winner != nil {
+				winnerName = winner.RuleName
+			}
+			if winnerName != tt.wantWinner {
+				t.Errorf("EffectivePrice() winner = %q, want %q", winnerName, tt.wantWinner)
+			}
+		})
+	}
+}
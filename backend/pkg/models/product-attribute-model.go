@@ -0,0 +1,74 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ProductAttributeData defines a specification products can be described and
+// filtered by, such as the movement or case diameter of a watch. Products keep
+// their values in ProductData.Attributes under AttributeCode. AttributeType is
+// TEXT, NUMBER (measured in Unit), BOOLEAN or OPTION (one of Options). An
+// attribute applies to the products of its Categories, or to every product
+// when it lists none; IsRequired attributes must be given for those products.
+type ProductAttributeData struct {
+	AttributeId     uuid.UUID       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	AttributeCode   string          `gorm:"type:text"`
+	AttributeName   string          `gorm:"type:text"`
+	AttributeType   string          `gorm:"type:text"`
+	Unit            string          `gorm:"type:text"`
+	Options         json.RawMessage `gorm:"type:jsonb"`
+	Categories      json.RawMessage `gorm:"type:jsonb"`
+	IsRequired      bool            `gorm:"type:boolean"`
+	SortOrder       int64           `gorm:"type:int"`
+	AttributeStatus string          `gorm:"type:text"`
+	CreatedBy       uuid.UUID       `gorm:"type:uuid"`
+	CreatedAt       time.Time       `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy       uuid.UUID       `gorm:"type:uuid"`
+	UpdatedAt       time.Time       `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt       gorm.DeletedAt  `gorm:"softDelete: true"`
+}
+
+func (ProductAttributeData) TableName() string {
+	return "chronex_product_attribute"
+}
+
+func (p ProductAttributeData) GetAttributeId() uuid.UUID {
+	if p.AttributeId == uuid.Nil {
+		return uuid.UUID{}
+	}
+	return p.AttributeId
+}
+
+// GetOptions decodes the values an OPTION attribute allows.
+func (p ProductAttributeData) GetOptions() []string {
+	var options []string
+	json.Unmarshal(p.Options, &options)
+	return options
+}
+
+// GetCategories decodes the categories the attribute applies to.
+func (p ProductAttributeData) GetCategories() []string {
+	var categories []string
+	json.Unmarshal(p.Categories, &categories)
+	return categories
+}
+
+// AppliesTo reports whether products of category carry the attribute.
+func (p ProductAttributeData) AppliesTo(category string) bool {
+	categories := p.GetCategories()
+	if len(categories) == 0 {
+		return true
+	}
+
+	for _, name := range categories {
+		if strings.EqualFold(strings.TrimSpace(name), strings.TrimSpace(category)) {
+			return true
+		}
+	}
+	return false
+}
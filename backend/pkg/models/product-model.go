@@ -11,6 +11,7 @@ import (
 type ProductData struct {
 	ProductId        uuid.UUID       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
 	ProductName      string          `gorm:"type:text"`
+	Sku              string          `gorm:"type:text"`
 	Img              json.RawMessage `gorm:"type:jsonb"`
 	Discount         float64         `gorm:"type:decimal(10, 2);"`
 	SupplierPrice    float64         `gorm:"type:decimal(10, 2);"`
@@ -23,6 +24,13 @@ type ProductData struct {
 	ProductStatus    string          `gorm:"type:text"`
 	ProductSold      float64         `gorm:"type:decimal(10, 2);"`
 	ProductFreebies  json.RawMessage `gorm:"type:jsonb"`
+	Category         string          `gorm:"type:text"`
+	WeightGrams      float64         `gorm:"type:decimal(10, 2);"`
+	LengthCm         float64         `gorm:"type:decimal(10, 2);"`
+	WidthCm          float64         `gorm:"type:decimal(10, 2);"`
+	HeightCm         float64         `gorm:"type:decimal(10, 2);"`
+	Attributes       json.RawMessage `gorm:"type:jsonb"`
+	Version          int64           `gorm:"type:bigint;default:1"`
 	CreatedBy        uuid.UUID       `gorm:"type:uuid"`
 	CreatedAt        time.Time       `gorm:"type:timestamptz;autoCreateTime"`
 	UpdatedBy        uuid.UUID       `gorm:"type:uuid"`
@@ -41,6 +49,10 @@ func (p ProductData) GetProductId() uuid.UUID {
 	return p.ProductId
 }
 
+func (p ProductData) GetVersion() int64 {
+	return p.Version
+}
+
 func (p ProductData) GetProductName() string {
 	if p.ProductName == "" {
 		return ""
@@ -49,6 +61,19 @@ func (p ProductData) GetProductName() string {
 	return p.ProductName
 }
 
+func (p ProductData) GetSku() string {
+	return p.Sku
+}
+
+// GetAttributes returns the product's ProductAttributeData values by code, as
+// a JSON object.
+func (p ProductData) GetAttributes() string {
+	if len(p.Attributes) == 0 || string(p.Attributes) == "null" {
+		return "{}"
+	}
+	return string(p.Attributes)
+}
+
 func (p ProductData) GetImg() json.RawMessage {
 	return p.Img
 }
@@ -132,3 +157,11 @@ func (p ProductData) GetProductSold() float64 {
 func (p ProductData) GetProductFreebies() json.RawMessage {
 	return p.ProductFreebies
 }
+
+func (p ProductData) GetCategory() string {
+	if p.Category == "" {
+		return ""
+	}
+
+	return p.Category
+}
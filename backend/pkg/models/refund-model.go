@@ -0,0 +1,80 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RefundData is money given back against a captured payment, optionally for
+// a return.
+type RefundData struct {
+	RefundId          uuid.UUID      `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	OrderId           uuid.UUID      `gorm:"type:uuid"`
+	PaymentId         uuid.UUID      `gorm:"type:uuid"`
+	ReturnId          *uuid.UUID     `gorm:"type:uuid"`
+	Amount            float64        `gorm:"type:decimal(10, 2);"`
+	Reason            string         `gorm:"type:text"`
+	ProviderReference string         `gorm:"type:text"`
+	CreatedBy         uuid.UUID      `gorm:"type:uuid"`
+	CreatedAt         time.Time      `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy         uuid.UUID      `gorm:"type:uuid"`
+	UpdatedAt         time.Time      `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt         gorm.DeletedAt `gorm:"softDelete: true"`
+}
+
+func (RefundData) TableName() string {
+	return "chronex_refund"
+}
+
+func (p RefundData) GetRefundId() uuid.UUID {
+	if p.RefundId == uuid.Nil {
+		return uuid.UUID{}
+	}
+	return p.RefundId
+}
+
+func (p RefundData) GetReturnId() string {
+	if p.ReturnId == nil {
+		return ""
+	}
+
+	return p.ReturnId.String()
+}
+
+// GetRefundsPerDay retrieves refund totals per day for a specific month.
+func GetRefundsPerDay(db *gorm.DB, year int, month time.Month) (map[string]float64, error) {
+	var results []struct {
+		Date  time.Time
+		Total float64
+	}
+
+	// Filter refunds by the month they were given in
+	err := db.Table("chronex_refund r").
+		Select("DATE(r.created_at) AS date, SUM(r.amount) AS total").
+		Joins("JOIN chronex_product_order o ON o.order_id = r.order_id AND o.deleted_at IS NULL").
+		Where("r.deleted_at IS NULL AND EXTRACT(YEAR FROM r.created_at) = ? AND EXTRACT(MONTH FROM r.created_at) = ?", year, month).
+		Group("date").
+		Find(&results).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	totalPerDay := make(map[string]float64)
+	for _, result := range results {
+		totalPerDay[result.Date.Format("2006-01-02")] = result.Total
+	}
+
+	return totalPerDay, nil
+}
+
+// NetOfRefunds subtracts the refunds given on each day from that day's revenue.
+func NetOfRefunds(revenuePerDay map[string]float64, refundsPerDay map[string]float64) map[string]float64 {
+	for day, refunded := range refundsPerDay {
+		revenuePerDay[day] -= refunded
+	}
+
+	return revenuePerDay
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ReturnData is a customer's request to send back some of a delivered
+// order's items. ReturnStatus is REQ until an admin approves (APR) or rejects
+// (REJ) it, and RCV once the items are back. Items holds one entry per order
+// line being returned, with the condition counts filled in on receipt.
+type ReturnData struct {
+	ReturnId     uuid.UUID       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	OrderId      uuid.UUID       `gorm:"type:uuid"`
+	ReturnStatus string          `gorm:"type:text"`
+	Items        json.RawMessage `gorm:"type:jsonb"`
+	Reason       string          `gorm:"type:text"`
+	Note         string          `gorm:"type:text"`
+	ReceivedAt   *time.Time      `gorm:"type:timestamptz"`
+	Version      int64           `gorm:"type:bigint;default:1"`
+	CreatedBy    uuid.UUID       `gorm:"type:uuid"`
+	CreatedAt    time.Time       `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy    uuid.UUID       `gorm:"type:uuid"`
+	UpdatedAt    time.Time       `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt    gorm.DeletedAt  `gorm:"softDelete: true"`
+}
+
+func (ReturnData) TableName() string {
+	return "chronex_return"
+}
+
+func (p ReturnData) GetReturnId() uuid.UUID {
+	if p.ReturnId == uuid.Nil {
+		return uuid.UUID{}
+	}
+	return p.ReturnId
+}
+
+func (p ReturnData) GetVersion() int64 {
+	return p.Version
+}
+
+func (p ReturnData) GetReceivedAt() int64 {
+	if p.ReceivedAt == nil {
+		return 0
+	}
+
+	return p.ReceivedAt.Unix()
+}
@@ -15,6 +15,7 @@ type ReviewsData struct {
 	ReviewsMessage    string         `gorm:"type:text"`
 	ReviewsStarRating int64          `gorm:"type:int"`
 	ReviewsStatus     string         `gorm:"type:text"`
+	Version           int64          `gorm:"type:bigint;default:1"`
 	CreatedBy         uuid.UUID      `gorm:"type:uuid"`
 	CreatedAt         time.Time      `gorm:"type:timestamptz;autoCreateTime"`
 	UpdatedBy         uuid.UUID      `gorm:"type:uuid"`
@@ -33,6 +34,10 @@ func (p ReviewsData) GetReviewsId() uuid.UUID {
 	return p.ReviewsId
 }
 
+func (p ReviewsData) GetVersion() int64 {
+	return p.Version
+}
+
 func (p ReviewsData) GetProductId() string {
 	if p.ProductId == "" {
 		return ""
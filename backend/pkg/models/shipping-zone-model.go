@@ -0,0 +1,95 @@
+package models
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ShippingZoneData is an area orders ship to and the rate charged there.
+// Orders match a zone on the city, then the province, of their
+// CompleteAddress; the IsDefault zone covers everywhere else. RateType is FLAT
+// (FlatFee per order) or WEIGHT (the first of WeightTiers that covers the
+// parcel, plus ExtraFeePerKg for every started kilo past the last tier).
+// Orders whose subtotal reaches FreeShippingThreshold ship free.
+type ShippingZoneData struct {
+	ShippingZoneId        uuid.UUID       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ZoneName              string          `gorm:"type:text"`
+	Provinces             json.RawMessage `gorm:"type:jsonb"`
+	Cities                json.RawMessage `gorm:"type:jsonb"`
+	IsDefault             bool            `gorm:"type:boolean"`
+	RateType              string          `gorm:"type:text"`
+	FlatFee               float64         `gorm:"type:decimal(10, 2);"`
+	WeightTiers           json.RawMessage `gorm:"type:jsonb"`
+	ExtraFeePerKg         float64         `gorm:"type:decimal(10, 2);"`
+	FreeShippingThreshold float64         `gorm:"type:decimal(10, 2);"`
+	ZoneStatus            string          `gorm:"type:text"`
+	CreatedBy             uuid.UUID       `gorm:"type:uuid"`
+	CreatedAt             time.Time       `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy             uuid.UUID       `gorm:"type:uuid"`
+	UpdatedAt             time.Time       `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt             gorm.DeletedAt  `gorm:"softDelete: true"`
+}
+
+// WeightTier charges Fee for parcels up to MaxWeightGrams.
+type WeightTier struct {
+	MaxWeightGrams float64 `json:"maxWeightGrams"`
+	Fee            float64 `json:"fee"`
+}
+
+func (ShippingZoneData) TableName() string {
+	return "chronex_shipping_zone"
+}
+
+func (p ShippingZoneData) GetShippingZoneId() uuid.UUID {
+	if p.ShippingZoneId == uuid.Nil {
+		return uuid.UUID{}
+	}
+	return p.ShippingZoneId
+}
+
+// GetWeightTiers decodes the zone's weight tiers, lightest first.
+func (p ShippingZoneData) GetWeightTiers() ([]WeightTier, error) {
+	var tiers []WeightTier
+	if len(p.WeightTiers) == 0 || string(p.WeightTiers) == "null" {
+		return tiers, nil
+	}
+	if err := json.Unmarshal(p.WeightTiers, &tiers); err != nil {
+		return nil, err
+	}
+
+	return tiers, nil
+}
+
+// FeeFor returns the shipping fee for a parcel of weightGrams on an order
+// with the given subtotal.
+func (p ShippingZoneData) FeeFor(weightGrams float64, subtotal float64) (float64, error) {
+	if p.FreeShippingThreshold > 0 && subtotal >= p.FreeShippingThreshold {
+		return 0, nil
+	}
+	if p.RateType != "WEIGHT" {
+		return p.FlatFee, nil
+	}
+
+	tiers, err := p.GetWeightTiers()
+	if err != nil {
+		return 0, err
+	}
+	if len(tiers) == 0 {
+		return p.FlatFee, nil
+	}
+
+	for _, tier := range tiers {
+		if weightGrams <= tier.MaxWeightGrams {
+			return tier.Fee, nil
+		}
+	}
+
+	last := tiers[len(tiers)-1]
+	extraKilos := math.Ceil((weightGrams - last.MaxWeightGrams) / 1000)
+
+	return last.Fee + extraKilos*p.ExtraFeePerKg, nil
+}
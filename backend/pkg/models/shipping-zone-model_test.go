@@ -0,0 +1,43 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestShippingZoneFeeFor(t *testing.T) {
+	tiers := json.RawMessage(`[{"maxWeightGrams":500,"fee":80},{"maxWeightGrams":1000,"fee":120},{"maxWeightGrams":3000,"fee":180}]`)
+
+	tests := []struct {
+		name        string
+		zone        ShippingZoneData
+		weightGrams float64
+		subtotal    float64
+		want        float64
+		wantErr     bool
+	}{
+		{name: "flat", zone: ShippingZoneData{RateType: "FLAT", FlatFee: 100}, weightGrams: 5000, subtotal: 500, want: 100},
+		{name: "free over the threshold", zone: ShippingZoneData{RateType: "FLAT", FlatFee: 100, FreeShippingThreshold: 3000}, subtotal: 3000, want: 0},
+		{name: "under the threshold", zone: ShippingZoneData{RateType: "FLAT", FlatFee: 100, FreeShippingThreshold: 3000}, subtotal: 2999.99, want: 100},
+		{name: "lightest tier", zone: ShippingZoneData{RateType: "WEIGHT", WeightTiers: tiers}, weightGrams: 200, want: 80},
+		{name: "tier bound is inclusive", zone: ShippingZoneData{RateType: "WEIGHT", WeightTiers: tiers}, weightGrams: 1000, want: 120},
+		{name: "heaviest tier", zone: ShippingZoneData{RateType: "WEIGHT", WeightTiers: tiers}, weightGrams: 2500, want: 180},
+		{name: "started kilo over the last tier", zone: ShippingZoneData{RateType: "WEIGHT", WeightTiers: tiers, ExtraFeePerKg: 40}, weightGrams: 3001, want: 220},
+		{name: "two kilos over the last tier", zone: ShippingZoneData{RateType: "WEIGHT", WeightTiers: tiers, ExtraFeePerKg: 40}, weightGrams: 5000, want: 260},
+		{name: "weight without tiers", zone: ShippingZoneData{RateType: "WEIGHT", FlatFee: 90}, weightGrams: 2500, want: 90},
+		{name: "free weight rate", zone: ShippingZoneData{RateType: "WEIGHT", WeightTiers: tiers, FreeShippingThreshold: 1000}, weightGrams: 2500, subtotal: 1000, want: 0},
+		{name: "broken tiers", zone: ShippingZoneData{RateType: "WEIGHT", WeightTiers: json.RawMessage(`{`)}, weightGrams: 100, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.zone.FeeFor(tt.weightGrams, tt.subtotal)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FeeFor() error = %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("FeeFor(%v, %v) = %v, want %v", tt.weightGrams, tt.subtotal, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// ErrVersionConflict is returned when a row was changed after the caller read it.
+var ErrVersionConflict = errors.New("version conflict")
+
+// SaveVersioned writes every column of value, like Save, but only while the row
+// is still at the version the caller read. On success the version is bumped.
+func SaveVersioned(db *gorm.DB, value interface{}, version *int64) error {
+	expected := *version
+	*version = expected + 1
+
+	result := db.Model(value).Where("version = ?", expected).Select("*").Updates(value)
+	if result.Error != nil || result.RowsAffected == 0 {
+		*version = expected
+		if result.Error != nil {
+			return result.Error
+		}
+		return ErrVersionConflict
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newDryRunDB builds the SQL for statements without running them.
+func newDryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open: %v", err)
+	}
+
+	return db
+}
+
+func TestSaveVersioned(t *testing.T) {
+	db := newDryRunDB(t)
+
+	product := ProductData{ProductName: "Watch", Version: 3}
+
+	// A dry run changes no rows, which is what a lost race looks like
+	err := SaveVersioned(db, &product, &product.Version)
+	if !errors.Is(err, ErrVersionConflict) {
+		t.Fatalf("SaveVersioned() error = %v, want %v", err, ErrVersionConflict)
+	}
+	if product.Version != 3 {
+		t.Errorf("version after a conflict = %d, want 3", product.Version)
+	}
+}
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// VoucherData is a discount code. DiscountType is PCT (DiscountValue percent
+// off the scoped subtotal, capped by MaxDiscount when set) or FIX (a fixed
+// amount off, never more than the scoped subtotal). UsageCount is counted
+// from its redemptions rather than stored.
+type VoucherData struct {
+	VoucherId        uuid.UUID      `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	VoucherCode      string         `gorm:"type:text"`
+	DiscountType     string         `gorm:"type:text"`
+	DiscountValue    float64        `gorm:"type:decimal(10, 2);"`
+	MaxDiscount      float64        `gorm:"type:decimal(10, 2);"`
+	MinSpend         float64        `gorm:"type:decimal(10, 2);"`
+	Category         string         `gorm:"type:text"`
+	ProductId        string         `gorm:"type:text"`
+	UsageLimit       int64          `gorm:"type:int"`
+	PerCustomerLimit int64          `gorm:"type:int"`
+	UsageCount       int64          `gorm:"-"`
+	StartAt          *time.Time     `gorm:"type:timestamptz"`
+	EndAt            *time.Time     `gorm:"type:timestamptz"`
+	VoucherStatus    string         `gorm:"type:text"`
+	CreatedBy        uuid.UUID      `gorm:"type:uuid"`
+	CreatedAt        time.Time      `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy        uuid.UUID      `gorm:"type:uuid"`
+	UpdatedAt        time.Time      `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt        gorm.DeletedAt `gorm:"softDelete: true"`
+}
+
+func (VoucherData) TableName() string {
+	return "chronex_voucher"
+}
+
+func (p VoucherData) GetVoucherId() uuid.UUID {
+	if p.VoucherId == uuid.Nil {
+		return uuid.UUID{}
+	}
+	return p.VoucherId
+}
+
+func (p VoucherData) GetStartAt() int64 {
+	if p.StartAt == nil {
+		return 0
+	}
+
+	return p.StartAt.Unix()
+}
+
+func (p VoucherData) GetEndAt() int64 {
+	if p.EndAt == nil {
+		return 0
+	}
+
+	return p.EndAt.Unix()
+}
+
+// DiscountFor returns the discount the voucher gives on a scoped subtotal.
+func (p VoucherData) DiscountFor(subtotal float64) float64 {
+	var discount float64
+	switch p.DiscountType {
+	case "PCT":
+		discount = subtotal * p.DiscountValue / 100
+		if p.MaxDiscount > 0 && discount > p.MaxDiscount {
+			discount = p.MaxDiscount
+		}
+	case "FIX":
+		discount = p.DiscountValue
+	}
+
+	if discount > subtotal {
+		discount = subtotal
+	}
+
+	return discount
+}
+
+// VoucherRedemptionData records a voucher used on an order. Both global and
+// per-customer usage are counted from here, leaving out cancelled orders.
+type VoucherRedemptionData struct {
+	VoucherRedemptionId uuid.UUID      `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	VoucherId           uuid.UUID      `gorm:"type:uuid"`
+	OrderId             uuid.UUID      `gorm:"type:uuid"`
+	CustomerEmail       string         `gorm:"type:text"`
+	DiscountAmount      float64        `gorm:"type:decimal(10, 2);"`
+	CreatedBy           uuid.UUID      `gorm:"type:uuid"`
+	CreatedAt           time.Time      `gorm:"type:timestamptz;autoCreateTime"`
+	UpdatedBy           uuid.UUID      `gorm:"type:uuid"`
+	UpdatedAt           time.Time      `gorm:"type:timestamptz;autoUpdateTime"`
+	DeletedAt           gorm.DeletedAt `gorm:"softDelete: true"`
+}
+
+func (VoucherRedemptionData) TableName() string {
+	return "chronex_voucher_redemption"
+}
@@ -31,6 +31,7 @@ const (
 	SortOptionProduct_PRODUCT_QUANTITY_LOW_TO_HIGH SortOptionProduct = 5
 	SortOptionProduct_PRODUCT_SUPPLIER_HIGH_TO_LOW SortOptionProduct = 6
 	SortOptionProduct_PRODUCT_SUPPLIER_LOW_TO_HIGH SortOptionProduct = 7
+	SortOptionProduct_PRODUCT_RELEVANCE            SortOptionProduct = 8
 )
 
 // Enum value maps for SortOptionProduct.
@@ -44,6 +45,7 @@ var (
 		5: "PRODUCT_QUANTITY_LOW_TO_HIGH",
 		6: "PRODUCT_SUPPLIER_HIGH_TO_LOW",
 		7: "PRODUCT_SUPPLIER_LOW_TO_HIGH",
+		8: "PRODUCT_RELEVANCE",
 	}
 	SortOptionProduct_value = map[string]int32{
 		"PRODUCT_ATOZ":                 0,
@@ -54,6 +56,7 @@ var (
 		"PRODUCT_QUANTITY_LOW_TO_HIGH": 5,
 		"PRODUCT_SUPPLIER_HIGH_TO_LOW": 6,
 		"PRODUCT_SUPPLIER_LOW_TO_HIGH": 7,
+		"PRODUCT_RELEVANCE":            8,
 	}
 )
 
@@ -207,6 +210,7 @@ const (
 	SortOptionOrder_ORDER_ZTOA             SortOptionOrder = 1
 	SortOptionOrder_ORDER_DATE_HIGH_TO_LOW SortOptionOrder = 2
 	SortOptionOrder_ORDER_DATE_LOW_TO_HIGH SortOptionOrder = 3
+	SortOptionOrder_ORDER_RELEVANCE        SortOptionOrder = 4
 )
 
 // Enum value maps for SortOptionOrder.
@@ -216,12 +220,14 @@ var (
 		1: "ORDER_ZTOA",
 		2: "ORDER_DATE_HIGH_TO_LOW",
 		3: "ORDER_DATE_LOW_TO_HIGH",
+		4: "ORDER_RELEVANCE",
 	}
 	SortOptionOrder_value = map[string]int32{
 		"ORDER_ATOZ":             0,
 		"ORDER_ZTOA":             1,
 		"ORDER_DATE_HIGH_TO_LOW": 2,
 		"ORDER_DATE_LOW_TO_HIGH": 3,
+		"ORDER_RELEVANCE":        4,
 	}
 )
 
@@ -275,6 +281,22 @@ type ProductData struct {
 	CreatedAt        int64   `protobuf:"varint,16,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedBy        string  `protobuf:"bytes,17,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
 	UpdatedAt        int64   `protobuf:"varint,18,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Category         string  `protobuf:"bytes,19,opt,name=category,proto3" json:"category,omitempty"`
+	EffectivePrice   float64 `protobuf:"fixed64,20,opt,name=effectivePrice,proto3" json:"effectivePrice,omitempty"`
+	PriceRuleId      string  `protobuf:"bytes,21,opt,name=priceRuleId,proto3" json:"priceRuleId,omitempty"`
+	SaleEndsAt       int64   `protobuf:"varint,22,opt,name=saleEndsAt,proto3" json:"saleEndsAt,omitempty"`
+	Version          int64   `protobuf:"varint,23,opt,name=version,proto3" json:"version,omitempty"`
+	WeightGrams      float64 `protobuf:"fixed64,24,opt,name=weightGrams,proto3" json:"weightGrams,omitempty"`
+	LengthCm         float64 `protobuf:"fixed64,25,opt,name=lengthCm,proto3" json:"lengthCm,omitempty"`
+	WidthCm          float64 `protobuf:"fixed64,26,opt,name=widthCm,proto3" json:"widthCm,omitempty"`
+	HeightCm         float64 `protobuf:"fixed64,27,opt,name=heightCm,proto3" json:"heightCm,omitempty"`
+	Sku              string  `protobuf:"bytes,28,opt,name=sku,proto3" json:"sku,omitempty"`
+	SearchRank       float64 `protobuf:"fixed64,29,opt,name=searchRank,proto3" json:"searchRank,omitempty"`
+	HighlightedName  string  `protobuf:"bytes,30,opt,name=highlightedName,proto3" json:"highlightedName,omitempty"`
+	SearchSnippet    string  `protobuf:"bytes,31,opt,name=searchSnippet,proto3" json:"searchSnippet,omitempty"`
+	AverageRating    float64 `protobuf:"fixed64,32,opt,name=averageRating,proto3" json:"averageRating,omitempty"`
+	ReviewCount      int64   `protobuf:"varint,33,opt,name=reviewCount,proto3" json:"reviewCount,omitempty"`
+	Attributes       string  `protobuf:"bytes,34,opt,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (x *ProductData) Reset() {
@@ -435,6 +457,118 @@ func (x *ProductData) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *ProductData) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ProductData) GetEffectivePrice() float64 {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return 0
+}
+
+func (x *ProductData) GetPriceRuleId() string {
+	if x != nil {
+		return x.PriceRuleId
+	}
+	return ""
+}
+
+func (x *ProductData) GetSaleEndsAt() int64 {
+	if x != nil {
+		return x.SaleEndsAt
+	}
+	return 0
+}
+
+func (x *ProductData) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ProductData) GetWeightGrams() float64 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *ProductData) GetLengthCm() float64 {
+	if x != nil {
+		return x.LengthCm
+	}
+	return 0
+}
+
+func (x *ProductData) GetWidthCm() float64 {
+	if x != nil {
+		return x.WidthCm
+	}
+	return 0
+}
+
+func (x *ProductData) GetHeightCm() float64 {
+	if x != nil {
+		return x.HeightCm
+	}
+	return 0
+}
+
+func (x *ProductData) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ProductData) GetSearchRank() float64 {
+	if x != nil {
+		return x.SearchRank
+	}
+	return 0
+}
+
+func (x *ProductData) GetHighlightedName() string {
+	if x != nil {
+		return x.HighlightedName
+	}
+	return ""
+}
+
+func (x *ProductData) GetSearchSnippet() string {
+	if x != nil {
+		return x.SearchSnippet
+	}
+	return ""
+}
+
+func (x *ProductData) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *ProductData) GetReviewCount() int64 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *ProductData) GetAttributes() string {
+	if x != nil {
+		return x.Attributes
+	}
+	return ""
+}
+
 type SaveProductRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -453,6 +587,13 @@ type SaveProductRequest struct {
 	ProductStatus    string  `protobuf:"bytes,11,opt,name=productStatus,proto3" json:"productStatus,omitempty"`
 	ProductSold      float64 `protobuf:"fixed64,12,opt,name=productSold,proto3" json:"productSold,omitempty"`
 	ProductFreebies  string  `protobuf:"bytes,13,opt,name=productFreebies,proto3" json:"productFreebies,omitempty"`
+	Category         string  `protobuf:"bytes,14,opt,name=category,proto3" json:"category,omitempty"`
+	WeightGrams      float64 `protobuf:"fixed64,15,opt,name=weightGrams,proto3" json:"weightGrams,omitempty"`
+	LengthCm         float64 `protobuf:"fixed64,16,opt,name=lengthCm,proto3" json:"lengthCm,omitempty"`
+	WidthCm          float64 `protobuf:"fixed64,17,opt,name=widthCm,proto3" json:"widthCm,omitempty"`
+	HeightCm         float64 `protobuf:"fixed64,18,opt,name=heightCm,proto3" json:"heightCm,omitempty"`
+	Sku              string  `protobuf:"bytes,19,opt,name=sku,proto3" json:"sku,omitempty"`
+	Attributes       string  `protobuf:"bytes,20,opt,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (x *SaveProductRequest) Reset() {
@@ -578,6 +719,55 @@ func (x *SaveProductRequest) GetProductFreebies() string {
 	return ""
 }
 
+func (x *SaveProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SaveProductRequest) GetWeightGrams() float64 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *SaveProductRequest) GetLengthCm() float64 {
+	if x != nil {
+		return x.LengthCm
+	}
+	return 0
+}
+
+func (x *SaveProductRequest) GetWidthCm() float64 {
+	if x != nil {
+		return x.WidthCm
+	}
+	return 0
+}
+
+func (x *SaveProductRequest) GetHeightCm() float64 {
+	if x != nil {
+		return x.HeightCm
+	}
+	return 0
+}
+
+func (x *SaveProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SaveProductRequest) GetAttributes() string {
+	if x != nil {
+		return x.Attributes
+	}
+	return ""
+}
+
 type SaveProductResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -630,8 +820,19 @@ type GetAllProductRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Search            string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
-	SortOptionProduct string `protobuf:"bytes,2,opt,name=sortOptionProduct,proto3" json:"sortOptionProduct,omitempty"`
+	Search            string   `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	SortOptionProduct string   `protobuf:"bytes,2,opt,name=sortOptionProduct,proto3" json:"sortOptionProduct,omitempty"`
+	MinPrice          float64  `protobuf:"fixed64,3,opt,name=minPrice,proto3" json:"minPrice,omitempty"`
+	MaxPrice          float64  `protobuf:"fixed64,4,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	Categories        []string `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	InStock           bool     `protobuf:"varint,6,opt,name=inStock,proto3" json:"inStock,omitempty"`
+	MinDiscount       float64  `protobuf:"fixed64,7,opt,name=minDiscount,proto3" json:"minDiscount,omitempty"`
+	MinRating         float64  `protobuf:"fixed64,8,opt,name=minRating,proto3" json:"minRating,omitempty"`
+	IncludeFacets     bool     `protobuf:"varint,9,opt,name=includeFacets,proto3" json:"includeFacets,omitempty"`
+	Limit             int32    `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32    `protobuf:"varint,11,opt,name=offset,proto3" json:"offset,omitempty"`
+	ProductStatus     string   `protobuf:"bytes,12,opt,name=productStatus,proto3" json:"productStatus,omitempty"`
+	Attributes        string   `protobuf:"bytes,13,opt,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (x *GetAllProductRequest) Reset() {
@@ -680,78 +881,109 @@ func (x *GetAllProductRequest) GetSortOptionProduct() string {
 	return ""
 }
 
-type GetAllProductResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetAllProductRequest) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
 
-	ProductData []*ProductData `protobuf:"bytes,1,rep,name=productData,proto3" json:"productData,omitempty"`
+func (x *GetAllProductRequest) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
 }
 
-func (x *GetAllProductResponse) Reset() {
-	*x = GetAllProductResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetAllProductRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
 	}
+	return nil
 }
 
-func (x *GetAllProductResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetAllProductRequest) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
 }
 
-func (*GetAllProductResponse) ProtoMessage() {}
+func (x *GetAllProductRequest) GetMinDiscount() float64 {
+	if x != nil {
+		return x.MinDiscount
+	}
+	return 0
+}
 
-func (x *GetAllProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetAllProductRequest) GetMinRating() float64 {
+	if x != nil {
+		return x.MinRating
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetAllProductResponse.ProtoReflect.Descriptor instead.
-func (*GetAllProductResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{4}
+func (x *GetAllProductRequest) GetIncludeFacets() bool {
+	if x != nil {
+		return x.IncludeFacets
+	}
+	return false
 }
 
-func (x *GetAllProductResponse) GetProductData() []*ProductData {
+func (x *GetAllProductRequest) GetLimit() int32 {
 	if x != nil {
-		return x.ProductData
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type GetAllProductRequestById struct {
+func (x *GetAllProductRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetAllProductRequest) GetProductStatus() string {
+	if x != nil {
+		return x.ProductStatus
+	}
+	return ""
+}
+
+func (x *GetAllProductRequest) GetAttributes() string {
+	if x != nil {
+		return x.Attributes
+	}
+	return ""
+}
+
+type FacetCount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductId string `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *GetAllProductRequestById) Reset() {
-	*x = GetAllProductRequestById{}
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[5]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllProductRequestById) String() string {
+func (x *FacetCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllProductRequestById) ProtoMessage() {}
+func (*FacetCount) ProtoMessage() {}
 
-func (x *GetAllProductRequestById) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[5]
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -762,43 +994,52 @@ func (x *GetAllProductRequestById) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllProductRequestById.ProtoReflect.Descriptor instead.
-func (*GetAllProductRequestById) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetAllProductRequestById) GetProductId() string {
+func (x *FacetCount) GetValue() string {
 	if x != nil {
-		return x.ProductId
+		return x.Value
 	}
 	return ""
 }
 
-type GetAllProductResponseById struct {
+func (x *FacetCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PriceRangeFacet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductData []*ProductData `protobuf:"bytes,1,rep,name=productData,proto3" json:"productData,omitempty"`
+	Min   float64 `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max   float64 `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	Count int64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *GetAllProductResponseById) Reset() {
-	*x = GetAllProductResponseById{}
+func (x *PriceRangeFacet) Reset() {
+	*x = PriceRangeFacet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[6]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllProductResponseById) String() string {
+func (x *PriceRangeFacet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllProductResponseById) ProtoMessage() {}
+func (*PriceRangeFacet) ProtoMessage() {}
 
-func (x *GetAllProductResponseById) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[6]
+func (x *PriceRangeFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,54 +1050,64 @@ func (x *GetAllProductResponseById) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllProductResponseById.ProtoReflect.Descriptor instead.
-func (*GetAllProductResponseById) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PriceRangeFacet.ProtoReflect.Descriptor instead.
+func (*PriceRangeFacet) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetAllProductResponseById) GetProductData() []*ProductData {
+func (x *PriceRangeFacet) GetMin() float64 {
 	if x != nil {
-		return x.ProductData
+		return x.Min
 	}
-	return nil
+	return 0
 }
 
-type UpdateProductRequest struct {
+func (x *PriceRangeFacet) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *PriceRangeFacet) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ProductFacets struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductId       string  `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
-	ProductName     string  `protobuf:"bytes,2,opt,name=productName,proto3" json:"productName,omitempty"`
-	Img             string  `protobuf:"bytes,3,opt,name=img,proto3" json:"img,omitempty"`
-	Discount        float64 `protobuf:"fixed64,4,opt,name=discount,proto3" json:"discount,omitempty"`
-	SupplierPrice   float64 `protobuf:"fixed64,5,opt,name=supplierPrice,proto3" json:"supplierPrice,omitempty"`
-	OriginalPrice   float64 `protobuf:"fixed64,6,opt,name=originalPrice,proto3" json:"originalPrice,omitempty"`
-	DiscountedPrice float64 `protobuf:"fixed64,7,opt,name=discountedPrice,proto3" json:"discountedPrice,omitempty"`
-	Description1    string  `protobuf:"bytes,8,opt,name=description1,proto3" json:"description1,omitempty"`
-	Description2    string  `protobuf:"bytes,9,opt,name=description2,proto3" json:"description2,omitempty"`
-	ProductStatus   string  `protobuf:"bytes,10,opt,name=productStatus,proto3" json:"productStatus,omitempty"`
-	ProductSold     float64 `protobuf:"fixed64,11,opt,name=productSold,proto3" json:"productSold,omitempty"`
-	ProductFreebies string  `protobuf:"bytes,12,opt,name=productFreebies,proto3" json:"productFreebies,omitempty"`
+	Categories  []*FacetCount      `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	PriceRanges []*PriceRangeFacet `protobuf:"bytes,2,rep,name=priceRanges,proto3" json:"priceRanges,omitempty"`
+	MinPrice    float64            `protobuf:"fixed64,3,opt,name=minPrice,proto3" json:"minPrice,omitempty"`
+	MaxPrice    float64            `protobuf:"fixed64,4,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	InStock     int64              `protobuf:"varint,5,opt,name=inStock,proto3" json:"inStock,omitempty"`
+	Discounts   []*FacetCount      `protobuf:"bytes,6,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	Ratings     []*FacetCount      `protobuf:"bytes,7,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	Attributes  []*AttributeFacet  `protobuf:"bytes,8,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
+func (x *ProductFacets) Reset() {
+	*x = ProductFacets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[7]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *ProductFacets) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*ProductFacets) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[7]
+func (x *ProductFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,120 +1118,98 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ProductFacets.ProtoReflect.Descriptor instead.
+func (*ProductFacets) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdateProductRequest) GetProductId() string {
+func (x *ProductFacets) GetCategories() []*FacetCount {
 	if x != nil {
-		return x.ProductId
+		return x.Categories
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateProductRequest) GetProductName() string {
+func (x *ProductFacets) GetPriceRanges() []*PriceRangeFacet {
 	if x != nil {
-		return x.ProductName
+		return x.PriceRanges
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateProductRequest) GetImg() string {
+func (x *ProductFacets) GetMinPrice() float64 {
 	if x != nil {
-		return x.Img
-	}
-	return ""
-}
-
-func (x *UpdateProductRequest) GetDiscount() float64 {
-	if x != nil {
-		return x.Discount
-	}
-	return 0
-}
-
-func (x *UpdateProductRequest) GetSupplierPrice() float64 {
-	if x != nil {
-		return x.SupplierPrice
+		return x.MinPrice
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetOriginalPrice() float64 {
+func (x *ProductFacets) GetMaxPrice() float64 {
 	if x != nil {
-		return x.OriginalPrice
+		return x.MaxPrice
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetDiscountedPrice() float64 {
+func (x *ProductFacets) GetInStock() int64 {
 	if x != nil {
-		return x.DiscountedPrice
+		return x.InStock
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetDescription1() string {
-	if x != nil {
-		return x.Description1
-	}
-	return ""
-}
-
-func (x *UpdateProductRequest) GetDescription2() string {
-	if x != nil {
-		return x.Description2
-	}
-	return ""
-}
-
-func (x *UpdateProductRequest) GetProductStatus() string {
+func (x *ProductFacets) GetDiscounts() []*FacetCount {
 	if x != nil {
-		return x.ProductStatus
+		return x.Discounts
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateProductRequest) GetProductSold() float64 {
+func (x *ProductFacets) GetRatings() []*FacetCount {
 	if x != nil {
-		return x.ProductSold
+		return x.Ratings
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateProductRequest) GetProductFreebies() string {
+func (x *ProductFacets) GetAttributes() []*AttributeFacet {
 	if x != nil {
-		return x.ProductFreebies
+		return x.Attributes
 	}
-	return ""
+	return nil
 }
 
-type UpdateProductResponse struct {
+type AttributeFacet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductData *ProductData `protobuf:"bytes,1,opt,name=productData,proto3" json:"productData,omitempty"`
+	Code   string        `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name   string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type   string        `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Unit   string        `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	Values []*FacetCount `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"`
+	Min    float64       `protobuf:"fixed64,6,opt,name=min,proto3" json:"min,omitempty"`
+	Max    float64       `protobuf:"fixed64,7,opt,name=max,proto3" json:"max,omitempty"`
 }
 
-func (x *UpdateProductResponse) Reset() {
-	*x = UpdateProductResponse{}
+func (x *AttributeFacet) Reset() {
+	*x = AttributeFacet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[8]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateProductResponse) String() string {
+func (x *AttributeFacet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductResponse) ProtoMessage() {}
+func (*AttributeFacet) ProtoMessage() {}
 
-func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[8]
+func (x *AttributeFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -991,106 +1220,87 @@ func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use AttributeFacet.ProtoReflect.Descriptor instead.
+func (*AttributeFacet) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UpdateProductResponse) GetProductData() *ProductData {
+func (x *AttributeFacet) GetCode() string {
 	if x != nil {
-		return x.ProductData
+		return x.Code
 	}
-	return nil
-}
-
-type UpdateProductQuantityRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ProductId        string  `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
-	OriginalQuantity float64 `protobuf:"fixed64,2,opt,name=originalQuantity,proto3" json:"originalQuantity,omitempty"`
-	CurrentQuantity  float64 `protobuf:"fixed64,3,opt,name=currentQuantity,proto3" json:"currentQuantity,omitempty"`
+	return ""
 }
 
-func (x *UpdateProductQuantityRequest) Reset() {
-	*x = UpdateProductQuantityRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AttributeFacet) GetName() string {
+	if x != nil {
+		return x.Name
 	}
+	return ""
 }
 
-func (x *UpdateProductQuantityRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateProductQuantityRequest) ProtoMessage() {}
-
-func (x *UpdateProductQuantityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AttributeFacet) GetType() string {
+	if x != nil {
+		return x.Type
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateProductQuantityRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductQuantityRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{9}
+func (x *AttributeFacet) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
 }
 
-func (x *UpdateProductQuantityRequest) GetProductId() string {
+func (x *AttributeFacet) GetValues() []*FacetCount {
 	if x != nil {
-		return x.ProductId
+		return x.Values
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateProductQuantityRequest) GetOriginalQuantity() float64 {
+func (x *AttributeFacet) GetMin() float64 {
 	if x != nil {
-		return x.OriginalQuantity
+		return x.Min
 	}
 	return 0
 }
 
-func (x *UpdateProductQuantityRequest) GetCurrentQuantity() float64 {
+func (x *AttributeFacet) GetMax() float64 {
 	if x != nil {
-		return x.CurrentQuantity
+		return x.Max
 	}
 	return 0
 }
 
-type UpdateProductQuantityResponse struct {
+type GetAllProductResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductData *ProductData `protobuf:"bytes,1,opt,name=productData,proto3" json:"productData,omitempty"`
+	ProductData []*ProductData `protobuf:"bytes,1,rep,name=productData,proto3" json:"productData,omitempty"`
+	Total       int64          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Facets      *ProductFacets `protobuf:"bytes,3,opt,name=facets,proto3" json:"facets,omitempty"`
 }
 
-func (x *UpdateProductQuantityResponse) Reset() {
-	*x = UpdateProductQuantityResponse{}
+func (x *GetAllProductResponse) Reset() {
+	*x = GetAllProductResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[10]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateProductQuantityResponse) String() string {
+func (x *GetAllProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductQuantityResponse) ProtoMessage() {}
+func (*GetAllProductResponse) ProtoMessage() {}
 
-func (x *UpdateProductQuantityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[10]
+func (x *GetAllProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,44 +1311,57 @@ func (x *UpdateProductQuantityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductQuantityResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProductQuantityResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetAllProductResponse.ProtoReflect.Descriptor instead.
+func (*GetAllProductResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateProductQuantityResponse) GetProductData() *ProductData {
+func (x *GetAllProductResponse) GetProductData() []*ProductData {
 	if x != nil {
 		return x.ProductData
 	}
 	return nil
 }
 
-type UpdateProductStatusRequest struct {
+func (x *GetAllProductResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetAllProductResponse) GetFacets() *ProductFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type GetAllProductRequestById struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductId     string `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
-	ProductStatus string `protobuf:"bytes,2,opt,name=productStatus,proto3" json:"productStatus,omitempty"`
+	ProductId string `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
 }
 
-func (x *UpdateProductStatusRequest) Reset() {
-	*x = UpdateProductStatusRequest{}
+func (x *GetAllProductRequestById) Reset() {
+	*x = GetAllProductRequestById{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[11]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateProductStatusRequest) String() string {
+func (x *GetAllProductRequestById) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductStatusRequest) ProtoMessage() {}
+func (*GetAllProductRequestById) ProtoMessage() {}
 
-func (x *UpdateProductStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[11]
+func (x *GetAllProductRequestById) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1149,50 +1372,43 @@ func (x *UpdateProductStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductStatusRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetAllProductRequestById.ProtoReflect.Descriptor instead.
+func (*GetAllProductRequestById) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateProductStatusRequest) GetProductId() string {
+func (x *GetAllProductRequestById) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *UpdateProductStatusRequest) GetProductStatus() string {
-	if x != nil {
-		return x.ProductStatus
-	}
-	return ""
-}
-
-type UpdateProductStatusResponse struct {
+type GetAllProductResponseById struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductData *ProductData `protobuf:"bytes,1,opt,name=productData,proto3" json:"productData,omitempty"`
+	ProductData []*ProductData `protobuf:"bytes,1,rep,name=productData,proto3" json:"productData,omitempty"`
 }
 
-func (x *UpdateProductStatusResponse) Reset() {
-	*x = UpdateProductStatusResponse{}
+func (x *GetAllProductResponseById) Reset() {
+	*x = GetAllProductResponseById{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[12]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateProductStatusResponse) String() string {
+func (x *GetAllProductResponseById) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductStatusResponse) ProtoMessage() {}
+func (*GetAllProductResponseById) ProtoMessage() {}
 
-func (x *UpdateProductStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[12]
+func (x *GetAllProductResponseById) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,53 +1419,63 @@ func (x *UpdateProductStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProductStatusResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetAllProductResponseById.ProtoReflect.Descriptor instead.
+func (*GetAllProductResponseById) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateProductStatusResponse) GetProductData() *ProductData {
+func (x *GetAllProductResponseById) GetProductData() []*ProductData {
 	if x != nil {
 		return x.ProductData
 	}
 	return nil
 }
 
-type FreebiesData struct {
+type UpdateProductRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FreebiesId               string  `protobuf:"bytes,1,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
-	FreebiesName             string  `protobuf:"bytes,2,opt,name=freebiesName,proto3" json:"freebiesName,omitempty"`
-	FreebiesImg              []byte  `protobuf:"bytes,3,opt,name=freebiesImg,proto3" json:"freebiesImg,omitempty"`
-	FreebiesStorePrice       float64 `protobuf:"fixed64,4,opt,name=freebiesStorePrice,proto3" json:"freebiesStorePrice,omitempty"`
-	FreebiesOriginalQuantity float64 `protobuf:"fixed64,5,opt,name=freebiesOriginalQuantity,proto3" json:"freebiesOriginalQuantity,omitempty"`
-	FreebiesCurrentQuantity  float64 `protobuf:"fixed64,6,opt,name=freebiesCurrentQuantity,proto3" json:"freebiesCurrentQuantity,omitempty"`
-	FreebiesStatus           string  `protobuf:"bytes,7,opt,name=freebiesStatus,proto3" json:"freebiesStatus,omitempty"`
-	CreatedBy                string  `protobuf:"bytes,8,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
-	CreatedAt                int64   `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedBy                string  `protobuf:"bytes,10,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
-	UpdatedAt                int64   `protobuf:"varint,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	ProductId       string   `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
+	ProductName     string   `protobuf:"bytes,2,opt,name=productName,proto3" json:"productName,omitempty"`
+	Img             string   `protobuf:"bytes,3,opt,name=img,proto3" json:"img,omitempty"`
+	Discount        float64  `protobuf:"fixed64,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	SupplierPrice   float64  `protobuf:"fixed64,5,opt,name=supplierPrice,proto3" json:"supplierPrice,omitempty"`
+	OriginalPrice   float64  `protobuf:"fixed64,6,opt,name=originalPrice,proto3" json:"originalPrice,omitempty"`
+	DiscountedPrice float64  `protobuf:"fixed64,7,opt,name=discountedPrice,proto3" json:"discountedPrice,omitempty"`
+	Description1    string   `protobuf:"bytes,8,opt,name=description1,proto3" json:"description1,omitempty"`
+	Description2    string   `protobuf:"bytes,9,opt,name=description2,proto3" json:"description2,omitempty"`
+	ProductStatus   string   `protobuf:"bytes,10,opt,name=productStatus,proto3" json:"productStatus,omitempty"`
+	ProductSold     float64  `protobuf:"fixed64,11,opt,name=productSold,proto3" json:"productSold,omitempty"`
+	ProductFreebies string   `protobuf:"bytes,12,opt,name=productFreebies,proto3" json:"productFreebies,omitempty"`
+	Category        string   `protobuf:"bytes,13,opt,name=category,proto3" json:"category,omitempty"`
+	UpdateMask      []string `protobuf:"bytes,14,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+	ExpectedVersion int64    `protobuf:"varint,15,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+	WeightGrams     float64  `protobuf:"fixed64,16,opt,name=weightGrams,proto3" json:"weightGrams,omitempty"`
+	LengthCm        float64  `protobuf:"fixed64,17,opt,name=lengthCm,proto3" json:"lengthCm,omitempty"`
+	WidthCm         float64  `protobuf:"fixed64,18,opt,name=widthCm,proto3" json:"widthCm,omitempty"`
+	HeightCm        float64  `protobuf:"fixed64,19,opt,name=heightCm,proto3" json:"heightCm,omitempty"`
+	Sku             string   `protobuf:"bytes,20,opt,name=sku,proto3" json:"sku,omitempty"`
+	Attributes      string   `protobuf:"bytes,21,opt,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
-func (x *FreebiesData) Reset() {
-	*x = FreebiesData{}
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[13]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FreebiesData) String() string {
+func (x *UpdateProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FreebiesData) ProtoMessage() {}
+func (*UpdateProductRequest) ProtoMessage() {}
 
-func (x *FreebiesData) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[13]
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1260,200 +1486,183 @@ func (x *FreebiesData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FreebiesData.ProtoReflect.Descriptor instead.
-func (*FreebiesData) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *FreebiesData) GetFreebiesId() string {
+func (x *UpdateProductRequest) GetProductId() string {
 	if x != nil {
-		return x.FreebiesId
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *FreebiesData) GetFreebiesName() string {
+func (x *UpdateProductRequest) GetProductName() string {
 	if x != nil {
-		return x.FreebiesName
+		return x.ProductName
 	}
 	return ""
 }
 
-func (x *FreebiesData) GetFreebiesImg() []byte {
+func (x *UpdateProductRequest) GetImg() string {
 	if x != nil {
-		return x.FreebiesImg
+		return x.Img
 	}
-	return nil
+	return ""
 }
 
-func (x *FreebiesData) GetFreebiesStorePrice() float64 {
+func (x *UpdateProductRequest) GetDiscount() float64 {
 	if x != nil {
-		return x.FreebiesStorePrice
+		return x.Discount
 	}
 	return 0
 }
 
-func (x *FreebiesData) GetFreebiesOriginalQuantity() float64 {
+func (x *UpdateProductRequest) GetSupplierPrice() float64 {
 	if x != nil {
-		return x.FreebiesOriginalQuantity
+		return x.SupplierPrice
 	}
 	return 0
 }
 
-func (x *FreebiesData) GetFreebiesCurrentQuantity() float64 {
+func (x *UpdateProductRequest) GetOriginalPrice() float64 {
 	if x != nil {
-		return x.FreebiesCurrentQuantity
+		return x.OriginalPrice
 	}
 	return 0
 }
 
-func (x *FreebiesData) GetFreebiesStatus() string {
+func (x *UpdateProductRequest) GetDiscountedPrice() float64 {
 	if x != nil {
-		return x.FreebiesStatus
+		return x.DiscountedPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *FreebiesData) GetCreatedBy() string {
+func (x *UpdateProductRequest) GetDescription1() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.Description1
 	}
 	return ""
 }
 
-func (x *FreebiesData) GetCreatedAt() int64 {
+func (x *UpdateProductRequest) GetDescription2() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Description2
 	}
-	return 0
+	return ""
 }
 
-func (x *FreebiesData) GetUpdatedBy() string {
+func (x *UpdateProductRequest) GetProductStatus() string {
 	if x != nil {
-		return x.UpdatedBy
+		return x.ProductStatus
 	}
 	return ""
 }
 
-func (x *FreebiesData) GetUpdatedAt() int64 {
+func (x *UpdateProductRequest) GetProductSold() float64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ProductSold
 	}
 	return 0
 }
 
-type SaveFreebiesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	FreebiesName             string  `protobuf:"bytes,1,opt,name=freebiesName,proto3" json:"freebiesName,omitempty"`
-	FreebiesImg              []byte  `protobuf:"bytes,2,opt,name=freebiesImg,proto3" json:"freebiesImg,omitempty"`
-	FreebiesStorePrice       float64 `protobuf:"fixed64,3,opt,name=freebiesStorePrice,proto3" json:"freebiesStorePrice,omitempty"`
-	FreebiesOriginalQuantity float64 `protobuf:"fixed64,4,opt,name=freebiesOriginalQuantity,proto3" json:"freebiesOriginalQuantity,omitempty"`
-	FreebiesCurrentQuantity  float64 `protobuf:"fixed64,5,opt,name=freebiesCurrentQuantity,proto3" json:"freebiesCurrentQuantity,omitempty"`
-	FreebiesStatus           string  `protobuf:"bytes,6,opt,name=freebiesStatus,proto3" json:"freebiesStatus,omitempty"`
-}
-
-func (x *SaveFreebiesRequest) Reset() {
-	*x = SaveFreebiesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UpdateProductRequest) GetProductFreebies() string {
+	if x != nil {
+		return x.ProductFreebies
 	}
+	return ""
 }
 
-func (x *SaveFreebiesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
 }
 
-func (*SaveFreebiesRequest) ProtoMessage() {}
-
-func (x *SaveFreebiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UpdateProductRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SaveFreebiesRequest.ProtoReflect.Descriptor instead.
-func (*SaveFreebiesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{14}
+func (x *UpdateProductRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
 }
 
-func (x *SaveFreebiesRequest) GetFreebiesName() string {
+func (x *UpdateProductRequest) GetWeightGrams() float64 {
 	if x != nil {
-		return x.FreebiesName
+		return x.WeightGrams
 	}
-	return ""
+	return 0
 }
 
-func (x *SaveFreebiesRequest) GetFreebiesImg() []byte {
+func (x *UpdateProductRequest) GetLengthCm() float64 {
 	if x != nil {
-		return x.FreebiesImg
+		return x.LengthCm
 	}
-	return nil
+	return 0
 }
 
-func (x *SaveFreebiesRequest) GetFreebiesStorePrice() float64 {
+func (x *UpdateProductRequest) GetWidthCm() float64 {
 	if x != nil {
-		return x.FreebiesStorePrice
+		return x.WidthCm
 	}
 	return 0
 }
 
-func (x *SaveFreebiesRequest) GetFreebiesOriginalQuantity() float64 {
+func (x *UpdateProductRequest) GetHeightCm() float64 {
 	if x != nil {
-		return x.FreebiesOriginalQuantity
+		return x.HeightCm
 	}
 	return 0
 }
 
-func (x *SaveFreebiesRequest) GetFreebiesCurrentQuantity() float64 {
+func (x *UpdateProductRequest) GetSku() string {
 	if x != nil {
-		return x.FreebiesCurrentQuantity
+		return x.Sku
 	}
-	return 0
+	return ""
 }
 
-func (x *SaveFreebiesRequest) GetFreebiesStatus() string {
+func (x *UpdateProductRequest) GetAttributes() string {
 	if x != nil {
-		return x.FreebiesStatus
+		return x.Attributes
 	}
 	return ""
 }
 
-type SaveFreebiesResponse struct {
+type UpdateProductResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FreebiesData *FreebiesData `protobuf:"bytes,1,opt,name=freebiesData,proto3" json:"freebiesData,omitempty"`
+	ProductData *ProductData `protobuf:"bytes,1,opt,name=productData,proto3" json:"productData,omitempty"`
 }
 
-func (x *SaveFreebiesResponse) Reset() {
-	*x = SaveFreebiesResponse{}
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[15]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SaveFreebiesResponse) String() string {
+func (x *UpdateProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveFreebiesResponse) ProtoMessage() {}
+func (*UpdateProductResponse) ProtoMessage() {}
 
-func (x *SaveFreebiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[15]
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,44 +1673,47 @@ func (x *SaveFreebiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveFreebiesResponse.ProtoReflect.Descriptor instead.
-func (*SaveFreebiesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SaveFreebiesResponse) GetFreebiesData() *FreebiesData {
+func (x *UpdateProductResponse) GetProductData() *ProductData {
 	if x != nil {
-		return x.FreebiesData
+		return x.ProductData
 	}
 	return nil
 }
 
-type GetAllFreebiesRequest struct {
+type UpdateProductQuantityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Search     string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
-	SortOption string `protobuf:"bytes,2,opt,name=sortOption,proto3" json:"sortOption,omitempty"`
+	ProductId        string   `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
+	OriginalQuantity float64  `protobuf:"fixed64,2,opt,name=originalQuantity,proto3" json:"originalQuantity,omitempty"`
+	CurrentQuantity  float64  `protobuf:"fixed64,3,opt,name=currentQuantity,proto3" json:"currentQuantity,omitempty"`
+	UpdateMask       []string `protobuf:"bytes,4,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+	ExpectedVersion  int64    `protobuf:"varint,5,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 }
 
-func (x *GetAllFreebiesRequest) Reset() {
-	*x = GetAllFreebiesRequest{}
+func (x *UpdateProductQuantityRequest) Reset() {
+	*x = UpdateProductQuantityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[16]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllFreebiesRequest) String() string {
+func (x *UpdateProductQuantityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllFreebiesRequest) ProtoMessage() {}
+func (*UpdateProductQuantityRequest) ProtoMessage() {}
 
-func (x *GetAllFreebiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[16]
+func (x *UpdateProductQuantityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1512,50 +1724,71 @@ func (x *GetAllFreebiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllFreebiesRequest.ProtoReflect.Descriptor instead.
-func (*GetAllFreebiesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use UpdateProductQuantityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductQuantityRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetAllFreebiesRequest) GetSearch() string {
+func (x *UpdateProductQuantityRequest) GetProductId() string {
 	if x != nil {
-		return x.Search
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *GetAllFreebiesRequest) GetSortOption() string {
+func (x *UpdateProductQuantityRequest) GetOriginalQuantity() float64 {
 	if x != nil {
-		return x.SortOption
+		return x.OriginalQuantity
 	}
-	return ""
+	return 0
 }
 
-type GetAllFreebiesResponse struct {
+func (x *UpdateProductQuantityRequest) GetCurrentQuantity() float64 {
+	if x != nil {
+		return x.CurrentQuantity
+	}
+	return 0
+}
+
+func (x *UpdateProductQuantityRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateProductQuantityRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateProductQuantityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FreebiesData []*FreebiesData `protobuf:"bytes,1,rep,name=freebiesData,proto3" json:"freebiesData,omitempty"`
+	ProductData *ProductData `protobuf:"bytes,1,opt,name=productData,proto3" json:"productData,omitempty"`
 }
 
-func (x *GetAllFreebiesResponse) Reset() {
-	*x = GetAllFreebiesResponse{}
+func (x *UpdateProductQuantityResponse) Reset() {
+	*x = UpdateProductQuantityResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[17]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllFreebiesResponse) String() string {
+func (x *UpdateProductQuantityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllFreebiesResponse) ProtoMessage() {}
+func (*UpdateProductQuantityResponse) ProtoMessage() {}
 
-func (x *GetAllFreebiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[17]
+func (x *UpdateProductQuantityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1566,41 +1799,45 @@ func (x *GetAllFreebiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllFreebiesResponse.ProtoReflect.Descriptor instead.
-func (*GetAllFreebiesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UpdateProductQuantityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductQuantityResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetAllFreebiesResponse) GetFreebiesData() []*FreebiesData {
+func (x *UpdateProductQuantityResponse) GetProductData() *ProductData {
 	if x != nil {
-		return x.FreebiesData
+		return x.ProductData
 	}
 	return nil
 }
 
-type GetAllFreebiesDropdownRequest struct {
+type UpdateProductStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ProductId       string `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
+	ProductStatus   string `protobuf:"bytes,2,opt,name=productStatus,proto3" json:"productStatus,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 }
 
-func (x *GetAllFreebiesDropdownRequest) Reset() {
-	*x = GetAllFreebiesDropdownRequest{}
+func (x *UpdateProductStatusRequest) Reset() {
+	*x = UpdateProductStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[18]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllFreebiesDropdownRequest) String() string {
+func (x *UpdateProductStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllFreebiesDropdownRequest) ProtoMessage() {}
+func (*UpdateProductStatusRequest) ProtoMessage() {}
 
-func (x *GetAllFreebiesDropdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[18]
+func (x *UpdateProductStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1611,36 +1848,57 @@ func (x *GetAllFreebiesDropdownRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllFreebiesDropdownRequest.ProtoReflect.Descriptor instead.
-func (*GetAllFreebiesDropdownRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use UpdateProductStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{15}
 }
 
-type GetAllFreebiesDropdownResponse struct {
+func (x *UpdateProductStatusRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateProductStatusRequest) GetProductStatus() string {
+	if x != nil {
+		return x.ProductStatus
+	}
+	return ""
+}
+
+func (x *UpdateProductStatusRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateProductStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FreebiesData []*FreebiesData `protobuf:"bytes,1,rep,name=freebiesData,proto3" json:"freebiesData,omitempty"`
+	ProductData *ProductData `protobuf:"bytes,1,opt,name=productData,proto3" json:"productData,omitempty"`
 }
 
-func (x *GetAllFreebiesDropdownResponse) Reset() {
-	*x = GetAllFreebiesDropdownResponse{}
+func (x *UpdateProductStatusResponse) Reset() {
+	*x = UpdateProductStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[19]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllFreebiesDropdownResponse) String() string {
+func (x *UpdateProductStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllFreebiesDropdownResponse) ProtoMessage() {}
+func (*UpdateProductStatusResponse) ProtoMessage() {}
 
-func (x *GetAllFreebiesDropdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[19]
+func (x *UpdateProductStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,43 +1909,57 @@ func (x *GetAllFreebiesDropdownResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllFreebiesDropdownResponse.ProtoReflect.Descriptor instead.
-func (*GetAllFreebiesDropdownResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use UpdateProductStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetAllFreebiesDropdownResponse) GetFreebiesData() []*FreebiesData {
+func (x *UpdateProductStatusResponse) GetProductData() *ProductData {
 	if x != nil {
-		return x.FreebiesData
+		return x.ProductData
 	}
 	return nil
 }
 
-type GetAllFreebiesRequestById struct {
+type FreebiesData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FreebiesId string `protobuf:"bytes,1,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
+	FreebiesId               string  `protobuf:"bytes,1,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
+	FreebiesName             string  `protobuf:"bytes,2,opt,name=freebiesName,proto3" json:"freebiesName,omitempty"`
+	FreebiesImg              []byte  `protobuf:"bytes,3,opt,name=freebiesImg,proto3" json:"freebiesImg,omitempty"`
+	FreebiesStorePrice       float64 `protobuf:"fixed64,4,opt,name=freebiesStorePrice,proto3" json:"freebiesStorePrice,omitempty"`
+	FreebiesOriginalQuantity float64 `protobuf:"fixed64,5,opt,name=freebiesOriginalQuantity,proto3" json:"freebiesOriginalQuantity,omitempty"`
+	FreebiesCurrentQuantity  float64 `protobuf:"fixed64,6,opt,name=freebiesCurrentQuantity,proto3" json:"freebiesCurrentQuantity,omitempty"`
+	FreebiesStatus           string  `protobuf:"bytes,7,opt,name=freebiesStatus,proto3" json:"freebiesStatus,omitempty"`
+	CreatedBy                string  `protobuf:"bytes,8,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt                int64   `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy                string  `protobuf:"bytes,10,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt                int64   `protobuf:"varint,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Version                  int64   `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	Sku                      string  `protobuf:"bytes,13,opt,name=sku,proto3" json:"sku,omitempty"`
+	FreebiesImgKey           string  `protobuf:"bytes,14,opt,name=freebiesImgKey,proto3" json:"freebiesImgKey,omitempty"`
+	FreebiesImgUrl           string  `protobuf:"bytes,15,opt,name=freebiesImgUrl,proto3" json:"freebiesImgUrl,omitempty"`
 }
 
-func (x *GetAllFreebiesRequestById) Reset() {
-	*x = GetAllFreebiesRequestById{}
+func (x *FreebiesData) Reset() {
+	*x = FreebiesData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[20]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllFreebiesRequestById) String() string {
+func (x *FreebiesData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllFreebiesRequestById) ProtoMessage() {}
+func (*FreebiesData) ProtoMessage() {}
 
-func (x *GetAllFreebiesRequestById) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[20]
+func (x *FreebiesData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1698,94 +1970,148 @@ func (x *GetAllFreebiesRequestById) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllFreebiesRequestById.ProtoReflect.Descriptor instead.
-func (*GetAllFreebiesRequestById) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use FreebiesData.ProtoReflect.Descriptor instead.
+func (*FreebiesData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetAllFreebiesRequestById) GetFreebiesId() string {
+func (x *FreebiesData) GetFreebiesId() string {
 	if x != nil {
 		return x.FreebiesId
 	}
 	return ""
 }
 
-type GetAllFreebiesResponseById struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *FreebiesData) GetFreebiesName() string {
+	if x != nil {
+		return x.FreebiesName
+	}
+	return ""
+}
 
-	FreebiesData []*FreebiesData `protobuf:"bytes,1,rep,name=freebiesData,proto3" json:"freebiesData,omitempty"`
+func (x *FreebiesData) GetFreebiesImg() []byte {
+	if x != nil {
+		return x.FreebiesImg
+	}
+	return nil
 }
 
-func (x *GetAllFreebiesResponseById) Reset() {
-	*x = GetAllFreebiesResponseById{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *FreebiesData) GetFreebiesStorePrice() float64 {
+	if x != nil {
+		return x.FreebiesStorePrice
 	}
+	return 0
 }
 
-func (x *GetAllFreebiesResponseById) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FreebiesData) GetFreebiesOriginalQuantity() float64 {
+	if x != nil {
+		return x.FreebiesOriginalQuantity
+	}
+	return 0
 }
 
-func (*GetAllFreebiesResponseById) ProtoMessage() {}
+func (x *FreebiesData) GetFreebiesCurrentQuantity() float64 {
+	if x != nil {
+		return x.FreebiesCurrentQuantity
+	}
+	return 0
+}
 
-func (x *GetAllFreebiesResponseById) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *FreebiesData) GetFreebiesStatus() string {
+	if x != nil {
+		return x.FreebiesStatus
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetAllFreebiesResponseById.ProtoReflect.Descriptor instead.
-func (*GetAllFreebiesResponseById) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{21}
+func (x *FreebiesData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
 }
 
-func (x *GetAllFreebiesResponseById) GetFreebiesData() []*FreebiesData {
+func (x *FreebiesData) GetCreatedAt() int64 {
 	if x != nil {
-		return x.FreebiesData
+		return x.CreatedAt
 	}
-	return nil
+	return 0
 }
 
-type UpdateFreebiesRequest struct {
+func (x *FreebiesData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *FreebiesData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *FreebiesData) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FreebiesData) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *FreebiesData) GetFreebiesImgKey() string {
+	if x != nil {
+		return x.FreebiesImgKey
+	}
+	return ""
+}
+
+func (x *FreebiesData) GetFreebiesImgUrl() string {
+	if x != nil {
+		return x.FreebiesImgUrl
+	}
+	return ""
+}
+
+type SaveFreebiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FreebiesId         string  `protobuf:"bytes,1,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
-	FreebiesName       string  `protobuf:"bytes,2,opt,name=freebiesName,proto3" json:"freebiesName,omitempty"`
-	FreebiesImg        []byte  `protobuf:"bytes,3,opt,name=freebiesImg,proto3" json:"freebiesImg,omitempty"`
-	FreebiesStorePrice float64 `protobuf:"fixed64,4,opt,name=freebiesStorePrice,proto3" json:"freebiesStorePrice,omitempty"`
-	FreebiesStatus     string  `protobuf:"bytes,5,opt,name=freebiesStatus,proto3" json:"freebiesStatus,omitempty"`
+	FreebiesName             string  `protobuf:"bytes,1,opt,name=freebiesName,proto3" json:"freebiesName,omitempty"`
+	FreebiesImg              []byte  `protobuf:"bytes,2,opt,name=freebiesImg,proto3" json:"freebiesImg,omitempty"`
+	FreebiesStorePrice       float64 `protobuf:"fixed64,3,opt,name=freebiesStorePrice,proto3" json:"freebiesStorePrice,omitempty"`
+	FreebiesOriginalQuantity float64 `protobuf:"fixed64,4,opt,name=freebiesOriginalQuantity,proto3" json:"freebiesOriginalQuantity,omitempty"`
+	FreebiesCurrentQuantity  float64 `protobuf:"fixed64,5,opt,name=freebiesCurrentQuantity,proto3" json:"freebiesCurrentQuantity,omitempty"`
+	FreebiesStatus           string  `protobuf:"bytes,6,opt,name=freebiesStatus,proto3" json:"freebiesStatus,omitempty"`
+	Sku                      string  `protobuf:"bytes,7,opt,name=sku,proto3" json:"sku,omitempty"`
+	FreebiesImgKey           string  `protobuf:"bytes,8,opt,name=freebiesImgKey,proto3" json:"freebiesImgKey,omitempty"`
 }
 
-func (x *UpdateFreebiesRequest) Reset() {
-	*x = UpdateFreebiesRequest{}
+func (x *SaveFreebiesRequest) Reset() {
+	*x = SaveFreebiesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[22]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateFreebiesRequest) String() string {
+func (x *SaveFreebiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFreebiesRequest) ProtoMessage() {}
+func (*SaveFreebiesRequest) ProtoMessage() {}
 
-func (x *UpdateFreebiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[22]
+func (x *SaveFreebiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1796,47 +2122,68 @@ func (x *UpdateFreebiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFreebiesRequest.ProtoReflect.Descriptor instead.
-func (*UpdateFreebiesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *UpdateFreebiesRequest) GetFreebiesId() string {
-	if x != nil {
-		return x.FreebiesId
-	}
-	return ""
+// Deprecated: Use SaveFreebiesRequest.ProtoReflect.Descriptor instead.
+func (*SaveFreebiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UpdateFreebiesRequest) GetFreebiesName() string {
+func (x *SaveFreebiesRequest) GetFreebiesName() string {
 	if x != nil {
 		return x.FreebiesName
 	}
 	return ""
 }
 
-func (x *UpdateFreebiesRequest) GetFreebiesImg() []byte {
+func (x *SaveFreebiesRequest) GetFreebiesImg() []byte {
 	if x != nil {
 		return x.FreebiesImg
 	}
 	return nil
 }
 
-func (x *UpdateFreebiesRequest) GetFreebiesStorePrice() float64 {
+func (x *SaveFreebiesRequest) GetFreebiesStorePrice() float64 {
 	if x != nil {
 		return x.FreebiesStorePrice
 	}
 	return 0
 }
 
-func (x *UpdateFreebiesRequest) GetFreebiesStatus() string {
+func (x *SaveFreebiesRequest) GetFreebiesOriginalQuantity() float64 {
+	if x != nil {
+		return x.FreebiesOriginalQuantity
+	}
+	return 0
+}
+
+func (x *SaveFreebiesRequest) GetFreebiesCurrentQuantity() float64 {
+	if x != nil {
+		return x.FreebiesCurrentQuantity
+	}
+	return 0
+}
+
+func (x *SaveFreebiesRequest) GetFreebiesStatus() string {
 	if x != nil {
 		return x.FreebiesStatus
 	}
 	return ""
 }
 
-type UpdateFreebiesResponse struct {
+func (x *SaveFreebiesRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SaveFreebiesRequest) GetFreebiesImgKey() string {
+	if x != nil {
+		return x.FreebiesImgKey
+	}
+	return ""
+}
+
+type SaveFreebiesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1844,23 +2191,23 @@ type UpdateFreebiesResponse struct {
 	FreebiesData *FreebiesData `protobuf:"bytes,1,opt,name=freebiesData,proto3" json:"freebiesData,omitempty"`
 }
 
-func (x *UpdateFreebiesResponse) Reset() {
-	*x = UpdateFreebiesResponse{}
+func (x *SaveFreebiesResponse) Reset() {
+	*x = SaveFreebiesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[23]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateFreebiesResponse) String() string {
+func (x *SaveFreebiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFreebiesResponse) ProtoMessage() {}
+func (*SaveFreebiesResponse) ProtoMessage() {}
 
-func (x *UpdateFreebiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[23]
+func (x *SaveFreebiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1871,45 +2218,44 @@ func (x *UpdateFreebiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFreebiesResponse.ProtoReflect.Descriptor instead.
-func (*UpdateFreebiesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SaveFreebiesResponse.ProtoReflect.Descriptor instead.
+func (*SaveFreebiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UpdateFreebiesResponse) GetFreebiesData() *FreebiesData {
+func (x *SaveFreebiesResponse) GetFreebiesData() *FreebiesData {
 	if x != nil {
 		return x.FreebiesData
 	}
 	return nil
 }
 
-type UpdateFreebiesQuantityRequest struct {
+type GetAllFreebiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FreebiesId               string  `protobuf:"bytes,1,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
-	FreebiesOriginalQuantity float64 `protobuf:"fixed64,2,opt,name=freebiesOriginalQuantity,proto3" json:"freebiesOriginalQuantity,omitempty"`
-	FreebiesCurrentQuantity  float64 `protobuf:"fixed64,3,opt,name=freebiesCurrentQuantity,proto3" json:"freebiesCurrentQuantity,omitempty"`
+	Search     string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	SortOption string `protobuf:"bytes,2,opt,name=sortOption,proto3" json:"sortOption,omitempty"`
 }
 
-func (x *UpdateFreebiesQuantityRequest) Reset() {
-	*x = UpdateFreebiesQuantityRequest{}
+func (x *GetAllFreebiesRequest) Reset() {
+	*x = GetAllFreebiesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[24]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateFreebiesQuantityRequest) String() string {
+func (x *GetAllFreebiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFreebiesQuantityRequest) ProtoMessage() {}
+func (*GetAllFreebiesRequest) ProtoMessage() {}
 
-func (x *UpdateFreebiesQuantityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[24]
+func (x *GetAllFreebiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1920,57 +2266,135 @@ func (x *UpdateFreebiesQuantityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFreebiesQuantityRequest.ProtoReflect.Descriptor instead.
-func (*UpdateFreebiesQuantityRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetAllFreebiesRequest.ProtoReflect.Descriptor instead.
+func (*GetAllFreebiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UpdateFreebiesQuantityRequest) GetFreebiesId() string {
+func (x *GetAllFreebiesRequest) GetSearch() string {
 	if x != nil {
-		return x.FreebiesId
+		return x.Search
 	}
 	return ""
 }
 
-func (x *UpdateFreebiesQuantityRequest) GetFreebiesOriginalQuantity() float64 {
+func (x *GetAllFreebiesRequest) GetSortOption() string {
 	if x != nil {
-		return x.FreebiesOriginalQuantity
+		return x.SortOption
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateFreebiesQuantityRequest) GetFreebiesCurrentQuantity() float64 {
+type GetAllFreebiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreebiesData []*FreebiesData `protobuf:"bytes,1,rep,name=freebiesData,proto3" json:"freebiesData,omitempty"`
+}
+
+func (x *GetAllFreebiesResponse) Reset() {
+	*x = GetAllFreebiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllFreebiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllFreebiesResponse) ProtoMessage() {}
+
+func (x *GetAllFreebiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllFreebiesResponse.ProtoReflect.Descriptor instead.
+func (*GetAllFreebiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAllFreebiesResponse) GetFreebiesData() []*FreebiesData {
 	if x != nil {
-		return x.FreebiesCurrentQuantity
+		return x.FreebiesData
 	}
-	return 0
+	return nil
 }
 
-type UpdateFreebiesQuantityResponse struct {
+type GetAllFreebiesDropdownRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	FreebiesData *FreebiesData `protobuf:"bytes,1,opt,name=freebiesData,proto3" json:"freebiesData,omitempty"`
+func (x *GetAllFreebiesDropdownRequest) Reset() {
+	*x = GetAllFreebiesDropdownRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *UpdateFreebiesQuantityResponse) Reset() {
-	*x = UpdateFreebiesQuantityResponse{}
+func (x *GetAllFreebiesDropdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllFreebiesDropdownRequest) ProtoMessage() {}
+
+func (x *GetAllFreebiesDropdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllFreebiesDropdownRequest.ProtoReflect.Descriptor instead.
+func (*GetAllFreebiesDropdownRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{22}
+}
+
+type GetAllFreebiesDropdownResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreebiesData []*FreebiesData `protobuf:"bytes,1,rep,name=freebiesData,proto3" json:"freebiesData,omitempty"`
+}
+
+func (x *GetAllFreebiesDropdownResponse) Reset() {
+	*x = GetAllFreebiesDropdownResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[25]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateFreebiesQuantityResponse) String() string {
+func (x *GetAllFreebiesDropdownResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFreebiesQuantityResponse) ProtoMessage() {}
+func (*GetAllFreebiesDropdownResponse) ProtoMessage() {}
 
-func (x *UpdateFreebiesQuantityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[25]
+func (x *GetAllFreebiesDropdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1981,44 +2405,43 @@ func (x *UpdateFreebiesQuantityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFreebiesQuantityResponse.ProtoReflect.Descriptor instead.
-func (*UpdateFreebiesQuantityResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetAllFreebiesDropdownResponse.ProtoReflect.Descriptor instead.
+func (*GetAllFreebiesDropdownResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *UpdateFreebiesQuantityResponse) GetFreebiesData() *FreebiesData {
+func (x *GetAllFreebiesDropdownResponse) GetFreebiesData() []*FreebiesData {
 	if x != nil {
 		return x.FreebiesData
 	}
 	return nil
 }
 
-type UpdateFreebiesStatusRequest struct {
+type GetAllFreebiesRequestById struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FreebiesId     string `protobuf:"bytes,1,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
-	FreebiesStatus string `protobuf:"bytes,2,opt,name=freebiesStatus,proto3" json:"freebiesStatus,omitempty"`
+	FreebiesId string `protobuf:"bytes,1,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
 }
 
-func (x *UpdateFreebiesStatusRequest) Reset() {
-	*x = UpdateFreebiesStatusRequest{}
+func (x *GetAllFreebiesRequestById) Reset() {
+	*x = GetAllFreebiesRequestById{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[26]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateFreebiesStatusRequest) String() string {
+func (x *GetAllFreebiesRequestById) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFreebiesStatusRequest) ProtoMessage() {}
+func (*GetAllFreebiesRequestById) ProtoMessage() {}
 
-func (x *UpdateFreebiesStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[26]
+func (x *GetAllFreebiesRequestById) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2029,50 +2452,43 @@ func (x *UpdateFreebiesStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFreebiesStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateFreebiesStatusRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetAllFreebiesRequestById.ProtoReflect.Descriptor instead.
+func (*GetAllFreebiesRequestById) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UpdateFreebiesStatusRequest) GetFreebiesId() string {
+func (x *GetAllFreebiesRequestById) GetFreebiesId() string {
 	if x != nil {
 		return x.FreebiesId
 	}
 	return ""
 }
 
-func (x *UpdateFreebiesStatusRequest) GetFreebiesStatus() string {
-	if x != nil {
-		return x.FreebiesStatus
-	}
-	return ""
-}
-
-type UpdateFreebiesStatusResponse struct {
+type GetAllFreebiesResponseById struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FreebiesData *FreebiesData `protobuf:"bytes,1,opt,name=freebiesData,proto3" json:"freebiesData,omitempty"`
+	FreebiesData []*FreebiesData `protobuf:"bytes,1,rep,name=freebiesData,proto3" json:"freebiesData,omitempty"`
 }
 
-func (x *UpdateFreebiesStatusResponse) Reset() {
-	*x = UpdateFreebiesStatusResponse{}
+func (x *GetAllFreebiesResponseById) Reset() {
+	*x = GetAllFreebiesResponseById{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[27]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateFreebiesStatusResponse) String() string {
+func (x *GetAllFreebiesResponseById) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFreebiesStatusResponse) ProtoMessage() {}
+func (*GetAllFreebiesResponseById) ProtoMessage() {}
 
-func (x *UpdateFreebiesStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[27]
+func (x *GetAllFreebiesResponseById) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2083,53 +2499,51 @@ func (x *UpdateFreebiesStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFreebiesStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateFreebiesStatusResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetAllFreebiesResponseById.ProtoReflect.Descriptor instead.
+func (*GetAllFreebiesResponseById) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UpdateFreebiesStatusResponse) GetFreebiesData() *FreebiesData {
+func (x *GetAllFreebiesResponseById) GetFreebiesData() []*FreebiesData {
 	if x != nil {
 		return x.FreebiesData
 	}
 	return nil
 }
 
-type ReviewsData struct {
+type UpdateFreebiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReviewsId         string `protobuf:"bytes,1,opt,name=reviewsId,proto3" json:"reviewsId,omitempty"`
-	ProductId         string `protobuf:"bytes,2,opt,name=productId,proto3" json:"productId,omitempty"`
-	ReviewsName       string `protobuf:"bytes,3,opt,name=reviewsName,proto3" json:"reviewsName,omitempty"`
-	ReviewsSubject    string `protobuf:"bytes,4,opt,name=reviewsSubject,proto3" json:"reviewsSubject,omitempty"`
-	ReviewsMessage    string `protobuf:"bytes,5,opt,name=reviewsMessage,proto3" json:"reviewsMessage,omitempty"`
-	ReviewsStarRating int64  `protobuf:"varint,6,opt,name=reviewsStarRating,proto3" json:"reviewsStarRating,omitempty"`
-	ReviewsStatus     string `protobuf:"bytes,7,opt,name=reviewsStatus,proto3" json:"reviewsStatus,omitempty"`
-	CreatedBy         string `protobuf:"bytes,8,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
-	CreatedAt         int64  `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedBy         string `protobuf:"bytes,10,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
-	UpdatedAt         int64  `protobuf:"varint,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	FreebiesId         string   `protobuf:"bytes,1,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
+	FreebiesName       string   `protobuf:"bytes,2,opt,name=freebiesName,proto3" json:"freebiesName,omitempty"`
+	FreebiesImg        []byte   `protobuf:"bytes,3,opt,name=freebiesImg,proto3" json:"freebiesImg,omitempty"`
+	FreebiesStorePrice float64  `protobuf:"fixed64,4,opt,name=freebiesStorePrice,proto3" json:"freebiesStorePrice,omitempty"`
+	FreebiesStatus     string   `protobuf:"bytes,5,opt,name=freebiesStatus,proto3" json:"freebiesStatus,omitempty"`
+	UpdateMask         []string `protobuf:"bytes,6,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+	ExpectedVersion    int64    `protobuf:"varint,7,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+	Sku                string   `protobuf:"bytes,8,opt,name=sku,proto3" json:"sku,omitempty"`
+	FreebiesImgKey     string   `protobuf:"bytes,9,opt,name=freebiesImgKey,proto3" json:"freebiesImgKey,omitempty"`
 }
 
-func (x *ReviewsData) Reset() {
-	*x = ReviewsData{}
+func (x *UpdateFreebiesRequest) Reset() {
+	*x = UpdateFreebiesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[28]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReviewsData) String() string {
+func (x *UpdateFreebiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReviewsData) ProtoMessage() {}
+func (*UpdateFreebiesRequest) ProtoMessage() {}
 
-func (x *ReviewsData) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[28]
+func (x *UpdateFreebiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2140,118 +2554,150 @@ func (x *ReviewsData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReviewsData.ProtoReflect.Descriptor instead.
-func (*ReviewsData) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UpdateFreebiesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFreebiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ReviewsData) GetReviewsId() string {
+func (x *UpdateFreebiesRequest) GetFreebiesId() string {
 	if x != nil {
-		return x.ReviewsId
+		return x.FreebiesId
 	}
 	return ""
 }
 
-func (x *ReviewsData) GetProductId() string {
+func (x *UpdateFreebiesRequest) GetFreebiesName() string {
 	if x != nil {
-		return x.ProductId
+		return x.FreebiesName
 	}
 	return ""
 }
 
-func (x *ReviewsData) GetReviewsName() string {
+func (x *UpdateFreebiesRequest) GetFreebiesImg() []byte {
 	if x != nil {
-		return x.ReviewsName
+		return x.FreebiesImg
 	}
-	return ""
+	return nil
 }
 
-func (x *ReviewsData) GetReviewsSubject() string {
+func (x *UpdateFreebiesRequest) GetFreebiesStorePrice() float64 {
 	if x != nil {
-		return x.ReviewsSubject
+		return x.FreebiesStorePrice
 	}
-	return ""
+	return 0
 }
 
-func (x *ReviewsData) GetReviewsMessage() string {
+func (x *UpdateFreebiesRequest) GetFreebiesStatus() string {
 	if x != nil {
-		return x.ReviewsMessage
+		return x.FreebiesStatus
 	}
 	return ""
 }
 
-func (x *ReviewsData) GetReviewsStarRating() int64 {
+func (x *UpdateFreebiesRequest) GetUpdateMask() []string {
 	if x != nil {
-		return x.ReviewsStarRating
+		return x.UpdateMask
 	}
-	return 0
+	return nil
 }
 
-func (x *ReviewsData) GetReviewsStatus() string {
+func (x *UpdateFreebiesRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.ReviewsStatus
+		return x.ExpectedVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *ReviewsData) GetCreatedBy() string {
+func (x *UpdateFreebiesRequest) GetSku() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.Sku
 	}
 	return ""
 }
 
-func (x *ReviewsData) GetCreatedAt() int64 {
+func (x *UpdateFreebiesRequest) GetFreebiesImgKey() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.FreebiesImgKey
 	}
-	return 0
+	return ""
 }
 
-func (x *ReviewsData) GetUpdatedBy() string {
-	if x != nil {
-		return x.UpdatedBy
+type UpdateFreebiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreebiesData *FreebiesData `protobuf:"bytes,1,opt,name=freebiesData,proto3" json:"freebiesData,omitempty"`
+}
+
+func (x *UpdateFreebiesResponse) Reset() {
+	*x = UpdateFreebiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ReviewsData) GetUpdatedAt() int64 {
+func (x *UpdateFreebiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFreebiesResponse) ProtoMessage() {}
+
+func (x *UpdateFreebiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFreebiesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFreebiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateFreebiesResponse) GetFreebiesData() *FreebiesData {
 	if x != nil {
-		return x.UpdatedAt
+		return x.FreebiesData
 	}
-	return 0
+	return nil
 }
 
-type SaveReviewsRequest struct {
+type UpdateFreebiesQuantityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductId         string `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
-	ReviewsName       string `protobuf:"bytes,2,opt,name=reviewsName,proto3" json:"reviewsName,omitempty"`
-	ReviewsSubject    string `protobuf:"bytes,3,opt,name=reviewsSubject,proto3" json:"reviewsSubject,omitempty"`
-	ReviewsMessage    string `protobuf:"bytes,4,opt,name=reviewsMessage,proto3" json:"reviewsMessage,omitempty"`
-	ReviewsStarRating int64  `protobuf:"varint,5,opt,name=reviewsStarRating,proto3" json:"reviewsStarRating,omitempty"`
-	ReviewsStatus     string `protobuf:"bytes,6,opt,name=reviewsStatus,proto3" json:"reviewsStatus,omitempty"`
+	FreebiesId               string   `protobuf:"bytes,1,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
+	FreebiesOriginalQuantity float64  `protobuf:"fixed64,2,opt,name=freebiesOriginalQuantity,proto3" json:"freebiesOriginalQuantity,omitempty"`
+	FreebiesCurrentQuantity  float64  `protobuf:"fixed64,3,opt,name=freebiesCurrentQuantity,proto3" json:"freebiesCurrentQuantity,omitempty"`
+	UpdateMask               []string `protobuf:"bytes,4,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+	ExpectedVersion          int64    `protobuf:"varint,5,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 }
 
-func (x *SaveReviewsRequest) Reset() {
-	*x = SaveReviewsRequest{}
+func (x *UpdateFreebiesQuantityRequest) Reset() {
+	*x = UpdateFreebiesQuantityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[29]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SaveReviewsRequest) String() string {
+func (x *UpdateFreebiesQuantityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveReviewsRequest) ProtoMessage() {}
+func (*UpdateFreebiesQuantityRequest) ProtoMessage() {}
 
-func (x *SaveReviewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[29]
+func (x *UpdateFreebiesQuantityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2262,78 +2708,71 @@ func (x *SaveReviewsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveReviewsRequest.ProtoReflect.Descriptor instead.
-func (*SaveReviewsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use UpdateFreebiesQuantityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFreebiesQuantityRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SaveReviewsRequest) GetProductId() string {
+func (x *UpdateFreebiesQuantityRequest) GetFreebiesId() string {
 	if x != nil {
-		return x.ProductId
+		return x.FreebiesId
 	}
 	return ""
 }
 
-func (x *SaveReviewsRequest) GetReviewsName() string {
+func (x *UpdateFreebiesQuantityRequest) GetFreebiesOriginalQuantity() float64 {
 	if x != nil {
-		return x.ReviewsName
+		return x.FreebiesOriginalQuantity
 	}
-	return ""
+	return 0
 }
 
-func (x *SaveReviewsRequest) GetReviewsSubject() string {
+func (x *UpdateFreebiesQuantityRequest) GetFreebiesCurrentQuantity() float64 {
 	if x != nil {
-		return x.ReviewsSubject
+		return x.FreebiesCurrentQuantity
 	}
-	return ""
+	return 0
 }
 
-func (x *SaveReviewsRequest) GetReviewsMessage() string {
+func (x *UpdateFreebiesQuantityRequest) GetUpdateMask() []string {
 	if x != nil {
-		return x.ReviewsMessage
+		return x.UpdateMask
 	}
-	return ""
+	return nil
 }
 
-func (x *SaveReviewsRequest) GetReviewsStarRating() int64 {
+func (x *UpdateFreebiesQuantityRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.ReviewsStarRating
+		return x.ExpectedVersion
 	}
 	return 0
 }
 
-func (x *SaveReviewsRequest) GetReviewsStatus() string {
-	if x != nil {
-		return x.ReviewsStatus
-	}
-	return ""
-}
-
-type SaveReviewsResponse struct {
+type UpdateFreebiesQuantityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReviewsData *ReviewsData `protobuf:"bytes,1,opt,name=reviewsData,proto3" json:"reviewsData,omitempty"`
+	FreebiesData *FreebiesData `protobuf:"bytes,1,opt,name=freebiesData,proto3" json:"freebiesData,omitempty"`
 }
 
-func (x *SaveReviewsResponse) Reset() {
-	*x = SaveReviewsResponse{}
+func (x *UpdateFreebiesQuantityResponse) Reset() {
+	*x = UpdateFreebiesQuantityResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[30]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SaveReviewsResponse) String() string {
+func (x *UpdateFreebiesQuantityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveReviewsResponse) ProtoMessage() {}
+func (*UpdateFreebiesQuantityResponse) ProtoMessage() {}
 
-func (x *SaveReviewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[30]
+func (x *UpdateFreebiesQuantityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2344,44 +2783,45 @@ func (x *SaveReviewsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveReviewsResponse.ProtoReflect.Descriptor instead.
-func (*SaveReviewsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use UpdateFreebiesQuantityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFreebiesQuantityResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *SaveReviewsResponse) GetReviewsData() *ReviewsData {
+func (x *UpdateFreebiesQuantityResponse) GetFreebiesData() *FreebiesData {
 	if x != nil {
-		return x.ReviewsData
+		return x.FreebiesData
 	}
 	return nil
 }
 
-type GetAllReviewsRequest struct {
+type UpdateFreebiesStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Search            string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
-	SortOptionReviews string `protobuf:"bytes,2,opt,name=sortOptionReviews,proto3" json:"sortOptionReviews,omitempty"`
+	FreebiesId      string `protobuf:"bytes,1,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
+	FreebiesStatus  string `protobuf:"bytes,2,opt,name=freebiesStatus,proto3" json:"freebiesStatus,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 }
 
-func (x *GetAllReviewsRequest) Reset() {
-	*x = GetAllReviewsRequest{}
+func (x *UpdateFreebiesStatusRequest) Reset() {
+	*x = UpdateFreebiesStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[31]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllReviewsRequest) String() string {
+func (x *UpdateFreebiesStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllReviewsRequest) ProtoMessage() {}
+func (*UpdateFreebiesStatusRequest) ProtoMessage() {}
 
-func (x *GetAllReviewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[31]
+func (x *UpdateFreebiesStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2392,50 +2832,57 @@ func (x *GetAllReviewsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllReviewsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllReviewsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use UpdateFreebiesStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFreebiesStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetAllReviewsRequest) GetSearch() string {
+func (x *UpdateFreebiesStatusRequest) GetFreebiesId() string {
 	if x != nil {
-		return x.Search
+		return x.FreebiesId
 	}
 	return ""
 }
 
-func (x *GetAllReviewsRequest) GetSortOptionReviews() string {
+func (x *UpdateFreebiesStatusRequest) GetFreebiesStatus() string {
 	if x != nil {
-		return x.SortOptionReviews
+		return x.FreebiesStatus
 	}
 	return ""
 }
 
-type GetAllReviewsResponse struct {
+func (x *UpdateFreebiesStatusRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateFreebiesStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReviewsData []*ReviewsData `protobuf:"bytes,1,rep,name=reviewsData,proto3" json:"reviewsData,omitempty"`
+	FreebiesData *FreebiesData `protobuf:"bytes,1,opt,name=freebiesData,proto3" json:"freebiesData,omitempty"`
 }
 
-func (x *GetAllReviewsResponse) Reset() {
-	*x = GetAllReviewsResponse{}
+func (x *UpdateFreebiesStatusResponse) Reset() {
+	*x = UpdateFreebiesStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[32]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllReviewsResponse) String() string {
+func (x *UpdateFreebiesStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllReviewsResponse) ProtoMessage() {}
+func (*UpdateFreebiesStatusResponse) ProtoMessage() {}
 
-func (x *GetAllReviewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[32]
+func (x *UpdateFreebiesStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2446,19 +2893,19 @@ func (x *GetAllReviewsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllReviewsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllReviewsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use UpdateFreebiesStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFreebiesStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetAllReviewsResponse) GetReviewsData() []*ReviewsData {
+func (x *UpdateFreebiesStatusResponse) GetFreebiesData() *FreebiesData {
 	if x != nil {
-		return x.ReviewsData
+		return x.FreebiesData
 	}
 	return nil
 }
 
-type UpdateReviewsRequest struct {
+type ReviewsData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2469,25 +2916,31 @@ type UpdateReviewsRequest struct {
 	ReviewsSubject    string `protobuf:"bytes,4,opt,name=reviewsSubject,proto3" json:"reviewsSubject,omitempty"`
 	ReviewsMessage    string `protobuf:"bytes,5,opt,name=reviewsMessage,proto3" json:"reviewsMessage,omitempty"`
 	ReviewsStarRating int64  `protobuf:"varint,6,opt,name=reviewsStarRating,proto3" json:"reviewsStarRating,omitempty"`
+	ReviewsStatus     string `protobuf:"bytes,7,opt,name=reviewsStatus,proto3" json:"reviewsStatus,omitempty"`
+	CreatedBy         string `protobuf:"bytes,8,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt         int64  `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy         string `protobuf:"bytes,10,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt         int64  `protobuf:"varint,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Version           int64  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *UpdateReviewsRequest) Reset() {
-	*x = UpdateReviewsRequest{}
+func (x *ReviewsData) Reset() {
+	*x = ReviewsData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[33]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateReviewsRequest) String() string {
+func (x *ReviewsData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReviewsRequest) ProtoMessage() {}
+func (*ReviewsData) ProtoMessage() {}
 
-func (x *UpdateReviewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[33]
+func (x *ReviewsData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2498,126 +2951,125 @@ func (x *UpdateReviewsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReviewsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateReviewsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ReviewsData.ProtoReflect.Descriptor instead.
+func (*ReviewsData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *UpdateReviewsRequest) GetReviewsId() string {
+func (x *ReviewsData) GetReviewsId() string {
 	if x != nil {
 		return x.ReviewsId
 	}
 	return ""
 }
 
-func (x *UpdateReviewsRequest) GetProductId() string {
+func (x *ReviewsData) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *UpdateReviewsRequest) GetReviewsName() string {
+func (x *ReviewsData) GetReviewsName() string {
 	if x != nil {
 		return x.ReviewsName
 	}
 	return ""
 }
 
-func (x *UpdateReviewsRequest) GetReviewsSubject() string {
+func (x *ReviewsData) GetReviewsSubject() string {
 	if x != nil {
 		return x.ReviewsSubject
 	}
 	return ""
 }
 
-func (x *UpdateReviewsRequest) GetReviewsMessage() string {
+func (x *ReviewsData) GetReviewsMessage() string {
 	if x != nil {
 		return x.ReviewsMessage
 	}
 	return ""
 }
 
-func (x *UpdateReviewsRequest) GetReviewsStarRating() int64 {
+func (x *ReviewsData) GetReviewsStarRating() int64 {
 	if x != nil {
 		return x.ReviewsStarRating
 	}
 	return 0
 }
 
-type UpdateReviewsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ReviewsData *ReviewsData `protobuf:"bytes,1,opt,name=reviewsData,proto3" json:"reviewsData,omitempty"`
+func (x *ReviewsData) GetReviewsStatus() string {
+	if x != nil {
+		return x.ReviewsStatus
+	}
+	return ""
 }
 
-func (x *UpdateReviewsResponse) Reset() {
-	*x = UpdateReviewsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[34]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReviewsData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
 	}
+	return ""
 }
 
-func (x *UpdateReviewsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReviewsData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-func (*UpdateReviewsResponse) ProtoMessage() {}
-
-func (x *UpdateReviewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[34]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ReviewsData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateReviewsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateReviewsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{34}
+func (x *ReviewsData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
 }
 
-func (x *UpdateReviewsResponse) GetReviewsData() *ReviewsData {
+func (x *ReviewsData) GetVersion() int64 {
 	if x != nil {
-		return x.ReviewsData
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-type UpdateReviewsStatusRequest struct {
+type SaveReviewsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReviewsId     string `protobuf:"bytes,1,opt,name=reviewsId,proto3" json:"reviewsId,omitempty"`
-	ReviewsStatus string `protobuf:"bytes,2,opt,name=reviewsStatus,proto3" json:"reviewsStatus,omitempty"`
+	ProductId         string `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
+	ReviewsName       string `protobuf:"bytes,2,opt,name=reviewsName,proto3" json:"reviewsName,omitempty"`
+	ReviewsSubject    string `protobuf:"bytes,3,opt,name=reviewsSubject,proto3" json:"reviewsSubject,omitempty"`
+	ReviewsMessage    string `protobuf:"bytes,4,opt,name=reviewsMessage,proto3" json:"reviewsMessage,omitempty"`
+	ReviewsStarRating int64  `protobuf:"varint,5,opt,name=reviewsStarRating,proto3" json:"reviewsStarRating,omitempty"`
+	ReviewsStatus     string `protobuf:"bytes,6,opt,name=reviewsStatus,proto3" json:"reviewsStatus,omitempty"`
 }
 
-func (x *UpdateReviewsStatusRequest) Reset() {
-	*x = UpdateReviewsStatusRequest{}
+func (x *SaveReviewsRequest) Reset() {
+	*x = SaveReviewsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[35]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateReviewsStatusRequest) String() string {
+func (x *SaveReviewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReviewsStatusRequest) ProtoMessage() {}
+func (*SaveReviewsRequest) ProtoMessage() {}
 
-func (x *UpdateReviewsStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[35]
+func (x *SaveReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2628,26 +3080,54 @@ func (x *UpdateReviewsStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReviewsStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateReviewsStatusRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use SaveReviewsRequest.ProtoReflect.Descriptor instead.
+func (*SaveReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *UpdateReviewsStatusRequest) GetReviewsId() string {
+func (x *SaveReviewsRequest) GetProductId() string {
 	if x != nil {
-		return x.ReviewsId
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *UpdateReviewsStatusRequest) GetReviewsStatus() string {
+func (x *SaveReviewsRequest) GetReviewsName() string {
+	if x != nil {
+		return x.ReviewsName
+	}
+	return ""
+}
+
+func (x *SaveReviewsRequest) GetReviewsSubject() string {
+	if x != nil {
+		return x.ReviewsSubject
+	}
+	return ""
+}
+
+func (x *SaveReviewsRequest) GetReviewsMessage() string {
+	if x != nil {
+		return x.ReviewsMessage
+	}
+	return ""
+}
+
+func (x *SaveReviewsRequest) GetReviewsStarRating() int64 {
+	if x != nil {
+		return x.ReviewsStarRating
+	}
+	return 0
+}
+
+func (x *SaveReviewsRequest) GetReviewsStatus() string {
 	if x != nil {
 		return x.ReviewsStatus
 	}
 	return ""
 }
 
-type UpdateReviewsStatusResponse struct {
+type SaveReviewsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2655,23 +3135,23 @@ type UpdateReviewsStatusResponse struct {
 	ReviewsData *ReviewsData `protobuf:"bytes,1,opt,name=reviewsData,proto3" json:"reviewsData,omitempty"`
 }
 
-func (x *UpdateReviewsStatusResponse) Reset() {
-	*x = UpdateReviewsStatusResponse{}
+func (x *SaveReviewsResponse) Reset() {
+	*x = SaveReviewsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[36]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateReviewsStatusResponse) String() string {
+func (x *SaveReviewsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReviewsStatusResponse) ProtoMessage() {}
+func (*SaveReviewsResponse) ProtoMessage() {}
 
-func (x *UpdateReviewsStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[36]
+func (x *SaveReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2682,43 +3162,44 @@ func (x *UpdateReviewsStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReviewsStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateReviewsStatusResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use SaveReviewsResponse.ProtoReflect.Descriptor instead.
+func (*SaveReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UpdateReviewsStatusResponse) GetReviewsData() *ReviewsData {
+func (x *SaveReviewsResponse) GetReviewsData() *ReviewsData {
 	if x != nil {
 		return x.ReviewsData
 	}
 	return nil
 }
 
-type GetAllReviewsRequestById struct {
+type GetAllReviewsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReviewsId string `protobuf:"bytes,1,opt,name=reviewsId,proto3" json:"reviewsId,omitempty"`
+	Search            string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	SortOptionReviews string `protobuf:"bytes,2,opt,name=sortOptionReviews,proto3" json:"sortOptionReviews,omitempty"`
 }
 
-func (x *GetAllReviewsRequestById) Reset() {
-	*x = GetAllReviewsRequestById{}
+func (x *GetAllReviewsRequest) Reset() {
+	*x = GetAllReviewsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[37]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllReviewsRequestById) String() string {
+func (x *GetAllReviewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllReviewsRequestById) ProtoMessage() {}
+func (*GetAllReviewsRequest) ProtoMessage() {}
 
-func (x *GetAllReviewsRequestById) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[37]
+func (x *GetAllReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2729,19 +3210,26 @@ func (x *GetAllReviewsRequestById) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllReviewsRequestById.ProtoReflect.Descriptor instead.
-func (*GetAllReviewsRequestById) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetAllReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetAllReviewsRequestById) GetReviewsId() string {
+func (x *GetAllReviewsRequest) GetSearch() string {
 	if x != nil {
-		return x.ReviewsId
+		return x.Search
 	}
 	return ""
 }
 
-type GetAllReviewsResponseById struct {
+func (x *GetAllReviewsRequest) GetSortOptionReviews() string {
+	if x != nil {
+		return x.SortOptionReviews
+	}
+	return ""
+}
+
+type GetAllReviewsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2749,23 +3237,23 @@ type GetAllReviewsResponseById struct {
 	ReviewsData []*ReviewsData `protobuf:"bytes,1,rep,name=reviewsData,proto3" json:"reviewsData,omitempty"`
 }
 
-func (x *GetAllReviewsResponseById) Reset() {
-	*x = GetAllReviewsResponseById{}
+func (x *GetAllReviewsResponse) Reset() {
+	*x = GetAllReviewsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[38]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllReviewsResponseById) String() string {
+func (x *GetAllReviewsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllReviewsResponseById) ProtoMessage() {}
+func (*GetAllReviewsResponse) ProtoMessage() {}
 
-func (x *GetAllReviewsResponseById) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[38]
+func (x *GetAllReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2776,54 +3264,50 @@ func (x *GetAllReviewsResponseById) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllReviewsResponseById.ProtoReflect.Descriptor instead.
-func (*GetAllReviewsResponseById) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetAllReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetAllReviewsResponseById) GetReviewsData() []*ReviewsData {
+func (x *GetAllReviewsResponse) GetReviewsData() []*ReviewsData {
 	if x != nil {
 		return x.ReviewsData
 	}
 	return nil
 }
 
-type OrderData struct {
+type UpdateReviewsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId         string  `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
-	Customer        string  `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty"`
-	CompleteAddress string  `protobuf:"bytes,3,opt,name=completeAddress,proto3" json:"completeAddress,omitempty"`
-	Product         string  `protobuf:"bytes,4,opt,name=product,proto3" json:"product,omitempty"`
-	Total           float64 `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
-	OrderStatus     string  `protobuf:"bytes,6,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
-	TrackingId      string  `protobuf:"bytes,7,opt,name=trackingId,proto3" json:"trackingId,omitempty"`
-	StickyNotes     string  `protobuf:"bytes,8,opt,name=stickyNotes,proto3" json:"stickyNotes,omitempty"`
-	CreatedBy       string  `protobuf:"bytes,9,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
-	CreatedAt       int64   `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedBy       string  `protobuf:"bytes,11,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
-	UpdatedAt       int64   `protobuf:"varint,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	ReviewsId         string   `protobuf:"bytes,1,opt,name=reviewsId,proto3" json:"reviewsId,omitempty"`
+	ProductId         string   `protobuf:"bytes,2,opt,name=productId,proto3" json:"productId,omitempty"`
+	ReviewsName       string   `protobuf:"bytes,3,opt,name=reviewsName,proto3" json:"reviewsName,omitempty"`
+	ReviewsSubject    string   `protobuf:"bytes,4,opt,name=reviewsSubject,proto3" json:"reviewsSubject,omitempty"`
+	ReviewsMessage    string   `protobuf:"bytes,5,opt,name=reviewsMessage,proto3" json:"reviewsMessage,omitempty"`
+	ReviewsStarRating int64    `protobuf:"varint,6,opt,name=reviewsStarRating,proto3" json:"reviewsStarRating,omitempty"`
+	UpdateMask        []string `protobuf:"bytes,7,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+	ExpectedVersion   int64    `protobuf:"varint,8,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 }
 
-func (x *OrderData) Reset() {
-	*x = OrderData{}
+func (x *UpdateReviewsRequest) Reset() {
+	*x = UpdateReviewsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[39]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrderData) String() string {
+func (x *UpdateReviewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderData) ProtoMessage() {}
+func (*UpdateReviewsRequest) ProtoMessage() {}
 
-func (x *OrderData) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[39]
+func (x *UpdateReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2834,124 +3318,141 @@ func (x *OrderData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderData.ProtoReflect.Descriptor instead.
-func (*OrderData) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use UpdateReviewsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *OrderData) GetOrderId() string {
+func (x *UpdateReviewsRequest) GetReviewsId() string {
 	if x != nil {
-		return x.OrderId
+		return x.ReviewsId
 	}
 	return ""
 }
 
-func (x *OrderData) GetCustomer() string {
+func (x *UpdateReviewsRequest) GetProductId() string {
 	if x != nil {
-		return x.Customer
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *OrderData) GetCompleteAddress() string {
+func (x *UpdateReviewsRequest) GetReviewsName() string {
 	if x != nil {
-		return x.CompleteAddress
+		return x.ReviewsName
 	}
 	return ""
 }
 
-func (x *OrderData) GetProduct() string {
+func (x *UpdateReviewsRequest) GetReviewsSubject() string {
 	if x != nil {
-		return x.Product
+		return x.ReviewsSubject
 	}
 	return ""
 }
 
-func (x *OrderData) GetTotal() float64 {
+func (x *UpdateReviewsRequest) GetReviewsMessage() string {
 	if x != nil {
-		return x.Total
+		return x.ReviewsMessage
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderData) GetOrderStatus() string {
+func (x *UpdateReviewsRequest) GetReviewsStarRating() int64 {
 	if x != nil {
-		return x.OrderStatus
+		return x.ReviewsStarRating
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderData) GetTrackingId() string {
+func (x *UpdateReviewsRequest) GetUpdateMask() []string {
 	if x != nil {
-		return x.TrackingId
+		return x.UpdateMask
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderData) GetStickyNotes() string {
+func (x *UpdateReviewsRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.StickyNotes
+		return x.ExpectedVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderData) GetCreatedBy() string {
-	if x != nil {
-		return x.CreatedBy
-	}
-	return ""
+type UpdateReviewsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewsData *ReviewsData `protobuf:"bytes,1,opt,name=reviewsData,proto3" json:"reviewsData,omitempty"`
 }
 
-func (x *OrderData) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
+func (x *UpdateReviewsResponse) Reset() {
+	*x = UpdateReviewsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *OrderData) GetUpdatedBy() string {
-	if x != nil {
-		return x.UpdatedBy
+func (x *UpdateReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewsResponse) ProtoMessage() {}
+
+func (x *UpdateReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OrderData) GetUpdatedAt() int64 {
+// Deprecated: Use UpdateReviewsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateReviewsResponse) GetReviewsData() *ReviewsData {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ReviewsData
 	}
-	return 0
+	return nil
 }
 
-type SaveOrderRequest struct {
+type UpdateReviewsStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Customer        string  `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
-	CompleteAddress string  `protobuf:"bytes,2,opt,name=completeAddress,proto3" json:"completeAddress,omitempty"`
-	Product         string  `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`
-	Total           float64 `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
-	OrderStatus     string  `protobuf:"bytes,5,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
+	ReviewsId       string `protobuf:"bytes,1,opt,name=reviewsId,proto3" json:"reviewsId,omitempty"`
+	ReviewsStatus   string `protobuf:"bytes,2,opt,name=reviewsStatus,proto3" json:"reviewsStatus,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 }
 
-func (x *SaveOrderRequest) Reset() {
-	*x = SaveOrderRequest{}
+func (x *UpdateReviewsStatusRequest) Reset() {
+	*x = UpdateReviewsStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[40]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SaveOrderRequest) String() string {
+func (x *UpdateReviewsStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveOrderRequest) ProtoMessage() {}
+func (*UpdateReviewsStatusRequest) ProtoMessage() {}
 
-func (x *SaveOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[40]
+func (x *UpdateReviewsStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2962,71 +3463,57 @@ func (x *SaveOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveOrderRequest.ProtoReflect.Descriptor instead.
-func (*SaveOrderRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *SaveOrderRequest) GetCustomer() string {
-	if x != nil {
-		return x.Customer
-	}
-	return ""
+// Deprecated: Use UpdateReviewsStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReviewsStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *SaveOrderRequest) GetCompleteAddress() string {
+func (x *UpdateReviewsStatusRequest) GetReviewsId() string {
 	if x != nil {
-		return x.CompleteAddress
+		return x.ReviewsId
 	}
 	return ""
 }
 
-func (x *SaveOrderRequest) GetProduct() string {
+func (x *UpdateReviewsStatusRequest) GetReviewsStatus() string {
 	if x != nil {
-		return x.Product
+		return x.ReviewsStatus
 	}
 	return ""
 }
 
-func (x *SaveOrderRequest) GetTotal() float64 {
+func (x *UpdateReviewsStatusRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.Total
+		return x.ExpectedVersion
 	}
 	return 0
 }
 
-func (x *SaveOrderRequest) GetOrderStatus() string {
-	if x != nil {
-		return x.OrderStatus
-	}
-	return ""
-}
-
-type SaveOrderResponse struct {
+type UpdateReviewsStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderData *OrderData `protobuf:"bytes,1,opt,name=orderData,proto3" json:"orderData,omitempty"`
+	ReviewsData *ReviewsData `protobuf:"bytes,1,opt,name=reviewsData,proto3" json:"reviewsData,omitempty"`
 }
 
-func (x *SaveOrderResponse) Reset() {
-	*x = SaveOrderResponse{}
+func (x *UpdateReviewsStatusResponse) Reset() {
+	*x = UpdateReviewsStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[41]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SaveOrderResponse) String() string {
+func (x *UpdateReviewsStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveOrderResponse) ProtoMessage() {}
+func (*UpdateReviewsStatusResponse) ProtoMessage() {}
 
-func (x *SaveOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[41]
+func (x *UpdateReviewsStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3037,45 +3524,43 @@ func (x *SaveOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveOrderResponse.ProtoReflect.Descriptor instead.
-func (*SaveOrderResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use UpdateReviewsStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReviewsStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SaveOrderResponse) GetOrderData() *OrderData {
+func (x *UpdateReviewsStatusResponse) GetReviewsData() *ReviewsData {
 	if x != nil {
-		return x.OrderData
+		return x.ReviewsData
 	}
 	return nil
 }
 
-type GetAllOrderRequest struct {
+type GetAllReviewsRequestById struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Search          string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
-	SortOptionOrder string `protobuf:"bytes,2,opt,name=sortOptionOrder,proto3" json:"sortOptionOrder,omitempty"`
-	OrderStatus     string `protobuf:"bytes,3,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
+	ReviewsId string `protobuf:"bytes,1,opt,name=reviewsId,proto3" json:"reviewsId,omitempty"`
 }
 
-func (x *GetAllOrderRequest) Reset() {
-	*x = GetAllOrderRequest{}
+func (x *GetAllReviewsRequestById) Reset() {
+	*x = GetAllReviewsRequestById{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[42]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllOrderRequest) String() string {
+func (x *GetAllReviewsRequestById) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllOrderRequest) ProtoMessage() {}
+func (*GetAllReviewsRequestById) ProtoMessage() {}
 
-func (x *GetAllOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[42]
+func (x *GetAllReviewsRequestById) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3086,57 +3571,43 @@ func (x *GetAllOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllOrderRequest.ProtoReflect.Descriptor instead.
-func (*GetAllOrderRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *GetAllOrderRequest) GetSearch() string {
-	if x != nil {
-		return x.Search
-	}
-	return ""
-}
-
-func (x *GetAllOrderRequest) GetSortOptionOrder() string {
-	if x != nil {
-		return x.SortOptionOrder
-	}
-	return ""
+// Deprecated: Use GetAllReviewsRequestById.ProtoReflect.Descriptor instead.
+func (*GetAllReviewsRequestById) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetAllOrderRequest) GetOrderStatus() string {
+func (x *GetAllReviewsRequestById) GetReviewsId() string {
 	if x != nil {
-		return x.OrderStatus
+		return x.ReviewsId
 	}
 	return ""
 }
 
-type GetAllOrderResponse struct {
+type GetAllReviewsResponseById struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderData []*OrderData `protobuf:"bytes,1,rep,name=orderData,proto3" json:"orderData,omitempty"`
+	ReviewsData []*ReviewsData `protobuf:"bytes,1,rep,name=reviewsData,proto3" json:"reviewsData,omitempty"`
 }
 
-func (x *GetAllOrderResponse) Reset() {
-	*x = GetAllOrderResponse{}
+func (x *GetAllReviewsResponseById) Reset() {
+	*x = GetAllReviewsResponseById{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[43]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllOrderResponse) String() string {
+func (x *GetAllReviewsResponseById) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllOrderResponse) ProtoMessage() {}
+func (*GetAllReviewsResponseById) ProtoMessage() {}
 
-func (x *GetAllOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[43]
+func (x *GetAllReviewsResponseById) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3147,19 +3618,19 @@ func (x *GetAllOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllOrderResponse.ProtoReflect.Descriptor instead.
-func (*GetAllOrderResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetAllReviewsResponseById.ProtoReflect.Descriptor instead.
+func (*GetAllReviewsResponseById) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetAllOrderResponse) GetOrderData() []*OrderData {
+func (x *GetAllReviewsResponseById) GetReviewsData() []*ReviewsData {
 	if x != nil {
-		return x.OrderData
+		return x.ReviewsData
 	}
 	return nil
 }
 
-type UpdateOrderRequest struct {
+type OrderData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3172,25 +3643,43 @@ type UpdateOrderRequest struct {
 	OrderStatus     string  `protobuf:"bytes,6,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
 	TrackingId      string  `protobuf:"bytes,7,opt,name=trackingId,proto3" json:"trackingId,omitempty"`
 	StickyNotes     string  `protobuf:"bytes,8,opt,name=stickyNotes,proto3" json:"stickyNotes,omitempty"`
+	CreatedBy       string  `protobuf:"bytes,9,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt       int64   `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy       string  `protobuf:"bytes,11,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt       int64   `protobuf:"varint,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Freebies        string  `protobuf:"bytes,13,opt,name=freebies,proto3" json:"freebies,omitempty"`
+	VoucherCode     string  `protobuf:"bytes,14,opt,name=voucherCode,proto3" json:"voucherCode,omitempty"`
+	VoucherDiscount float64 `protobuf:"fixed64,15,opt,name=voucherDiscount,proto3" json:"voucherDiscount,omitempty"`
+	Version         int64   `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
+	CustomerId      string  `protobuf:"bytes,17,opt,name=customerId,proto3" json:"customerId,omitempty"`
+	PaymentStatus   string  `protobuf:"bytes,18,opt,name=paymentStatus,proto3" json:"paymentStatus,omitempty"`
+	PaidAt          int64   `protobuf:"varint,19,opt,name=paidAt,proto3" json:"paidAt,omitempty"`
+	ShippingFee     float64 `protobuf:"fixed64,20,opt,name=shippingFee,proto3" json:"shippingFee,omitempty"`
+	Courier         string  `protobuf:"bytes,21,opt,name=courier,proto3" json:"courier,omitempty"`
+	OrderNumber     string  `protobuf:"bytes,22,opt,name=orderNumber,proto3" json:"orderNumber,omitempty"`
+	Channel         string  `protobuf:"bytes,23,opt,name=channel,proto3" json:"channel,omitempty"`
+	ExternalOrderId string  `protobuf:"bytes,24,opt,name=externalOrderId,proto3" json:"externalOrderId,omitempty"`
+	SearchRank      float64 `protobuf:"fixed64,25,opt,name=searchRank,proto3" json:"searchRank,omitempty"`
+	SearchSnippet   string  `protobuf:"bytes,26,opt,name=searchSnippet,proto3" json:"searchSnippet,omitempty"`
 }
 
-func (x *UpdateOrderRequest) Reset() {
-	*x = UpdateOrderRequest{}
+func (x *OrderData) Reset() {
+	*x = OrderData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[44]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateOrderRequest) String() string {
+func (x *OrderData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderRequest) ProtoMessage() {}
+func (*OrderData) ProtoMessage() {}
 
-func (x *UpdateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[44]
+func (x *OrderData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3201,194 +3690,224 @@ func (x *UpdateOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use OrderData.ProtoReflect.Descriptor instead.
+func (*OrderData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UpdateOrderRequest) GetOrderId() string {
+func (x *OrderData) GetOrderId() string {
 	if x != nil {
 		return x.OrderId
 	}
 	return ""
 }
 
-func (x *UpdateOrderRequest) GetCustomer() string {
+func (x *OrderData) GetCustomer() string {
 	if x != nil {
 		return x.Customer
 	}
 	return ""
 }
 
-func (x *UpdateOrderRequest) GetCompleteAddress() string {
+func (x *OrderData) GetCompleteAddress() string {
 	if x != nil {
 		return x.CompleteAddress
 	}
 	return ""
 }
 
-func (x *UpdateOrderRequest) GetProduct() string {
+func (x *OrderData) GetProduct() string {
 	if x != nil {
 		return x.Product
 	}
 	return ""
 }
 
-func (x *UpdateOrderRequest) GetTotal() float64 {
+func (x *OrderData) GetTotal() float64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *UpdateOrderRequest) GetOrderStatus() string {
+func (x *OrderData) GetOrderStatus() string {
 	if x != nil {
 		return x.OrderStatus
 	}
 	return ""
 }
 
-func (x *UpdateOrderRequest) GetTrackingId() string {
+func (x *OrderData) GetTrackingId() string {
 	if x != nil {
 		return x.TrackingId
 	}
 	return ""
 }
 
-func (x *UpdateOrderRequest) GetStickyNotes() string {
+func (x *OrderData) GetStickyNotes() string {
 	if x != nil {
 		return x.StickyNotes
 	}
 	return ""
 }
 
-type UpdateOrderResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	OrderData *OrderData `protobuf:"bytes,1,opt,name=orderData,proto3" json:"orderData,omitempty"`
-}
-
-func (x *UpdateOrderResponse) Reset() {
-	*x = UpdateOrderResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[45]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *OrderData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
 	}
+	return ""
 }
 
-func (x *UpdateOrderResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OrderData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-func (*UpdateOrderResponse) ProtoMessage() {}
+func (x *OrderData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
 
-func (x *UpdateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[45]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *OrderData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UpdateOrderResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{45}
+func (x *OrderData) GetFreebies() string {
+	if x != nil {
+		return x.Freebies
+	}
+	return ""
 }
 
-func (x *UpdateOrderResponse) GetOrderData() *OrderData {
+func (x *OrderData) GetVoucherCode() string {
 	if x != nil {
-		return x.OrderData
+		return x.VoucherCode
 	}
-	return nil
+	return ""
 }
 
-type UpdateOrderStatusRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *OrderData) GetVoucherDiscount() float64 {
+	if x != nil {
+		return x.VoucherDiscount
+	}
+	return 0
+}
 
-	OrderId     string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
-	OrderStatus string `protobuf:"bytes,2,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
+func (x *OrderData) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
-func (x *UpdateOrderStatusRequest) Reset() {
-	*x = UpdateOrderStatusRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[46]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *OrderData) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
 	}
+	return ""
 }
 
-func (x *UpdateOrderStatusRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OrderData) GetPaymentStatus() string {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return ""
 }
 
-func (*UpdateOrderStatusRequest) ProtoMessage() {}
+func (x *OrderData) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
 
-func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[46]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *OrderData) GetShippingFee() float64 {
+	if x != nil {
+		return x.ShippingFee
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{46}
+func (x *OrderData) GetCourier() string {
+	if x != nil {
+		return x.Courier
+	}
+	return ""
 }
 
-func (x *UpdateOrderStatusRequest) GetOrderId() string {
+func (x *OrderData) GetOrderNumber() string {
 	if x != nil {
-		return x.OrderId
+		return x.OrderNumber
 	}
 	return ""
 }
 
-func (x *UpdateOrderStatusRequest) GetOrderStatus() string {
+func (x *OrderData) GetChannel() string {
 	if x != nil {
-		return x.OrderStatus
+		return x.Channel
 	}
 	return ""
 }
 
-type UpdateOrderStatusResponse struct {
+func (x *OrderData) GetExternalOrderId() string {
+	if x != nil {
+		return x.ExternalOrderId
+	}
+	return ""
+}
+
+func (x *OrderData) GetSearchRank() float64 {
+	if x != nil {
+		return x.SearchRank
+	}
+	return 0
+}
+
+func (x *OrderData) GetSearchSnippet() string {
+	if x != nil {
+		return x.SearchSnippet
+	}
+	return ""
+}
+
+type SaveOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderData *OrderData `protobuf:"bytes,1,opt,name=orderData,proto3" json:"orderData,omitempty"`
+	Customer        string  `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	CompleteAddress string  `protobuf:"bytes,2,opt,name=completeAddress,proto3" json:"completeAddress,omitempty"`
+	Product         string  `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`
+	Total           float64 `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
+	OrderStatus     string  `protobuf:"bytes,5,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
+	VoucherCode     string  `protobuf:"bytes,6,opt,name=voucherCode,proto3" json:"voucherCode,omitempty"`
+	PaymentMethod   string  `protobuf:"bytes,7,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty"`
 }
 
-func (x *UpdateOrderStatusResponse) Reset() {
-	*x = UpdateOrderStatusResponse{}
+func (x *SaveOrderRequest) Reset() {
+	*x = SaveOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[47]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateOrderStatusResponse) String() string {
+func (x *SaveOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusResponse) ProtoMessage() {}
+func (*SaveOrderRequest) ProtoMessage() {}
 
-func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[47]
+func (x *SaveOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3399,91 +3918,87 @@ func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use SaveOrderRequest.ProtoReflect.Descriptor instead.
+func (*SaveOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UpdateOrderStatusResponse) GetOrderData() *OrderData {
+func (x *SaveOrderRequest) GetCustomer() string {
 	if x != nil {
-		return x.OrderData
+		return x.Customer
 	}
-	return nil
+	return ""
 }
 
-type GetAllOrderRevenueRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	OrderStatus string `protobuf:"bytes,2,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
+func (x *SaveOrderRequest) GetCompleteAddress() string {
+	if x != nil {
+		return x.CompleteAddress
+	}
+	return ""
 }
 
-func (x *GetAllOrderRevenueRequest) Reset() {
-	*x = GetAllOrderRevenueRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[48]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SaveOrderRequest) GetProduct() string {
+	if x != nil {
+		return x.Product
 	}
+	return ""
 }
 
-func (x *GetAllOrderRevenueRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SaveOrderRequest) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
 }
 
-func (*GetAllOrderRevenueRequest) ProtoMessage() {}
-
-func (x *GetAllOrderRevenueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[48]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SaveOrderRequest) GetOrderStatus() string {
+	if x != nil {
+		return x.OrderStatus
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetAllOrderRevenueRequest.ProtoReflect.Descriptor instead.
-func (*GetAllOrderRevenueRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{48}
+func (x *SaveOrderRequest) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
 }
 
-func (x *GetAllOrderRevenueRequest) GetOrderStatus() string {
+func (x *SaveOrderRequest) GetPaymentMethod() string {
 	if x != nil {
-		return x.OrderStatus
+		return x.PaymentMethod
 	}
 	return ""
 }
 
-type GetAllOrderRevenueResponse struct {
+type SaveOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CurrentData  string `protobuf:"bytes,1,opt,name=currentData,proto3" json:"currentData,omitempty"`
-	PreviousData string `protobuf:"bytes,2,opt,name=previousData,proto3" json:"previousData,omitempty"`
+	OrderData      *OrderData   `protobuf:"bytes,1,opt,name=orderData,proto3" json:"orderData,omitempty"`
+	OrderLinkToken string       `protobuf:"bytes,2,opt,name=orderLinkToken,proto3" json:"orderLinkToken,omitempty"`
+	PaymentData    *PaymentData `protobuf:"bytes,3,opt,name=paymentData,proto3" json:"paymentData,omitempty"`
 }
 
-func (x *GetAllOrderRevenueResponse) Reset() {
-	*x = GetAllOrderRevenueResponse{}
+func (x *SaveOrderResponse) Reset() {
+	*x = SaveOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[49]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllOrderRevenueResponse) String() string {
+func (x *SaveOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllOrderRevenueResponse) ProtoMessage() {}
+func (*SaveOrderResponse) ProtoMessage() {}
 
-func (x *GetAllOrderRevenueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[49]
+func (x *SaveOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3494,50 +4009,59 @@ func (x *GetAllOrderRevenueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllOrderRevenueResponse.ProtoReflect.Descriptor instead.
-func (*GetAllOrderRevenueResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use SaveOrderResponse.ProtoReflect.Descriptor instead.
+func (*SaveOrderResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetAllOrderRevenueResponse) GetCurrentData() string {
+func (x *SaveOrderResponse) GetOrderData() *OrderData {
 	if x != nil {
-		return x.CurrentData
+		return x.OrderData
 	}
-	return ""
+	return nil
 }
 
-func (x *GetAllOrderRevenueResponse) GetPreviousData() string {
+func (x *SaveOrderResponse) GetOrderLinkToken() string {
 	if x != nil {
-		return x.PreviousData
+		return x.OrderLinkToken
 	}
 	return ""
 }
 
-type GetAllTotalOrderRequest struct {
+func (x *SaveOrderResponse) GetPaymentData() *PaymentData {
+	if x != nil {
+		return x.PaymentData
+	}
+	return nil
+}
+
+type GetAllOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderStatus string `protobuf:"bytes,2,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
+	Search          string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	SortOptionOrder string `protobuf:"bytes,2,opt,name=sortOptionOrder,proto3" json:"sortOptionOrder,omitempty"`
+	OrderStatus     string `protobuf:"bytes,3,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
 }
 
-func (x *GetAllTotalOrderRequest) Reset() {
-	*x = GetAllTotalOrderRequest{}
+func (x *GetAllOrderRequest) Reset() {
+	*x = GetAllOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[50]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllTotalOrderRequest) String() string {
+func (x *GetAllOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllTotalOrderRequest) ProtoMessage() {}
+func (*GetAllOrderRequest) ProtoMessage() {}
 
-func (x *GetAllTotalOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[50]
+func (x *GetAllOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3548,98 +4072,57 @@ func (x *GetAllTotalOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllTotalOrderRequest.ProtoReflect.Descriptor instead.
-func (*GetAllTotalOrderRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use GetAllOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetAllOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetAllTotalOrderRequest) GetOrderStatus() string {
+func (x *GetAllOrderRequest) GetSearch() string {
 	if x != nil {
-		return x.OrderStatus
+		return x.Search
 	}
 	return ""
 }
 
-type GetAllTotalOrderResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CurrentData  string `protobuf:"bytes,1,opt,name=currentData,proto3" json:"currentData,omitempty"`
-	PreviousData string `protobuf:"bytes,2,opt,name=previousData,proto3" json:"previousData,omitempty"`
-}
-
-func (x *GetAllTotalOrderResponse) Reset() {
-	*x = GetAllTotalOrderResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[51]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetAllTotalOrderResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetAllTotalOrderResponse) ProtoMessage() {}
-
-func (x *GetAllTotalOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[51]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetAllTotalOrderResponse.ProtoReflect.Descriptor instead.
-func (*GetAllTotalOrderResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{51}
-}
-
-func (x *GetAllTotalOrderResponse) GetCurrentData() string {
+func (x *GetAllOrderRequest) GetSortOptionOrder() string {
 	if x != nil {
-		return x.CurrentData
+		return x.SortOptionOrder
 	}
 	return ""
 }
 
-func (x *GetAllTotalOrderResponse) GetPreviousData() string {
+func (x *GetAllOrderRequest) GetOrderStatus() string {
 	if x != nil {
-		return x.PreviousData
+		return x.OrderStatus
 	}
 	return ""
 }
 
-type GetBestSellingProductsRequest struct {
+type GetAllOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderStatus string `protobuf:"bytes,1,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
+	OrderData []*OrderData `protobuf:"bytes,1,rep,name=orderData,proto3" json:"orderData,omitempty"`
 }
 
-func (x *GetBestSellingProductsRequest) Reset() {
-	*x = GetBestSellingProductsRequest{}
+func (x *GetAllOrderResponse) Reset() {
+	*x = GetAllOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[52]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBestSellingProductsRequest) String() string {
+func (x *GetAllOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBestSellingProductsRequest) ProtoMessage() {}
+func (*GetAllOrderResponse) ProtoMessage() {}
 
-func (x *GetBestSellingProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[52]
+func (x *GetAllOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3650,43 +4133,52 @@ func (x *GetBestSellingProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBestSellingProductsRequest.ProtoReflect.Descriptor instead.
-func (*GetBestSellingProductsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GetAllOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetAllOrderResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetBestSellingProductsRequest) GetOrderStatus() string {
+func (x *GetAllOrderResponse) GetOrderData() []*OrderData {
 	if x != nil {
-		return x.OrderStatus
+		return x.OrderData
 	}
-	return ""
+	return nil
 }
 
-type GetBestSellingProductsResponse struct {
+type UpdateOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BestSellingProducts string `protobuf:"bytes,1,opt,name=bestSellingProducts,proto3" json:"bestSellingProducts,omitempty"`
+	OrderId         string   `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Customer        string   `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty"`
+	CompleteAddress string   `protobuf:"bytes,3,opt,name=completeAddress,proto3" json:"completeAddress,omitempty"`
+	Product         string   `protobuf:"bytes,4,opt,name=product,proto3" json:"product,omitempty"`
+	Total           float64  `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
+	OrderStatus     string   `protobuf:"bytes,6,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
+	TrackingId      string   `protobuf:"bytes,7,opt,name=trackingId,proto3" json:"trackingId,omitempty"`
+	StickyNotes     string   `protobuf:"bytes,8,opt,name=stickyNotes,proto3" json:"stickyNotes,omitempty"`
+	UpdateMask      []string `protobuf:"bytes,9,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+	ExpectedVersion int64    `protobuf:"varint,10,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 }
 
-func (x *GetBestSellingProductsResponse) Reset() {
-	*x = GetBestSellingProductsResponse{}
+func (x *UpdateOrderRequest) Reset() {
+	*x = UpdateOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[53]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBestSellingProductsResponse) String() string {
+func (x *UpdateOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBestSellingProductsResponse) ProtoMessage() {}
+func (*UpdateOrderRequest) ProtoMessage() {}
 
-func (x *GetBestSellingProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[53]
+func (x *UpdateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3697,130 +4189,106 @@ func (x *GetBestSellingProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBestSellingProductsResponse.ProtoReflect.Descriptor instead.
-func (*GetBestSellingProductsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use UpdateOrderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetBestSellingProductsResponse) GetBestSellingProducts() string {
+func (x *UpdateOrderRequest) GetOrderId() string {
 	if x != nil {
-		return x.BestSellingProducts
+		return x.OrderId
 	}
 	return ""
 }
 
-type HomeImagesData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	HomeImagesId string `protobuf:"bytes,1,opt,name=homeImagesId,proto3" json:"homeImagesId,omitempty"`
-	HomeImg      string `protobuf:"bytes,2,opt,name=homeImg,proto3" json:"homeImg,omitempty"`
-	CreatedBy    string `protobuf:"bytes,3,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
-	CreatedAt    int64  `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedBy    string `protobuf:"bytes,5,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
-	UpdatedAt    int64  `protobuf:"varint,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-}
-
-func (x *HomeImagesData) Reset() {
-	*x = HomeImagesData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[54]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UpdateOrderRequest) GetCustomer() string {
+	if x != nil {
+		return x.Customer
 	}
+	return ""
 }
 
-func (x *HomeImagesData) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*HomeImagesData) ProtoMessage() {}
-
-func (x *HomeImagesData) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[54]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UpdateOrderRequest) GetCompleteAddress() string {
+	if x != nil {
+		return x.CompleteAddress
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use HomeImagesData.ProtoReflect.Descriptor instead.
-func (*HomeImagesData) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{54}
+func (x *UpdateOrderRequest) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
 }
 
-func (x *HomeImagesData) GetHomeImagesId() string {
+func (x *UpdateOrderRequest) GetTotal() float64 {
 	if x != nil {
-		return x.HomeImagesId
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *HomeImagesData) GetHomeImg() string {
+func (x *UpdateOrderRequest) GetOrderStatus() string {
 	if x != nil {
-		return x.HomeImg
+		return x.OrderStatus
 	}
 	return ""
 }
 
-func (x *HomeImagesData) GetCreatedBy() string {
+func (x *UpdateOrderRequest) GetTrackingId() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.TrackingId
 	}
 	return ""
 }
 
-func (x *HomeImagesData) GetCreatedAt() int64 {
+func (x *UpdateOrderRequest) GetStickyNotes() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.StickyNotes
 	}
-	return 0
+	return ""
 }
 
-func (x *HomeImagesData) GetUpdatedBy() string {
+func (x *UpdateOrderRequest) GetUpdateMask() []string {
 	if x != nil {
-		return x.UpdatedBy
+		return x.UpdateMask
 	}
-	return ""
+	return nil
 }
 
-func (x *HomeImagesData) GetUpdatedAt() int64 {
+func (x *UpdateOrderRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ExpectedVersion
 	}
 	return 0
 }
 
-type SaveHomeImagesRequest struct {
+type UpdateOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HomeImg string `protobuf:"bytes,1,opt,name=homeImg,proto3" json:"homeImg,omitempty"`
+	OrderData *OrderData `protobuf:"bytes,1,opt,name=orderData,proto3" json:"orderData,omitempty"`
 }
 
-func (x *SaveHomeImagesRequest) Reset() {
-	*x = SaveHomeImagesRequest{}
+func (x *UpdateOrderResponse) Reset() {
+	*x = UpdateOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[55]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SaveHomeImagesRequest) String() string {
+func (x *UpdateOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveHomeImagesRequest) ProtoMessage() {}
+func (*UpdateOrderResponse) ProtoMessage() {}
 
-func (x *SaveHomeImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[55]
+func (x *UpdateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3831,43 +4299,45 @@ func (x *SaveHomeImagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveHomeImagesRequest.ProtoReflect.Descriptor instead.
-func (*SaveHomeImagesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use UpdateOrderResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *SaveHomeImagesRequest) GetHomeImg() string {
+func (x *UpdateOrderResponse) GetOrderData() *OrderData {
 	if x != nil {
-		return x.HomeImg
+		return x.OrderData
 	}
-	return ""
+	return nil
 }
 
-type SaveHomeImagesResponse struct {
+type UpdateOrderStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HomeImagesData *HomeImagesData `protobuf:"bytes,1,opt,name=homeImagesData,proto3" json:"homeImagesData,omitempty"`
+	OrderId         string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	OrderStatus     string `protobuf:"bytes,2,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 }
 
-func (x *SaveHomeImagesResponse) Reset() {
-	*x = SaveHomeImagesResponse{}
+func (x *UpdateOrderStatusRequest) Reset() {
+	*x = UpdateOrderStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[56]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SaveHomeImagesResponse) String() string {
+func (x *UpdateOrderStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveHomeImagesResponse) ProtoMessage() {}
+func (*UpdateOrderStatusRequest) ProtoMessage() {}
 
-func (x *SaveHomeImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[56]
+func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3878,41 +4348,57 @@ func (x *SaveHomeImagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveHomeImagesResponse.ProtoReflect.Descriptor instead.
-func (*SaveHomeImagesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *SaveHomeImagesResponse) GetHomeImagesData() *HomeImagesData {
+func (x *UpdateOrderStatusRequest) GetOrderId() string {
 	if x != nil {
-		return x.HomeImagesData
+		return x.OrderId
 	}
-	return nil
-}
-
-type GetAllHomeImagesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return ""
 }
 
-func (x *GetAllHomeImagesRequest) Reset() {
-	*x = GetAllHomeImagesRequest{}
+func (x *UpdateOrderStatusRequest) GetOrderStatus() string {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateOrderStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderData *OrderData `protobuf:"bytes,1,opt,name=orderData,proto3" json:"orderData,omitempty"`
+}
+
+func (x *UpdateOrderStatusResponse) Reset() {
+	*x = UpdateOrderStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[57]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllHomeImagesRequest) String() string {
+func (x *UpdateOrderStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllHomeImagesRequest) ProtoMessage() {}
+func (*UpdateOrderStatusResponse) ProtoMessage() {}
 
-func (x *GetAllHomeImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[57]
+func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3923,36 +4409,43 @@ func (x *GetAllHomeImagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllHomeImagesRequest.ProtoReflect.Descriptor instead.
-func (*GetAllHomeImagesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{51}
 }
 
-type GetAllHomeImagesResponse struct {
+func (x *UpdateOrderStatusResponse) GetOrderData() *OrderData {
+	if x != nil {
+		return x.OrderData
+	}
+	return nil
+}
+
+type GetAllOrderRevenueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HomeImagesData []*HomeImagesData `protobuf:"bytes,1,rep,name=homeImagesData,proto3" json:"homeImagesData,omitempty"`
+	OrderStatus string `protobuf:"bytes,2,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
 }
 
-func (x *GetAllHomeImagesResponse) Reset() {
-	*x = GetAllHomeImagesResponse{}
+func (x *GetAllOrderRevenueRequest) Reset() {
+	*x = GetAllOrderRevenueRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[58]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllHomeImagesResponse) String() string {
+func (x *GetAllOrderRevenueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllHomeImagesResponse) ProtoMessage() {}
+func (*GetAllOrderRevenueRequest) ProtoMessage() {}
 
-func (x *GetAllHomeImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[58]
+func (x *GetAllOrderRevenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3963,44 +4456,46 @@ func (x *GetAllHomeImagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllHomeImagesResponse.ProtoReflect.Descriptor instead.
-func (*GetAllHomeImagesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use GetAllOrderRevenueRequest.ProtoReflect.Descriptor instead.
+func (*GetAllOrderRevenueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetAllHomeImagesResponse) GetHomeImagesData() []*HomeImagesData {
+func (x *GetAllOrderRevenueRequest) GetOrderStatus() string {
 	if x != nil {
-		return x.HomeImagesData
+		return x.OrderStatus
 	}
-	return nil
+	return ""
 }
 
-type UpdateHomeImagesRequest struct {
+type GetAllOrderRevenueResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HomeImagesId string `protobuf:"bytes,1,opt,name=homeImagesId,proto3" json:"homeImagesId,omitempty"`
-	HomeImg      string `protobuf:"bytes,2,opt,name=homeImg,proto3" json:"homeImg,omitempty"`
+	CurrentData       string `protobuf:"bytes,1,opt,name=currentData,proto3" json:"currentData,omitempty"`
+	PreviousData      string `protobuf:"bytes,2,opt,name=previousData,proto3" json:"previousData,omitempty"`
+	CurrentGrossData  string `protobuf:"bytes,3,opt,name=currentGrossData,proto3" json:"currentGrossData,omitempty"`
+	PreviousGrossData string `protobuf:"bytes,4,opt,name=previousGrossData,proto3" json:"previousGrossData,omitempty"`
 }
 
-func (x *UpdateHomeImagesRequest) Reset() {
-	*x = UpdateHomeImagesRequest{}
+func (x *GetAllOrderRevenueResponse) Reset() {
+	*x = GetAllOrderRevenueResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[59]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateHomeImagesRequest) String() string {
+func (x *GetAllOrderRevenueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateHomeImagesRequest) ProtoMessage() {}
+func (*GetAllOrderRevenueResponse) ProtoMessage() {}
 
-func (x *UpdateHomeImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[59]
+func (x *GetAllOrderRevenueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4011,50 +4506,64 @@ func (x *UpdateHomeImagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateHomeImagesRequest.ProtoReflect.Descriptor instead.
-func (*UpdateHomeImagesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use GetAllOrderRevenueResponse.ProtoReflect.Descriptor instead.
+func (*GetAllOrderRevenueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *UpdateHomeImagesRequest) GetHomeImagesId() string {
+func (x *GetAllOrderRevenueResponse) GetCurrentData() string {
 	if x != nil {
-		return x.HomeImagesId
+		return x.CurrentData
 	}
 	return ""
 }
 
-func (x *UpdateHomeImagesRequest) GetHomeImg() string {
+func (x *GetAllOrderRevenueResponse) GetPreviousData() string {
 	if x != nil {
-		return x.HomeImg
+		return x.PreviousData
 	}
 	return ""
 }
 
-type UpdateHomeImagesResponse struct {
+func (x *GetAllOrderRevenueResponse) GetCurrentGrossData() string {
+	if x != nil {
+		return x.CurrentGrossData
+	}
+	return ""
+}
+
+func (x *GetAllOrderRevenueResponse) GetPreviousGrossData() string {
+	if x != nil {
+		return x.PreviousGrossData
+	}
+	return ""
+}
+
+type GetAllTotalOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HomeImagesData *HomeImagesData `protobuf:"bytes,1,opt,name=homeImagesData,proto3" json:"homeImagesData,omitempty"`
+	OrderStatus string `protobuf:"bytes,2,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
 }
 
-func (x *UpdateHomeImagesResponse) Reset() {
-	*x = UpdateHomeImagesResponse{}
+func (x *GetAllTotalOrderRequest) Reset() {
+	*x = GetAllTotalOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[60]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateHomeImagesResponse) String() string {
+func (x *GetAllTotalOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateHomeImagesResponse) ProtoMessage() {}
+func (*GetAllTotalOrderRequest) ProtoMessage() {}
 
-func (x *UpdateHomeImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[60]
+func (x *GetAllTotalOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4065,43 +4574,44 @@ func (x *UpdateHomeImagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateHomeImagesResponse.ProtoReflect.Descriptor instead.
-func (*UpdateHomeImagesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use GetAllTotalOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetAllTotalOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *UpdateHomeImagesResponse) GetHomeImagesData() *HomeImagesData {
+func (x *GetAllTotalOrderRequest) GetOrderStatus() string {
 	if x != nil {
-		return x.HomeImagesData
+		return x.OrderStatus
 	}
-	return nil
+	return ""
 }
 
-type DeleteHomeImagesRequest struct {
+type GetAllTotalOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HomeImagesId string `protobuf:"bytes,1,opt,name=homeImagesId,proto3" json:"homeImagesId,omitempty"`
+	CurrentData  string `protobuf:"bytes,1,opt,name=currentData,proto3" json:"currentData,omitempty"`
+	PreviousData string `protobuf:"bytes,2,opt,name=previousData,proto3" json:"previousData,omitempty"`
 }
 
-func (x *DeleteHomeImagesRequest) Reset() {
-	*x = DeleteHomeImagesRequest{}
+func (x *GetAllTotalOrderResponse) Reset() {
+	*x = GetAllTotalOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[61]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteHomeImagesRequest) String() string {
+func (x *GetAllTotalOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteHomeImagesRequest) ProtoMessage() {}
+func (*GetAllTotalOrderResponse) ProtoMessage() {}
 
-func (x *DeleteHomeImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[61]
+func (x *GetAllTotalOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4112,43 +4622,50 @@ func (x *DeleteHomeImagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteHomeImagesRequest.ProtoReflect.Descriptor instead.
-func (*DeleteHomeImagesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use GetAllTotalOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetAllTotalOrderResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *DeleteHomeImagesRequest) GetHomeImagesId() string {
+func (x *GetAllTotalOrderResponse) GetCurrentData() string {
 	if x != nil {
-		return x.HomeImagesId
+		return x.CurrentData
 	}
 	return ""
 }
 
-type DeleteHomeImagesResponse struct {
+func (x *GetAllTotalOrderResponse) GetPreviousData() string {
+	if x != nil {
+		return x.PreviousData
+	}
+	return ""
+}
+
+type GetBestSellingProductsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HomeImagesData *HomeImagesData `protobuf:"bytes,1,opt,name=homeImagesData,proto3" json:"homeImagesData,omitempty"`
+	OrderStatus string `protobuf:"bytes,1,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
 }
 
-func (x *DeleteHomeImagesResponse) Reset() {
-	*x = DeleteHomeImagesResponse{}
+func (x *GetBestSellingProductsRequest) Reset() {
+	*x = GetBestSellingProductsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_chronexdata_proto_msgTypes[62]
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteHomeImagesResponse) String() string {
+func (x *GetBestSellingProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteHomeImagesResponse) ProtoMessage() {}
+func (*GetBestSellingProductsRequest) ProtoMessage() {}
 
-func (x *DeleteHomeImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_chronexdata_proto_msgTypes[62]
+func (x *GetBestSellingProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4159,937 +4676,16430 @@ func (x *DeleteHomeImagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteHomeImagesResponse.ProtoReflect.Descriptor instead.
-func (*DeleteHomeImagesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use GetBestSellingProductsRequest.ProtoReflect.Descriptor instead.
+func (*GetBestSellingProductsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *DeleteHomeImagesResponse) GetHomeImagesData() *HomeImagesData {
+func (x *GetBestSellingProductsRequest) GetOrderStatus() string {
 	if x != nil {
-		return x.HomeImagesData
+		return x.OrderStatus
 	}
-	return nil
+	return ""
 }
 
-var File_pkg_pb_chronexdata_proto protoreflect.FileDescriptor
+type GetBestSellingProductsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_pkg_pb_chronexdata_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x65, 0x78,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x22,
-	0xf9, 0x04, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x69, 0x6d, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x6d,
-	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
-	0x0d, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x31, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x12, 0x2a, 0x0a, 0x10, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x65, 0x65, 0x62,
-	0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
-	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xea, 0x03, 0x0a, 0x12,
-	0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x6d, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x69, 0x6d, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6c,
-	0x69, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28,
-	0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x12, 0x22, 0x0a, 0x0c,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32,
-	0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x0f,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x12, 0x28,
-	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
-	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x32, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x22, 0x5c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x22, 0x4b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0x38,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0xb4, 0x03, 0x0a, 0x14, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64,
-	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x6d, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x69, 0x6d, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x24, 0x0a, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
-	0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f,
-	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
-	0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x12, 0x24,
-	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53,
-	0x6f, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
-	0x22, 0x4b, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0x92, 0x01,
-	0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x51,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
-	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x22, 0x53, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x51, 0x0a, 0x1b, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0xba, 0x03, 0x0a,
-	0x0c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a,
-	0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a,
-	0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x6d, 0x67,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
-	0x49, 0x6d, 0x67, 0x12, 0x2e, 0x0a, 0x12, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x12, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x38, 0x0a, 0x17, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x17, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x72, 0x65,
-	0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa9, 0x02, 0x0a, 0x13, 0x53, 0x61,
-	0x76, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
-	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
-	0x73, 0x49, 0x6d, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x66, 0x72, 0x65, 0x65,
-	0x62, 0x69, 0x65, 0x73, 0x49, 0x6d, 0x67, 0x12, 0x2e, 0x0a, 0x12, 0x66, 0x72, 0x65, 0x65, 0x62,
-	0x69, 0x65, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x12, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x66, 0x72, 0x65, 0x65, 0x62,
-	0x69, 0x65, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x66, 0x72, 0x65, 0x65, 0x62,
-	0x69, 0x65, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a,
-	0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4d, 0x0a, 0x14, 0x53, 0x61, 0x76, 0x65, 0x46, 0x72, 0x65,
-	0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
-	0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69,
-	0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
-	0x44, 0x61, 0x74, 0x61, 0x22, 0x4f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72,
-	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46,
-	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x35, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x72, 0x65, 0x65,
-	0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69,
-	0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x1f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x72, 0x65,
-	0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61,
-	0x22, 0x3b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x0a,
-	0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x22, 0x53, 0x0a,
-	0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x66,
-	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61,
-	0x74, 0x61, 0x22, 0xd5, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65,
-	0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
-	0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x6d, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49,
-	0x6d, 0x67, 0x12, 0x2e, 0x0a, 0x12, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12,
-	0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65,
-	0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4f, 0x0a, 0x16, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
-	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x66,
-	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0xb5, 0x01, 0x0a, 0x1d,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x51, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x3a, 0x0a,
-	0x18, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
-	0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x18, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
-	0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x66, 0x72, 0x65,
-	0x65, 0x62, 0x69, 0x65, 0x73, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x66, 0x72, 0x65, 0x65,
-	0x62, 0x69, 0x65, 0x73, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x22, 0x57, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65,
-	0x65, 0x62, 0x69, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
-	0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c,
-	0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x65, 0x0a, 0x1b,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
-	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x66,
-	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x55, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65,
-	0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44,
-	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x66, 0x72,
-	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x87, 0x03, 0x0a, 0x0b, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x73, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x72,
-	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53,
-	0x74, 0x61, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x49, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x5c, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x6f,
-	0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x4b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0xf2, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x11,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
-	0x53, 0x74, 0x61, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x4b, 0x0a, 0x15, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x73, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x51, 0x0a, 0x1b, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x38, 0x0a, 0x18,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x73, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x79, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0xf7, 0x02, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x63,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b,
-	0x69, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61,
-	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x69, 0x63, 0x6b,
-	0x79, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74,
-	0x69, 0x63, 0x6b, 0x79, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x42, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0xaa, 0x01, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x41,
-	0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x22, 0x78, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
-	0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x43, 0x0a, 0x13, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
-	0x22, 0x88, 0x02, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x28, 0x0a,
-	0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61,
-	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
-	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x69,
-	0x63, 0x6b, 0x79, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x73, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x13, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
-	0x22, 0x56, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x49, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44,
-	0x61, 0x74, 0x61, 0x22, 0x3d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x62, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
-	0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x60, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x44, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
-	0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x41, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74,
-	0x53, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x52, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x42,
-	0x65, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x62, 0x65,
-	0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6c,
-	0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x22, 0xc6, 0x01, 0x0a,
-	0x0e, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x22, 0x0a, 0x0c, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x73, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x67, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x31, 0x0a, 0x15, 0x53, 0x61, 0x76, 0x65, 0x48, 0x6f, 0x6d,
-	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x67, 0x22, 0x55, 0x0a, 0x16, 0x53, 0x61, 0x76, 0x65,
-	0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22,
-	0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44,
-	0x61, 0x74, 0x61, 0x22, 0x57, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d,
-	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
-	0x0a, 0x0c, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x67, 0x22, 0x57, 0x0a, 0x18,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x68, 0x6f, 0x6d, 0x65,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3d, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48,
-	0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x22, 0x0a, 0x0c, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x73, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f,
-	0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3b, 0x0a, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48,
-	0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x68,
-	0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x2a, 0xfd, 0x01,
-	0x0a, 0x11, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x41,
-	0x54, 0x4f, 0x5a, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54,
-	0x5f, 0x5a, 0x54, 0x4f, 0x41, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x4f, 0x44, 0x55,
-	0x43, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x54, 0x4f,
-	0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43,
-	0x54, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x4f, 0x5f, 0x48,
-	0x49, 0x47, 0x48, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54,
-	0x5f, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x54,
-	0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x4f, 0x44, 0x55,
-	0x43, 0x54, 0x5f, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x5f,
-	0x54, 0x4f, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x4f,
-	0x44, 0x55, 0x43, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x52, 0x5f, 0x48, 0x49,
-	0x47, 0x48, 0x5f, 0x54, 0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x06, 0x12, 0x20, 0x0a, 0x1c, 0x50,
-	0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x52, 0x5f,
-	0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x4f, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x07, 0x2a, 0x82, 0x01,
-	0x0a, 0x0a, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04,
-	0x41, 0x54, 0x4f, 0x5a, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x5a, 0x54, 0x4f, 0x41, 0x10, 0x01,
-	0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x54,
-	0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x49, 0x43, 0x45,
-	0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x4f, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x03, 0x12, 0x18,
-	0x0a, 0x14, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f,
-	0x54, 0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x51, 0x55, 0x41, 0x4e,
-	0x54, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x4f, 0x5f, 0x48, 0x49, 0x47, 0x48,
-	0x10, 0x05, 0x2a, 0xb3, 0x01, 0x0a, 0x11, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x56, 0x49,
-	0x45, 0x57, 0x53, 0x5f, 0x41, 0x54, 0x4f, 0x5a, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45,
-	0x56, 0x49, 0x45, 0x57, 0x53, 0x5f, 0x5a, 0x54, 0x4f, 0x41, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a,
-	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x53, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x48,
-	0x49, 0x47, 0x48, 0x5f, 0x54, 0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a,
-	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x53, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4c,
-	0x4f, 0x57, 0x5f, 0x54, 0x4f, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18,
-	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x53, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x48, 0x49, 0x47,
-	0x48, 0x5f, 0x54, 0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45,
-	0x56, 0x49, 0x45, 0x57, 0x53, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x54,
-	0x4f, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x05, 0x2a, 0x69, 0x0a, 0x0f, 0x53, 0x6f, 0x72, 0x74,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x0a, 0x4f,
-	0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x54, 0x4f, 0x5a, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4f,
-	0x52, 0x44, 0x45, 0x52, 0x5f, 0x5a, 0x54, 0x4f, 0x41, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4f,
-	0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x54,
-	0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52,
-	0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x4f, 0x5f, 0x48, 0x49, 0x47,
-	0x48, 0x10, 0x03, 0x32, 0xe6, 0x12, 0x0a, 0x18, 0x43, 0x68, 0x72, 0x6f, 0x6e, 0x65, 0x78, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x42, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12,
-	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x65, 0x46, 0x72, 0x65, 0x65,
-	0x62, 0x69, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x46,
-	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x53,
-	0x61, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x3c, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a,
-	0x0e, 0x53, 0x61, 0x76, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12,
-	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77,
-	0x6e, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72,
-	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x64, 0x6f,
-	0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x19, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x42, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x48, 0x6f,
-	0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x1a, 0x1f, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x49, 0x64, 0x22, 0x00,
-	0x12, 0x54, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x42, 0x79, 0x49, 0x64, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x79, 0x49, 0x64, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x49, 0x64, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x12, 0x1a,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x16, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d,
-	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a,
-	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x19,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a,
-	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x51, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x51, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x5a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x54, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48,
-	0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x12, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75,
-	0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x65,
-	0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x73, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74, 0x53,
-	0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x65, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08,
-	0x2e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	BestSellingProducts string `protobuf:"bytes,1,opt,name=bestSellingProducts,proto3" json:"bestSellingProducts,omitempty"`
 }
 
-var (
-	file_pkg_pb_chronexdata_proto_rawDescOnce sync.Once
-	file_pkg_pb_chronexdata_proto_rawDescData = file_pkg_pb_chronexdata_proto_rawDesc
-)
+func (x *GetBestSellingProductsResponse) Reset() {
+	*x = GetBestSellingProductsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_pkg_pb_chronexdata_proto_rawDescGZIP() []byte {
-	file_pkg_pb_chronexdata_proto_rawDescOnce.Do(func() {
-		file_pkg_pb_chronexdata_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_pb_chronexdata_proto_rawDescData)
-	})
-	return file_pkg_pb_chronexdata_proto_rawDescData
+func (x *GetBestSellingProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_pkg_pb_chronexdata_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_pkg_pb_chronexdata_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
-var file_pkg_pb_chronexdata_proto_goTypes = []interface{}{
-	(SortOptionProduct)(0),                 // 0: api.SortOptionProduct
-	(SortOption)(0),                        // 1: api.SortOption
-	(SortOptionReviews)(0),                 // 2: api.SortOptionReviews
-	(SortOptionOrder)(0),                   // 3: api.SortOptionOrder
-	(*ProductData)(nil),                    // 4: api.ProductData
-	(*SaveProductRequest)(nil),             // 5: api.SaveProductRequest
-	(*SaveProductResponse)(nil),            // 6: api.SaveProductResponse
-	(*GetAllProductRequest)(nil),           // 7: api.GetAllProductRequest
-	(*GetAllProductResponse)(nil),          // 8: api.GetAllProductResponse
-	(*GetAllProductRequestById)(nil),       // 9: api.GetAllProductRequestById
-	(*GetAllProductResponseById)(nil),      // 10: api.GetAllProductResponseById
-	(*UpdateProductRequest)(nil),           // 11: api.UpdateProductRequest
-	(*UpdateProductResponse)(nil),          // 12: api.UpdateProductResponse
-	(*UpdateProductQuantityRequest)(nil),   // 13: api.UpdateProductQuantityRequest
-	(*UpdateProductQuantityResponse)(nil),  // 14: api.UpdateProductQuantityResponse
-	(*UpdateProductStatusRequest)(nil),     // 15: api.UpdateProductStatusRequest
-	(*UpdateProductStatusResponse)(nil),    // 16: api.UpdateProductStatusResponse
-	(*FreebiesData)(nil),                   // 17: api.FreebiesData
-	(*SaveFreebiesRequest)(nil),            // 18: api.SaveFreebiesRequest
-	(*SaveFreebiesResponse)(nil),           // 19: api.SaveFreebiesResponse
-	(*GetAllFreebiesRequest)(nil),          // 20: api.GetAllFreebiesRequest
-	(*GetAllFreebiesResponse)(nil),         // 21: api.GetAllFreebiesResponse
-	(*GetAllFreebiesDropdownRequest)(nil),  // 22: api.GetAllFreebiesDropdownRequest
-	(*GetAllFreebiesDropdownResponse)(nil), // 23: api.GetAllFreebiesDropdownResponse
-	(*GetAllFreebiesRequestById)(nil),      // 24: api.GetAllFreebiesRequestById
-	(*GetAllFreebiesResponseById)(nil),     // 25: api.GetAllFreebiesResponseById
-	(*UpdateFreebiesRequest)(nil),          // 26: api.UpdateFreebiesRequest
-	(*UpdateFreebiesResponse)(nil),         // 27: api.UpdateFreebiesResponse
-	(*UpdateFreebiesQuantityRequest)(nil),  // 28: api.UpdateFreebiesQuantityRequest
-	(*UpdateFreebiesQuantityResponse)(nil), // 29: api.UpdateFreebiesQuantityResponse
-	(*UpdateFreebiesStatusRequest)(nil),    // 30: api.UpdateFreebiesStatusRequest
-	(*UpdateFreebiesStatusResponse)(nil),   // 31: api.UpdateFreebiesStatusResponse
-	(*ReviewsData)(nil),                    // 32: api.ReviewsData
-	(*SaveReviewsRequest)(nil),             // 33: api.SaveReviewsRequest
-	(*SaveReviewsResponse)(nil),            // 34: api.SaveReviewsResponse
-	(*GetAllReviewsRequest)(nil),           // 35: api.GetAllReviewsRequest
-	(*GetAllReviewsResponse)(nil),          // 36: api.GetAllReviewsResponse
-	(*UpdateReviewsRequest)(nil),           // 37: api.UpdateReviewsRequest
-	(*UpdateReviewsResponse)(nil),          // 38: api.UpdateReviewsResponse
-	(*UpdateReviewsStatusRequest)(nil),     // 39: api.UpdateReviewsStatusRequest
-	(*UpdateReviewsStatusResponse)(nil),    // 40: api.UpdateReviewsStatusResponse
-	(*GetAllReviewsRequestById)(nil),       // 41: api.GetAllReviewsRequestById
-	(*GetAllReviewsResponseById)(nil),      // 42: api.GetAllReviewsResponseById
-	(*OrderData)(nil),                      // 43: api.OrderData
-	(*SaveOrderRequest)(nil),               // 44: api.SaveOrderRequest
-	(*SaveOrderResponse)(nil),              // 45: api.SaveOrderResponse
-	(*GetAllOrderRequest)(nil),             // 46: api.GetAllOrderRequest
-	(*GetAllOrderResponse)(nil),            // 47: api.GetAllOrderResponse
-	(*UpdateOrderRequest)(nil),             // 48: api.UpdateOrderRequest
-	(*UpdateOrderResponse)(nil),            // 49: api.UpdateOrderResponse
-	(*UpdateOrderStatusRequest)(nil),       // 50: api.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil),      // 51: api.UpdateOrderStatusResponse
-	(*GetAllOrderRevenueRequest)(nil),      // 52: api.GetAllOrderRevenueRequest
-	(*GetAllOrderRevenueResponse)(nil),     // 53: api.GetAllOrderRevenueResponse
-	(*GetAllTotalOrderRequest)(nil),        // 54: api.GetAllTotalOrderRequest
-	(*GetAllTotalOrderResponse)(nil),       // 55: api.GetAllTotalOrderResponse
-	(*GetBestSellingProductsRequest)(nil),  // 56: api.GetBestSellingProductsRequest
-	(*GetBestSellingProductsResponse)(nil), // 57: api.GetBestSellingProductsResponse
-	(*HomeImagesData)(nil),                 // 58: api.HomeImagesData
-	(*SaveHomeImagesRequest)(nil),          // 59: api.SaveHomeImagesRequest
-	(*SaveHomeImagesResponse)(nil),         // 60: api.SaveHomeImagesResponse
-	(*GetAllHomeImagesRequest)(nil),        // 61: api.GetAllHomeImagesRequest
-	(*GetAllHomeImagesResponse)(nil),       // 62: api.GetAllHomeImagesResponse
-	(*UpdateHomeImagesRequest)(nil),        // 63: api.UpdateHomeImagesRequest
-	(*UpdateHomeImagesResponse)(nil),       // 64: api.UpdateHomeImagesResponse
-	(*DeleteHomeImagesRequest)(nil),        // 65: api.DeleteHomeImagesRequest
-	(*DeleteHomeImagesResponse)(nil),       // 66: api.DeleteHomeImagesResponse
+func (*GetBestSellingProductsResponse) ProtoMessage() {}
+
+func (x *GetBestSellingProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_pkg_pb_chronexdata_proto_depIdxs = []int32{
-	4,  // 0: api.SaveProductResponse.productData:type_name -> api.ProductData
-	4,  // 1: api.GetAllProductResponse.productData:type_name -> api.ProductData
-	4,  // 2: api.GetAllProductResponseById.productData:type_name -> api.ProductData
-	4,  // 3: api.UpdateProductResponse.productData:type_name -> api.ProductData
-	4,  // 4: api.UpdateProductQuantityResponse.productData:type_name -> api.ProductData
-	4,  // 5: api.UpdateProductStatusResponse.productData:type_name -> api.ProductData
-	17, // 6: api.SaveFreebiesResponse.freebiesData:type_name -> api.FreebiesData
-	17, // 7: api.GetAllFreebiesResponse.freebiesData:type_name -> api.FreebiesData
-	17, // 8: api.GetAllFreebiesDropdownResponse.freebiesData:type_name -> api.FreebiesData
-	17, // 9: api.GetAllFreebiesResponseById.freebiesData:type_name -> api.FreebiesData
-	17, // 10: api.UpdateFreebiesResponse.freebiesData:type_name -> api.FreebiesData
-	17, // 11: api.UpdateFreebiesQuantityResponse.freebiesData:type_name -> api.FreebiesData
-	17, // 12: api.UpdateFreebiesStatusResponse.freebiesData:type_name -> api.FreebiesData
-	32, // 13: api.SaveReviewsResponse.reviewsData:type_name -> api.ReviewsData
-	32, // 14: api.GetAllReviewsResponse.reviewsData:type_name -> api.ReviewsData
-	32, // 15: api.UpdateReviewsResponse.reviewsData:type_name -> api.ReviewsData
-	32, // 16: api.UpdateReviewsStatusResponse.reviewsData:type_name -> api.ReviewsData
-	32, // 17: api.GetAllReviewsResponseById.reviewsData:type_name -> api.ReviewsData
-	43, // 18: api.SaveOrderResponse.orderData:type_name -> api.OrderData
-	43, // 19: api.GetAllOrderResponse.orderData:type_name -> api.OrderData
-	43, // 20: api.UpdateOrderResponse.orderData:type_name -> api.OrderData
-	43, // 21: api.UpdateOrderStatusResponse.orderData:type_name -> api.OrderData
-	58, // 22: api.SaveHomeImagesResponse.homeImagesData:type_name -> api.HomeImagesData
-	58, // 23: api.GetAllHomeImagesResponse.homeImagesData:type_name -> api.HomeImagesData
-	58, // 24: api.UpdateHomeImagesResponse.homeImagesData:type_name -> api.HomeImagesData
-	58, // 25: api.DeleteHomeImagesResponse.homeImagesData:type_name -> api.HomeImagesData
-	5,  // 26: api.ChronexAdminProtoService.SaveProduct:input_type -> api.SaveProductRequest
-	18, // 27: api.ChronexAdminProtoService.SaveFreebies:input_type -> api.SaveFreebiesRequest
-	33, // 28: api.ChronexAdminProtoService.SaveReviews:input_type -> api.SaveReviewsRequest
-	44, // 29: api.ChronexAdminProtoService.SaveOrder:input_type -> api.SaveOrderRequest
-	59, // 30: api.ChronexAdminProtoService.SaveHomeImages:input_type -> api.SaveHomeImagesRequest
-	20, // 31: api.ChronexAdminProtoService.GetAllFreebies:input_type -> api.GetAllFreebiesRequest
-	22, // 32: api.ChronexAdminProtoService.GetAllFreebiesDropdown:input_type -> api.GetAllFreebiesDropdownRequest
-	7,  // 33: api.ChronexAdminProtoService.GetAllProduct:input_type -> api.GetAllProductRequest
-	35, // 34: api.ChronexAdminProtoService.GetAllReviews:input_type -> api.GetAllReviewsRequest
-	46, // 35: api.ChronexAdminProtoService.GetAllOrder:input_type -> api.GetAllOrderRequest
-	61, // 36: api.ChronexAdminProtoService.GetAllHomeImages:input_type -> api.GetAllHomeImagesRequest
-	24, // 37: api.ChronexAdminProtoService.GetAllFreebiesById:input_type -> api.GetAllFreebiesRequestById
-	9,  // 38: api.ChronexAdminProtoService.GetAllProductById:input_type -> api.GetAllProductRequestById
-	41, // 39: api.ChronexAdminProtoService.GetAllReviewsById:input_type -> api.GetAllReviewsRequestById
-	26, // 40: api.ChronexAdminProtoService.UpdateFreebies:input_type -> api.UpdateFreebiesRequest
-	28, // 41: api.ChronexAdminProtoService.UpdateFreebiesQuantity:input_type -> api.UpdateFreebiesQuantityRequest
-	30, // 42: api.ChronexAdminProtoService.UpdateFreebiesStatus:input_type -> api.UpdateFreebiesStatusRequest
-	37, // 43: api.ChronexAdminProtoService.UpdateReviews:input_type -> api.UpdateReviewsRequest
-	39, // 44: api.ChronexAdminProtoService.UpdateReviewsStatus:input_type -> api.UpdateReviewsStatusRequest
-	11, // 45: api.ChronexAdminProtoService.UpdateProduct:input_type -> api.UpdateProductRequest
-	13, // 46: api.ChronexAdminProtoService.UpdateProductQuantity:input_type -> api.UpdateProductQuantityRequest
-	15, // 47: api.ChronexAdminProtoService.UpdateProductStatus:input_type -> api.UpdateProductStatusRequest
-	48, // 48: api.ChronexAdminProtoService.UpdateOrder:input_type -> api.UpdateOrderRequest
-	50, // 49: api.ChronexAdminProtoService.UpdateOrderStatus:input_type -> api.UpdateOrderStatusRequest
-	63, // 50: api.ChronexAdminProtoService.UpdateHomeImages:input_type -> api.UpdateHomeImagesRequest
-	65, // 51: api.ChronexAdminProtoService.DeleteHomeImages:input_type -> api.DeleteHomeImagesRequest
-	52, // 52: api.ChronexAdminProtoService.GetAllOrderRevenue:input_type -> api.GetAllOrderRevenueRequest
-	54, // 53: api.ChronexAdminProtoService.GetAllTotalOrder:input_type -> api.GetAllTotalOrderRequest
-	56, // 54: api.ChronexAdminProtoService.GetBestSellingProducts:input_type -> api.GetBestSellingProductsRequest
-	6,  // 55: api.ChronexAdminProtoService.SaveProduct:output_type -> api.SaveProductResponse
-	19, // 56: api.ChronexAdminProtoService.SaveFreebies:output_type -> api.SaveFreebiesResponse
-	34, // 57: api.ChronexAdminProtoService.SaveReviews:output_type -> api.SaveReviewsResponse
-	45, // 58: api.ChronexAdminProtoService.SaveOrder:output_type -> api.SaveOrderResponse
-	60, // 59: api.ChronexAdminProtoService.SaveHomeImages:output_type -> api.SaveHomeImagesResponse
-	21, // 60: api.ChronexAdminProtoService.GetAllFreebies:output_type -> api.GetAllFreebiesResponse
-	23, // 61: api.ChronexAdminProtoService.GetAllFreebiesDropdown:output_type -> api.GetAllFreebiesDropdownResponse
-	8,  // 62: api.ChronexAdminProtoService.GetAllProduct:output_type -> api.GetAllProductResponse
-	36, // 63: api.ChronexAdminProtoService.GetAllReviews:output_type -> api.GetAllReviewsResponse
-	47, // 64: api.ChronexAdminProtoService.GetAllOrder:output_type -> api.GetAllOrderResponse
-	62, // 65: api.ChronexAdminProtoService.GetAllHomeImages:output_type -> api.GetAllHomeImagesResponse
-	25, // 66: api.ChronexAdminProtoService.GetAllFreebiesById:output_type -> api.GetAllFreebiesResponseById
-	10, // 67: api.ChronexAdminProtoService.GetAllProductById:output_type -> api.GetAllProductResponseById
-	42, // 68: api.ChronexAdminProtoService.GetAllReviewsById:output_type -> api.GetAllReviewsResponseById
-	27, // 69: api.ChronexAdminProtoService.UpdateFreebies:output_type -> api.UpdateFreebiesResponse
-	29, // 70: api.ChronexAdminProtoService.UpdateFreebiesQuantity:output_type -> api.UpdateFreebiesQuantityResponse
-	31, // 71: api.ChronexAdminProtoService.UpdateFreebiesStatus:output_type -> api.UpdateFreebiesStatusResponse
-	38, // 72: api.ChronexAdminProtoService.UpdateReviews:output_type -> api.UpdateReviewsResponse
-	40, // 73: api.ChronexAdminProtoService.UpdateReviewsStatus:output_type -> api.UpdateReviewsStatusResponse
-	12, // 74: api.ChronexAdminProtoService.UpdateProduct:output_type -> api.UpdateProductResponse
-	14, // 75: api.ChronexAdminProtoService.UpdateProductQuantity:output_type -> api.UpdateProductQuantityResponse
-	16, // 76: api.ChronexAdminProtoService.UpdateProductStatus:output_type -> api.UpdateProductStatusResponse
-	49, // 77: api.ChronexAdminProtoService.UpdateOrder:output_type -> api.UpdateOrderResponse
-	51, // 78: api.ChronexAdminProtoService.UpdateOrderStatus:output_type -> api.UpdateOrderStatusResponse
-	64, // 79: api.ChronexAdminProtoService.UpdateHomeImages:output_type -> api.UpdateHomeImagesResponse
-	66, // 80: api.ChronexAdminProtoService.DeleteHomeImages:output_type -> api.DeleteHomeImagesResponse
-	53, // 81: api.ChronexAdminProtoService.GetAllOrderRevenue:output_type -> api.GetAllOrderRevenueResponse
-	55, // 82: api.ChronexAdminProtoService.GetAllTotalOrder:output_type -> api.GetAllTotalOrderResponse
-	57, // 83: api.ChronexAdminProtoService.GetBestSellingProducts:output_type -> api.GetBestSellingProductsResponse
-	55, // [55:84] is the sub-list for method output_type
-	26, // [26:55] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+
+// Deprecated: Use GetBestSellingProductsResponse.ProtoReflect.Descriptor instead.
+func (*GetBestSellingProductsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{57}
 }
 
-func init() { file_pkg_pb_chronexdata_proto_init() }
-func file_pkg_pb_chronexdata_proto_init() {
-	if File_pkg_pb_chronexdata_proto != nil {
-		return
+func (x *GetBestSellingProductsResponse) GetBestSellingProducts() string {
+	if x != nil {
+		return x.BestSellingProducts
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_pb_chronexdata_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProductData); i {
+	return ""
+}
+
+type HomeImagesData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HomeImagesId string `protobuf:"bytes,1,opt,name=homeImagesId,proto3" json:"homeImagesId,omitempty"`
+	HomeImg      string `protobuf:"bytes,2,opt,name=homeImg,proto3" json:"homeImg,omitempty"`
+	CreatedBy    string `protobuf:"bytes,3,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt    int64  `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy    string `protobuf:"bytes,5,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt    int64  `protobuf:"varint,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Version      int64  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *HomeImagesData) Reset() {
+	*x = HomeImagesData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HomeImagesData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HomeImagesData) ProtoMessage() {}
+
+func (x *HomeImagesData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HomeImagesData.ProtoReflect.Descriptor instead.
+func (*HomeImagesData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *HomeImagesData) GetHomeImagesId() string {
+	if x != nil {
+		return x.HomeImagesId
+	}
+	return ""
+}
+
+func (x *HomeImagesData) GetHomeImg() string {
+	if x != nil {
+		return x.HomeImg
+	}
+	return ""
+}
+
+func (x *HomeImagesData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *HomeImagesData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *HomeImagesData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *HomeImagesData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *HomeImagesData) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type SaveHomeImagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HomeImg string `protobuf:"bytes,1,opt,name=homeImg,proto3" json:"homeImg,omitempty"`
+}
+
+func (x *SaveHomeImagesRequest) Reset() {
+	*x = SaveHomeImagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveHomeImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveHomeImagesRequest) ProtoMessage() {}
+
+func (x *SaveHomeImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveHomeImagesRequest.ProtoReflect.Descriptor instead.
+func (*SaveHomeImagesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SaveHomeImagesRequest) GetHomeImg() string {
+	if x != nil {
+		return x.HomeImg
+	}
+	return ""
+}
+
+type SaveHomeImagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HomeImagesData *HomeImagesData `protobuf:"bytes,1,opt,name=homeImagesData,proto3" json:"homeImagesData,omitempty"`
+}
+
+func (x *SaveHomeImagesResponse) Reset() {
+	*x = SaveHomeImagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveHomeImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveHomeImagesResponse) ProtoMessage() {}
+
+func (x *SaveHomeImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveHomeImagesResponse.ProtoReflect.Descriptor instead.
+func (*SaveHomeImagesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SaveHomeImagesResponse) GetHomeImagesData() *HomeImagesData {
+	if x != nil {
+		return x.HomeImagesData
+	}
+	return nil
+}
+
+type GetAllHomeImagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAllHomeImagesRequest) Reset() {
+	*x = GetAllHomeImagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllHomeImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllHomeImagesRequest) ProtoMessage() {}
+
+func (x *GetAllHomeImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllHomeImagesRequest.ProtoReflect.Descriptor instead.
+func (*GetAllHomeImagesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{61}
+}
+
+type GetAllHomeImagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HomeImagesData []*HomeImagesData `protobuf:"bytes,1,rep,name=homeImagesData,proto3" json:"homeImagesData,omitempty"`
+}
+
+func (x *GetAllHomeImagesResponse) Reset() {
+	*x = GetAllHomeImagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllHomeImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllHomeImagesResponse) ProtoMessage() {}
+
+func (x *GetAllHomeImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllHomeImagesResponse.ProtoReflect.Descriptor instead.
+func (*GetAllHomeImagesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetAllHomeImagesResponse) GetHomeImagesData() []*HomeImagesData {
+	if x != nil {
+		return x.HomeImagesData
+	}
+	return nil
+}
+
+type UpdateHomeImagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HomeImagesId    string `protobuf:"bytes,1,opt,name=homeImagesId,proto3" json:"homeImagesId,omitempty"`
+	HomeImg         string `protobuf:"bytes,2,opt,name=homeImg,proto3" json:"homeImg,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+}
+
+func (x *UpdateHomeImagesRequest) Reset() {
+	*x = UpdateHomeImagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHomeImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHomeImagesRequest) ProtoMessage() {}
+
+func (x *UpdateHomeImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHomeImagesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHomeImagesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateHomeImagesRequest) GetHomeImagesId() string {
+	if x != nil {
+		return x.HomeImagesId
+	}
+	return ""
+}
+
+func (x *UpdateHomeImagesRequest) GetHomeImg() string {
+	if x != nil {
+		return x.HomeImg
+	}
+	return ""
+}
+
+func (x *UpdateHomeImagesRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateHomeImagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HomeImagesData *HomeImagesData `protobuf:"bytes,1,opt,name=homeImagesData,proto3" json:"homeImagesData,omitempty"`
+}
+
+func (x *UpdateHomeImagesResponse) Reset() {
+	*x = UpdateHomeImagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHomeImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHomeImagesResponse) ProtoMessage() {}
+
+func (x *UpdateHomeImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHomeImagesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHomeImagesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateHomeImagesResponse) GetHomeImagesData() *HomeImagesData {
+	if x != nil {
+		return x.HomeImagesData
+	}
+	return nil
+}
+
+type DeleteHomeImagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HomeImagesId string `protobuf:"bytes,1,opt,name=homeImagesId,proto3" json:"homeImagesId,omitempty"`
+}
+
+func (x *DeleteHomeImagesRequest) Reset() {
+	*x = DeleteHomeImagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteHomeImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHomeImagesRequest) ProtoMessage() {}
+
+func (x *DeleteHomeImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHomeImagesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHomeImagesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeleteHomeImagesRequest) GetHomeImagesId() string {
+	if x != nil {
+		return x.HomeImagesId
+	}
+	return ""
+}
+
+type DeleteHomeImagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HomeImagesData *HomeImagesData `protobuf:"bytes,1,opt,name=homeImagesData,proto3" json:"homeImagesData,omitempty"`
+}
+
+func (x *DeleteHomeImagesResponse) Reset() {
+	*x = DeleteHomeImagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteHomeImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHomeImagesResponse) ProtoMessage() {}
+
+func (x *DeleteHomeImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHomeImagesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHomeImagesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteHomeImagesResponse) GetHomeImagesData() *HomeImagesData {
+	if x != nil {
+		return x.HomeImagesData
+	}
+	return nil
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId string `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductData *ProductData `protobuf:"bytes,1,opt,name=productData,proto3" json:"productData,omitempty"`
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteProductResponse) GetProductData() *ProductData {
+	if x != nil {
+		return x.ProductData
+	}
+	return nil
+}
+
+type DeleteFreebiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreebiesId string `protobuf:"bytes,1,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
+}
+
+func (x *DeleteFreebiesRequest) Reset() {
+	*x = DeleteFreebiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFreebiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFreebiesRequest) ProtoMessage() {}
+
+func (x *DeleteFreebiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFreebiesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFreebiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteFreebiesRequest) GetFreebiesId() string {
+	if x != nil {
+		return x.FreebiesId
+	}
+	return ""
+}
+
+type DeleteFreebiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreebiesData *FreebiesData `protobuf:"bytes,1,opt,name=freebiesData,proto3" json:"freebiesData,omitempty"`
+}
+
+func (x *DeleteFreebiesResponse) Reset() {
+	*x = DeleteFreebiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFreebiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFreebiesResponse) ProtoMessage() {}
+
+func (x *DeleteFreebiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFreebiesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFreebiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DeleteFreebiesResponse) GetFreebiesData() *FreebiesData {
+	if x != nil {
+		return x.FreebiesData
+	}
+	return nil
+}
+
+type DeleteReviewsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewsId string `protobuf:"bytes,1,opt,name=reviewsId,proto3" json:"reviewsId,omitempty"`
+}
+
+func (x *DeleteReviewsRequest) Reset() {
+	*x = DeleteReviewsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewsRequest) ProtoMessage() {}
+
+func (x *DeleteReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DeleteReviewsRequest) GetReviewsId() string {
+	if x != nil {
+		return x.ReviewsId
+	}
+	return ""
+}
+
+type DeleteReviewsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewsData *ReviewsData `protobuf:"bytes,1,opt,name=reviewsData,proto3" json:"reviewsData,omitempty"`
+}
+
+func (x *DeleteReviewsResponse) Reset() {
+	*x = DeleteReviewsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewsResponse) ProtoMessage() {}
+
+func (x *DeleteReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteReviewsResponse) GetReviewsData() *ReviewsData {
+	if x != nil {
+		return x.ReviewsData
+	}
+	return nil
+}
+
+type DeleteOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+}
+
+func (x *DeleteOrderRequest) Reset() {
+	*x = DeleteOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrderRequest) ProtoMessage() {}
+
+func (x *DeleteOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type DeleteOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderData *OrderData `protobuf:"bytes,1,opt,name=orderData,proto3" json:"orderData,omitempty"`
+}
+
+func (x *DeleteOrderResponse) Reset() {
+	*x = DeleteOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrderResponse) ProtoMessage() {}
+
+func (x *DeleteOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrderResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteOrderResponse) GetOrderData() *OrderData {
+	if x != nil {
+		return x.OrderData
+	}
+	return nil
+}
+
+type FreebiesRuleData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreebiesRuleId      string  `protobuf:"bytes,1,opt,name=freebiesRuleId,proto3" json:"freebiesRuleId,omitempty"`
+	RuleName            string  `protobuf:"bytes,2,opt,name=ruleName,proto3" json:"ruleName,omitempty"`
+	MinSpend            float64 `protobuf:"fixed64,3,opt,name=minSpend,proto3" json:"minSpend,omitempty"`
+	MinQuantity         int64   `protobuf:"varint,4,opt,name=minQuantity,proto3" json:"minQuantity,omitempty"`
+	Category            string  `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	ProductId           string  `protobuf:"bytes,6,opt,name=productId,proto3" json:"productId,omitempty"`
+	FreebiesId          string  `protobuf:"bytes,7,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
+	AlternateFreebiesId string  `protobuf:"bytes,8,opt,name=alternateFreebiesId,proto3" json:"alternateFreebiesId,omitempty"`
+	FreebiesQuantity    int64   `protobuf:"varint,9,opt,name=freebiesQuantity,proto3" json:"freebiesQuantity,omitempty"`
+	PerCustomerLimit    int64   `protobuf:"varint,10,opt,name=perCustomerLimit,proto3" json:"perCustomerLimit,omitempty"`
+	Priority            int64   `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	Exclusive           bool    `protobuf:"varint,12,opt,name=exclusive,proto3" json:"exclusive,omitempty"`
+	StartAt             int64   `protobuf:"varint,13,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	EndAt               int64   `protobuf:"varint,14,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	RuleStatus          string  `protobuf:"bytes,15,opt,name=ruleStatus,proto3" json:"ruleStatus,omitempty"`
+	CreatedBy           string  `protobuf:"bytes,16,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt           int64   `protobuf:"varint,17,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy           string  `protobuf:"bytes,18,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt           int64   `protobuf:"varint,19,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *FreebiesRuleData) Reset() {
+	*x = FreebiesRuleData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreebiesRuleData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreebiesRuleData) ProtoMessage() {}
+
+func (x *FreebiesRuleData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreebiesRuleData.ProtoReflect.Descriptor instead.
+func (*FreebiesRuleData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *FreebiesRuleData) GetFreebiesRuleId() string {
+	if x != nil {
+		return x.FreebiesRuleId
+	}
+	return ""
+}
+
+func (x *FreebiesRuleData) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *FreebiesRuleData) GetMinSpend() float64 {
+	if x != nil {
+		return x.MinSpend
+	}
+	return 0
+}
+
+func (x *FreebiesRuleData) GetMinQuantity() int64 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *FreebiesRuleData) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *FreebiesRuleData) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *FreebiesRuleData) GetFreebiesId() string {
+	if x != nil {
+		return x.FreebiesId
+	}
+	return ""
+}
+
+func (x *FreebiesRuleData) GetAlternateFreebiesId() string {
+	if x != nil {
+		return x.AlternateFreebiesId
+	}
+	return ""
+}
+
+func (x *FreebiesRuleData) GetFreebiesQuantity() int64 {
+	if x != nil {
+		return x.FreebiesQuantity
+	}
+	return 0
+}
+
+func (x *FreebiesRuleData) GetPerCustomerLimit() int64 {
+	if x != nil {
+		return x.PerCustomerLimit
+	}
+	return 0
+}
+
+func (x *FreebiesRuleData) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *FreebiesRuleData) GetExclusive() bool {
+	if x != nil {
+		return x.Exclusive
+	}
+	return false
+}
+
+func (x *FreebiesRuleData) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *FreebiesRuleData) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *FreebiesRuleData) GetRuleStatus() string {
+	if x != nil {
+		return x.RuleStatus
+	}
+	return ""
+}
+
+func (x *FreebiesRuleData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *FreebiesRuleData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *FreebiesRuleData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *FreebiesRuleData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SaveFreebiesRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleName            string  `protobuf:"bytes,1,opt,name=ruleName,proto3" json:"ruleName,omitempty"`
+	MinSpend            float64 `protobuf:"fixed64,2,opt,name=minSpend,proto3" json:"minSpend,omitempty"`
+	MinQuantity         int64   `protobuf:"varint,3,opt,name=minQuantity,proto3" json:"minQuantity,omitempty"`
+	Category            string  `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	ProductId           string  `protobuf:"bytes,5,opt,name=productId,proto3" json:"productId,omitempty"`
+	FreebiesId          string  `protobuf:"bytes,6,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
+	AlternateFreebiesId string  `protobuf:"bytes,7,opt,name=alternateFreebiesId,proto3" json:"alternateFreebiesId,omitempty"`
+	FreebiesQuantity    int64   `protobuf:"varint,8,opt,name=freebiesQuantity,proto3" json:"freebiesQuantity,omitempty"`
+	PerCustomerLimit    int64   `protobuf:"varint,9,opt,name=perCustomerLimit,proto3" json:"perCustomerLimit,omitempty"`
+	Priority            int64   `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	Exclusive           bool    `protobuf:"varint,11,opt,name=exclusive,proto3" json:"exclusive,omitempty"`
+	StartAt             int64   `protobuf:"varint,12,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	EndAt               int64   `protobuf:"varint,13,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	RuleStatus          string  `protobuf:"bytes,14,opt,name=ruleStatus,proto3" json:"ruleStatus,omitempty"`
+}
+
+func (x *SaveFreebiesRuleRequest) Reset() {
+	*x = SaveFreebiesRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveFreebiesRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveFreebiesRuleRequest) ProtoMessage() {}
+
+func (x *SaveFreebiesRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveFreebiesRuleRequest.ProtoReflect.Descriptor instead.
+func (*SaveFreebiesRuleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SaveFreebiesRuleRequest) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *SaveFreebiesRuleRequest) GetMinSpend() float64 {
+	if x != nil {
+		return x.MinSpend
+	}
+	return 0
+}
+
+func (x *SaveFreebiesRuleRequest) GetMinQuantity() int64 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *SaveFreebiesRuleRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SaveFreebiesRuleRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SaveFreebiesRuleRequest) GetFreebiesId() string {
+	if x != nil {
+		return x.FreebiesId
+	}
+	return ""
+}
+
+func (x *SaveFreebiesRuleRequest) GetAlternateFreebiesId() string {
+	if x != nil {
+		return x.AlternateFreebiesId
+	}
+	return ""
+}
+
+func (x *SaveFreebiesRuleRequest) GetFreebiesQuantity() int64 {
+	if x != nil {
+		return x.FreebiesQuantity
+	}
+	return 0
+}
+
+func (x *SaveFreebiesRuleRequest) GetPerCustomerLimit() int64 {
+	if x != nil {
+		return x.PerCustomerLimit
+	}
+	return 0
+}
+
+func (x *SaveFreebiesRuleRequest) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *SaveFreebiesRuleRequest) GetExclusive() bool {
+	if x != nil {
+		return x.Exclusive
+	}
+	return false
+}
+
+func (x *SaveFreebiesRuleRequest) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *SaveFreebiesRuleRequest) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *SaveFreebiesRuleRequest) GetRuleStatus() string {
+	if x != nil {
+		return x.RuleStatus
+	}
+	return ""
+}
+
+type SaveFreebiesRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreebiesRuleData *FreebiesRuleData `protobuf:"bytes,1,opt,name=freebiesRuleData,proto3" json:"freebiesRuleData,omitempty"`
+}
+
+func (x *SaveFreebiesRuleResponse) Reset() {
+	*x = SaveFreebiesRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveFreebiesRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveFreebiesRuleResponse) ProtoMessage() {}
+
+func (x *SaveFreebiesRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveFreebiesRuleResponse.ProtoReflect.Descriptor instead.
+func (*SaveFreebiesRuleResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SaveFreebiesRuleResponse) GetFreebiesRuleData() *FreebiesRuleData {
+	if x != nil {
+		return x.FreebiesRuleData
+	}
+	return nil
+}
+
+type GetAllFreebiesRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleStatus string `protobuf:"bytes,1,opt,name=ruleStatus,proto3" json:"ruleStatus,omitempty"`
+}
+
+func (x *GetAllFreebiesRuleRequest) Reset() {
+	*x = GetAllFreebiesRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllFreebiesRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllFreebiesRuleRequest) ProtoMessage() {}
+
+func (x *GetAllFreebiesRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllFreebiesRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetAllFreebiesRuleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetAllFreebiesRuleRequest) GetRuleStatus() string {
+	if x != nil {
+		return x.RuleStatus
+	}
+	return ""
+}
+
+type GetAllFreebiesRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreebiesRuleData []*FreebiesRuleData `protobuf:"bytes,1,rep,name=freebiesRuleData,proto3" json:"freebiesRuleData,omitempty"`
+}
+
+func (x *GetAllFreebiesRuleResponse) Reset() {
+	*x = GetAllFreebiesRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllFreebiesRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllFreebiesRuleResponse) ProtoMessage() {}
+
+func (x *GetAllFreebiesRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllFreebiesRuleResponse.ProtoReflect.Descriptor instead.
+func (*GetAllFreebiesRuleResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetAllFreebiesRuleResponse) GetFreebiesRuleData() []*FreebiesRuleData {
+	if x != nil {
+		return x.FreebiesRuleData
+	}
+	return nil
+}
+
+type UpdateFreebiesRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreebiesRuleId      string   `protobuf:"bytes,1,opt,name=freebiesRuleId,proto3" json:"freebiesRuleId,omitempty"`
+	RuleName            string   `protobuf:"bytes,2,opt,name=ruleName,proto3" json:"ruleName,omitempty"`
+	MinSpend            float64  `protobuf:"fixed64,3,opt,name=minSpend,proto3" json:"minSpend,omitempty"`
+	MinQuantity         int64    `protobuf:"varint,4,opt,name=minQuantity,proto3" json:"minQuantity,omitempty"`
+	Category            string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	ProductId           string   `protobuf:"bytes,6,opt,name=productId,proto3" json:"productId,omitempty"`
+	FreebiesId          string   `protobuf:"bytes,7,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
+	AlternateFreebiesId string   `protobuf:"bytes,8,opt,name=alternateFreebiesId,proto3" json:"alternateFreebiesId,omitempty"`
+	FreebiesQuantity    int64    `protobuf:"varint,9,opt,name=freebiesQuantity,proto3" json:"freebiesQuantity,omitempty"`
+	PerCustomerLimit    int64    `protobuf:"varint,10,opt,name=perCustomerLimit,proto3" json:"perCustomerLimit,omitempty"`
+	Priority            int64    `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	Exclusive           bool     `protobuf:"varint,12,opt,name=exclusive,proto3" json:"exclusive,omitempty"`
+	StartAt             int64    `protobuf:"varint,13,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	EndAt               int64    `protobuf:"varint,14,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	UpdateMask          []string `protobuf:"bytes,15,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+}
+
+func (x *UpdateFreebiesRuleRequest) Reset() {
+	*x = UpdateFreebiesRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFreebiesRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFreebiesRuleRequest) ProtoMessage() {}
+
+func (x *UpdateFreebiesRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFreebiesRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFreebiesRuleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UpdateFreebiesRuleRequest) GetFreebiesRuleId() string {
+	if x != nil {
+		return x.FreebiesRuleId
+	}
+	return ""
+}
+
+func (x *UpdateFreebiesRuleRequest) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *UpdateFreebiesRuleRequest) GetMinSpend() float64 {
+	if x != nil {
+		return x.MinSpend
+	}
+	return 0
+}
+
+func (x *UpdateFreebiesRuleRequest) GetMinQuantity() int64 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *UpdateFreebiesRuleRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateFreebiesRuleRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateFreebiesRuleRequest) GetFreebiesId() string {
+	if x != nil {
+		return x.FreebiesId
+	}
+	return ""
+}
+
+func (x *UpdateFreebiesRuleRequest) GetAlternateFreebiesId() string {
+	if x != nil {
+		return x.AlternateFreebiesId
+	}
+	return ""
+}
+
+func (x *UpdateFreebiesRuleRequest) GetFreebiesQuantity() int64 {
+	if x != nil {
+		return x.FreebiesQuantity
+	}
+	return 0
+}
+
+func (x *UpdateFreebiesRuleRequest) GetPerCustomerLimit() int64 {
+	if x != nil {
+		return x.PerCustomerLimit
+	}
+	return 0
+}
+
+func (x *UpdateFreebiesRuleRequest) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *UpdateFreebiesRuleRequest) GetExclusive() bool {
+	if x != nil {
+		return x.Exclusive
+	}
+	return false
+}
+
+func (x *UpdateFreebiesRuleRequest) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *UpdateFreebiesRuleRequest) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *UpdateFreebiesRuleRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateFreebiesRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreebiesRuleData *FreebiesRuleData `protobuf:"bytes,1,opt,name=freebiesRuleData,proto3" json:"freebiesRuleData,omitempty"`
+}
+
+func (x *UpdateFreebiesRuleResponse) Reset() {
+	*x = UpdateFreebiesRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFreebiesRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFreebiesRuleResponse) ProtoMessage() {}
+
+func (x *UpdateFreebiesRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFreebiesRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFreebiesRuleResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UpdateFreebiesRuleResponse) GetFreebiesRuleData() *FreebiesRuleData {
+	if x != nil {
+		return x.FreebiesRuleData
+	}
+	return nil
+}
+
+type UpdateFreebiesRuleStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreebiesRuleId string `protobuf:"bytes,1,opt,name=freebiesRuleId,proto3" json:"freebiesRuleId,omitempty"`
+	RuleStatus     string `protobuf:"bytes,2,opt,name=ruleStatus,proto3" json:"ruleStatus,omitempty"`
+}
+
+func (x *UpdateFreebiesRuleStatusRequest) Reset() {
+	*x = UpdateFreebiesRuleStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFreebiesRuleStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFreebiesRuleStatusRequest) ProtoMessage() {}
+
+func (x *UpdateFreebiesRuleStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFreebiesRuleStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFreebiesRuleStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateFreebiesRuleStatusRequest) GetFreebiesRuleId() string {
+	if x != nil {
+		return x.FreebiesRuleId
+	}
+	return ""
+}
+
+func (x *UpdateFreebiesRuleStatusRequest) GetRuleStatus() string {
+	if x != nil {
+		return x.RuleStatus
+	}
+	return ""
+}
+
+type UpdateFreebiesRuleStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreebiesRuleData *FreebiesRuleData `protobuf:"bytes,1,opt,name=freebiesRuleData,proto3" json:"freebiesRuleData,omitempty"`
+}
+
+func (x *UpdateFreebiesRuleStatusResponse) Reset() {
+	*x = UpdateFreebiesRuleStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFreebiesRuleStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFreebiesRuleStatusResponse) ProtoMessage() {}
+
+func (x *UpdateFreebiesRuleStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFreebiesRuleStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFreebiesRuleStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateFreebiesRuleStatusResponse) GetFreebiesRuleData() *FreebiesRuleData {
+	if x != nil {
+		return x.FreebiesRuleData
+	}
+	return nil
+}
+
+type CartFreebies struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreebiesRuleId string `protobuf:"bytes,1,opt,name=freebiesRuleId,proto3" json:"freebiesRuleId,omitempty"`
+	RuleName       string `protobuf:"bytes,2,opt,name=ruleName,proto3" json:"ruleName,omitempty"`
+	FreebiesId     string `protobuf:"bytes,3,opt,name=freebiesId,proto3" json:"freebiesId,omitempty"`
+	FreebiesName   string `protobuf:"bytes,4,opt,name=freebiesName,proto3" json:"freebiesName,omitempty"`
+	Quantity       int64  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Alternate      bool   `protobuf:"varint,6,opt,name=alternate,proto3" json:"alternate,omitempty"`
+}
+
+func (x *CartFreebies) Reset() {
+	*x = CartFreebies{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CartFreebies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartFreebies) ProtoMessage() {}
+
+func (x *CartFreebies) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartFreebies.ProtoReflect.Descriptor instead.
+func (*CartFreebies) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CartFreebies) GetFreebiesRuleId() string {
+	if x != nil {
+		return x.FreebiesRuleId
+	}
+	return ""
+}
+
+func (x *CartFreebies) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *CartFreebies) GetFreebiesId() string {
+	if x != nil {
+		return x.FreebiesId
+	}
+	return ""
+}
+
+func (x *CartFreebies) GetFreebiesName() string {
+	if x != nil {
+		return x.FreebiesName
+	}
+	return ""
+}
+
+func (x *CartFreebies) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CartFreebies) GetAlternate() bool {
+	if x != nil {
+		return x.Alternate
+	}
+	return false
+}
+
+type PreviewCartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Customer        string `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Product         string `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	VoucherCode     string `protobuf:"bytes,3,opt,name=voucherCode,proto3" json:"voucherCode,omitempty"`
+	CompleteAddress string `protobuf:"bytes,4,opt,name=completeAddress,proto3" json:"completeAddress,omitempty"`
+}
+
+func (x *PreviewCartRequest) Reset() {
+	*x = PreviewCartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCartRequest) ProtoMessage() {}
+
+func (x *PreviewCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCartRequest.ProtoReflect.Descriptor instead.
+func (*PreviewCartRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PreviewCartRequest) GetCustomer() string {
+	if x != nil {
+		return x.Customer
+	}
+	return ""
+}
+
+func (x *PreviewCartRequest) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *PreviewCartRequest) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+func (x *PreviewCartRequest) GetCompleteAddress() string {
+	if x != nil {
+		return x.CompleteAddress
+	}
+	return ""
+}
+
+type PreviewCartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subtotal        float64         `protobuf:"fixed64,1,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	CartFreebies    []*CartFreebies `protobuf:"bytes,2,rep,name=cartFreebies,proto3" json:"cartFreebies,omitempty"`
+	VoucherDiscount float64         `protobuf:"fixed64,3,opt,name=voucherDiscount,proto3" json:"voucherDiscount,omitempty"`
+	Total           float64         `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
+	ShippingFee     float64         `protobuf:"fixed64,5,opt,name=shippingFee,proto3" json:"shippingFee,omitempty"`
+}
+
+func (x *PreviewCartResponse) Reset() {
+	*x = PreviewCartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCartResponse) ProtoMessage() {}
+
+func (x *PreviewCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCartResponse.ProtoReflect.Descriptor instead.
+func (*PreviewCartResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PreviewCartResponse) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *PreviewCartResponse) GetCartFreebies() []*CartFreebies {
+	if x != nil {
+		return x.CartFreebies
+	}
+	return nil
+}
+
+func (x *PreviewCartResponse) GetVoucherDiscount() float64 {
+	if x != nil {
+		return x.VoucherDiscount
+	}
+	return 0
+}
+
+func (x *PreviewCartResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PreviewCartResponse) GetShippingFee() float64 {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return 0
+}
+
+type VoucherData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoucherId        string  `protobuf:"bytes,1,opt,name=voucherId,proto3" json:"voucherId,omitempty"`
+	VoucherCode      string  `protobuf:"bytes,2,opt,name=voucherCode,proto3" json:"voucherCode,omitempty"`
+	DiscountType     string  `protobuf:"bytes,3,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	DiscountValue    float64 `protobuf:"fixed64,4,opt,name=discountValue,proto3" json:"discountValue,omitempty"`
+	MaxDiscount      float64 `protobuf:"fixed64,5,opt,name=maxDiscount,proto3" json:"maxDiscount,omitempty"`
+	MinSpend         float64 `protobuf:"fixed64,6,opt,name=minSpend,proto3" json:"minSpend,omitempty"`
+	Category         string  `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	ProductId        string  `protobuf:"bytes,8,opt,name=productId,proto3" json:"productId,omitempty"`
+	UsageLimit       int64   `protobuf:"varint,9,opt,name=usageLimit,proto3" json:"usageLimit,omitempty"`
+	PerCustomerLimit int64   `protobuf:"varint,10,opt,name=perCustomerLimit,proto3" json:"perCustomerLimit,omitempty"`
+	UsageCount       int64   `protobuf:"varint,11,opt,name=usageCount,proto3" json:"usageCount,omitempty"`
+	StartAt          int64   `protobuf:"varint,12,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	EndAt            int64   `protobuf:"varint,13,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	VoucherStatus    string  `protobuf:"bytes,14,opt,name=voucherStatus,proto3" json:"voucherStatus,omitempty"`
+	CreatedBy        string  `protobuf:"bytes,15,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt        int64   `protobuf:"varint,16,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy        string  `protobuf:"bytes,17,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt        int64   `protobuf:"varint,18,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *VoucherData) Reset() {
+	*x = VoucherData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoucherData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoucherData) ProtoMessage() {}
+
+func (x *VoucherData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoucherData.ProtoReflect.Descriptor instead.
+func (*VoucherData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *VoucherData) GetVoucherId() string {
+	if x != nil {
+		return x.VoucherId
+	}
+	return ""
+}
+
+func (x *VoucherData) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+func (x *VoucherData) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *VoucherData) GetDiscountValue() float64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *VoucherData) GetMaxDiscount() float64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *VoucherData) GetMinSpend() float64 {
+	if x != nil {
+		return x.MinSpend
+	}
+	return 0
+}
+
+func (x *VoucherData) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *VoucherData) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *VoucherData) GetUsageLimit() int64 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *VoucherData) GetPerCustomerLimit() int64 {
+	if x != nil {
+		return x.PerCustomerLimit
+	}
+	return 0
+}
+
+func (x *VoucherData) GetUsageCount() int64 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+func (x *VoucherData) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *VoucherData) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *VoucherData) GetVoucherStatus() string {
+	if x != nil {
+		return x.VoucherStatus
+	}
+	return ""
+}
+
+func (x *VoucherData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *VoucherData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *VoucherData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *VoucherData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SaveVoucherRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoucherCode      string  `protobuf:"bytes,1,opt,name=voucherCode,proto3" json:"voucherCode,omitempty"`
+	DiscountType     string  `protobuf:"bytes,2,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	DiscountValue    float64 `protobuf:"fixed64,3,opt,name=discountValue,proto3" json:"discountValue,omitempty"`
+	MaxDiscount      float64 `protobuf:"fixed64,4,opt,name=maxDiscount,proto3" json:"maxDiscount,omitempty"`
+	MinSpend         float64 `protobuf:"fixed64,5,opt,name=minSpend,proto3" json:"minSpend,omitempty"`
+	Category         string  `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	ProductId        string  `protobuf:"bytes,7,opt,name=productId,proto3" json:"productId,omitempty"`
+	UsageLimit       int64   `protobuf:"varint,8,opt,name=usageLimit,proto3" json:"usageLimit,omitempty"`
+	PerCustomerLimit int64   `protobuf:"varint,9,opt,name=perCustomerLimit,proto3" json:"perCustomerLimit,omitempty"`
+	StartAt          int64   `protobuf:"varint,10,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	EndAt            int64   `protobuf:"varint,11,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	VoucherStatus    string  `protobuf:"bytes,12,opt,name=voucherStatus,proto3" json:"voucherStatus,omitempty"`
+}
+
+func (x *SaveVoucherRequest) Reset() {
+	*x = SaveVoucherRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveVoucherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveVoucherRequest) ProtoMessage() {}
+
+func (x *SaveVoucherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveVoucherRequest.ProtoReflect.Descriptor instead.
+func (*SaveVoucherRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SaveVoucherRequest) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+func (x *SaveVoucherRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *SaveVoucherRequest) GetDiscountValue() float64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *SaveVoucherRequest) GetMaxDiscount() float64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *SaveVoucherRequest) GetMinSpend() float64 {
+	if x != nil {
+		return x.MinSpend
+	}
+	return 0
+}
+
+func (x *SaveVoucherRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SaveVoucherRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SaveVoucherRequest) GetUsageLimit() int64 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *SaveVoucherRequest) GetPerCustomerLimit() int64 {
+	if x != nil {
+		return x.PerCustomerLimit
+	}
+	return 0
+}
+
+func (x *SaveVoucherRequest) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *SaveVoucherRequest) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *SaveVoucherRequest) GetVoucherStatus() string {
+	if x != nil {
+		return x.VoucherStatus
+	}
+	return ""
+}
+
+type SaveVoucherResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoucherData *VoucherData `protobuf:"bytes,1,opt,name=voucherData,proto3" json:"voucherData,omitempty"`
+}
+
+func (x *SaveVoucherResponse) Reset() {
+	*x = SaveVoucherResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveVoucherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveVoucherResponse) ProtoMessage() {}
+
+func (x *SaveVoucherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveVoucherResponse.ProtoReflect.Descriptor instead.
+func (*SaveVoucherResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SaveVoucherResponse) GetVoucherData() *VoucherData {
+	if x != nil {
+		return x.VoucherData
+	}
+	return nil
+}
+
+type GetAllVoucherRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Search        string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	VoucherStatus string `protobuf:"bytes,2,opt,name=voucherStatus,proto3" json:"voucherStatus,omitempty"`
+}
+
+func (x *GetAllVoucherRequest) Reset() {
+	*x = GetAllVoucherRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllVoucherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllVoucherRequest) ProtoMessage() {}
+
+func (x *GetAllVoucherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllVoucherRequest.ProtoReflect.Descriptor instead.
+func (*GetAllVoucherRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetAllVoucherRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetAllVoucherRequest) GetVoucherStatus() string {
+	if x != nil {
+		return x.VoucherStatus
+	}
+	return ""
+}
+
+type GetAllVoucherResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoucherData []*VoucherData `protobuf:"bytes,1,rep,name=voucherData,proto3" json:"voucherData,omitempty"`
+}
+
+func (x *GetAllVoucherResponse) Reset() {
+	*x = GetAllVoucherResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllVoucherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllVoucherResponse) ProtoMessage() {}
+
+func (x *GetAllVoucherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllVoucherResponse.ProtoReflect.Descriptor instead.
+func (*GetAllVoucherResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetAllVoucherResponse) GetVoucherData() []*VoucherData {
+	if x != nil {
+		return x.VoucherData
+	}
+	return nil
+}
+
+type UpdateVoucherRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoucherId        string   `protobuf:"bytes,1,opt,name=voucherId,proto3" json:"voucherId,omitempty"`
+	DiscountType     string   `protobuf:"bytes,2,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	DiscountValue    float64  `protobuf:"fixed64,3,opt,name=discountValue,proto3" json:"discountValue,omitempty"`
+	MaxDiscount      float64  `protobuf:"fixed64,4,opt,name=maxDiscount,proto3" json:"maxDiscount,omitempty"`
+	MinSpend         float64  `protobuf:"fixed64,5,opt,name=minSpend,proto3" json:"minSpend,omitempty"`
+	Category         string   `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	ProductId        string   `protobuf:"bytes,7,opt,name=productId,proto3" json:"productId,omitempty"`
+	UsageLimit       int64    `protobuf:"varint,8,opt,name=usageLimit,proto3" json:"usageLimit,omitempty"`
+	PerCustomerLimit int64    `protobuf:"varint,9,opt,name=perCustomerLimit,proto3" json:"perCustomerLimit,omitempty"`
+	StartAt          int64    `protobuf:"varint,10,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	EndAt            int64    `protobuf:"varint,11,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	UpdateMask       []string `protobuf:"bytes,12,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+}
+
+func (x *UpdateVoucherRequest) Reset() {
+	*x = UpdateVoucherRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVoucherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVoucherRequest) ProtoMessage() {}
+
+func (x *UpdateVoucherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVoucherRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVoucherRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UpdateVoucherRequest) GetVoucherId() string {
+	if x != nil {
+		return x.VoucherId
+	}
+	return ""
+}
+
+func (x *UpdateVoucherRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *UpdateVoucherRequest) GetDiscountValue() float64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *UpdateVoucherRequest) GetMaxDiscount() float64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *UpdateVoucherRequest) GetMinSpend() float64 {
+	if x != nil {
+		return x.MinSpend
+	}
+	return 0
+}
+
+func (x *UpdateVoucherRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateVoucherRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateVoucherRequest) GetUsageLimit() int64 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *UpdateVoucherRequest) GetPerCustomerLimit() int64 {
+	if x != nil {
+		return x.PerCustomerLimit
+	}
+	return 0
+}
+
+func (x *UpdateVoucherRequest) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *UpdateVoucherRequest) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *UpdateVoucherRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateVoucherResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoucherData *VoucherData `protobuf:"bytes,1,opt,name=voucherData,proto3" json:"voucherData,omitempty"`
+}
+
+func (x *UpdateVoucherResponse) Reset() {
+	*x = UpdateVoucherResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVoucherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVoucherResponse) ProtoMessage() {}
+
+func (x *UpdateVoucherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVoucherResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVoucherResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UpdateVoucherResponse) GetVoucherData() *VoucherData {
+	if x != nil {
+		return x.VoucherData
+	}
+	return nil
+}
+
+type UpdateVoucherStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoucherId     string `protobuf:"bytes,1,opt,name=voucherId,proto3" json:"voucherId,omitempty"`
+	VoucherStatus string `protobuf:"bytes,2,opt,name=voucherStatus,proto3" json:"voucherStatus,omitempty"`
+}
+
+func (x *UpdateVoucherStatusRequest) Reset() {
+	*x = UpdateVoucherStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVoucherStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVoucherStatusRequest) ProtoMessage() {}
+
+func (x *UpdateVoucherStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVoucherStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVoucherStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *UpdateVoucherStatusRequest) GetVoucherId() string {
+	if x != nil {
+		return x.VoucherId
+	}
+	return ""
+}
+
+func (x *UpdateVoucherStatusRequest) GetVoucherStatus() string {
+	if x != nil {
+		return x.VoucherStatus
+	}
+	return ""
+}
+
+type UpdateVoucherStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoucherData *VoucherData `protobuf:"bytes,1,opt,name=voucherData,proto3" json:"voucherData,omitempty"`
+}
+
+func (x *UpdateVoucherStatusResponse) Reset() {
+	*x = UpdateVoucherStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVoucherStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVoucherStatusResponse) ProtoMessage() {}
+
+func (x *UpdateVoucherStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVoucherStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVoucherStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UpdateVoucherStatusResponse) GetVoucherData() *VoucherData {
+	if x != nil {
+		return x.VoucherData
+	}
+	return nil
+}
+
+type PriceRuleData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceRuleId string  `protobuf:"bytes,1,opt,name=priceRuleId,proto3" json:"priceRuleId,omitempty"`
+	RuleName    string  `protobuf:"bytes,2,opt,name=ruleName,proto3" json:"ruleName,omitempty"`
+	Category    string  `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	ProductId   string  `protobuf:"bytes,4,opt,name=productId,proto3" json:"productId,omitempty"`
+	SalePrice   float64 `protobuf:"fixed64,5,opt,name=salePrice,proto3" json:"salePrice,omitempty"`
+	PercentOff  float64 `protobuf:"fixed64,6,opt,name=percentOff,proto3" json:"percentOff,omitempty"`
+	StartAt     int64   `protobuf:"varint,7,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	EndAt       int64   `protobuf:"varint,8,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	StartedAt   int64   `protobuf:"varint,9,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	EndedAt     int64   `protobuf:"varint,10,opt,name=endedAt,proto3" json:"endedAt,omitempty"`
+	RuleStatus  string  `protobuf:"bytes,11,opt,name=ruleStatus,proto3" json:"ruleStatus,omitempty"`
+	CreatedBy   string  `protobuf:"bytes,12,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt   int64   `protobuf:"varint,13,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy   string  `protobuf:"bytes,14,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt   int64   `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *PriceRuleData) Reset() {
+	*x = PriceRuleData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceRuleData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceRuleData) ProtoMessage() {}
+
+func (x *PriceRuleData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceRuleData.ProtoReflect.Descriptor instead.
+func (*PriceRuleData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PriceRuleData) GetPriceRuleId() string {
+	if x != nil {
+		return x.PriceRuleId
+	}
+	return ""
+}
+
+func (x *PriceRuleData) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *PriceRuleData) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PriceRuleData) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PriceRuleData) GetSalePrice() float64 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *PriceRuleData) GetPercentOff() float64 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *PriceRuleData) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *PriceRuleData) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *PriceRuleData) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *PriceRuleData) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+func (x *PriceRuleData) GetRuleStatus() string {
+	if x != nil {
+		return x.RuleStatus
+	}
+	return ""
+}
+
+func (x *PriceRuleData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PriceRuleData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PriceRuleData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *PriceRuleData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SavePriceRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleName   string  `protobuf:"bytes,1,opt,name=ruleName,proto3" json:"ruleName,omitempty"`
+	Category   string  `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	ProductId  string  `protobuf:"bytes,3,opt,name=productId,proto3" json:"productId,omitempty"`
+	SalePrice  float64 `protobuf:"fixed64,4,opt,name=salePrice,proto3" json:"salePrice,omitempty"`
+	PercentOff float64 `protobuf:"fixed64,5,opt,name=percentOff,proto3" json:"percentOff,omitempty"`
+	StartAt    int64   `protobuf:"varint,6,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	EndAt      int64   `protobuf:"varint,7,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	RuleStatus string  `protobuf:"bytes,8,opt,name=ruleStatus,proto3" json:"ruleStatus,omitempty"`
+}
+
+func (x *SavePriceRuleRequest) Reset() {
+	*x = SavePriceRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavePriceRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePriceRuleRequest) ProtoMessage() {}
+
+func (x *SavePriceRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePriceRuleRequest.ProtoReflect.Descriptor instead.
+func (*SavePriceRuleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SavePriceRuleRequest) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *SavePriceRuleRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SavePriceRuleRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SavePriceRuleRequest) GetSalePrice() float64 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *SavePriceRuleRequest) GetPercentOff() float64 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *SavePriceRuleRequest) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *SavePriceRuleRequest) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *SavePriceRuleRequest) GetRuleStatus() string {
+	if x != nil {
+		return x.RuleStatus
+	}
+	return ""
+}
+
+type SavePriceRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceRuleData *PriceRuleData `protobuf:"bytes,1,opt,name=priceRuleData,proto3" json:"priceRuleData,omitempty"`
+}
+
+func (x *SavePriceRuleResponse) Reset() {
+	*x = SavePriceRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavePriceRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePriceRuleResponse) ProtoMessage() {}
+
+func (x *SavePriceRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePriceRuleResponse.ProtoReflect.Descriptor instead.
+func (*SavePriceRuleResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SavePriceRuleResponse) GetPriceRuleData() *PriceRuleData {
+	if x != nil {
+		return x.PriceRuleData
+	}
+	return nil
+}
+
+type GetAllPriceRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleStatus string `protobuf:"bytes,1,opt,name=ruleStatus,proto3" json:"ruleStatus,omitempty"`
+}
+
+func (x *GetAllPriceRuleRequest) Reset() {
+	*x = GetAllPriceRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllPriceRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPriceRuleRequest) ProtoMessage() {}
+
+func (x *GetAllPriceRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPriceRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetAllPriceRuleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetAllPriceRuleRequest) GetRuleStatus() string {
+	if x != nil {
+		return x.RuleStatus
+	}
+	return ""
+}
+
+type GetAllPriceRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceRuleData []*PriceRuleData `protobuf:"bytes,1,rep,name=priceRuleData,proto3" json:"priceRuleData,omitempty"`
+}
+
+func (x *GetAllPriceRuleResponse) Reset() {
+	*x = GetAllPriceRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllPriceRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPriceRuleResponse) ProtoMessage() {}
+
+func (x *GetAllPriceRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPriceRuleResponse.ProtoReflect.Descriptor instead.
+func (*GetAllPriceRuleResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetAllPriceRuleResponse) GetPriceRuleData() []*PriceRuleData {
+	if x != nil {
+		return x.PriceRuleData
+	}
+	return nil
+}
+
+type UpdatePriceRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceRuleId string   `protobuf:"bytes,1,opt,name=priceRuleId,proto3" json:"priceRuleId,omitempty"`
+	RuleName    string   `protobuf:"bytes,2,opt,name=ruleName,proto3" json:"ruleName,omitempty"`
+	Category    string   `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	ProductId   string   `protobuf:"bytes,4,opt,name=productId,proto3" json:"productId,omitempty"`
+	SalePrice   float64  `protobuf:"fixed64,5,opt,name=salePrice,proto3" json:"salePrice,omitempty"`
+	PercentOff  float64  `protobuf:"fixed64,6,opt,name=percentOff,proto3" json:"percentOff,omitempty"`
+	StartAt     int64    `protobuf:"varint,7,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	EndAt       int64    `protobuf:"varint,8,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	UpdateMask  []string `protobuf:"bytes,9,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+}
+
+func (x *UpdatePriceRuleRequest) Reset() {
+	*x = UpdatePriceRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePriceRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceRuleRequest) ProtoMessage() {}
+
+func (x *UpdatePriceRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePriceRuleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *UpdatePriceRuleRequest) GetPriceRuleId() string {
+	if x != nil {
+		return x.PriceRuleId
+	}
+	return ""
+}
+
+func (x *UpdatePriceRuleRequest) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *UpdatePriceRuleRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdatePriceRuleRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdatePriceRuleRequest) GetSalePrice() float64 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *UpdatePriceRuleRequest) GetPercentOff() float64 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *UpdatePriceRuleRequest) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *UpdatePriceRuleRequest) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *UpdatePriceRuleRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdatePriceRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceRuleData *PriceRuleData `protobuf:"bytes,1,opt,name=priceRuleData,proto3" json:"priceRuleData,omitempty"`
+}
+
+func (x *UpdatePriceRuleResponse) Reset() {
+	*x = UpdatePriceRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePriceRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceRuleResponse) ProtoMessage() {}
+
+func (x *UpdatePriceRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePriceRuleResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *UpdatePriceRuleResponse) GetPriceRuleData() *PriceRuleData {
+	if x != nil {
+		return x.PriceRuleData
+	}
+	return nil
+}
+
+type UpdatePriceRuleStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceRuleId string `protobuf:"bytes,1,opt,name=priceRuleId,proto3" json:"priceRuleId,omitempty"`
+	RuleStatus  string `protobuf:"bytes,2,opt,name=ruleStatus,proto3" json:"ruleStatus,omitempty"`
+}
+
+func (x *UpdatePriceRuleStatusRequest) Reset() {
+	*x = UpdatePriceRuleStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePriceRuleStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceRuleStatusRequest) ProtoMessage() {}
+
+func (x *UpdatePriceRuleStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceRuleStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePriceRuleStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpdatePriceRuleStatusRequest) GetPriceRuleId() string {
+	if x != nil {
+		return x.PriceRuleId
+	}
+	return ""
+}
+
+func (x *UpdatePriceRuleStatusRequest) GetRuleStatus() string {
+	if x != nil {
+		return x.RuleStatus
+	}
+	return ""
+}
+
+type UpdatePriceRuleStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceRuleData *PriceRuleData `protobuf:"bytes,1,opt,name=priceRuleData,proto3" json:"priceRuleData,omitempty"`
+}
+
+func (x *UpdatePriceRuleStatusResponse) Reset() {
+	*x = UpdatePriceRuleStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePriceRuleStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceRuleStatusResponse) ProtoMessage() {}
+
+func (x *UpdatePriceRuleStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceRuleStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePriceRuleStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UpdatePriceRuleStatusResponse) GetPriceRuleData() *PriceRuleData {
+	if x != nil {
+		return x.PriceRuleData
+	}
+	return nil
+}
+
+type DeletePriceRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceRuleId string `protobuf:"bytes,1,opt,name=priceRuleId,proto3" json:"priceRuleId,omitempty"`
+}
+
+func (x *DeletePriceRuleRequest) Reset() {
+	*x = DeletePriceRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePriceRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceRuleRequest) ProtoMessage() {}
+
+func (x *DeletePriceRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeletePriceRuleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DeletePriceRuleRequest) GetPriceRuleId() string {
+	if x != nil {
+		return x.PriceRuleId
+	}
+	return ""
+}
+
+type DeletePriceRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceRuleData *PriceRuleData `protobuf:"bytes,1,opt,name=priceRuleData,proto3" json:"priceRuleData,omitempty"`
+}
+
+func (x *DeletePriceRuleResponse) Reset() {
+	*x = DeletePriceRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePriceRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceRuleResponse) ProtoMessage() {}
+
+func (x *DeletePriceRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeletePriceRuleResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DeletePriceRuleResponse) GetPriceRuleData() *PriceRuleData {
+	if x != nil {
+		return x.PriceRuleData
+	}
+	return nil
+}
+
+type ProductPriceHistoryData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceHistoryId  string  `protobuf:"bytes,1,opt,name=priceHistoryId,proto3" json:"priceHistoryId,omitempty"`
+	ProductId       string  `protobuf:"bytes,2,opt,name=productId,proto3" json:"productId,omitempty"`
+	OriginalPrice   float64 `protobuf:"fixed64,3,opt,name=originalPrice,proto3" json:"originalPrice,omitempty"`
+	DiscountedPrice float64 `protobuf:"fixed64,4,opt,name=discountedPrice,proto3" json:"discountedPrice,omitempty"`
+	EffectivePrice  float64 `protobuf:"fixed64,5,opt,name=effectivePrice,proto3" json:"effectivePrice,omitempty"`
+	PriceRuleId     string  `protobuf:"bytes,6,opt,name=priceRuleId,proto3" json:"priceRuleId,omitempty"`
+	Source          string  `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	EffectiveFrom   int64   `protobuf:"varint,8,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"`
+}
+
+func (x *ProductPriceHistoryData) Reset() {
+	*x = ProductPriceHistoryData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductPriceHistoryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPriceHistoryData) ProtoMessage() {}
+
+func (x *ProductPriceHistoryData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPriceHistoryData.ProtoReflect.Descriptor instead.
+func (*ProductPriceHistoryData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ProductPriceHistoryData) GetPriceHistoryId() string {
+	if x != nil {
+		return x.PriceHistoryId
+	}
+	return ""
+}
+
+func (x *ProductPriceHistoryData) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductPriceHistoryData) GetOriginalPrice() float64 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
+func (x *ProductPriceHistoryData) GetDiscountedPrice() float64 {
+	if x != nil {
+		return x.DiscountedPrice
+	}
+	return 0
+}
+
+func (x *ProductPriceHistoryData) GetEffectivePrice() float64 {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return 0
+}
+
+func (x *ProductPriceHistoryData) GetPriceRuleId() string {
+	if x != nil {
+		return x.PriceRuleId
+	}
+	return ""
+}
+
+func (x *ProductPriceHistoryData) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ProductPriceHistoryData) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+type GetProductPriceHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId string `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
+}
+
+func (x *GetProductPriceHistoryRequest) Reset() {
+	*x = GetProductPriceHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProductPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetProductPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetProductPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetProductPriceHistoryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type GetProductPriceHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductPriceHistoryData []*ProductPriceHistoryData `protobuf:"bytes,1,rep,name=productPriceHistoryData,proto3" json:"productPriceHistoryData,omitempty"`
+}
+
+func (x *GetProductPriceHistoryResponse) Reset() {
+	*x = GetProductPriceHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProductPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetProductPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetProductPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetProductPriceHistoryResponse) GetProductPriceHistoryData() []*ProductPriceHistoryData {
+	if x != nil {
+		return x.ProductPriceHistoryData
+	}
+	return nil
+}
+
+type TrashData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity    string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	Id        string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Label     string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	DeletedAt int64  `protobuf:"varint,4,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+}
+
+func (x *TrashData) Reset() {
+	*x = TrashData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrashData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashData) ProtoMessage() {}
+
+func (x *TrashData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashData.ProtoReflect.Descriptor instead.
+func (*TrashData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *TrashData) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *TrashData) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrashData) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TrashData) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+type GetAllTrashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+}
+
+func (x *GetAllTrashRequest) Reset() {
+	*x = GetAllTrashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTrashRequest) ProtoMessage() {}
+
+func (x *GetAllTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTrashRequest.ProtoReflect.Descriptor instead.
+func (*GetAllTrashRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetAllTrashRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+type GetAllTrashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrashData []*TrashData `protobuf:"bytes,1,rep,name=trashData,proto3" json:"trashData,omitempty"`
+}
+
+func (x *GetAllTrashResponse) Reset() {
+	*x = GetAllTrashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTrashResponse) ProtoMessage() {}
+
+func (x *GetAllTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTrashResponse.ProtoReflect.Descriptor instead.
+func (*GetAllTrashResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetAllTrashResponse) GetTrashData() []*TrashData {
+	if x != nil {
+		return x.TrashData
+	}
+	return nil
+}
+
+type RestoreTrashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	Id     string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreTrashRequest) Reset() {
+	*x = RestoreTrashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTrashRequest) ProtoMessage() {}
+
+func (x *RestoreTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTrashRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTrashRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *RestoreTrashRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *RestoreTrashRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreTrashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrashData *TrashData `protobuf:"bytes,1,opt,name=trashData,proto3" json:"trashData,omitempty"`
+}
+
+func (x *RestoreTrashResponse) Reset() {
+	*x = RestoreTrashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTrashResponse) ProtoMessage() {}
+
+func (x *RestoreTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTrashResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTrashResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *RestoreTrashResponse) GetTrashData() *TrashData {
+	if x != nil {
+		return x.TrashData
+	}
+	return nil
+}
+
+type PurgeTrashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	Id     string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PurgeTrashRequest) Reset() {
+	*x = PurgeTrashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashRequest) ProtoMessage() {}
+
+func (x *PurgeTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTrashRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *PurgeTrashRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *PurgeTrashRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PurgeTrashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrashData *TrashData `protobuf:"bytes,1,opt,name=trashData,proto3" json:"trashData,omitempty"`
+}
+
+func (x *PurgeTrashResponse) Reset() {
+	*x = PurgeTrashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashResponse) ProtoMessage() {}
+
+func (x *PurgeTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTrashResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *PurgeTrashResponse) GetTrashData() *TrashData {
+	if x != nil {
+		return x.TrashData
+	}
+	return nil
+}
+
+type CustomerData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerId    string  `protobuf:"bytes,1,opt,name=customerId,proto3" json:"customerId,omitempty"`
+	FirstName     string  `protobuf:"bytes,2,opt,name=firstName,proto3" json:"firstName,omitempty"`
+	LastName      string  `protobuf:"bytes,3,opt,name=lastName,proto3" json:"lastName,omitempty"`
+	EmailAddress  string  `protobuf:"bytes,4,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	ContactNumber string  `protobuf:"bytes,5,opt,name=contactNumber,proto3" json:"contactNumber,omitempty"`
+	Addresses     string  `protobuf:"bytes,6,opt,name=addresses,proto3" json:"addresses,omitempty"`
+	OrderCount    int64   `protobuf:"varint,7,opt,name=orderCount,proto3" json:"orderCount,omitempty"`
+	LifetimeSpend float64 `protobuf:"fixed64,8,opt,name=lifetimeSpend,proto3" json:"lifetimeSpend,omitempty"`
+	LastOrderAt   int64   `protobuf:"varint,9,opt,name=lastOrderAt,proto3" json:"lastOrderAt,omitempty"`
+	CreatedBy     string  `protobuf:"bytes,10,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt     int64   `protobuf:"varint,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy     string  `protobuf:"bytes,12,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt     int64   `protobuf:"varint,13,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *CustomerData) Reset() {
+	*x = CustomerData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerData) ProtoMessage() {}
+
+func (x *CustomerData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerData.ProtoReflect.Descriptor instead.
+func (*CustomerData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CustomerData) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CustomerData) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CustomerData) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *CustomerData) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *CustomerData) GetContactNumber() string {
+	if x != nil {
+		return x.ContactNumber
+	}
+	return ""
+}
+
+func (x *CustomerData) GetAddresses() string {
+	if x != nil {
+		return x.Addresses
+	}
+	return ""
+}
+
+func (x *CustomerData) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *CustomerData) GetLifetimeSpend() float64 {
+	if x != nil {
+		return x.LifetimeSpend
+	}
+	return 0
+}
+
+func (x *CustomerData) GetLastOrderAt() int64 {
+	if x != nil {
+		return x.LastOrderAt
+	}
+	return 0
+}
+
+func (x *CustomerData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CustomerData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CustomerData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *CustomerData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetAllCustomerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Search     string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	SortOption string `protobuf:"bytes,2,opt,name=sortOption,proto3" json:"sortOption,omitempty"`
+}
+
+func (x *GetAllCustomerRequest) Reset() {
+	*x = GetAllCustomerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllCustomerRequest) ProtoMessage() {}
+
+func (x *GetAllCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllCustomerRequest.ProtoReflect.Descriptor instead.
+func (*GetAllCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetAllCustomerRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetAllCustomerRequest) GetSortOption() string {
+	if x != nil {
+		return x.SortOption
+	}
+	return ""
+}
+
+type GetAllCustomerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerData []*CustomerData `protobuf:"bytes,1,rep,name=customerData,proto3" json:"customerData,omitempty"`
+}
+
+func (x *GetAllCustomerResponse) Reset() {
+	*x = GetAllCustomerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllCustomerResponse) ProtoMessage() {}
+
+func (x *GetAllCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllCustomerResponse.ProtoReflect.Descriptor instead.
+func (*GetAllCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetAllCustomerResponse) GetCustomerData() []*CustomerData {
+	if x != nil {
+		return x.CustomerData
+	}
+	return nil
+}
+
+type GetCustomerByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerId string `protobuf:"bytes,1,opt,name=customerId,proto3" json:"customerId,omitempty"`
+}
+
+func (x *GetCustomerByIdRequest) Reset() {
+	*x = GetCustomerByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCustomerByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerByIdRequest) ProtoMessage() {}
+
+func (x *GetCustomerByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerByIdRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetCustomerByIdRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type GetCustomerByIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerData *CustomerData `protobuf:"bytes,1,opt,name=customerData,proto3" json:"customerData,omitempty"`
+	OrderData    []*OrderData  `protobuf:"bytes,2,rep,name=orderData,proto3" json:"orderData,omitempty"`
+}
+
+func (x *GetCustomerByIdResponse) Reset() {
+	*x = GetCustomerByIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCustomerByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerByIdResponse) ProtoMessage() {}
+
+func (x *GetCustomerByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerByIdResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetCustomerByIdResponse) GetCustomerData() *CustomerData {
+	if x != nil {
+		return x.CustomerData
+	}
+	return nil
+}
+
+func (x *GetCustomerByIdResponse) GetOrderData() []*OrderData {
+	if x != nil {
+		return x.OrderData
+	}
+	return nil
+}
+
+type PublicOrderData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId         string  `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	OrderStatus     string  `protobuf:"bytes,2,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
+	TrackingId      string  `protobuf:"bytes,3,opt,name=trackingId,proto3" json:"trackingId,omitempty"`
+	Product         string  `protobuf:"bytes,4,opt,name=product,proto3" json:"product,omitempty"`
+	Freebies        string  `protobuf:"bytes,5,opt,name=freebies,proto3" json:"freebies,omitempty"`
+	Total           float64 `protobuf:"fixed64,6,opt,name=total,proto3" json:"total,omitempty"`
+	VoucherDiscount float64 `protobuf:"fixed64,7,opt,name=voucherDiscount,proto3" json:"voucherDiscount,omitempty"`
+	Cancellable     bool    `protobuf:"varint,8,opt,name=cancellable,proto3" json:"cancellable,omitempty"`
+	CreatedAt       int64   `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt       int64   `protobuf:"varint,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	ShippingFee     float64 `protobuf:"fixed64,11,opt,name=shippingFee,proto3" json:"shippingFee,omitempty"`
+	OrderNumber     string  `protobuf:"bytes,12,opt,name=orderNumber,proto3" json:"orderNumber,omitempty"`
+}
+
+func (x *PublicOrderData) Reset() {
+	*x = PublicOrderData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicOrderData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicOrderData) ProtoMessage() {}
+
+func (x *PublicOrderData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicOrderData.ProtoReflect.Descriptor instead.
+func (*PublicOrderData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *PublicOrderData) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PublicOrderData) GetOrderStatus() string {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return ""
+}
+
+func (x *PublicOrderData) GetTrackingId() string {
+	if x != nil {
+		return x.TrackingId
+	}
+	return ""
+}
+
+func (x *PublicOrderData) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *PublicOrderData) GetFreebies() string {
+	if x != nil {
+		return x.Freebies
+	}
+	return ""
+}
+
+func (x *PublicOrderData) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PublicOrderData) GetVoucherDiscount() float64 {
+	if x != nil {
+		return x.VoucherDiscount
+	}
+	return 0
+}
+
+func (x *PublicOrderData) GetCancellable() bool {
+	if x != nil {
+		return x.Cancellable
+	}
+	return false
+}
+
+func (x *PublicOrderData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PublicOrderData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *PublicOrderData) GetShippingFee() float64 {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return 0
+}
+
+func (x *PublicOrderData) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+type LookupOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId      string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	EmailAddress string `protobuf:"bytes,2,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	Token        string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *LookupOrderRequest) Reset() {
+	*x = LookupOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupOrderRequest) ProtoMessage() {}
+
+func (x *LookupOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupOrderRequest.ProtoReflect.Descriptor instead.
+func (*LookupOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *LookupOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *LookupOrderRequest) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *LookupOrderRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type LookupOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderData *PublicOrderData `protobuf:"bytes,1,opt,name=orderData,proto3" json:"orderData,omitempty"`
+}
+
+func (x *LookupOrderResponse) Reset() {
+	*x = LookupOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupOrderResponse) ProtoMessage() {}
+
+func (x *LookupOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupOrderResponse.ProtoReflect.Descriptor instead.
+func (*LookupOrderResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *LookupOrderResponse) GetOrderData() *PublicOrderData {
+	if x != nil {
+		return x.OrderData
+	}
+	return nil
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId      string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	EmailAddress string `protobuf:"bytes,2,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	Token        string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderData *PublicOrderData `protobuf:"bytes,1,opt,name=orderData,proto3" json:"orderData,omitempty"`
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *CancelOrderResponse) GetOrderData() *PublicOrderData {
+	if x != nil {
+		return x.OrderData
+	}
+	return nil
+}
+
+type PreviewOrderEmailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event   string `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	OrderId string `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+}
+
+func (x *PreviewOrderEmailRequest) Reset() {
+	*x = PreviewOrderEmailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewOrderEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewOrderEmailRequest) ProtoMessage() {}
+
+func (x *PreviewOrderEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewOrderEmailRequest.ProtoReflect.Descriptor instead.
+func (*PreviewOrderEmailRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *PreviewOrderEmailRequest) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *PreviewOrderEmailRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type PreviewOrderEmailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	To      string `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Html    string `protobuf:"bytes,3,opt,name=html,proto3" json:"html,omitempty"`
+	Enabled bool   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *PreviewOrderEmailResponse) Reset() {
+	*x = PreviewOrderEmailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewOrderEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewOrderEmailResponse) ProtoMessage() {}
+
+func (x *PreviewOrderEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewOrderEmailResponse.ProtoReflect.Descriptor instead.
+func (*PreviewOrderEmailResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *PreviewOrderEmailResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PreviewOrderEmailResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *PreviewOrderEmailResponse) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *PreviewOrderEmailResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type EmailOutboxData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmailOutboxId string `protobuf:"bytes,1,opt,name=emailOutboxId,proto3" json:"emailOutboxId,omitempty"`
+	EmailEvent    string `protobuf:"bytes,2,opt,name=emailEvent,proto3" json:"emailEvent,omitempty"`
+	OrderId       string `protobuf:"bytes,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Recipient     string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Subject       string `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	HtmlBody      string `protobuf:"bytes,6,opt,name=htmlBody,proto3" json:"htmlBody,omitempty"`
+	EmailStatus   string `protobuf:"bytes,7,opt,name=emailStatus,proto3" json:"emailStatus,omitempty"`
+	Attempts      int64  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt int64  `protobuf:"varint,9,opt,name=nextAttemptAt,proto3" json:"nextAttemptAt,omitempty"`
+	LastError     string `protobuf:"bytes,10,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	SentAt        int64  `protobuf:"varint,11,opt,name=sentAt,proto3" json:"sentAt,omitempty"`
+	CreatedBy     string `protobuf:"bytes,12,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,13,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy     string `protobuf:"bytes,14,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt     int64  `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *EmailOutboxData) Reset() {
+	*x = EmailOutboxData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmailOutboxData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailOutboxData) ProtoMessage() {}
+
+func (x *EmailOutboxData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailOutboxData.ProtoReflect.Descriptor instead.
+func (*EmailOutboxData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *EmailOutboxData) GetEmailOutboxId() string {
+	if x != nil {
+		return x.EmailOutboxId
+	}
+	return ""
+}
+
+func (x *EmailOutboxData) GetEmailEvent() string {
+	if x != nil {
+		return x.EmailEvent
+	}
+	return ""
+}
+
+func (x *EmailOutboxData) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *EmailOutboxData) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *EmailOutboxData) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *EmailOutboxData) GetHtmlBody() string {
+	if x != nil {
+		return x.HtmlBody
+	}
+	return ""
+}
+
+func (x *EmailOutboxData) GetEmailStatus() string {
+	if x != nil {
+		return x.EmailStatus
+	}
+	return ""
+}
+
+func (x *EmailOutboxData) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *EmailOutboxData) GetNextAttemptAt() int64 {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return 0
+}
+
+func (x *EmailOutboxData) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *EmailOutboxData) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+func (x *EmailOutboxData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *EmailOutboxData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *EmailOutboxData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *EmailOutboxData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetAllEmailOutboxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Search      string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	EmailStatus string `protobuf:"bytes,2,opt,name=emailStatus,proto3" json:"emailStatus,omitempty"`
+	OrderId     string `protobuf:"bytes,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
+}
+
+func (x *GetAllEmailOutboxRequest) Reset() {
+	*x = GetAllEmailOutboxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllEmailOutboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllEmailOutboxRequest) ProtoMessage() {}
+
+func (x *GetAllEmailOutboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllEmailOutboxRequest.ProtoReflect.Descriptor instead.
+func (*GetAllEmailOutboxRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetAllEmailOutboxRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetAllEmailOutboxRequest) GetEmailStatus() string {
+	if x != nil {
+		return x.EmailStatus
+	}
+	return ""
+}
+
+func (x *GetAllEmailOutboxRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetAllEmailOutboxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmailOutboxData []*EmailOutboxData `protobuf:"bytes,1,rep,name=emailOutboxData,proto3" json:"emailOutboxData,omitempty"`
+}
+
+func (x *GetAllEmailOutboxResponse) Reset() {
+	*x = GetAllEmailOutboxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllEmailOutboxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllEmailOutboxResponse) ProtoMessage() {}
+
+func (x *GetAllEmailOutboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllEmailOutboxResponse.ProtoReflect.Descriptor instead.
+func (*GetAllEmailOutboxResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetAllEmailOutboxResponse) GetEmailOutboxData() []*EmailOutboxData {
+	if x != nil {
+		return x.EmailOutboxData
+	}
+	return nil
+}
+
+type ResendEmailOutboxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmailOutboxId string `protobuf:"bytes,1,opt,name=emailOutboxId,proto3" json:"emailOutboxId,omitempty"`
+}
+
+func (x *ResendEmailOutboxRequest) Reset() {
+	*x = ResendEmailOutboxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResendEmailOutboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendEmailOutboxRequest) ProtoMessage() {}
+
+func (x *ResendEmailOutboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendEmailOutboxRequest.ProtoReflect.Descriptor instead.
+func (*ResendEmailOutboxRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ResendEmailOutboxRequest) GetEmailOutboxId() string {
+	if x != nil {
+		return x.EmailOutboxId
+	}
+	return ""
+}
+
+type ResendEmailOutboxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmailOutboxData *EmailOutboxData `protobuf:"bytes,1,opt,name=emailOutboxData,proto3" json:"emailOutboxData,omitempty"`
+}
+
+func (x *ResendEmailOutboxResponse) Reset() {
+	*x = ResendEmailOutboxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResendEmailOutboxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendEmailOutboxResponse) ProtoMessage() {}
+
+func (x *ResendEmailOutboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendEmailOutboxResponse.ProtoReflect.Descriptor instead.
+func (*ResendEmailOutboxResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ResendEmailOutboxResponse) GetEmailOutboxData() *EmailOutboxData {
+	if x != nil {
+		return x.EmailOutboxData
+	}
+	return nil
+}
+
+type SendOrderEmailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Event   string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SendOrderEmailRequest) Reset() {
+	*x = SendOrderEmailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendOrderEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendOrderEmailRequest) ProtoMessage() {}
+
+func (x *SendOrderEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendOrderEmailRequest.ProtoReflect.Descriptor instead.
+func (*SendOrderEmailRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *SendOrderEmailRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *SendOrderEmailRequest) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *SendOrderEmailRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SendOrderEmailRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SendOrderEmailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmailOutboxData *EmailOutboxData `protobuf:"bytes,1,opt,name=emailOutboxData,proto3" json:"emailOutboxData,omitempty"`
+}
+
+func (x *SendOrderEmailResponse) Reset() {
+	*x = SendOrderEmailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendOrderEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendOrderEmailResponse) ProtoMessage() {}
+
+func (x *SendOrderEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendOrderEmailResponse.ProtoReflect.Descriptor instead.
+func (*SendOrderEmailResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *SendOrderEmailResponse) GetEmailOutboxData() *EmailOutboxData {
+	if x != nil {
+		return x.EmailOutboxData
+	}
+	return nil
+}
+
+type SubmitContactFormRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	EmailAddress string `protobuf:"bytes,2,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	Subject      string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Message      string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Website      string `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
+	CaptchaToken string `protobuf:"bytes,6,opt,name=captchaToken,proto3" json:"captchaToken,omitempty"`
+	RemoteIp     string `protobuf:"bytes,7,opt,name=remoteIp,proto3" json:"remoteIp,omitempty"`
+}
+
+func (x *SubmitContactFormRequest) Reset() {
+	*x = SubmitContactFormRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitContactFormRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitContactFormRequest) ProtoMessage() {}
+
+func (x *SubmitContactFormRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitContactFormRequest.ProtoReflect.Descriptor instead.
+func (*SubmitContactFormRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *SubmitContactFormRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubmitContactFormRequest) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *SubmitContactFormRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SubmitContactFormRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubmitContactFormRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *SubmitContactFormRequest) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
+func (x *SubmitContactFormRequest) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+type SubmitContactFormResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SubmitContactFormResponse) Reset() {
+	*x = SubmitContactFormResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitContactFormResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitContactFormResponse) ProtoMessage() {}
+
+func (x *SubmitContactFormResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitContactFormResponse.ProtoReflect.Descriptor instead.
+func (*SubmitContactFormResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *SubmitContactFormResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PaymentData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentId         string  `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	OrderId           string  `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	PaymentMethod     string  `protobuf:"bytes,3,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty"`
+	Provider          string  `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	Amount            float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaymentStatus     string  `protobuf:"bytes,6,opt,name=paymentStatus,proto3" json:"paymentStatus,omitempty"`
+	ProviderReference string  `protobuf:"bytes,7,opt,name=providerReference,proto3" json:"providerReference,omitempty"`
+	CheckoutUrl       string  `protobuf:"bytes,8,opt,name=checkoutUrl,proto3" json:"checkoutUrl,omitempty"`
+	FailureReason     string  `protobuf:"bytes,9,opt,name=failureReason,proto3" json:"failureReason,omitempty"`
+	CapturedAt        int64   `protobuf:"varint,10,opt,name=capturedAt,proto3" json:"capturedAt,omitempty"`
+	CreatedBy         string  `protobuf:"bytes,11,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt         int64   `protobuf:"varint,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy         string  `protobuf:"bytes,13,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt         int64   `protobuf:"varint,14,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *PaymentData) Reset() {
+	*x = PaymentData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentData) ProtoMessage() {}
+
+func (x *PaymentData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentData.ProtoReflect.Descriptor instead.
+func (*PaymentData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *PaymentData) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *PaymentData) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PaymentData) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *PaymentData) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PaymentData) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PaymentData) GetPaymentStatus() string {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return ""
+}
+
+func (x *PaymentData) GetProviderReference() string {
+	if x != nil {
+		return x.ProviderReference
+	}
+	return ""
+}
+
+func (x *PaymentData) GetCheckoutUrl() string {
+	if x != nil {
+		return x.CheckoutUrl
+	}
+	return ""
+}
+
+func (x *PaymentData) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *PaymentData) GetCapturedAt() int64 {
+	if x != nil {
+		return x.CapturedAt
+	}
+	return 0
+}
+
+func (x *PaymentData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PaymentData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PaymentData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *PaymentData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetOrderPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+}
+
+func (x *GetOrderPaymentRequest) Reset() {
+	*x = GetOrderPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderPaymentRequest) ProtoMessage() {}
+
+func (x *GetOrderPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderPaymentRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetOrderPaymentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetOrderPaymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentData    []*PaymentData `protobuf:"bytes,1,rep,name=paymentData,proto3" json:"paymentData,omitempty"`
+	PaymentStatus  string         `protobuf:"bytes,2,opt,name=paymentStatus,proto3" json:"paymentStatus,omitempty"`
+	AmountPaid     float64        `protobuf:"fixed64,3,opt,name=amountPaid,proto3" json:"amountPaid,omitempty"`
+	RefundData     []*RefundData  `protobuf:"bytes,4,rep,name=refundData,proto3" json:"refundData,omitempty"`
+	AmountRefunded float64        `protobuf:"fixed64,5,opt,name=amountRefunded,proto3" json:"amountRefunded,omitempty"`
+}
+
+func (x *GetOrderPaymentResponse) Reset() {
+	*x = GetOrderPaymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderPaymentResponse) ProtoMessage() {}
+
+func (x *GetOrderPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderPaymentResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GetOrderPaymentResponse) GetPaymentData() []*PaymentData {
+	if x != nil {
+		return x.PaymentData
+	}
+	return nil
+}
+
+func (x *GetOrderPaymentResponse) GetPaymentStatus() string {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return ""
+}
+
+func (x *GetOrderPaymentResponse) GetAmountPaid() float64 {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return 0
+}
+
+func (x *GetOrderPaymentResponse) GetRefundData() []*RefundData {
+	if x != nil {
+		return x.RefundData
+	}
+	return nil
+}
+
+func (x *GetOrderPaymentResponse) GetAmountRefunded() float64 {
+	if x != nil {
+		return x.AmountRefunded
+	}
+	return 0
+}
+
+type RecordPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId           string  `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	PaymentMethod     string  `protobuf:"bytes,2,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty"`
+	Amount            float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ProviderReference string  `protobuf:"bytes,4,opt,name=providerReference,proto3" json:"providerReference,omitempty"`
+	PaymentStatus     string  `protobuf:"bytes,5,opt,name=paymentStatus,proto3" json:"paymentStatus,omitempty"`
+}
+
+func (x *RecordPaymentRequest) Reset() {
+	*x = RecordPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordPaymentRequest) ProtoMessage() {}
+
+func (x *RecordPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RecordPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *RecordPaymentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RecordPaymentRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *RecordPaymentRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecordPaymentRequest) GetProviderReference() string {
+	if x != nil {
+		return x.ProviderReference
+	}
+	return ""
+}
+
+func (x *RecordPaymentRequest) GetPaymentStatus() string {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return ""
+}
+
+type RecordPaymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentData *PaymentData `protobuf:"bytes,1,opt,name=paymentData,proto3" json:"paymentData,omitempty"`
+}
+
+func (x *RecordPaymentResponse) Reset() {
+	*x = RecordPaymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordPaymentResponse) ProtoMessage() {}
+
+func (x *RecordPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RecordPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *RecordPaymentResponse) GetPaymentData() *PaymentData {
+	if x != nil {
+		return x.PaymentData
+	}
+	return nil
+}
+
+type UpdatePaymentStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentId         string `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	PaymentStatus     string `protobuf:"bytes,2,opt,name=paymentStatus,proto3" json:"paymentStatus,omitempty"`
+	ProviderReference string `protobuf:"bytes,3,opt,name=providerReference,proto3" json:"providerReference,omitempty"`
+	FailureReason     string `protobuf:"bytes,4,opt,name=failureReason,proto3" json:"failureReason,omitempty"`
+}
+
+func (x *UpdatePaymentStatusRequest) Reset() {
+	*x = UpdatePaymentStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePaymentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePaymentStatusRequest) ProtoMessage() {}
+
+func (x *UpdatePaymentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePaymentStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePaymentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *UpdatePaymentStatusRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *UpdatePaymentStatusRequest) GetPaymentStatus() string {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return ""
+}
+
+func (x *UpdatePaymentStatusRequest) GetProviderReference() string {
+	if x != nil {
+		return x.ProviderReference
+	}
+	return ""
+}
+
+func (x *UpdatePaymentStatusRequest) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+type UpdatePaymentStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentData *PaymentData `protobuf:"bytes,1,opt,name=paymentData,proto3" json:"paymentData,omitempty"`
+}
+
+func (x *UpdatePaymentStatusResponse) Reset() {
+	*x = UpdatePaymentStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePaymentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePaymentStatusResponse) ProtoMessage() {}
+
+func (x *UpdatePaymentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePaymentStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePaymentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *UpdatePaymentStatusResponse) GetPaymentData() *PaymentData {
+	if x != nil {
+		return x.PaymentData
+	}
+	return nil
+}
+
+type HandlePaymentWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider  string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Payload   []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *HandlePaymentWebhookRequest) Reset() {
+	*x = HandlePaymentWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandlePaymentWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlePaymentWebhookRequest) ProtoMessage() {}
+
+func (x *HandlePaymentWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandlePaymentWebhookRequest.ProtoReflect.Descriptor instead.
+func (*HandlePaymentWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *HandlePaymentWebhookRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *HandlePaymentWebhookRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *HandlePaymentWebhookRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type HandlePaymentWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentData *PaymentData `protobuf:"bytes,1,opt,name=paymentData,proto3" json:"paymentData,omitempty"`
+}
+
+func (x *HandlePaymentWebhookResponse) Reset() {
+	*x = HandlePaymentWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandlePaymentWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlePaymentWebhookResponse) ProtoMessage() {}
+
+func (x *HandlePaymentWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandlePaymentWebhookResponse.ProtoReflect.Descriptor instead.
+func (*HandlePaymentWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *HandlePaymentWebhookResponse) GetPaymentData() *PaymentData {
+	if x != nil {
+		return x.PaymentData
+	}
+	return nil
+}
+
+type ReturnData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReturnId       string  `protobuf:"bytes,1,opt,name=returnId,proto3" json:"returnId,omitempty"`
+	OrderId        string  `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	ReturnStatus   string  `protobuf:"bytes,3,opt,name=returnStatus,proto3" json:"returnStatus,omitempty"`
+	Items          string  `protobuf:"bytes,4,opt,name=items,proto3" json:"items,omitempty"`
+	Reason         string  `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Note           string  `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	ReceivedAt     int64   `protobuf:"varint,7,opt,name=receivedAt,proto3" json:"receivedAt,omitempty"`
+	AmountRefunded float64 `protobuf:"fixed64,8,opt,name=amountRefunded,proto3" json:"amountRefunded,omitempty"`
+	CreatedBy      string  `protobuf:"bytes,9,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt      int64   `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy      string  `protobuf:"bytes,11,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt      int64   `protobuf:"varint,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Version        int64   `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *ReturnData) Reset() {
+	*x = ReturnData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnData) ProtoMessage() {}
+
+func (x *ReturnData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnData.ProtoReflect.Descriptor instead.
+func (*ReturnData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ReturnData) GetReturnId() string {
+	if x != nil {
+		return x.ReturnId
+	}
+	return ""
+}
+
+func (x *ReturnData) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReturnData) GetReturnStatus() string {
+	if x != nil {
+		return x.ReturnStatus
+	}
+	return ""
+}
+
+func (x *ReturnData) GetItems() string {
+	if x != nil {
+		return x.Items
+	}
+	return ""
+}
+
+func (x *ReturnData) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReturnData) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ReturnData) GetReceivedAt() int64 {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return 0
+}
+
+func (x *ReturnData) GetAmountRefunded() float64 {
+	if x != nil {
+		return x.AmountRefunded
+	}
+	return 0
+}
+
+func (x *ReturnData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ReturnData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ReturnData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *ReturnData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *ReturnData) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RefundData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefundId          string  `protobuf:"bytes,1,opt,name=refundId,proto3" json:"refundId,omitempty"`
+	OrderId           string  `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	PaymentId         string  `protobuf:"bytes,3,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	ReturnId          string  `protobuf:"bytes,4,opt,name=returnId,proto3" json:"returnId,omitempty"`
+	Amount            float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason            string  `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	ProviderReference string  `protobuf:"bytes,7,opt,name=providerReference,proto3" json:"providerReference,omitempty"`
+	CreatedBy         string  `protobuf:"bytes,8,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt         int64   `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy         string  `protobuf:"bytes,10,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt         int64   `protobuf:"varint,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *RefundData) Reset() {
+	*x = RefundData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundData) ProtoMessage() {}
+
+func (x *RefundData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundData.ProtoReflect.Descriptor instead.
+func (*RefundData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *RefundData) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *RefundData) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RefundData) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *RefundData) GetReturnId() string {
+	if x != nil {
+		return x.ReturnId
+	}
+	return ""
+}
+
+func (x *RefundData) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundData) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundData) GetProviderReference() string {
+	if x != nil {
+		return x.ProviderReference
+	}
+	return ""
+}
+
+func (x *RefundData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *RefundData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RefundData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *RefundData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SaveReturnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Items   string `protobuf:"bytes,2,opt,name=items,proto3" json:"items,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SaveReturnRequest) Reset() {
+	*x = SaveReturnRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveReturnRequest) ProtoMessage() {}
+
+func (x *SaveReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveReturnRequest.ProtoReflect.Descriptor instead.
+func (*SaveReturnRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *SaveReturnRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *SaveReturnRequest) GetItems() string {
+	if x != nil {
+		return x.Items
+	}
+	return ""
+}
+
+func (x *SaveReturnRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SaveReturnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReturnData *ReturnData `protobuf:"bytes,1,opt,name=returnData,proto3" json:"returnData,omitempty"`
+}
+
+func (x *SaveReturnResponse) Reset() {
+	*x = SaveReturnResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveReturnResponse) ProtoMessage() {}
+
+func (x *SaveReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveReturnResponse.ProtoReflect.Descriptor instead.
+func (*SaveReturnResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *SaveReturnResponse) GetReturnData() *ReturnData {
+	if x != nil {
+		return x.ReturnData
+	}
+	return nil
+}
+
+type RequestReturnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId      string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	EmailAddress string `protobuf:"bytes,2,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	Token        string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Items        string `protobuf:"bytes,4,opt,name=items,proto3" json:"items,omitempty"`
+	Reason       string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RequestReturnRequest) Reset() {
+	*x = RequestReturnRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnRequest) ProtoMessage() {}
+
+func (x *RequestReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnRequest.ProtoReflect.Descriptor instead.
+func (*RequestReturnRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *RequestReturnRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RequestReturnRequest) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *RequestReturnRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RequestReturnRequest) GetItems() string {
+	if x != nil {
+		return x.Items
+	}
+	return ""
+}
+
+func (x *RequestReturnRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RequestReturnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReturnData *ReturnData `protobuf:"bytes,1,opt,name=returnData,proto3" json:"returnData,omitempty"`
+}
+
+func (x *RequestReturnResponse) Reset() {
+	*x = RequestReturnResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnResponse) ProtoMessage() {}
+
+func (x *RequestReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnResponse.ProtoReflect.Descriptor instead.
+func (*RequestReturnResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *RequestReturnResponse) GetReturnData() *ReturnData {
+	if x != nil {
+		return x.ReturnData
+	}
+	return nil
+}
+
+type GetAllReturnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Search       string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	ReturnStatus string `protobuf:"bytes,2,opt,name=returnStatus,proto3" json:"returnStatus,omitempty"`
+	OrderId      string `protobuf:"bytes,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
+}
+
+func (x *GetAllReturnRequest) Reset() {
+	*x = GetAllReturnRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllReturnRequest) ProtoMessage() {}
+
+func (x *GetAllReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllReturnRequest.ProtoReflect.Descriptor instead.
+func (*GetAllReturnRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *GetAllReturnRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetAllReturnRequest) GetReturnStatus() string {
+	if x != nil {
+		return x.ReturnStatus
+	}
+	return ""
+}
+
+func (x *GetAllReturnRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetAllReturnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReturnData []*ReturnData `protobuf:"bytes,1,rep,name=returnData,proto3" json:"returnData,omitempty"`
+}
+
+func (x *GetAllReturnResponse) Reset() {
+	*x = GetAllReturnResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllReturnResponse) ProtoMessage() {}
+
+func (x *GetAllReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllReturnResponse.ProtoReflect.Descriptor instead.
+func (*GetAllReturnResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *GetAllReturnResponse) GetReturnData() []*ReturnData {
+	if x != nil {
+		return x.ReturnData
+	}
+	return nil
+}
+
+type GetReturnByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReturnId string `protobuf:"bytes,1,opt,name=returnId,proto3" json:"returnId,omitempty"`
+}
+
+func (x *GetReturnByIdRequest) Reset() {
+	*x = GetReturnByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReturnByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnByIdRequest) ProtoMessage() {}
+
+func (x *GetReturnByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetReturnByIdRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *GetReturnByIdRequest) GetReturnId() string {
+	if x != nil {
+		return x.ReturnId
+	}
+	return ""
+}
+
+type GetReturnByIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReturnData *ReturnData   `protobuf:"bytes,1,opt,name=returnData,proto3" json:"returnData,omitempty"`
+	RefundData []*RefundData `protobuf:"bytes,2,rep,name=refundData,proto3" json:"refundData,omitempty"`
+}
+
+func (x *GetReturnByIdResponse) Reset() {
+	*x = GetReturnByIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReturnByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnByIdResponse) ProtoMessage() {}
+
+func (x *GetReturnByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetReturnByIdResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *GetReturnByIdResponse) GetReturnData() *ReturnData {
+	if x != nil {
+		return x.ReturnData
+	}
+	return nil
+}
+
+func (x *GetReturnByIdResponse) GetRefundData() []*RefundData {
+	if x != nil {
+		return x.RefundData
+	}
+	return nil
+}
+
+type UpdateReturnStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReturnId        string `protobuf:"bytes,1,opt,name=returnId,proto3" json:"returnId,omitempty"`
+	ReturnStatus    string `protobuf:"bytes,2,opt,name=returnStatus,proto3" json:"returnStatus,omitempty"`
+	Note            string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,4,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+}
+
+func (x *UpdateReturnStatusRequest) Reset() {
+	*x = UpdateReturnStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateReturnStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReturnStatusRequest) ProtoMessage() {}
+
+func (x *UpdateReturnStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReturnStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReturnStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *UpdateReturnStatusRequest) GetReturnId() string {
+	if x != nil {
+		return x.ReturnId
+	}
+	return ""
+}
+
+func (x *UpdateReturnStatusRequest) GetReturnStatus() string {
+	if x != nil {
+		return x.ReturnStatus
+	}
+	return ""
+}
+
+func (x *UpdateReturnStatusRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *UpdateReturnStatusRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateReturnStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReturnData *ReturnData `protobuf:"bytes,1,opt,name=returnData,proto3" json:"returnData,omitempty"`
+}
+
+func (x *UpdateReturnStatusResponse) Reset() {
+	*x = UpdateReturnStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateReturnStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReturnStatusResponse) ProtoMessage() {}
+
+func (x *UpdateReturnStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReturnStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReturnStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *UpdateReturnStatusResponse) GetReturnData() *ReturnData {
+	if x != nil {
+		return x.ReturnData
+	}
+	return nil
+}
+
+type ReceiveReturnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReturnId        string `protobuf:"bytes,1,opt,name=returnId,proto3" json:"returnId,omitempty"`
+	Items           string `protobuf:"bytes,2,opt,name=items,proto3" json:"items,omitempty"`
+	Note            string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,4,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+}
+
+func (x *ReceiveReturnRequest) Reset() {
+	*x = ReceiveReturnRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiveReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveReturnRequest) ProtoMessage() {}
+
+func (x *ReceiveReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveReturnRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveReturnRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *ReceiveReturnRequest) GetReturnId() string {
+	if x != nil {
+		return x.ReturnId
+	}
+	return ""
+}
+
+func (x *ReceiveReturnRequest) GetItems() string {
+	if x != nil {
+		return x.Items
+	}
+	return ""
+}
+
+func (x *ReceiveReturnRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ReceiveReturnRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ReceiveReturnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReturnData *ReturnData `protobuf:"bytes,1,opt,name=returnData,proto3" json:"returnData,omitempty"`
+}
+
+func (x *ReceiveReturnResponse) Reset() {
+	*x = ReceiveReturnResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiveReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveReturnResponse) ProtoMessage() {}
+
+func (x *ReceiveReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveReturnResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveReturnResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *ReceiveReturnResponse) GetReturnData() *ReturnData {
+	if x != nil {
+		return x.ReturnData
+	}
+	return nil
+}
+
+type RefundPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentId         string  `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	Amount            float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason            string  `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReturnId          string  `protobuf:"bytes,4,opt,name=returnId,proto3" json:"returnId,omitempty"`
+	ProviderReference string  `protobuf:"bytes,5,opt,name=providerReference,proto3" json:"providerReference,omitempty"`
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *RefundPaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundPaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetReturnId() string {
+	if x != nil {
+		return x.ReturnId
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetProviderReference() string {
+	if x != nil {
+		return x.ProviderReference
+	}
+	return ""
+}
+
+type RefundPaymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefundData *RefundData `protobuf:"bytes,1,opt,name=refundData,proto3" json:"refundData,omitempty"`
+}
+
+func (x *RefundPaymentResponse) Reset() {
+	*x = RefundPaymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentResponse) ProtoMessage() {}
+
+func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *RefundPaymentResponse) GetRefundData() *RefundData {
+	if x != nil {
+		return x.RefundData
+	}
+	return nil
+}
+
+type ShippingZoneData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShippingZoneId        string  `protobuf:"bytes,1,opt,name=shippingZoneId,proto3" json:"shippingZoneId,omitempty"`
+	ZoneName              string  `protobuf:"bytes,2,opt,name=zoneName,proto3" json:"zoneName,omitempty"`
+	Provinces             string  `protobuf:"bytes,3,opt,name=provinces,proto3" json:"provinces,omitempty"`
+	Cities                string  `protobuf:"bytes,4,opt,name=cities,proto3" json:"cities,omitempty"`
+	IsDefault             bool    `protobuf:"varint,5,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	RateType              string  `protobuf:"bytes,6,opt,name=rateType,proto3" json:"rateType,omitempty"`
+	FlatFee               float64 `protobuf:"fixed64,7,opt,name=flatFee,proto3" json:"flatFee,omitempty"`
+	WeightTiers           string  `protobuf:"bytes,8,opt,name=weightTiers,proto3" json:"weightTiers,omitempty"`
+	ExtraFeePerKg         float64 `protobuf:"fixed64,9,opt,name=extraFeePerKg,proto3" json:"extraFeePerKg,omitempty"`
+	FreeShippingThreshold float64 `protobuf:"fixed64,10,opt,name=freeShippingThreshold,proto3" json:"freeShippingThreshold,omitempty"`
+	ZoneStatus            string  `protobuf:"bytes,11,opt,name=zoneStatus,proto3" json:"zoneStatus,omitempty"`
+	CreatedBy             string  `protobuf:"bytes,12,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt             int64   `protobuf:"varint,13,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy             string  `protobuf:"bytes,14,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt             int64   `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *ShippingZoneData) Reset() {
+	*x = ShippingZoneData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShippingZoneData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingZoneData) ProtoMessage() {}
+
+func (x *ShippingZoneData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingZoneData.ProtoReflect.Descriptor instead.
+func (*ShippingZoneData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ShippingZoneData) GetShippingZoneId() string {
+	if x != nil {
+		return x.ShippingZoneId
+	}
+	return ""
+}
+
+func (x *ShippingZoneData) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *ShippingZoneData) GetProvinces() string {
+	if x != nil {
+		return x.Provinces
+	}
+	return ""
+}
+
+func (x *ShippingZoneData) GetCities() string {
+	if x != nil {
+		return x.Cities
+	}
+	return ""
+}
+
+func (x *ShippingZoneData) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *ShippingZoneData) GetRateType() string {
+	if x != nil {
+		return x.RateType
+	}
+	return ""
+}
+
+func (x *ShippingZoneData) GetFlatFee() float64 {
+	if x != nil {
+		return x.FlatFee
+	}
+	return 0
+}
+
+func (x *ShippingZoneData) GetWeightTiers() string {
+	if x != nil {
+		return x.WeightTiers
+	}
+	return ""
+}
+
+func (x *ShippingZoneData) GetExtraFeePerKg() float64 {
+	if x != nil {
+		return x.ExtraFeePerKg
+	}
+	return 0
+}
+
+func (x *ShippingZoneData) GetFreeShippingThreshold() float64 {
+	if x != nil {
+		return x.FreeShippingThreshold
+	}
+	return 0
+}
+
+func (x *ShippingZoneData) GetZoneStatus() string {
+	if x != nil {
+		return x.ZoneStatus
+	}
+	return ""
+}
+
+func (x *ShippingZoneData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ShippingZoneData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ShippingZoneData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *ShippingZoneData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SaveShippingZoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ZoneName              string  `protobuf:"bytes,1,opt,name=zoneName,proto3" json:"zoneName,omitempty"`
+	Provinces             string  `protobuf:"bytes,2,opt,name=provinces,proto3" json:"provinces,omitempty"`
+	Cities                string  `protobuf:"bytes,3,opt,name=cities,proto3" json:"cities,omitempty"`
+	IsDefault             bool    `protobuf:"varint,4,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	RateType              string  `protobuf:"bytes,5,opt,name=rateType,proto3" json:"rateType,omitempty"`
+	FlatFee               float64 `protobuf:"fixed64,6,opt,name=flatFee,proto3" json:"flatFee,omitempty"`
+	WeightTiers           string  `protobuf:"bytes,7,opt,name=weightTiers,proto3" json:"weightTiers,omitempty"`
+	ExtraFeePerKg         float64 `protobuf:"fixed64,8,opt,name=extraFeePerKg,proto3" json:"extraFeePerKg,omitempty"`
+	FreeShippingThreshold float64 `protobuf:"fixed64,9,opt,name=freeShippingThreshold,proto3" json:"freeShippingThreshold,omitempty"`
+	ZoneStatus            string  `protobuf:"bytes,10,opt,name=zoneStatus,proto3" json:"zoneStatus,omitempty"`
+}
+
+func (x *SaveShippingZoneRequest) Reset() {
+	*x = SaveShippingZoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveShippingZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveShippingZoneRequest) ProtoMessage() {}
+
+func (x *SaveShippingZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveShippingZoneRequest.ProtoReflect.Descriptor instead.
+func (*SaveShippingZoneRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *SaveShippingZoneRequest) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *SaveShippingZoneRequest) GetProvinces() string {
+	if x != nil {
+		return x.Provinces
+	}
+	return ""
+}
+
+func (x *SaveShippingZoneRequest) GetCities() string {
+	if x != nil {
+		return x.Cities
+	}
+	return ""
+}
+
+func (x *SaveShippingZoneRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *SaveShippingZoneRequest) GetRateType() string {
+	if x != nil {
+		return x.RateType
+	}
+	return ""
+}
+
+func (x *SaveShippingZoneRequest) GetFlatFee() float64 {
+	if x != nil {
+		return x.FlatFee
+	}
+	return 0
+}
+
+func (x *SaveShippingZoneRequest) GetWeightTiers() string {
+	if x != nil {
+		return x.WeightTiers
+	}
+	return ""
+}
+
+func (x *SaveShippingZoneRequest) GetExtraFeePerKg() float64 {
+	if x != nil {
+		return x.ExtraFeePerKg
+	}
+	return 0
+}
+
+func (x *SaveShippingZoneRequest) GetFreeShippingThreshold() float64 {
+	if x != nil {
+		return x.FreeShippingThreshold
+	}
+	return 0
+}
+
+func (x *SaveShippingZoneRequest) GetZoneStatus() string {
+	if x != nil {
+		return x.ZoneStatus
+	}
+	return ""
+}
+
+type SaveShippingZoneResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShippingZoneData *ShippingZoneData `protobuf:"bytes,1,opt,name=shippingZoneData,proto3" json:"shippingZoneData,omitempty"`
+}
+
+func (x *SaveShippingZoneResponse) Reset() {
+	*x = SaveShippingZoneResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveShippingZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveShippingZoneResponse) ProtoMessage() {}
+
+func (x *SaveShippingZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveShippingZoneResponse.ProtoReflect.Descriptor instead.
+func (*SaveShippingZoneResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *SaveShippingZoneResponse) GetShippingZoneData() *ShippingZoneData {
+	if x != nil {
+		return x.ShippingZoneData
+	}
+	return nil
+}
+
+type GetAllShippingZoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Search     string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	ZoneStatus string `protobuf:"bytes,2,opt,name=zoneStatus,proto3" json:"zoneStatus,omitempty"`
+}
+
+func (x *GetAllShippingZoneRequest) Reset() {
+	*x = GetAllShippingZoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllShippingZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllShippingZoneRequest) ProtoMessage() {}
+
+func (x *GetAllShippingZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllShippingZoneRequest.ProtoReflect.Descriptor instead.
+func (*GetAllShippingZoneRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *GetAllShippingZoneRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetAllShippingZoneRequest) GetZoneStatus() string {
+	if x != nil {
+		return x.ZoneStatus
+	}
+	return ""
+}
+
+type GetAllShippingZoneResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShippingZoneData []*ShippingZoneData `protobuf:"bytes,1,rep,name=shippingZoneData,proto3" json:"shippingZoneData,omitempty"`
+}
+
+func (x *GetAllShippingZoneResponse) Reset() {
+	*x = GetAllShippingZoneResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllShippingZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllShippingZoneResponse) ProtoMessage() {}
+
+func (x *GetAllShippingZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllShippingZoneResponse.ProtoReflect.Descriptor instead.
+func (*GetAllShippingZoneResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *GetAllShippingZoneResponse) GetShippingZoneData() []*ShippingZoneData {
+	if x != nil {
+		return x.ShippingZoneData
+	}
+	return nil
+}
+
+type UpdateShippingZoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShippingZoneId        string   `protobuf:"bytes,1,opt,name=shippingZoneId,proto3" json:"shippingZoneId,omitempty"`
+	ZoneName              string   `protobuf:"bytes,2,opt,name=zoneName,proto3" json:"zoneName,omitempty"`
+	Provinces             string   `protobuf:"bytes,3,opt,name=provinces,proto3" json:"provinces,omitempty"`
+	Cities                string   `protobuf:"bytes,4,opt,name=cities,proto3" json:"cities,omitempty"`
+	IsDefault             bool     `protobuf:"varint,5,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	RateType              string   `protobuf:"bytes,6,opt,name=rateType,proto3" json:"rateType,omitempty"`
+	FlatFee               float64  `protobuf:"fixed64,7,opt,name=flatFee,proto3" json:"flatFee,omitempty"`
+	WeightTiers           string   `protobuf:"bytes,8,opt,name=weightTiers,proto3" json:"weightTiers,omitempty"`
+	ExtraFeePerKg         float64  `protobuf:"fixed64,9,opt,name=extraFeePerKg,proto3" json:"extraFeePerKg,omitempty"`
+	FreeShippingThreshold float64  `protobuf:"fixed64,10,opt,name=freeShippingThreshold,proto3" json:"freeShippingThreshold,omitempty"`
+	UpdateMask            []string `protobuf:"bytes,11,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+}
+
+func (x *UpdateShippingZoneRequest) Reset() {
+	*x = UpdateShippingZoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateShippingZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShippingZoneRequest) ProtoMessage() {}
+
+func (x *UpdateShippingZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShippingZoneRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShippingZoneRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *UpdateShippingZoneRequest) GetShippingZoneId() string {
+	if x != nil {
+		return x.ShippingZoneId
+	}
+	return ""
+}
+
+func (x *UpdateShippingZoneRequest) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *UpdateShippingZoneRequest) GetProvinces() string {
+	if x != nil {
+		return x.Provinces
+	}
+	return ""
+}
+
+func (x *UpdateShippingZoneRequest) GetCities() string {
+	if x != nil {
+		return x.Cities
+	}
+	return ""
+}
+
+func (x *UpdateShippingZoneRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *UpdateShippingZoneRequest) GetRateType() string {
+	if x != nil {
+		return x.RateType
+	}
+	return ""
+}
+
+func (x *UpdateShippingZoneRequest) GetFlatFee() float64 {
+	if x != nil {
+		return x.FlatFee
+	}
+	return 0
+}
+
+func (x *UpdateShippingZoneRequest) GetWeightTiers() string {
+	if x != nil {
+		return x.WeightTiers
+	}
+	return ""
+}
+
+func (x *UpdateShippingZoneRequest) GetExtraFeePerKg() float64 {
+	if x != nil {
+		return x.ExtraFeePerKg
+	}
+	return 0
+}
+
+func (x *UpdateShippingZoneRequest) GetFreeShippingThreshold() float64 {
+	if x != nil {
+		return x.FreeShippingThreshold
+	}
+	return 0
+}
+
+func (x *UpdateShippingZoneRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateShippingZoneResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShippingZoneData *ShippingZoneData `protobuf:"bytes,1,opt,name=shippingZoneData,proto3" json:"shippingZoneData,omitempty"`
+}
+
+func (x *UpdateShippingZoneResponse) Reset() {
+	*x = UpdateShippingZoneResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateShippingZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShippingZoneResponse) ProtoMessage() {}
+
+func (x *UpdateShippingZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShippingZoneResponse.ProtoReflect.Descriptor instead.
+func (*UpdateShippingZoneResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *UpdateShippingZoneResponse) GetShippingZoneData() *ShippingZoneData {
+	if x != nil {
+		return x.ShippingZoneData
+	}
+	return nil
+}
+
+type UpdateShippingZoneStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShippingZoneId string `protobuf:"bytes,1,opt,name=shippingZoneId,proto3" json:"shippingZoneId,omitempty"`
+	ZoneStatus     string `protobuf:"bytes,2,opt,name=zoneStatus,proto3" json:"zoneStatus,omitempty"`
+}
+
+func (x *UpdateShippingZoneStatusRequest) Reset() {
+	*x = UpdateShippingZoneStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateShippingZoneStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShippingZoneStatusRequest) ProtoMessage() {}
+
+func (x *UpdateShippingZoneStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShippingZoneStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShippingZoneStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *UpdateShippingZoneStatusRequest) GetShippingZoneId() string {
+	if x != nil {
+		return x.ShippingZoneId
+	}
+	return ""
+}
+
+func (x *UpdateShippingZoneStatusRequest) GetZoneStatus() string {
+	if x != nil {
+		return x.ZoneStatus
+	}
+	return ""
+}
+
+type UpdateShippingZoneStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShippingZoneData *ShippingZoneData `protobuf:"bytes,1,opt,name=shippingZoneData,proto3" json:"shippingZoneData,omitempty"`
+}
+
+func (x *UpdateShippingZoneStatusResponse) Reset() {
+	*x = UpdateShippingZoneStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateShippingZoneStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShippingZoneStatusResponse) ProtoMessage() {}
+
+func (x *UpdateShippingZoneStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShippingZoneStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateShippingZoneStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *UpdateShippingZoneStatusResponse) GetShippingZoneData() *ShippingZoneData {
+	if x != nil {
+		return x.ShippingZoneData
+	}
+	return nil
+}
+
+type DeleteShippingZoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShippingZoneId string `protobuf:"bytes,1,opt,name=shippingZoneId,proto3" json:"shippingZoneId,omitempty"`
+}
+
+func (x *DeleteShippingZoneRequest) Reset() {
+	*x = DeleteShippingZoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteShippingZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShippingZoneRequest) ProtoMessage() {}
+
+func (x *DeleteShippingZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShippingZoneRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShippingZoneRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *DeleteShippingZoneRequest) GetShippingZoneId() string {
+	if x != nil {
+		return x.ShippingZoneId
+	}
+	return ""
+}
+
+type DeleteShippingZoneResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShippingZoneData *ShippingZoneData `protobuf:"bytes,1,opt,name=shippingZoneData,proto3" json:"shippingZoneData,omitempty"`
+}
+
+func (x *DeleteShippingZoneResponse) Reset() {
+	*x = DeleteShippingZoneResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteShippingZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShippingZoneResponse) ProtoMessage() {}
+
+func (x *DeleteShippingZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShippingZoneResponse.ProtoReflect.Descriptor instead.
+func (*DeleteShippingZoneResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *DeleteShippingZoneResponse) GetShippingZoneData() *ShippingZoneData {
+	if x != nil {
+		return x.ShippingZoneData
+	}
+	return nil
+}
+
+type QuoteShippingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product         string `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	CompleteAddress string `protobuf:"bytes,2,opt,name=completeAddress,proto3" json:"completeAddress,omitempty"`
+}
+
+func (x *QuoteShippingRequest) Reset() {
+	*x = QuoteShippingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteShippingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteShippingRequest) ProtoMessage() {}
+
+func (x *QuoteShippingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteShippingRequest.ProtoReflect.Descriptor instead.
+func (*QuoteShippingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *QuoteShippingRequest) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *QuoteShippingRequest) GetCompleteAddress() string {
+	if x != nil {
+		return x.CompleteAddress
+	}
+	return ""
+}
+
+type QuoteShippingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShippingZoneId        string  `protobuf:"bytes,1,opt,name=shippingZoneId,proto3" json:"shippingZoneId,omitempty"`
+	ZoneName              string  `protobuf:"bytes,2,opt,name=zoneName,proto3" json:"zoneName,omitempty"`
+	ShippingFee           float64 `protobuf:"fixed64,3,opt,name=shippingFee,proto3" json:"shippingFee,omitempty"`
+	WeightGrams           float64 `protobuf:"fixed64,4,opt,name=weightGrams,proto3" json:"weightGrams,omitempty"`
+	Subtotal              float64 `protobuf:"fixed64,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	FreeShippingThreshold float64 `protobuf:"fixed64,6,opt,name=freeShippingThreshold,proto3" json:"freeShippingThreshold,omitempty"`
+	AmountToFreeShipping  float64 `protobuf:"fixed64,7,opt,name=amountToFreeShipping,proto3" json:"amountToFreeShipping,omitempty"`
+}
+
+func (x *QuoteShippingResponse) Reset() {
+	*x = QuoteShippingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteShippingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteShippingResponse) ProtoMessage() {}
+
+func (x *QuoteShippingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteShippingResponse.ProtoReflect.Descriptor instead.
+func (*QuoteShippingResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *QuoteShippingResponse) GetShippingZoneId() string {
+	if x != nil {
+		return x.ShippingZoneId
+	}
+	return ""
+}
+
+func (x *QuoteShippingResponse) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *QuoteShippingResponse) GetShippingFee() float64 {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return 0
+}
+
+func (x *QuoteShippingResponse) GetWeightGrams() float64 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *QuoteShippingResponse) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *QuoteShippingResponse) GetFreeShippingThreshold() float64 {
+	if x != nil {
+		return x.FreeShippingThreshold
+	}
+	return 0
+}
+
+func (x *QuoteShippingResponse) GetAmountToFreeShipping() float64 {
+	if x != nil {
+		return x.AmountToFreeShipping
+	}
+	return 0
+}
+
+type OrderEventData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderEventId string `protobuf:"bytes,1,opt,name=orderEventId,proto3" json:"orderEventId,omitempty"`
+	OrderId      string `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	EventType    string `protobuf:"bytes,3,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	EventStatus  string `protobuf:"bytes,4,opt,name=eventStatus,proto3" json:"eventStatus,omitempty"`
+	Description  string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Location     string `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Source       string `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	OccurredAt   int64  `protobuf:"varint,8,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+	CreatedBy    string `protobuf:"bytes,9,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt    int64  `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *OrderEventData) Reset() {
+	*x = OrderEventData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEventData) ProtoMessage() {}
+
+func (x *OrderEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEventData.ProtoReflect.Descriptor instead.
+func (*OrderEventData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *OrderEventData) GetOrderEventId() string {
+	if x != nil {
+		return x.OrderEventId
+	}
+	return ""
+}
+
+func (x *OrderEventData) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderEventData) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *OrderEventData) GetEventStatus() string {
+	if x != nil {
+		return x.EventStatus
+	}
+	return ""
+}
+
+func (x *OrderEventData) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OrderEventData) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *OrderEventData) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *OrderEventData) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+func (x *OrderEventData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *OrderEventData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateShipmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId         string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,2,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+}
+
+func (x *CreateShipmentRequest) Reset() {
+	*x = CreateShipmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentRequest) ProtoMessage() {}
+
+func (x *CreateShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *CreateShipmentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type CreateShipmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderData *OrderData `protobuf:"bytes,1,opt,name=orderData,proto3" json:"orderData,omitempty"`
+}
+
+func (x *CreateShipmentResponse) Reset() {
+	*x = CreateShipmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentResponse) ProtoMessage() {}
+
+func (x *CreateShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *CreateShipmentResponse) GetOrderData() *OrderData {
+	if x != nil {
+		return x.OrderData
+	}
+	return nil
+}
+
+type CancelShipmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId         string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,2,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+}
+
+func (x *CancelShipmentRequest) Reset() {
+	*x = CancelShipmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelShipmentRequest) ProtoMessage() {}
+
+func (x *CancelShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CancelShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *CancelShipmentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelShipmentRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type CancelShipmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderData *OrderData `protobuf:"bytes,1,opt,name=orderData,proto3" json:"orderData,omitempty"`
+}
+
+func (x *CancelShipmentResponse) Reset() {
+	*x = CancelShipmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelShipmentResponse) ProtoMessage() {}
+
+func (x *CancelShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelShipmentResponse.ProtoReflect.Descriptor instead.
+func (*CancelShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *CancelShipmentResponse) GetOrderData() *OrderData {
+	if x != nil {
+		return x.OrderData
+	}
+	return nil
+}
+
+type SyncTrackingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+}
+
+func (x *SyncTrackingRequest) Reset() {
+	*x = SyncTrackingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncTrackingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncTrackingRequest) ProtoMessage() {}
+
+func (x *SyncTrackingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncTrackingRequest.ProtoReflect.Descriptor instead.
+func (*SyncTrackingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *SyncTrackingRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type SyncTrackingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderData      *OrderData        `protobuf:"bytes,1,opt,name=orderData,proto3" json:"orderData,omitempty"`
+	OrderEventData []*OrderEventData `protobuf:"bytes,2,rep,name=orderEventData,proto3" json:"orderEventData,omitempty"`
+}
+
+func (x *SyncTrackingResponse) Reset() {
+	*x = SyncTrackingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncTrackingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncTrackingResponse) ProtoMessage() {}
+
+func (x *SyncTrackingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncTrackingResponse.ProtoReflect.Descriptor instead.
+func (*SyncTrackingResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *SyncTrackingResponse) GetOrderData() *OrderData {
+	if x != nil {
+		return x.OrderData
+	}
+	return nil
+}
+
+func (x *SyncTrackingResponse) GetOrderEventData() []*OrderEventData {
+	if x != nil {
+		return x.OrderEventData
+	}
+	return nil
+}
+
+type HandleCourierWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Courier   string `protobuf:"bytes,1,opt,name=courier,proto3" json:"courier,omitempty"`
+	Payload   []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *HandleCourierWebhookRequest) Reset() {
+	*x = HandleCourierWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandleCourierWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleCourierWebhookRequest) ProtoMessage() {}
+
+func (x *HandleCourierWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleCourierWebhookRequest.ProtoReflect.Descriptor instead.
+func (*HandleCourierWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *HandleCourierWebhookRequest) GetCourier() string {
+	if x != nil {
+		return x.Courier
+	}
+	return ""
+}
+
+func (x *HandleCourierWebhookRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *HandleCourierWebhookRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type HandleCourierWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderData []*OrderData `protobuf:"bytes,1,rep,name=orderData,proto3" json:"orderData,omitempty"`
+}
+
+func (x *HandleCourierWebhookResponse) Reset() {
+	*x = HandleCourierWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandleCourierWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleCourierWebhookResponse) ProtoMessage() {}
+
+func (x *HandleCourierWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleCourierWebhookResponse.ProtoReflect.Descriptor instead.
+func (*HandleCourierWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *HandleCourierWebhookResponse) GetOrderData() []*OrderData {
+	if x != nil {
+		return x.OrderData
+	}
+	return nil
+}
+
+type GetOrderTimelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+}
+
+func (x *GetOrderTimelineRequest) Reset() {
+	*x = GetOrderTimelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderTimelineRequest) ProtoMessage() {}
+
+func (x *GetOrderTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *GetOrderTimelineRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetOrderTimelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderEventData []*OrderEventData `protobuf:"bytes,1,rep,name=orderEventData,proto3" json:"orderEventData,omitempty"`
+}
+
+func (x *GetOrderTimelineResponse) Reset() {
+	*x = GetOrderTimelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderTimelineResponse) ProtoMessage() {}
+
+func (x *GetOrderTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *GetOrderTimelineResponse) GetOrderEventData() []*OrderEventData {
+	if x != nil {
+		return x.OrderEventData
+	}
+	return nil
+}
+
+type GetOrderDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId  string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Document string `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+}
+
+func (x *GetOrderDocumentRequest) Reset() {
+	*x = GetOrderDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderDocumentRequest) ProtoMessage() {}
+
+func (x *GetOrderDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *GetOrderDocumentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetOrderDocumentRequest) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+type GetOrderDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pdf      []byte `protobuf:"bytes,1,opt,name=pdf,proto3" json:"pdf,omitempty"`
+	FileName string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
+}
+
+func (x *GetOrderDocumentResponse) Reset() {
+	*x = GetOrderDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderDocumentResponse) ProtoMessage() {}
+
+func (x *GetOrderDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderDocumentResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *GetOrderDocumentResponse) GetPdf() []byte {
+	if x != nil {
+		return x.Pdf
+	}
+	return nil
+}
+
+func (x *GetOrderDocumentResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type GetBulkOrderDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderIds []string `protobuf:"bytes,1,rep,name=orderIds,proto3" json:"orderIds,omitempty"`
+	Document string   `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+}
+
+func (x *GetBulkOrderDocumentRequest) Reset() {
+	*x = GetBulkOrderDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBulkOrderDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBulkOrderDocumentRequest) ProtoMessage() {}
+
+func (x *GetBulkOrderDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBulkOrderDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetBulkOrderDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *GetBulkOrderDocumentRequest) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+func (x *GetBulkOrderDocumentRequest) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+type GetBulkOrderDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pdf      []byte `protobuf:"bytes,1,opt,name=pdf,proto3" json:"pdf,omitempty"`
+	FileName string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
+}
+
+func (x *GetBulkOrderDocumentResponse) Reset() {
+	*x = GetBulkOrderDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBulkOrderDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBulkOrderDocumentResponse) ProtoMessage() {}
+
+func (x *GetBulkOrderDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBulkOrderDocumentResponse.ProtoReflect.Descriptor instead.
+func (*GetBulkOrderDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *GetBulkOrderDocumentResponse) GetPdf() []byte {
+	if x != nil {
+		return x.Pdf
+	}
+	return nil
+}
+
+func (x *GetBulkOrderDocumentResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type BulkOrderResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row         int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	OrderId     string `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	OrderNumber string `protobuf:"bytes,3,opt,name=orderNumber,proto3" json:"orderNumber,omitempty"`
+	Success     bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Error       string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Version     int64  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *BulkOrderResult) Reset() {
+	*x = BulkOrderResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkOrderResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkOrderResult) ProtoMessage() {}
+
+func (x *BulkOrderResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkOrderResult.ProtoReflect.Descriptor instead.
+func (*BulkOrderResult) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *BulkOrderResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *BulkOrderResult) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *BulkOrderResult) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+func (x *BulkOrderResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BulkOrderResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BulkOrderResult) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type BulkUpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderIds    []string `protobuf:"bytes,1,rep,name=orderIds,proto3" json:"orderIds,omitempty"`
+	OrderStatus string   `protobuf:"bytes,2,opt,name=orderStatus,proto3" json:"orderStatus,omitempty"`
+}
+
+func (x *BulkUpdateOrderStatusRequest) Reset() {
+	*x = BulkUpdateOrderStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkUpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *BulkUpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *BulkUpdateOrderStatusRequest) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+func (x *BulkUpdateOrderStatusRequest) GetOrderStatus() string {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return ""
+}
+
+type BulkUpdateOrderStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results   []*BulkOrderResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded int32              `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    int32              `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *BulkUpdateOrderStatusResponse) Reset() {
+	*x = BulkUpdateOrderStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkUpdateOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateOrderStatusResponse) ProtoMessage() {}
+
+func (x *BulkUpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *BulkUpdateOrderStatusResponse) GetResults() []*BulkOrderResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkUpdateOrderStatusResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BulkUpdateOrderStatusResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type BulkAssignTrackingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File        []byte `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	FileName    string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	MarkShipped bool   `protobuf:"varint,3,opt,name=markShipped,proto3" json:"markShipped,omitempty"`
+}
+
+func (x *BulkAssignTrackingRequest) Reset() {
+	*x = BulkAssignTrackingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkAssignTrackingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAssignTrackingRequest) ProtoMessage() {}
+
+func (x *BulkAssignTrackingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAssignTrackingRequest.ProtoReflect.Descriptor instead.
+func (*BulkAssignTrackingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *BulkAssignTrackingRequest) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *BulkAssignTrackingRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *BulkAssignTrackingRequest) GetMarkShipped() bool {
+	if x != nil {
+		return x.MarkShipped
+	}
+	return false
+}
+
+type BulkAssignTrackingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results   []*BulkOrderResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded int32              `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    int32              `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *BulkAssignTrackingResponse) Reset() {
+	*x = BulkAssignTrackingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkAssignTrackingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAssignTrackingResponse) ProtoMessage() {}
+
+func (x *BulkAssignTrackingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAssignTrackingResponse.ProtoReflect.Descriptor instead.
+func (*BulkAssignTrackingResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *BulkAssignTrackingResponse) GetResults() []*BulkOrderResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkAssignTrackingResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BulkAssignTrackingResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type BulkAddOrderNoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderIds []string `protobuf:"bytes,1,rep,name=orderIds,proto3" json:"orderIds,omitempty"`
+	Note     string   `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *BulkAddOrderNoteRequest) Reset() {
+	*x = BulkAddOrderNoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkAddOrderNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAddOrderNoteRequest) ProtoMessage() {}
+
+func (x *BulkAddOrderNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAddOrderNoteRequest.ProtoReflect.Descriptor instead.
+func (*BulkAddOrderNoteRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *BulkAddOrderNoteRequest) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+func (x *BulkAddOrderNoteRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type BulkAddOrderNoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results   []*BulkOrderResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded int32              `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    int32              `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *BulkAddOrderNoteResponse) Reset() {
+	*x = BulkAddOrderNoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkAddOrderNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAddOrderNoteResponse) ProtoMessage() {}
+
+func (x *BulkAddOrderNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAddOrderNoteResponse.ProtoReflect.Descriptor instead.
+func (*BulkAddOrderNoteResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *BulkAddOrderNoteResponse) GetResults() []*BulkOrderResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkAddOrderNoteResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BulkAddOrderNoteResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type ImportOrdersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File          []byte `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	FileName      string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	Channel       string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Preset        string `protobuf:"bytes,4,opt,name=preset,proto3" json:"preset,omitempty"`
+	ColumnMapping string `protobuf:"bytes,5,opt,name=columnMapping,proto3" json:"columnMapping,omitempty"`
+	DryRun        bool   `protobuf:"varint,6,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *ImportOrdersRequest) Reset() {
+	*x = ImportOrdersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrdersRequest) ProtoMessage() {}
+
+func (x *ImportOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ImportOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *ImportOrdersRequest) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ImportOrdersRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ImportOrdersRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ImportOrdersRequest) GetPreset() string {
+	if x != nil {
+		return x.Preset
+	}
+	return ""
+}
+
+func (x *ImportOrdersRequest) GetColumnMapping() string {
+	if x != nil {
+		return x.ColumnMapping
+	}
+	return ""
+}
+
+func (x *ImportOrdersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportRowError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row     int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column  string `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportOrderResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows            []int32           `protobuf:"varint,1,rep,packed,name=rows,proto3" json:"rows,omitempty"`
+	ExternalOrderId string            `protobuf:"bytes,2,opt,name=externalOrderId,proto3" json:"externalOrderId,omitempty"`
+	OrderId         string            `protobuf:"bytes,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	OrderNumber     string            `protobuf:"bytes,4,opt,name=orderNumber,proto3" json:"orderNumber,omitempty"`
+	Outcome         string            `protobuf:"bytes,5,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Errors          []*ImportRowError `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ImportOrderResult) Reset() {
+	*x = ImportOrderResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportOrderResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrderResult) ProtoMessage() {}
+
+func (x *ImportOrderResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrderResult.ProtoReflect.Descriptor instead.
+func (*ImportOrderResult) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *ImportOrderResult) GetRows() []int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportOrderResult) GetExternalOrderId() string {
+	if x != nil {
+		return x.ExternalOrderId
+	}
+	return ""
+}
+
+func (x *ImportOrderResult) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ImportOrderResult) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+func (x *ImportOrderResult) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ImportOrderResult) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ImportOrdersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results  []*ImportOrderResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Imported int32                `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
+	Skipped  int32                `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed   int32                `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	DryRun   bool                 `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *ImportOrdersResponse) Reset() {
+	*x = ImportOrdersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrdersResponse) ProtoMessage() {}
+
+func (x *ImportOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ImportOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *ImportOrdersResponse) GetResults() []*ImportOrderResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportOrdersResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportOrdersResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportOrdersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportOrdersResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportCatalogueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File     []byte `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	FileName string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	DryRun   bool   `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *ImportCatalogueRequest) Reset() {
+	*x = ImportCatalogueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportCatalogueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCatalogueRequest) ProtoMessage() {}
+
+func (x *ImportCatalogueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCatalogueRequest.ProtoReflect.Descriptor instead.
+func (*ImportCatalogueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *ImportCatalogueRequest) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ImportCatalogueRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ImportCatalogueRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportCatalogueResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row     int32             `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Id      string            `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Sku     string            `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name    string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Outcome string            `protobuf:"bytes,5,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Errors  []*ImportRowError `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	Version int64             `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *ImportCatalogueResult) Reset() {
+	*x = ImportCatalogueResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportCatalogueResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCatalogueResult) ProtoMessage() {}
+
+func (x *ImportCatalogueResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCatalogueResult.ProtoReflect.Descriptor instead.
+func (*ImportCatalogueResult) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *ImportCatalogueResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportCatalogueResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportCatalogueResult) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ImportCatalogueResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportCatalogueResult) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ImportCatalogueResult) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportCatalogueResult) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ImportCatalogueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ImportCatalogueResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Created int32                    `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated int32                    `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed  int32                    `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	DryRun  bool                     `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *ImportCatalogueResponse) Reset() {
+	*x = ImportCatalogueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[204]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportCatalogueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCatalogueResponse) ProtoMessage() {}
+
+func (x *ImportCatalogueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[204]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCatalogueResponse.ProtoReflect.Descriptor instead.
+func (*ImportCatalogueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *ImportCatalogueResponse) GetResults() []*ImportCatalogueResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportCatalogueResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportCatalogueResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportCatalogueResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportCatalogueResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type UploadImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File     []byte `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	FileName string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	Kind     string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *UploadImageRequest) Reset() {
+	*x = UploadImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[205]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageRequest) ProtoMessage() {}
+
+func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[205]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadImageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *UploadImageRequest) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *UploadImageRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *UploadImageRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type ImageThumbnail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size   int32  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Key    string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Url    string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Width  int32  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *ImageThumbnail) Reset() {
+	*x = ImageThumbnail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[206]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageThumbnail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageThumbnail) ProtoMessage() {}
+
+func (x *ImageThumbnail) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[206]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageThumbnail.ProtoReflect.Descriptor instead.
+func (*ImageThumbnail) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *ImageThumbnail) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ImageThumbnail) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ImageThumbnail) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImageThumbnail) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageThumbnail) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type UploadedImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key         string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Url         string            `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	ContentType string            `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Width       int32             `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height      int32             `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Size        int64             `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Thumbnails  []*ImageThumbnail `protobuf:"bytes,7,rep,name=thumbnails,proto3" json:"thumbnails,omitempty"`
+}
+
+func (x *UploadedImage) Reset() {
+	*x = UploadedImage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[207]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadedImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadedImage) ProtoMessage() {}
+
+func (x *UploadedImage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[207]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadedImage.ProtoReflect.Descriptor instead.
+func (*UploadedImage) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *UploadedImage) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UploadedImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UploadedImage) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadedImage) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *UploadedImage) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *UploadedImage) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadedImage) GetThumbnails() []*ImageThumbnail {
+	if x != nil {
+		return x.Thumbnails
+	}
+	return nil
+}
+
+type UploadImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image *UploadedImage `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *UploadImageResponse) Reset() {
+	*x = UploadImageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[208]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageResponse) ProtoMessage() {}
+
+func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[208]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
+func (*UploadImageResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *UploadImageResponse) GetImage() *UploadedImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type ProductAttributeData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttributeId     string `protobuf:"bytes,1,opt,name=attributeId,proto3" json:"attributeId,omitempty"`
+	AttributeCode   string `protobuf:"bytes,2,opt,name=attributeCode,proto3" json:"attributeCode,omitempty"`
+	AttributeName   string `protobuf:"bytes,3,opt,name=attributeName,proto3" json:"attributeName,omitempty"`
+	AttributeType   string `protobuf:"bytes,4,opt,name=attributeType,proto3" json:"attributeType,omitempty"`
+	Unit            string `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	Options         string `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
+	Categories      string `protobuf:"bytes,7,opt,name=categories,proto3" json:"categories,omitempty"`
+	IsRequired      bool   `protobuf:"varint,8,opt,name=isRequired,proto3" json:"isRequired,omitempty"`
+	SortOrder       int64  `protobuf:"varint,9,opt,name=sortOrder,proto3" json:"sortOrder,omitempty"`
+	AttributeStatus string `protobuf:"bytes,10,opt,name=attributeStatus,proto3" json:"attributeStatus,omitempty"`
+	CreatedBy       string `protobuf:"bytes,11,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt       int64  `protobuf:"varint,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedBy       string `protobuf:"bytes,13,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedAt       int64  `protobuf:"varint,14,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *ProductAttributeData) Reset() {
+	*x = ProductAttributeData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[209]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductAttributeData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductAttributeData) ProtoMessage() {}
+
+func (x *ProductAttributeData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[209]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductAttributeData.ProtoReflect.Descriptor instead.
+func (*ProductAttributeData) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *ProductAttributeData) GetAttributeId() string {
+	if x != nil {
+		return x.AttributeId
+	}
+	return ""
+}
+
+func (x *ProductAttributeData) GetAttributeCode() string {
+	if x != nil {
+		return x.AttributeCode
+	}
+	return ""
+}
+
+func (x *ProductAttributeData) GetAttributeName() string {
+	if x != nil {
+		return x.AttributeName
+	}
+	return ""
+}
+
+func (x *ProductAttributeData) GetAttributeType() string {
+	if x != nil {
+		return x.AttributeType
+	}
+	return ""
+}
+
+func (x *ProductAttributeData) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ProductAttributeData) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+func (x *ProductAttributeData) GetCategories() string {
+	if x != nil {
+		return x.Categories
+	}
+	return ""
+}
+
+func (x *ProductAttributeData) GetIsRequired() bool {
+	if x != nil {
+		return x.IsRequired
+	}
+	return false
+}
+
+func (x *ProductAttributeData) GetSortOrder() int64 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *ProductAttributeData) GetAttributeStatus() string {
+	if x != nil {
+		return x.AttributeStatus
+	}
+	return ""
+}
+
+func (x *ProductAttributeData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ProductAttributeData) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ProductAttributeData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *ProductAttributeData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SaveProductAttributeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttributeCode   string `protobuf:"bytes,1,opt,name=attributeCode,proto3" json:"attributeCode,omitempty"`
+	AttributeName   string `protobuf:"bytes,2,opt,name=attributeName,proto3" json:"attributeName,omitempty"`
+	AttributeType   string `protobuf:"bytes,3,opt,name=attributeType,proto3" json:"attributeType,omitempty"`
+	Unit            string `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	Options         string `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	Categories      string `protobuf:"bytes,6,opt,name=categories,proto3" json:"categories,omitempty"`
+	IsRequired      bool   `protobuf:"varint,7,opt,name=isRequired,proto3" json:"isRequired,omitempty"`
+	SortOrder       int64  `protobuf:"varint,8,opt,name=sortOrder,proto3" json:"sortOrder,omitempty"`
+	AttributeStatus string `protobuf:"bytes,9,opt,name=attributeStatus,proto3" json:"attributeStatus,omitempty"`
+}
+
+func (x *SaveProductAttributeRequest) Reset() {
+	*x = SaveProductAttributeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[210]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveProductAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveProductAttributeRequest) ProtoMessage() {}
+
+func (x *SaveProductAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[210]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveProductAttributeRequest.ProtoReflect.Descriptor instead.
+func (*SaveProductAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *SaveProductAttributeRequest) GetAttributeCode() string {
+	if x != nil {
+		return x.AttributeCode
+	}
+	return ""
+}
+
+func (x *SaveProductAttributeRequest) GetAttributeName() string {
+	if x != nil {
+		return x.AttributeName
+	}
+	return ""
+}
+
+func (x *SaveProductAttributeRequest) GetAttributeType() string {
+	if x != nil {
+		return x.AttributeType
+	}
+	return ""
+}
+
+func (x *SaveProductAttributeRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *SaveProductAttributeRequest) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+func (x *SaveProductAttributeRequest) GetCategories() string {
+	if x != nil {
+		return x.Categories
+	}
+	return ""
+}
+
+func (x *SaveProductAttributeRequest) GetIsRequired() bool {
+	if x != nil {
+		return x.IsRequired
+	}
+	return false
+}
+
+func (x *SaveProductAttributeRequest) GetSortOrder() int64 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *SaveProductAttributeRequest) GetAttributeStatus() string {
+	if x != nil {
+		return x.AttributeStatus
+	}
+	return ""
+}
+
+type SaveProductAttributeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductAttributeData *ProductAttributeData `protobuf:"bytes,1,opt,name=productAttributeData,proto3" json:"productAttributeData,omitempty"`
+}
+
+func (x *SaveProductAttributeResponse) Reset() {
+	*x = SaveProductAttributeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[211]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveProductAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveProductAttributeResponse) ProtoMessage() {}
+
+func (x *SaveProductAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[211]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveProductAttributeResponse.ProtoReflect.Descriptor instead.
+func (*SaveProductAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *SaveProductAttributeResponse) GetProductAttributeData() *ProductAttributeData {
+	if x != nil {
+		return x.ProductAttributeData
+	}
+	return nil
+}
+
+type GetAllProductAttributeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Search          string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	AttributeStatus string `protobuf:"bytes,2,opt,name=attributeStatus,proto3" json:"attributeStatus,omitempty"`
+	Category        string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *GetAllProductAttributeRequest) Reset() {
+	*x = GetAllProductAttributeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[212]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllProductAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllProductAttributeRequest) ProtoMessage() {}
+
+func (x *GetAllProductAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[212]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllProductAttributeRequest.ProtoReflect.Descriptor instead.
+func (*GetAllProductAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *GetAllProductAttributeRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetAllProductAttributeRequest) GetAttributeStatus() string {
+	if x != nil {
+		return x.AttributeStatus
+	}
+	return ""
+}
+
+func (x *GetAllProductAttributeRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type GetAllProductAttributeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductAttributeData []*ProductAttributeData `protobuf:"bytes,1,rep,name=productAttributeData,proto3" json:"productAttributeData,omitempty"`
+}
+
+func (x *GetAllProductAttributeResponse) Reset() {
+	*x = GetAllProductAttributeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[213]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllProductAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllProductAttributeResponse) ProtoMessage() {}
+
+func (x *GetAllProductAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[213]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllProductAttributeResponse.ProtoReflect.Descriptor instead.
+func (*GetAllProductAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{213}
+}
+
+func (x *GetAllProductAttributeResponse) GetProductAttributeData() []*ProductAttributeData {
+	if x != nil {
+		return x.ProductAttributeData
+	}
+	return nil
+}
+
+type UpdateProductAttributeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttributeId   string   `protobuf:"bytes,1,opt,name=attributeId,proto3" json:"attributeId,omitempty"`
+	AttributeName string   `protobuf:"bytes,2,opt,name=attributeName,proto3" json:"attributeName,omitempty"`
+	AttributeType string   `protobuf:"bytes,3,opt,name=attributeType,proto3" json:"attributeType,omitempty"`
+	Unit          string   `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	Options       string   `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	Categories    string   `protobuf:"bytes,6,opt,name=categories,proto3" json:"categories,omitempty"`
+	IsRequired    bool     `protobuf:"varint,7,opt,name=isRequired,proto3" json:"isRequired,omitempty"`
+	SortOrder     int64    `protobuf:"varint,8,opt,name=sortOrder,proto3" json:"sortOrder,omitempty"`
+	UpdateMask    []string `protobuf:"bytes,9,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+}
+
+func (x *UpdateProductAttributeRequest) Reset() {
+	*x = UpdateProductAttributeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[214]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProductAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductAttributeRequest) ProtoMessage() {}
+
+func (x *UpdateProductAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[214]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductAttributeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *UpdateProductAttributeRequest) GetAttributeId() string {
+	if x != nil {
+		return x.AttributeId
+	}
+	return ""
+}
+
+func (x *UpdateProductAttributeRequest) GetAttributeName() string {
+	if x != nil {
+		return x.AttributeName
+	}
+	return ""
+}
+
+func (x *UpdateProductAttributeRequest) GetAttributeType() string {
+	if x != nil {
+		return x.AttributeType
+	}
+	return ""
+}
+
+func (x *UpdateProductAttributeRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *UpdateProductAttributeRequest) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+func (x *UpdateProductAttributeRequest) GetCategories() string {
+	if x != nil {
+		return x.Categories
+	}
+	return ""
+}
+
+func (x *UpdateProductAttributeRequest) GetIsRequired() bool {
+	if x != nil {
+		return x.IsRequired
+	}
+	return false
+}
+
+func (x *UpdateProductAttributeRequest) GetSortOrder() int64 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *UpdateProductAttributeRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateProductAttributeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductAttributeData *ProductAttributeData `protobuf:"bytes,1,opt,name=productAttributeData,proto3" json:"productAttributeData,omitempty"`
+}
+
+func (x *UpdateProductAttributeResponse) Reset() {
+	*x = UpdateProductAttributeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[215]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProductAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductAttributeResponse) ProtoMessage() {}
+
+func (x *UpdateProductAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[215]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductAttributeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *UpdateProductAttributeResponse) GetProductAttributeData() *ProductAttributeData {
+	if x != nil {
+		return x.ProductAttributeData
+	}
+	return nil
+}
+
+type UpdateProductAttributeStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttributeId     string `protobuf:"bytes,1,opt,name=attributeId,proto3" json:"attributeId,omitempty"`
+	AttributeStatus string `protobuf:"bytes,2,opt,name=attributeStatus,proto3" json:"attributeStatus,omitempty"`
+}
+
+func (x *UpdateProductAttributeStatusRequest) Reset() {
+	*x = UpdateProductAttributeStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[216]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProductAttributeStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductAttributeStatusRequest) ProtoMessage() {}
+
+func (x *UpdateProductAttributeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[216]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductAttributeStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductAttributeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{216}
+}
+
+func (x *UpdateProductAttributeStatusRequest) GetAttributeId() string {
+	if x != nil {
+		return x.AttributeId
+	}
+	return ""
+}
+
+func (x *UpdateProductAttributeStatusRequest) GetAttributeStatus() string {
+	if x != nil {
+		return x.AttributeStatus
+	}
+	return ""
+}
+
+type UpdateProductAttributeStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductAttributeData *ProductAttributeData `protobuf:"bytes,1,opt,name=productAttributeData,proto3" json:"productAttributeData,omitempty"`
+}
+
+func (x *UpdateProductAttributeStatusResponse) Reset() {
+	*x = UpdateProductAttributeStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[217]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProductAttributeStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductAttributeStatusResponse) ProtoMessage() {}
+
+func (x *UpdateProductAttributeStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[217]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductAttributeStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductAttributeStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *UpdateProductAttributeStatusResponse) GetProductAttributeData() *ProductAttributeData {
+	if x != nil {
+		return x.ProductAttributeData
+	}
+	return nil
+}
+
+type DeleteProductAttributeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttributeId string `protobuf:"bytes,1,opt,name=attributeId,proto3" json:"attributeId,omitempty"`
+}
+
+func (x *DeleteProductAttributeRequest) Reset() {
+	*x = DeleteProductAttributeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[218]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProductAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductAttributeRequest) ProtoMessage() {}
+
+func (x *DeleteProductAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[218]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductAttributeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *DeleteProductAttributeRequest) GetAttributeId() string {
+	if x != nil {
+		return x.AttributeId
+	}
+	return ""
+}
+
+type DeleteProductAttributeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductAttributeData *ProductAttributeData `protobuf:"bytes,1,opt,name=productAttributeData,proto3" json:"productAttributeData,omitempty"`
+}
+
+func (x *DeleteProductAttributeResponse) Reset() {
+	*x = DeleteProductAttributeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[219]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProductAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductAttributeResponse) ProtoMessage() {}
+
+func (x *DeleteProductAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[219]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductAttributeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{219}
+}
+
+func (x *DeleteProductAttributeResponse) GetProductAttributeData() *ProductAttributeData {
+	if x != nil {
+		return x.ProductAttributeData
+	}
+	return nil
+}
+
+type CompareProductsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductIds []string `protobuf:"bytes,1,rep,name=productIds,proto3" json:"productIds,omitempty"`
+}
+
+func (x *CompareProductsRequest) Reset() {
+	*x = CompareProductsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[220]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareProductsRequest) ProtoMessage() {}
+
+func (x *CompareProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[220]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareProductsRequest.ProtoReflect.Descriptor instead.
+func (*CompareProductsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{220}
+}
+
+func (x *CompareProductsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type ComparedProduct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductData     *ProductData `protobuf:"bytes,1,opt,name=productData,proto3" json:"productData,omitempty"`
+	InStock         bool         `protobuf:"varint,2,opt,name=inStock,proto3" json:"inStock,omitempty"`
+	DiscountPercent float64      `protobuf:"fixed64,3,opt,name=discountPercent,proto3" json:"discountPercent,omitempty"`
+}
+
+func (x *ComparedProduct) Reset() {
+	*x = ComparedProduct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[221]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComparedProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparedProduct) ProtoMessage() {}
+
+func (x *ComparedProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[221]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparedProduct.ProtoReflect.Descriptor instead.
+func (*ComparedProduct) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *ComparedProduct) GetProductData() *ProductData {
+	if x != nil {
+		return x.ProductData
+	}
+	return nil
+}
+
+func (x *ComparedProduct) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *ComparedProduct) GetDiscountPercent() float64 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+type ComparisonRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Section string   `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	Code    string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name    string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type    string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Unit    string   `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	Values  []string `protobuf:"bytes,6,rep,name=values,proto3" json:"values,omitempty"`
+	Differs bool     `protobuf:"varint,7,opt,name=differs,proto3" json:"differs,omitempty"`
+	Best    []int32  `protobuf:"varint,8,rep,packed,name=best,proto3" json:"best,omitempty"`
+}
+
+func (x *ComparisonRow) Reset() {
+	*x = ComparisonRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[222]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComparisonRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparisonRow) ProtoMessage() {}
+
+func (x *ComparisonRow) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[222]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparisonRow.ProtoReflect.Descriptor instead.
+func (*ComparisonRow) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *ComparisonRow) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *ComparisonRow) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ComparisonRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ComparisonRow) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ComparisonRow) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ComparisonRow) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *ComparisonRow) GetDiffers() bool {
+	if x != nil {
+		return x.Differs
+	}
+	return false
+}
+
+func (x *ComparisonRow) GetBest() []int32 {
+	if x != nil {
+		return x.Best
+	}
+	return nil
+}
+
+type CompareProductsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Products    []*ComparedProduct `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Rows        []*ComparisonRow   `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	Differences []string           `protobuf:"bytes,3,rep,name=differences,proto3" json:"differences,omitempty"`
+}
+
+func (x *CompareProductsResponse) Reset() {
+	*x = CompareProductsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_chronexdata_proto_msgTypes[223]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareProductsResponse) ProtoMessage() {}
+
+func (x *CompareProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_chronexdata_proto_msgTypes[223]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareProductsResponse.ProtoReflect.Descriptor instead.
+func (*CompareProductsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_chronexdata_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *CompareProductsResponse) GetProducts() []*ComparedProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *CompareProductsResponse) GetRows() []*ComparisonRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *CompareProductsResponse) GetDifferences() []string {
+	if x != nil {
+		return x.Differences
+	}
+	return nil
+}
+
+var File_pkg_pb_chronexdata_proto protoreflect.FileDescriptor
+
+var file_pkg_pb_chronexdata_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x65, 0x78,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x22,
+	0xf7, 0x08, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x6d, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x6d,
+	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x31, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x12, 0x2a, 0x0a, 0x10, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x61, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x61, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x73, 0x41,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x47, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x47, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x43, 0x6d, 0x18, 0x19, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x43, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x43, 0x6d, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x43, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6d, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6d, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b,
+	0x75, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x61, 0x6e, 0x6b, 0x18,
+	0x1d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x61, 0x6e,
+	0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x68, 0x69, 0x67, 0x68,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x1f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x20, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0xac, 0x05, 0x0a, 0x12, 0x53, 0x61,
+	0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x6d, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x69, 0x6d, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x12, 0x2a,
+	0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72,
+	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x47, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x47,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x43, 0x6d,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x43, 0x6d,
+	0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x64, 0x74, 0x68, 0x43, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x07, 0x77, 0x69, 0x64, 0x74, 0x68, 0x43, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x43, 0x6d, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x43, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0xa8, 0x03, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46,
+	0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x38,
+	0x0a, 0x0a, 0x46, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x61, 0x63, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd9, 0x02, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x61,
+	0x63, 0x65, 0x74, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x6e, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x12, 0x2d, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x61, 0x63, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x46, 0x61, 0x63, 0x65, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x22, 0xad, 0x01, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46,
+	0x61, 0x63, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x6e, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x22, 0x8d, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74,
+	0x73, 0x22, 0x38, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0xc0, 0x05, 0x0a,
+	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x6d, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x69, 0x6d, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x12, 0x22, 0x0a,
+	0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x32, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x0e, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12,
+	0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x47, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x47, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x43, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x43, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x43, 0x6d, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x77, 0x69, 0x64, 0x74, 0x68, 0x43,
+	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6d, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6d, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x6b, 0x75, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22,
+	0x4b, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0xdc, 0x01, 0x0a,
+	0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73,
+	0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x1d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x8a, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a,
+	0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0xb6, 0x04, 0x0a, 0x0c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x49, 0x6d, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x66, 0x72, 0x65, 0x65,
+	0x62, 0x69, 0x65, 0x73, 0x49, 0x6d, 0x67, 0x12, 0x2e, 0x0a, 0x12, 0x66, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x12, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x66, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x66, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a,
+	0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x72, 0x65,
+	0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x6d, 0x67, 0x4b, 0x65, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x6d, 0x67, 0x4b, 0x65,
+	0x79, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x6d, 0x67,
+	0x55, 0x72, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x22, 0xe3, 0x02, 0x0a, 0x13, 0x53, 0x61,
+	0x76, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x49, 0x6d, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x66, 0x72, 0x65, 0x65,
+	0x62, 0x69, 0x65, 0x73, 0x49, 0x6d, 0x67, 0x12, 0x2e, 0x0a, 0x12, 0x66, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x12, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x66, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x66, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a,
+	0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x49, 0x6d, 0x67, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x6d, 0x67, 0x4b, 0x65, 0x79, 0x22,
+	0x4d, 0x0a, 0x14, 0x53, 0x61, 0x76, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x4f,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x4f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x72, 0x65,
+	0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x1f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69,
+	0x65, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x57, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x66, 0x72,
+	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3b, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x65,
+	0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c,
+	0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0xd9, 0x02, 0x0a,
+	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69,
+	0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65,
+	0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69,
+	0x65, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72,
+	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72,
+	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x6d, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x6d, 0x67, 0x12, 0x2e, 0x0a, 0x12,
+	0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69,
+	0x65, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75,
+	0x12, 0x26, 0x0a, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x6d, 0x67, 0x4b,
+	0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69,
+	0x65, 0x73, 0x49, 0x6d, 0x67, 0x4b, 0x65, 0x79, 0x22, 0x4f, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46,
+	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x66, 0x72, 0x65,
+	0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0xff, 0x01, 0x0a, 0x1d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
+	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x18, 0x66,
+	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x66,
+	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x66, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69,
+	0x65, 0x73, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73,
+	0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x1e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69,
+	0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x8f, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69,
+	0x65, 0x73, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72,
+	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69,
+	0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0xa1, 0x03,
+	0x0a, 0x0b, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53,
+	0x74, 0x61, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xf8, 0x01, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x72,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x49, 0x0a, 0x13,
+	0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x5c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x6f, 0x72, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x4b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0xbc, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61,
+	0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x4b, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x8a,
+	0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x1b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x38,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0xbf, 0x06, 0x0a, 0x09, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x28, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x69,
+	0x63, 0x6b, 0x79, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x76, 0x6f, 0x75, 0x63,
+	0x68, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x69, 0x64, 0x41, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x69,
+	0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x46,
+	0x65, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x61, 0x6e, 0x6b, 0x18, 0x19, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53,
+	0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x22, 0xf2, 0x01, 0x0a, 0x10,
+	0x53, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x6f, 0x75, 0x63,
+	0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76,
+	0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x22, 0x9d, 0x01, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e,
+	0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x0b,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x78, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x28,
+	0x0a, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x43, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0xd2, 0x02, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x69, 0x63,
+	0x6b, 0x79, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x74, 0x69, 0x63, 0x6b, 0x79, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x43, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x80, 0x01, 0x0a, 0x18, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x19,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x10, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x6f, 0x73, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x47, 0x72, 0x6f, 0x73,
+	0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x60, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x41, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74, 0x53,
+	0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x52, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x42, 0x65,
+	0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x62, 0x65, 0x73,
+	0x74, 0x53, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x0e,
+	0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22,
+	0x0a, 0x0c, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x67, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x31,
+	0x0a, 0x15, 0x53, 0x61, 0x76, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x6d, 0x65, 0x49,
+	0x6d, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d,
+	0x67, 0x22, 0x55, 0x0a, 0x16, 0x53, 0x61, 0x76, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x68,
+	0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x48, 0x6f, 0x6d,
+	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x6f,
+	0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x68, 0x6f,
+	0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x81, 0x01, 0x0a,
+	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x6f, 0x6d, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68,
+	0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x67, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x57, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e,
+	0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3d, 0x0a, 0x17, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x6f, 0x6d, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0x34, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72,
+	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x22, 0x4f, 0x0a,
+	0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x34,
+	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x43, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0xfa, 0x04, 0x0a, 0x10, 0x46, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x66,
+	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6d,
+	0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x65,
+	0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x6c, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x46,
+	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x66, 0x72, 0x65,
+	0x65, 0x62, 0x69, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x70, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xe1, 0x03, 0x0a, 0x17, 0x53, 0x61, 0x76, 0x65, 0x46, 0x72, 0x65, 0x65,
+	0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x69, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d,
+	0x69, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69,
+	0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65,
+	0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x65, 0x72,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x75, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5d, 0x0a, 0x18, 0x53, 0x61, 0x76, 0x65, 0x46,
+	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52,
+	0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x5f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65,
+	0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x10, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x8b, 0x04, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75,
+	0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65,
+	0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x75,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x13, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x6c, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64,
+	0x12, 0x2a, 0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x72, 0x65, 0x65,
+	0x62, 0x69, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x10,
+	0x70, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x6e, 0x64,
+	0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x22, 0x5f, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65,
+	0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x10, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0x69, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65,
+	0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69,
+	0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x65,
+	0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x10, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0xd0, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x72, 0x74, 0x46, 0x72,
+	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69,
+	0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72,
+	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72,
+	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61,
+	0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x6f, 0x75, 0x63,
+	0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0xca, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x75, 0x62,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x61, 0x72, 0x74, 0x46, 0x72, 0x65,
+	0x65, 0x62, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x0c,
+	0x63, 0x61, 0x72, 0x74, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x22, 0xc9,
+	0x04, 0x0a, 0x0b, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x09, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d,
+	0x61, 0x78, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69,
+	0x6e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69,
+	0x6e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x2a, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x65, 0x72, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9a, 0x03, 0x0a, 0x12, 0x53,
+	0x61, 0x76, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x70, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6e, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x49, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x56,
+	0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0x54, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x56, 0x6f, 0x75, 0x63,
+	0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x6f, 0x75,
+	0x63, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x92, 0x03, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2a,
+	0x0a, 0x10, 0x70, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x65, 0x72, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x4b, 0x0a, 0x15, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56,
+	0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x76, 0x6f, 0x75, 0x63,
+	0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x6f, 0x75, 0x63,
+	0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x51, 0x0a, 0x1b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x76, 0x6f, 0x75, 0x63,
+	0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0xc5, 0x03, 0x0a,
+	0x0d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x61, 0x6c, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x61, 0x6c, 0x65, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4f,
+	0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x4f, 0x66, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65,
+	0x6e, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xfa, 0x01, 0x0a, 0x14, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x61, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x61, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4f, 0x66,
+	0x66, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6e, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x51, 0x0a, 0x15, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x38, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x53,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0x9e, 0x02, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x61, 0x6c, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x61, 0x6c, 0x65, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4f,
+	0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x4f, 0x66, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65,
+	0x6e, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x22, 0x53, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x1c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x59, 0x0a, 0x1d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x49, 0x64, 0x22, 0x53, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0xb7, 0x02, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x22, 0x3d, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64,
+	0x22, 0x78, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x17, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0x67, 0x0a, 0x09, 0x54, 0x72,
+	0x61, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x22, 0x43, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x73,
+	0x68, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x72, 0x61, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3d, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x09, 0x74, 0x72, 0x61, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x09, 0x74, 0x72, 0x61, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3b, 0x0a, 0x11, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x12, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x09, 0x74, 0x72, 0x61, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x73, 0x68, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x09, 0x74, 0x72, 0x61, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0xb0, 0x03, 0x0a,
+	0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x4f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x4f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0x38, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x22, 0x7e, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a,
+	0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x85, 0x03, 0x0a, 0x0f,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x46, 0x65,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x49, 0x0a,
+	0x13, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x68, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x49, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x4a, 0x0a,
+	0x18, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x73, 0x0a, 0x19, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x74, 0x6d, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xd7,
+	0x03, 0x0a, 0x0f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f,
+	0x78, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x74,
+	0x6d, 0x6c, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x74,
+	0x6d, 0x6c, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x74,
+	0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x0b,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x78, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f,
+	0x78, 0x44, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x65, 0x6e,
+	0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74,
+	0x62, 0x6f, 0x78, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x7b, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x58, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x44, 0x61, 0x74, 0x61, 0x22, 0xe0, 0x01, 0x0a, 0x18,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x22, 0x35,
+	0x0a, 0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x46,
+	0x6f, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd3, 0x03, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a,
+	0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x24, 0x0a,
+	0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x32, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0xec, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x24, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x61, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x61, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x22, 0xc2,
+	0x01, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x4b, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0xb4, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0x71, 0x0a, 0x1b, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x52, 0x0a,
+	0x1c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0x82, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0e,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5b, 0x0a, 0x11, 0x53,
+	0x61, 0x76, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
+	0x0a, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0x98, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x15, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x6b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x47, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x32, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64, 0x22, 0x79,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x22, 0x99, 0x01, 0x0a, 0x19, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a,
+	0x15, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0xae, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x48, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0xf6, 0x03, 0x0a, 0x10, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a,
+	0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x68, 0x69, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x7a, 0x6f, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x7a, 0x6f, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66,
+	0x6c, 0x61, 0x74, 0x46, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x6c,
+	0x61, 0x74, 0x46, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54,
+	0x69, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x67, 0x12, 0x34, 0x0a,
+	0x15, 0x66, 0x72, 0x65, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x66, 0x72,
+	0x65, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdd, 0x02, 0x0a, 0x17,
+	0x53, 0x61, 0x76, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x7a, 0x6f, 0x6e, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x7a, 0x6f, 0x6e, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x46, 0x65, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x46, 0x65, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x69, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x67,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x46, 0x65, 0x65,
+	0x50, 0x65, 0x72, 0x4b, 0x67, 0x12, 0x34, 0x0a, 0x15, 0x66, 0x72, 0x65, 0x65, 0x53, 0x68, 0x69,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x66, 0x72, 0x65, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x7a,
+	0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5d, 0x0a, 0x18, 0x53,
+	0x61, 0x76, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x73, 0x68, 0x69, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x53, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x1e, 0x0a, 0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x5f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x10, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x68,
+	0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10,
+	0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x87, 0x03, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x7a, 0x6f, 0x6e, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x7a, 0x6f, 0x6e, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x46, 0x65, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x46, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x69, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x67, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x46, 0x65, 0x65, 0x50,
+	0x65, 0x72, 0x4b, 0x67, 0x12, 0x34, 0x0a, 0x15, 0x66, 0x72, 0x65, 0x65, 0x53, 0x68, 0x69, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x15, 0x66, 0x72, 0x65, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x5f, 0x0a, 0x1a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x73, 0x68, 0x69, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x73, 0x68, 0x69, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x69, 0x0a, 0x1f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x7a, 0x6f, 0x6e, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x65, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x73, 0x68,
+	0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x68, 0x69, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x73, 0x68, 0x69,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x43, 0x0a,
+	0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a,
+	0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x68,
+	0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65,
+	0x49, 0x64, 0x22, 0x5f, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x10, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x10, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0x5a, 0x0a, 0x14, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0xa5, 0x02, 0x0a, 0x15, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x68, 0x69,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x7a, 0x6f, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x7a, 0x6f, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x47, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x47, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x75, 0x62, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a,
+	0x15, 0x66, 0x72, 0x65, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x66, 0x72,
+	0x65, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x46,
+	0x72, 0x65, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x14, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x46, 0x72, 0x65, 0x65, 0x53,
+	0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x22, 0xc0, 0x02, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5b, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a,
+	0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0x5b, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x16,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x2f, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x14, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x0e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0x6f, 0x0a, 0x1b, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72,
+	0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x69,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4c, 0x0a, 0x1c, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x33, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x57, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0x4f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x64, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x70, 0x64, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x55, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4c, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42,
+	0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x64, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x70, 0x64, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x0f, 0x42, 0x75, 0x6c, 0x6b, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f,
+	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x5c, 0x0a, 0x1c, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x85, 0x01, 0x0a, 0x1d, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x6d, 0x0a, 0x19, 0x42, 0x75, 0x6c, 0x6b,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x68, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b,
+	0x53, 0x68, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x1a, 0x42, 0x75, 0x6c, 0x6b,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75,
+	0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x49, 0x0a, 0x17,
+	0x42, 0x75, 0x6c, 0x6b, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x18, 0x42, 0x75, 0x6c, 0x6b,
+	0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75, 0x6c, 0x6b,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x13, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x22, 0x54, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x11, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f,
+	0x77, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22,
+	0xae, 0x01, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0x60, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x22, 0xc0, 0x01, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x2b,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb3, 0x01, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x58, 0x0a, 0x12, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x76, 0x0a, 0x0e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x68,
+	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xcc, 0x01,
+	0x0a, 0x0d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x74, 0x68, 0x75, 0x6d, 0x62,
+	0x6e, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c,
+	0x52, 0x0a, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x3f, 0x0a, 0x13,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0xd8, 0x03,
+	0x0a, 0x14, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e,
+	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x6f, 0x72,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc5, 0x02, 0x0a, 0x1b, 0x53, 0x61, 0x76,
+	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x6f, 0x72,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x6d, 0x0a, 0x1c, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x14, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0x7d, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x6f,
+	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x14, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0xb9, 0x02, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x6e, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x6f, 0x0a, 0x1e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
+	0x14, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x14, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x71, 0x0a, 0x23,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x75, 0x0a, 0x24, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x14, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x41, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x1e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x14, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x14, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x38, 0x0a, 0x16, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x64, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07,
+	0x69, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69,
+	0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x22, 0xbf, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52,
+	0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x62, 0x65,
+	0x73, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
+	0x12, 0x26, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52,
+	0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x66, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2a, 0x94, 0x02, 0x0a, 0x11, 0x53,
+	0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x4f, 0x5a,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x5a, 0x54,
+	0x4f, 0x41, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f,
+	0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x54, 0x4f, 0x5f, 0x4c, 0x4f,
+	0x57, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x50,
+	0x52, 0x49, 0x43, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x4f, 0x5f, 0x48, 0x49, 0x47, 0x48,
+	0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x51, 0x55,
+	0x41, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x54, 0x4f, 0x5f, 0x4c,
+	0x4f, 0x57, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f,
+	0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x4f, 0x5f,
+	0x48, 0x49, 0x47, 0x48, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43,
+	0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f,
+	0x54, 0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x06, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x4f, 0x44,
+	0x55, 0x43, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x57,
+	0x5f, 0x54, 0x4f, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52,
+	0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x56, 0x41, 0x4e, 0x43, 0x45, 0x10,
+	0x08, 0x2a, 0x82, 0x01, 0x0a, 0x0a, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x08, 0x0a, 0x04, 0x41, 0x54, 0x4f, 0x5a, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x5a, 0x54,
+	0x4f, 0x41, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x49,
+	0x47, 0x48, 0x5f, 0x54, 0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x50,
+	0x52, 0x49, 0x43, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x4f, 0x5f, 0x48, 0x49, 0x47, 0x48,
+	0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x48,
+	0x49, 0x47, 0x48, 0x5f, 0x54, 0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14,
+	0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x4f, 0x5f,
+	0x48, 0x49, 0x47, 0x48, 0x10, 0x05, 0x2a, 0xb3, 0x01, 0x0a, 0x11, 0x53, 0x6f, 0x72, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x10, 0x0a, 0x0c,
+	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x53, 0x5f, 0x41, 0x54, 0x4f, 0x5a, 0x10, 0x00, 0x12, 0x10,
+	0x0a, 0x0c, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x53, 0x5f, 0x5a, 0x54, 0x4f, 0x41, 0x10, 0x01,
+	0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x53, 0x5f, 0x52, 0x41, 0x54, 0x49,
+	0x4e, 0x47, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x54, 0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x02,
+	0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x53, 0x5f, 0x52, 0x41, 0x54, 0x49,
+	0x4e, 0x47, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x4f, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x03,
+	0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x53, 0x5f, 0x44, 0x41, 0x54, 0x45,
+	0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x54, 0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x04, 0x12, 0x1c,
+	0x0a, 0x18, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x53, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4c,
+	0x4f, 0x57, 0x5f, 0x54, 0x4f, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x05, 0x2a, 0x7e, 0x0a, 0x0f,
+	0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x0a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x54, 0x4f, 0x5a, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x5a, 0x54, 0x4f, 0x41, 0x10, 0x01, 0x12,
+	0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x48, 0x49,
+	0x47, 0x48, 0x5f, 0x54, 0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x4f,
+	0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x52, 0x45, 0x4c, 0x45, 0x56, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x04, 0x32, 0xcc, 0x3e, 0x0a,
+	0x18, 0x43, 0x68, 0x72, 0x6f, 0x6e, 0x65, 0x78, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x53, 0x61, 0x76,
+	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a,
+	0x0c, 0x53, 0x61, 0x76, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x12, 0x18, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61,
+	0x76, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65, 0x48, 0x6f,
+	0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x61, 0x76, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x48,
+	0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65,
+	0x65, 0x62, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72,
+	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x63, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69,
+	0x65, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x44,
+	0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62,
+	0x69, 0x65, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x48, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x48, 0x6f,
+	0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x48, 0x6f, 0x6d, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69,
+	0x65, 0x73, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x79, 0x49, 0x64, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x1a, 0x1e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x49, 0x64, 0x22, 0x00, 0x12,
+	0x54, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x42, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x79, 0x49, 0x64, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x79, 0x49, 0x64, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x63, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65,
+	0x62, 0x69, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x22, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65,
+	0x65, 0x62, 0x69, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65,
+	0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72,
+	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x19, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51,
+	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48,
+	0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d,
+	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x51, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x48, 0x6f, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b,
+	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
+	0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x65,
+	0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12,
+	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74,
+	0x53, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12,
+	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x73,
+	0x74, 0x53, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x53, 0x61,
+	0x76, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x69, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65,
+	0x73, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x62, 0x69, 0x65, 0x73,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72,
+	0x65, 0x65, 0x62, 0x69, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42,
+	0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x12, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76,
+	0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x56, 0x6f, 0x75, 0x63,
+	0x68, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x56, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x12, 0x19, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x15,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61,
+	0x73, 0x68, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54,
+	0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54,
+	0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x0a, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x12, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x12,
+	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x42, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x12,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x54, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x78, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x6e,
+	0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5d, 0x0a, 0x14, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x0a, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x48, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x48, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x79, 0x49,
+	0x64, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x79, 0x49, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x0d, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x53,
+	0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x61, 0x76, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65,
+	0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x68, 0x69,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x68, 0x69,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x18,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f,
+	0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x48, 0x0a, 0x0d, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x68, 0x69,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x14, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x60, 0x0a, 0x15, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42,
+	0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x57, 0x0a, 0x12, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75,
+	0x6c, 0x6b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75,
+	0x6c, 0x6b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x42, 0x75,
+	0x6c, 0x6b, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a,
+	0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x65,
+	0x65, 0x62, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x14, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12,
+	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x16, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x75, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x1b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x2e,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_pb_chronexdata_proto_rawDescOnce sync.Once
+	file_pkg_pb_chronexdata_proto_rawDescData = file_pkg_pb_chronexdata_proto_rawDesc
+)
+
+func file_pkg_pb_chronexdata_proto_rawDescGZIP() []byte {
+	file_pkg_pb_chronexdata_proto_rawDescOnce.Do(func() {
+		file_pkg_pb_chronexdata_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_pb_chronexdata_proto_rawDescData)
+	})
+	return file_pkg_pb_chronexdata_proto_rawDescData
+}
+
+var file_pkg_pb_chronexdata_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_pkg_pb_chronexdata_proto_msgTypes = make([]protoimpl.MessageInfo, 224)
+var file_pkg_pb_chronexdata_proto_goTypes = []interface{}{
+	(SortOptionProduct)(0),                       // 0: api.SortOptionProduct
+	(SortOption)(0),                              // 1: api.SortOption
+	(SortOptionReviews)(0),                       // 2: api.SortOptionReviews
+	(SortOptionOrder)(0),                         // 3: api.SortOptionOrder
+	(*ProductData)(nil),                          // 4: api.ProductData
+	(*SaveProductRequest)(nil),                   // 5: api.SaveProductRequest
+	(*SaveProductResponse)(nil),                  // 6: api.SaveProductResponse
+	(*GetAllProductRequest)(nil),                 // 7: api.GetAllProductRequest
+	(*FacetCount)(nil),                           // 8: api.FacetCount
+	(*PriceRangeFacet)(nil),                      // 9: api.PriceRangeFacet
+	(*ProductFacets)(nil),                        // 10: api.ProductFacets
+	(*AttributeFacet)(nil),                       // 11: api.AttributeFacet
+	(*GetAllProductResponse)(nil),                // 12: api.GetAllProductResponse
+	(*GetAllProductRequestById)(nil),             // 13: api.GetAllProductRequestById
+	(*GetAllProductResponseById)(nil),            // 14: api.GetAllProductResponseById
+	(*UpdateProductRequest)(nil),                 // 15: api.UpdateProductRequest
+	(*UpdateProductResponse)(nil),                // 16: api.UpdateProductResponse
+	(*UpdateProductQuantityRequest)(nil),         // 17: api.UpdateProductQuantityRequest
+	(*UpdateProductQuantityResponse)(nil),        // 18: api.UpdateProductQuantityResponse
+	(*UpdateProductStatusRequest)(nil),           // 19: api.UpdateProductStatusRequest
+	(*UpdateProductStatusResponse)(nil),          // 20: api.UpdateProductStatusResponse
+	(*FreebiesData)(nil),                         // 21: api.FreebiesData
+	(*SaveFreebiesRequest)(nil),                  // 22: api.SaveFreebiesRequest
+	(*SaveFreebiesResponse)(nil),                 // 23: api.SaveFreebiesResponse
+	(*GetAllFreebiesRequest)(nil),                // 24: api.GetAllFreebiesRequest
+	(*GetAllFreebiesResponse)(nil),               // 25: api.GetAllFreebiesResponse
+	(*GetAllFreebiesDropdownRequest)(nil),        // 26: api.GetAllFreebiesDropdownRequest
+	(*GetAllFreebiesDropdownResponse)(nil),       // 27: api.GetAllFreebiesDropdownResponse
+	(*GetAllFreebiesRequestById)(nil),            // 28: api.GetAllFreebiesRequestById
+	(*GetAllFreebiesResponseById)(nil),           // 29: api.GetAllFreebiesResponseById
+	(*UpdateFreebiesRequest)(nil),                // 30: api.UpdateFreebiesRequest
+	(*UpdateFreebiesResponse)(nil),               // 31: api.UpdateFreebiesResponse
+	(*UpdateFreebiesQuantityRequest)(nil),        // 32: api.UpdateFreebiesQuantityRequest
+	(*UpdateFreebiesQuantityResponse)(nil),       // 33: api.UpdateFreebiesQuantityResponse
+	(*UpdateFreebiesStatusRequest)(nil),          // 34: api.UpdateFreebiesStatusRequest
+	(*UpdateFreebiesStatusResponse)(nil),         // 35: api.UpdateFreebiesStatusResponse
+	(*ReviewsData)(nil),                          // 36: api.ReviewsData
+	(*SaveReviewsRequest)(nil),                   // 37: api.SaveReviewsRequest
+	(*SaveReviewsResponse)(nil),                  // 38: api.SaveReviewsResponse
+	(*GetAllReviewsRequest)(nil),                 // 39: api.GetAllReviewsRequest
+	(*GetAllReviewsResponse)(nil),                // 40: api.GetAllReviewsResponse
+	(*UpdateReviewsRequest)(nil),                 // 41: api.UpdateReviewsRequest
+	(*UpdateReviewsResponse)(nil),                // 42: api.UpdateReviewsResponse
+	(*UpdateReviewsStatusRequest)(nil),           // 43: api.UpdateReviewsStatusRequest
+	(*UpdateReviewsStatusResponse)(nil),          // 44: api.UpdateReviewsStatusResponse
+	(*GetAllReviewsRequestById)(nil),             // 45: api.GetAllReviewsRequestById
+	(*GetAllReviewsResponseById)(nil),            // 46: api.GetAllReviewsResponseById
+	(*OrderData)(nil),                            // 47: api.OrderData
+	(*SaveOrderRequest)(nil),                     // 48: api.SaveOrderRequest
+	(*SaveOrderResponse)(nil),                    // 49: api.SaveOrderResponse
+	(*GetAllOrderRequest)(nil),                   // 50: api.GetAllOrderRequest
+	(*GetAllOrderResponse)(nil),                  // 51: api.GetAllOrderResponse
+	(*UpdateOrderRequest)(nil),                   // 52: api.UpdateOrderRequest
+	(*UpdateOrderResponse)(nil),                  // 53: api.UpdateOrderResponse
+	(*UpdateOrderStatusRequest)(nil),             // 54: api.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil),            // 55: api.UpdateOrderStatusResponse
+	(*GetAllOrderRevenueRequest)(nil),            // 56: api.GetAllOrderRevenueRequest
+	(*GetAllOrderRevenueResponse)(nil),           // 57: api.GetAllOrderRevenueResponse
+	(*GetAllTotalOrderRequest)(nil),              // 58: api.GetAllTotalOrderRequest
+	(*GetAllTotalOrderResponse)(nil),             // 59: api.GetAllTotalOrderResponse
+	(*GetBestSellingProductsRequest)(nil),        // 60: api.GetBestSellingProductsRequest
+	(*GetBestSellingProductsResponse)(nil),       // 61: api.GetBestSellingProductsResponse
+	(*HomeImagesData)(nil),                       // 62: api.HomeImagesData
+	(*SaveHomeImagesRequest)(nil),                // 63: api.SaveHomeImagesRequest
+	(*SaveHomeImagesResponse)(nil),               // 64: api.SaveHomeImagesResponse
+	(*GetAllHomeImagesRequest)(nil),              // 65: api.GetAllHomeImagesRequest
+	(*GetAllHomeImagesResponse)(nil),             // 66: api.GetAllHomeImagesResponse
+	(*UpdateHomeImagesRequest)(nil),              // 67: api.UpdateHomeImagesRequest
+	(*UpdateHomeImagesResponse)(nil),             // 68: api.UpdateHomeImagesResponse
+	(*DeleteHomeImagesRequest)(nil),              // 69: api.DeleteHomeImagesRequest
+	(*DeleteHomeImagesResponse)(nil),             // 70: api.DeleteHomeImagesResponse
+	(*DeleteProductRequest)(nil),                 // 71: api.DeleteProductRequest
+	(*DeleteProductResponse)(nil),                // 72: api.DeleteProductResponse
+	(*DeleteFreebiesRequest)(nil),                // 73: api.DeleteFreebiesRequest
+	(*DeleteFreebiesResponse)(nil),               // 74: api.DeleteFreebiesResponse
+	(*DeleteReviewsRequest)(nil),                 // 75: api.DeleteReviewsRequest
+	(*DeleteReviewsResponse)(nil),                // 76: api.DeleteReviewsResponse
+	(*DeleteOrderRequest)(nil),                   // 77: api.DeleteOrderRequest
+	(*DeleteOrderResponse)(nil),                  // 78: api.DeleteOrderResponse
+	(*FreebiesRuleData)(nil),                     // 79: api.FreebiesRuleData
+	(*SaveFreebiesRuleRequest)(nil),              // 80: api.SaveFreebiesRuleRequest
+	(*SaveFreebiesRuleResponse)(nil),             // 81: api.SaveFreebiesRuleResponse
+	(*GetAllFreebiesRuleRequest)(nil),            // 82: api.GetAllFreebiesRuleRequest
+	(*GetAllFreebiesRuleResponse)(nil),           // 83: api.GetAllFreebiesRuleResponse
+	(*UpdateFreebiesRuleRequest)(nil),            // 84: api.UpdateFreebiesRuleRequest
+	(*UpdateFreebiesRuleResponse)(nil),           // 85: api.UpdateFreebiesRuleResponse
+	(*UpdateFreebiesRuleStatusRequest)(nil),      // 86: api.UpdateFreebiesRuleStatusRequest
+	(*UpdateFreebiesRuleStatusResponse)(nil),     // 87: api.UpdateFreebiesRuleStatusResponse
+	(*CartFreebies)(nil),                         // 88: api.CartFreebies
+	(*PreviewCartRequest)(nil),                   // 89: api.PreviewCartRequest
+	(*PreviewCartResponse)(nil),                  // 90: api.PreviewCartResponse
+	(*VoucherData)(nil),                          // 91: api.VoucherData
+	(*SaveVoucherRequest)(nil),                   // 92: api.SaveVoucherRequest
+	(*SaveVoucherResponse)(nil),                  // 93: api.SaveVoucherResponse
+	(*GetAllVoucherRequest)(nil),                 // 94: api.GetAllVoucherRequest
+	(*GetAllVoucherResponse)(nil),                // 95: api.GetAllVoucherResponse
+	(*UpdateVoucherRequest)(nil),                 // 96: api.UpdateVoucherRequest
+	(*UpdateVoucherResponse)(nil),                // 97: api.UpdateVoucherResponse
+	(*UpdateVoucherStatusRequest)(nil),           // 98: api.UpdateVoucherStatusRequest
+	(*UpdateVoucherStatusResponse)(nil),          // 99: api.UpdateVoucherStatusResponse
+	(*PriceRuleData)(nil),                        // 100: api.PriceRuleData
+	(*SavePriceRuleRequest)(nil),                 // 101: api.SavePriceRuleRequest
+	(*SavePriceRuleResponse)(nil),                // 102: api.SavePriceRuleResponse
+	(*GetAllPriceRuleRequest)(nil),               // 103: api.GetAllPriceRuleRequest
+	(*GetAllPriceRuleResponse)(nil),              // 104: api.GetAllPriceRuleResponse
+	(*UpdatePriceRuleRequest)(nil),               // 105: api.UpdatePriceRuleRequest
+	(*UpdatePriceRuleResponse)(nil),              // 106: api.UpdatePriceRuleResponse
+	(*UpdatePriceRuleStatusRequest)(nil),         // 107: api.UpdatePriceRuleStatusRequest
+	(*UpdatePriceRuleStatusResponse)(nil),        // 108: api.UpdatePriceRuleStatusResponse
+	(*DeletePriceRuleRequest)(nil),               // 109: api.DeletePriceRuleRequest
+	(*DeletePriceRuleResponse)(nil),              // 110: api.DeletePriceRuleResponse
+	(*ProductPriceHistoryData)(nil),              // 111: api.ProductPriceHistoryData
+	(*GetProductPriceHistoryRequest)(nil),        // 112: api.GetProductPriceHistoryRequest
+	(*GetProductPriceHistoryResponse)(nil),       // 113: api.GetProductPriceHistoryResponse
+	(*TrashData)(nil),                            // 114: api.TrashData
+	(*GetAllTrashRequest)(nil),                   // 115: api.GetAllTrashRequest
+	(*GetAllTrashResponse)(nil),                  // 116: api.GetAllTrashResponse
+	(*RestoreTrashRequest)(nil),                  // 117: api.RestoreTrashRequest
+	(*RestoreTrashResponse)(nil),                 // 118: api.RestoreTrashResponse
+	(*PurgeTrashRequest)(nil),                    // 119: api.PurgeTrashRequest
+	(*PurgeTrashResponse)(nil),                   // 120: api.PurgeTrashResponse
+	(*CustomerData)(nil),                         // 121: api.CustomerData
+	(*GetAllCustomerRequest)(nil),                // 122: api.GetAllCustomerRequest
+	(*GetAllCustomerResponse)(nil),               // 123: api.GetAllCustomerResponse
+	(*GetCustomerByIdRequest)(nil),               // 124: api.GetCustomerByIdRequest
+	(*GetCustomerByIdResponse)(nil),              // 125: api.GetCustomerByIdResponse
+	(*PublicOrderData)(nil),                      // 126: api.PublicOrderData
+	(*LookupOrderRequest)(nil),                   // 127: api.LookupOrderRequest
+	(*LookupOrderResponse)(nil),                  // 128: api.LookupOrderResponse
+	(*CancelOrderRequest)(nil),                   // 129: api.CancelOrderRequest
+	(*CancelOrderResponse)(nil),                  // 130: api.CancelOrderResponse
+	(*PreviewOrderEmailRequest)(nil),             // 131: api.PreviewOrderEmailRequest
+	(*PreviewOrderEmailResponse)(nil),            // 132: api.PreviewOrderEmailResponse
+	(*EmailOutboxData)(nil),                      // 133: api.EmailOutboxData
+	(*GetAllEmailOutboxRequest)(nil),             // 134: api.GetAllEmailOutboxRequest
+	(*GetAllEmailOutboxResponse)(nil),            // 135: api.GetAllEmailOutboxResponse
+	(*ResendEmailOutboxRequest)(nil),             // 136: api.ResendEmailOutboxRequest
+	(*ResendEmailOutboxResponse)(nil),            // 137: api.ResendEmailOutboxResponse
+	(*SendOrderEmailRequest)(nil),                // 138: api.SendOrderEmailRequest
+	(*SendOrderEmailResponse)(nil),               // 139: api.SendOrderEmailResponse
+	(*SubmitContactFormRequest)(nil),             // 140: api.SubmitContactFormRequest
+	(*SubmitContactFormResponse)(nil),            // 141: api.SubmitContactFormResponse
+	(*PaymentData)(nil),                          // 142: api.PaymentData
+	(*GetOrderPaymentRequest)(nil),               // 143: api.GetOrderPaymentRequest
+	(*GetOrderPaymentResponse)(nil),              // 144: api.GetOrderPaymentResponse
+	(*RecordPaymentRequest)(nil),                 // 145: api.RecordPaymentRequest
+	(*RecordPaymentResponse)(nil),                // 146: api.RecordPaymentResponse
+	(*UpdatePaymentStatusRequest)(nil),           // 147: api.UpdatePaymentStatusRequest
+	(*UpdatePaymentStatusResponse)(nil),          // 148: api.UpdatePaymentStatusResponse
+	(*HandlePaymentWebhookRequest)(nil),          // 149: api.HandlePaymentWebhookRequest
+	(*HandlePaymentWebhookResponse)(nil),         // 150: api.HandlePaymentWebhookResponse
+	(*ReturnData)(nil),                           // 151: api.ReturnData
+	(*RefundData)(nil),                           // 152: api.RefundData
+	(*SaveReturnRequest)(nil),                    // 153: api.SaveReturnRequest
+	(*SaveReturnResponse)(nil),                   // 154: api.SaveReturnResponse
+	(*RequestReturnRequest)(nil),                 // 155: api.RequestReturnRequest
+	(*RequestReturnResponse)(nil),                // 156: api.RequestReturnResponse
+	(*GetAllReturnRequest)(nil),                  // 157: api.GetAllReturnRequest
+	(*GetAllReturnResponse)(nil),                 // 158: api.GetAllReturnResponse
+	(*GetReturnByIdRequest)(nil),                 // 159: api.GetReturnByIdRequest
+	(*GetReturnByIdResponse)(nil),                // 160: api.GetReturnByIdResponse
+	(*UpdateReturnStatusRequest)(nil),            // 161: api.UpdateReturnStatusRequest
+	(*UpdateReturnStatusResponse)(nil),           // 162: api.UpdateReturnStatusResponse
+	(*ReceiveReturnRequest)(nil),                 // 163: api.ReceiveReturnRequest
+	(*ReceiveReturnResponse)(nil),                // 164: api.ReceiveReturnResponse
+	(*RefundPaymentRequest)(nil),                 // 165: api.RefundPaymentRequest
+	(*RefundPaymentResponse)(nil),                // 166: api.RefundPaymentResponse
+	(*ShippingZoneData)(nil),                     // 167: api.ShippingZoneData
+	(*SaveShippingZoneRequest)(nil),              // 168: api.SaveShippingZoneRequest
+	(*SaveShippingZoneResponse)(nil),             // 169: api.SaveShippingZoneResponse
+	(*GetAllShippingZoneRequest)(nil),            // 170: api.GetAllShippingZoneRequest
+	(*GetAllShippingZoneResponse)(nil),           // 171: api.GetAllShippingZoneResponse
+	(*UpdateShippingZoneRequest)(nil),            // 172: api.UpdateShippingZoneRequest
+	(*UpdateShippingZoneResponse)(nil),           // 173: api.UpdateShippingZoneResponse
+	(*UpdateShippingZoneStatusRequest)(nil),      // 174: api.UpdateShippingZoneStatusRequest
+	(*UpdateShippingZoneStatusResponse)(nil),     // 175: api.UpdateShippingZoneStatusResponse
+	(*DeleteShippingZoneRequest)(nil),            // 176: api.DeleteShippingZoneRequest
+	(*DeleteShippingZoneResponse)(nil),           // 177: api.DeleteShippingZoneResponse
+	(*QuoteShippingRequest)(nil),                 // 178: api.QuoteShippingRequest
+	(*QuoteShippingResponse)(nil),                // 179: api.QuoteShippingResponse
+	(*OrderEventData)(nil),                       // 180: api.OrderEventData
+	(*CreateShipmentRequest)(nil),                // 181: api.CreateShipmentRequest
+	(*CreateShipmentResponse)(nil),               // 182: api.CreateShipmentResponse
+	(*CancelShipmentRequest)(nil),                // 183: api.CancelShipmentRequest
+	(*CancelShipmentResponse)(nil),               // 184: api.CancelShipmentResponse
+	(*SyncTrackingRequest)(nil),                  // 185: api.SyncTrackingRequest
+	(*SyncTrackingResponse)(nil),                 // 186: api.SyncTrackingResponse
+	(*HandleCourierWebhookRequest)(nil),          // 187: api.HandleCourierWebhookRequest
+	(*HandleCourierWebhookResponse)(nil),         // 188: api.HandleCourierWebhookResponse
+	(*GetOrderTimelineRequest)(nil),              // 189: api.GetOrderTimelineRequest
+	(*GetOrderTimelineResponse)(nil),             // 190: api.GetOrderTimelineResponse
+	(*GetOrderDocumentRequest)(nil),              // 191: api.GetOrderDocumentRequest
+	(*GetOrderDocumentResponse)(nil),             // 192: api.GetOrderDocumentResponse
+	(*GetBulkOrderDocumentRequest)(nil),          // 193: api.GetBulkOrderDocumentRequest
+	(*GetBulkOrderDocumentResponse)(nil),         // 194: api.GetBulkOrderDocumentResponse
+	(*BulkOrderResult)(nil),                      // 195: api.BulkOrderResult
+	(*BulkUpdateOrderStatusRequest)(nil),         // 196: api.BulkUpdateOrderStatusRequest
+	(*BulkUpdateOrderStatusResponse)(nil),        // 197: api.BulkUpdateOrderStatusResponse
+	(*BulkAssignTrackingRequest)(nil),            // 198: api.BulkAssignTrackingRequest
+	(*BulkAssignTrackingResponse)(nil),           // 199: api.BulkAssignTrackingResponse
+	(*BulkAddOrderNoteRequest)(nil),              // 200: api.BulkAddOrderNoteRequest
+	(*BulkAddOrderNoteResponse)(nil),             // 201: api.BulkAddOrderNoteResponse
+	(*ImportOrdersRequest)(nil),                  // 202: api.ImportOrdersRequest
+	(*ImportRowError)(nil),                       // 203: api.ImportRowError
+	(*ImportOrderResult)(nil),                    // 204: api.ImportOrderResult
+	(*ImportOrdersResponse)(nil),                 // 205: api.ImportOrdersResponse
+	(*ImportCatalogueRequest)(nil),               // 206: api.ImportCatalogueRequest
+	(*ImportCatalogueResult)(nil),                // 207: api.ImportCatalogueResult
+	(*ImportCatalogueResponse)(nil),              // 208: api.ImportCatalogueResponse
+	(*UploadImageRequest)(nil),                   // 209: api.UploadImageRequest
+	(*ImageThumbnail)(nil),                       // 210: api.ImageThumbnail
+	(*UploadedImage)(nil),                        // 211: api.UploadedImage
+	(*UploadImageResponse)(nil),                  // 212: api.UploadImageResponse
+	(*ProductAttributeData)(nil),                 // 213: api.ProductAttributeData
+	(*SaveProductAttributeRequest)(nil),          // 214: api.SaveProductAttributeRequest
+	(*SaveProductAttributeResponse)(nil),         // 215: api.SaveProductAttributeResponse
+	(*GetAllProductAttributeRequest)(nil),        // 216: api.GetAllProductAttributeRequest
+	(*GetAllProductAttributeResponse)(nil),       // 217: api.GetAllProductAttributeResponse
+	(*UpdateProductAttributeRequest)(nil),        // 218: api.UpdateProductAttributeRequest
+	(*UpdateProductAttributeResponse)(nil),       // 219: api.UpdateProductAttributeResponse
+	(*UpdateProductAttributeStatusRequest)(nil),  // 220: api.UpdateProductAttributeStatusRequest
+	(*UpdateProductAttributeStatusResponse)(nil), // 221: api.UpdateProductAttributeStatusResponse
+	(*DeleteProductAttributeRequest)(nil),        // 222: api.DeleteProductAttributeRequest
+	(*DeleteProductAttributeResponse)(nil),       // 223: api.DeleteProductAttributeResponse
+	(*CompareProductsRequest)(nil),               // 224: api.CompareProductsRequest
+	(*ComparedProduct)(nil),                      // 225: api.ComparedProduct
+	(*ComparisonRow)(nil),                        // 226: api.ComparisonRow
+	(*CompareProductsResponse)(nil),              // 227: api.CompareProductsResponse
+}
+var file_pkg_pb_chronexdata_proto_depIdxs = []int32{
+	4,   // 0: api.SaveProductResponse.productData:type_name -> api.ProductData
+	8,   // 1: api.ProductFacets.categories:type_name -> api.FacetCount
+	9,   // 2: api.ProductFacets.priceRanges:type_name -> api.PriceRangeFacet
+	8,   // 3: api.ProductFacets.discounts:type_name -> api.FacetCount
+	8,   // 4: api.ProductFacets.ratings:type_name -> api.FacetCount
+	11,  // 5: api.ProductFacets.attributes:type_name -> api.AttributeFacet
+	8,   // 6: api.AttributeFacet.values:type_name -> api.FacetCount
+	4,   // 7: api.GetAllProductResponse.productData:type_name -> api.ProductData
+	10,  // 8: api.GetAllProductResponse.facets:type_name -> api.ProductFacets
+	4,   // 9: api.GetAllProductResponseById.productData:type_name -> api.ProductData
+	4,   // 10: api.UpdateProductResponse.productData:type_name -> api.ProductData
+	4,   // 11: api.UpdateProductQuantityResponse.productData:type_name -> api.ProductData
+	4,   // 12: api.UpdateProductStatusResponse.productData:type_name -> api.ProductData
+	21,  // 13: api.SaveFreebiesResponse.freebiesData:type_name -> api.FreebiesData
+	21,  // 14: api.GetAllFreebiesResponse.freebiesData:type_name -> api.FreebiesData
+	21,  // 15: api.GetAllFreebiesDropdownResponse.freebiesData:type_name -> api.FreebiesData
+	21,  // 16: api.GetAllFreebiesResponseById.freebiesData:type_name -> api.FreebiesData
+	21,  // 17: api.UpdateFreebiesResponse.freebiesData:type_name -> api.FreebiesData
+	21,  // 18: api.UpdateFreebiesQuantityResponse.freebiesData:type_name -> api.FreebiesData
+	21,  // 19: api.UpdateFreebiesStatusResponse.freebiesData:type_name -> api.FreebiesData
+	36,  // 20: api.SaveReviewsResponse.reviewsData:type_name -> api.ReviewsData
+	36,  // 21: api.GetAllReviewsResponse.reviewsData:type_name -> api.ReviewsData
+	36,  // 22: api.UpdateReviewsResponse.reviewsData:type_name -> api.ReviewsData
+	36,  // 23: api.UpdateReviewsStatusResponse.reviewsData:type_name -> api.ReviewsData
+	36,  // 24: api.GetAllReviewsResponseById.reviewsData:type_name -> api.ReviewsData
+	47,  // 25: api.SaveOrderResponse.orderData:type_name -> api.OrderData
+	142, // 26: api.SaveOrderResponse.paymentData:type_name -> api.PaymentData
+	47,  // 27: api.GetAllOrderResponse.orderData:type_name -> api.OrderData
+	47,  // 28: api.UpdateOrderResponse.orderData:type_name -> api.OrderData
+	47,  // 29: api.UpdateOrderStatusResponse.orderData:type_name -> api.OrderData
+	62,  // 30: api.SaveHomeImagesResponse.homeImagesData:type_name -> api.HomeImagesData
+	62,  // 31: api.GetAllHomeImagesResponse.homeImagesData:type_name -> api.HomeImagesData
+	62,  // 32: api.UpdateHomeImagesResponse.homeImagesData:type_name -> api.HomeImagesData
+	62,  // 33: api.DeleteHomeImagesResponse.homeImagesData:type_name -> api.HomeImagesData
+	4,   // 34: api.DeleteProductResponse.productData:type_name -> api.ProductData
+	21,  // 35: api.DeleteFreebiesResponse.freebiesData:type_name -> api.FreebiesData
+	36,  // 36: api.DeleteReviewsResponse.reviewsData:type_name -> api.ReviewsData
+	47,  // 37: api.DeleteOrderResponse.orderData:type_name -> api.OrderData
+	79,  // 38: api.SaveFreebiesRuleResponse.freebiesRuleData:type_name -> api.FreebiesRuleData
+	79,  // 39: api.GetAllFreebiesRuleResponse.freebiesRuleData:type_name -> api.FreebiesRuleData
+	79,  // 40: api.UpdateFreebiesRuleResponse.freebiesRuleData:type_name -> api.FreebiesRuleData
+	79,  // 41: api.UpdateFreebiesRuleStatusResponse.freebiesRuleData:type_name -> api.FreebiesRuleData
+	88,  // 42: api.PreviewCartResponse.cartFreebies:type_name -> api.CartFreebies
+	91,  // 43: api.SaveVoucherResponse.voucherData:type_name -> api.VoucherData
+	91,  // 44: api.GetAllVoucherResponse.voucherData:type_name -> api.VoucherData
+	91,  // 45: api.UpdateVoucherResponse.voucherData:type_name -> api.VoucherData
+	91,  // 46: api.UpdateVoucherStatusResponse.voucherData:type_name -> api.VoucherData
+	100, // 47: api.SavePriceRuleResponse.priceRuleData:type_name -> api.PriceRuleData
+	100, // 48: api.GetAllPriceRuleResponse.priceRuleData:type_name -> api.PriceRuleData
+	100, // 49: api.UpdatePriceRuleResponse.priceRuleData:type_name -> api.PriceRuleData
+	100, // 50: api.UpdatePriceRuleStatusResponse.priceRuleData:type_name -> api.PriceRuleData
+	100, // 51: api.DeletePriceRuleResponse.priceRuleData:type_name -> api.PriceRuleData
+	111, // 52: api.GetProductPriceHistoryResponse.productPriceHistoryData:type_name -> api.ProductPriceHistoryData
+	114, // 53: api.GetAllTrashResponse.trashData:type_name -> api.TrashData
+	114, // 54: api.RestoreTrashResponse.trashData:type_name -> api.TrashData
+	114, // 55: api.PurgeTrashResponse.trashData:type_name -> api.TrashData
+	121, // 56: api.GetAllCustomerResponse.customerData:type_name -> api.CustomerData
+	121, // 57: api.GetCustomerByIdResponse.customerData:type_name -> api.CustomerData
+	47,  // 58: api.GetCustomerByIdResponse.orderData:type_name -> api.OrderData
+	126, // 59: api.LookupOrderResponse.orderData:type_name -> api.PublicOrderData
+	126, // 60: api.CancelOrderResponse.orderData:type_name -> api.PublicOrderData
+	133, // 61: api.GetAllEmailOutboxResponse.emailOutboxData:type_name -> api.EmailOutboxData
+	133, // 62: api.ResendEmailOutboxResponse.emailOutboxData:type_name -> api.EmailOutboxData
+	133, // 63: api.SendOrderEmailResponse.emailOutboxData:type_name -> api.EmailOutboxData
+	142, // 64: api.GetOrderPaymentResponse.paymentData:type_name -> api.PaymentData
+	152, // 65: api.GetOrderPaymentResponse.refundData:type_name -> api.RefundData
+	142, // 66: api.RecordPaymentResponse.paymentData:type_name -> api.PaymentData
+	142, // 67: api.UpdatePaymentStatusResponse.paymentData:type_name -> api.PaymentData
+	142, // 68: api.HandlePaymentWebhookResponse.paymentData:type_name -> api.PaymentData
+	151, // 69: api.SaveReturnResponse.returnData:type_name -> api.ReturnData
+	151, // 70: api.RequestReturnResponse.returnData:type_name -> api.ReturnData
+	151, // 71: api.GetAllReturnResponse.returnData:type_name -> api.ReturnData
+	151, // 72: api.GetReturnByIdResponse.returnData:type_name -> api.ReturnData
+	152, // 73: api.GetReturnByIdResponse.refundData:type_name -> api.RefundData
+	151, // 74: api.UpdateReturnStatusResponse.returnData:type_name -> api.ReturnData
+	151, // 75: api.ReceiveReturnResponse.returnData:type_name -> api.ReturnData
+	152, // 76: api.RefundPaymentResponse.refundData:type_name -> api.RefundData
+	167, // 77: api.SaveShippingZoneResponse.shippingZoneData:type_name -> api.ShippingZoneData
+	167, // 78: api.GetAllShippingZoneResponse.shippingZoneData:type_name -> api.ShippingZoneData
+	167, // 79: api.UpdateShippingZoneResponse.shippingZoneData:type_name -> api.ShippingZoneData
+	167, // 80: api.UpdateShippingZoneStatusResponse.shippingZoneData:type_name -> api.ShippingZoneData
+	167, // 81: api.DeleteShippingZoneResponse.shippingZoneData:type_name -> api.ShippingZoneData
+	47,  // 82: api.CreateShipmentResponse.orderData:type_name -> api.OrderData
+	47,  // 83: api.CancelShipmentResponse.orderData:type_name -> api.OrderData
+	47,  // 84: api.SyncTrackingResponse.orderData:type_name -> api.OrderData
+	180, // 85: api.SyncTrackingResponse.orderEventData:type_name -> api.OrderEventData
+	47,  // 86: api.HandleCourierWebhookResponse.orderData:type_name -> api.OrderData
+	180, // 87: api.GetOrderTimelineResponse.orderEventData:type_name -> api.OrderEventData
+	195, // 88: api.BulkUpdateOrderStatusResponse.results:type_name -> api.BulkOrderResult
+	195, // 89: api.BulkAssignTrackingResponse.results:type_name -> api.BulkOrderResult
+	195, // 90: api.BulkAddOrderNoteResponse.results:type_name -> api.BulkOrderResult
+	203, // 91: api.ImportOrderResult.errors:type_name -> api.ImportRowError
+	204, // 92: api.ImportOrdersResponse.results:type_name -> api.ImportOrderResult
+	203, // 93: api.ImportCatalogueResult.errors:type_name -> api.ImportRowError
+	207, // 94: api.ImportCatalogueResponse.results:type_name -> api.ImportCatalogueResult
+	210, // 95: api.UploadedImage.thumbnails:type_name -> api.ImageThumbnail
+	211, // 96: api.UploadImageResponse.image:type_name -> api.UploadedImage
+	213, // 97: api.SaveProductAttributeResponse.productAttributeData:type_name -> api.ProductAttributeData
+	213, // 98: api.GetAllProductAttributeResponse.productAttributeData:type_name -> api.ProductAttributeData
+	213, // 99: api.UpdateProductAttributeResponse.productAttributeData:type_name -> api.ProductAttributeData
+	213, // 100: api.UpdateProductAttributeStatusResponse.productAttributeData:type_name -> api.ProductAttributeData
+	213, // 101: api.DeleteProductAttributeResponse.productAttributeData:type_name -> api.ProductAttributeData
+	4,   // 102: api.ComparedProduct.productData:type_name -> api.ProductData
+	225, // 103: api.CompareProductsResponse.products:type_name -> api.ComparedProduct
+	226, // 104: api.CompareProductsResponse.rows:type_name -> api.ComparisonRow
+	5,   // 105: api.ChronexAdminProtoService.SaveProduct:input_type -> api.SaveProductRequest
+	22,  // 106: api.ChronexAdminProtoService.SaveFreebies:input_type -> api.SaveFreebiesRequest
+	37,  // 107: api.ChronexAdminProtoService.SaveReviews:input_type -> api.SaveReviewsRequest
+	48,  // 108: api.ChronexAdminProtoService.SaveOrder:input_type -> api.SaveOrderRequest
+	63,  // 109: api.ChronexAdminProtoService.SaveHomeImages:input_type -> api.SaveHomeImagesRequest
+	24,  // 110: api.ChronexAdminProtoService.GetAllFreebies:input_type -> api.GetAllFreebiesRequest
+	26,  // 111: api.ChronexAdminProtoService.GetAllFreebiesDropdown:input_type -> api.GetAllFreebiesDropdownRequest
+	7,   // 112: api.ChronexAdminProtoService.GetAllProduct:input_type -> api.GetAllProductRequest
+	39,  // 113: api.ChronexAdminProtoService.GetAllReviews:input_type -> api.GetAllReviewsRequest
+	50,  // 114: api.ChronexAdminProtoService.GetAllOrder:input_type -> api.GetAllOrderRequest
+	65,  // 115: api.ChronexAdminProtoService.GetAllHomeImages:input_type -> api.GetAllHomeImagesRequest
+	28,  // 116: api.ChronexAdminProtoService.GetAllFreebiesById:input_type -> api.GetAllFreebiesRequestById
+	13,  // 117: api.ChronexAdminProtoService.GetAllProductById:input_type -> api.GetAllProductRequestById
+	45,  // 118: api.ChronexAdminProtoService.GetAllReviewsById:input_type -> api.GetAllReviewsRequestById
+	30,  // 119: api.ChronexAdminProtoService.UpdateFreebies:input_type -> api.UpdateFreebiesRequest
+	32,  // 120: api.ChronexAdminProtoService.UpdateFreebiesQuantity:input_type -> api.UpdateFreebiesQuantityRequest
+	34,  // 121: api.ChronexAdminProtoService.UpdateFreebiesStatus:input_type -> api.UpdateFreebiesStatusRequest
+	41,  // 122: api.ChronexAdminProtoService.UpdateReviews:input_type -> api.UpdateReviewsRequest
+	43,  // 123: api.ChronexAdminProtoService.UpdateReviewsStatus:input_type -> api.UpdateReviewsStatusRequest
+	15,  // 124: api.ChronexAdminProtoService.UpdateProduct:input_type -> api.UpdateProductRequest
+	17,  // 125: api.ChronexAdminProtoService.UpdateProductQuantity:input_type -> api.UpdateProductQuantityRequest
+	19,  // 126: api.ChronexAdminProtoService.UpdateProductStatus:input_type -> api.UpdateProductStatusRequest
+	52,  // 127: api.ChronexAdminProtoService.UpdateOrder:input_type -> api.UpdateOrderRequest
+	54,  // 128: api.ChronexAdminProtoService.UpdateOrderStatus:input_type -> api.UpdateOrderStatusRequest
+	67,  // 129: api.ChronexAdminProtoService.UpdateHomeImages:input_type -> api.UpdateHomeImagesRequest
+	69,  // 130: api.ChronexAdminProtoService.DeleteHomeImages:input_type -> api.DeleteHomeImagesRequest
+	71,  // 131: api.ChronexAdminProtoService.DeleteProduct:input_type -> api.DeleteProductRequest
+	73,  // 132: api.ChronexAdminProtoService.DeleteFreebies:input_type -> api.DeleteFreebiesRequest
+	75,  // 133: api.ChronexAdminProtoService.DeleteReviews:input_type -> api.DeleteReviewsRequest
+	77,  // 134: api.ChronexAdminProtoService.DeleteOrder:input_type -> api.DeleteOrderRequest
+	56,  // 135: api.ChronexAdminProtoService.GetAllOrderRevenue:input_type -> api.GetAllOrderRevenueRequest
+	58,  // 136: api.ChronexAdminProtoService.GetAllTotalOrder:input_type -> api.GetAllTotalOrderRequest
+	60,  // 137: api.ChronexAdminProtoService.GetBestSellingProducts:input_type -> api.GetBestSellingProductsRequest
+	80,  // 138: api.ChronexAdminProtoService.SaveFreebiesRule:input_type -> api.SaveFreebiesRuleRequest
+	82,  // 139: api.ChronexAdminProtoService.GetAllFreebiesRule:input_type -> api.GetAllFreebiesRuleRequest
+	84,  // 140: api.ChronexAdminProtoService.UpdateFreebiesRule:input_type -> api.UpdateFreebiesRuleRequest
+	86,  // 141: api.ChronexAdminProtoService.UpdateFreebiesRuleStatus:input_type -> api.UpdateFreebiesRuleStatusRequest
+	89,  // 142: api.ChronexAdminProtoService.PreviewCart:input_type -> api.PreviewCartRequest
+	92,  // 143: api.ChronexAdminProtoService.SaveVoucher:input_type -> api.SaveVoucherRequest
+	94,  // 144: api.ChronexAdminProtoService.GetAllVoucher:input_type -> api.GetAllVoucherRequest
+	96,  // 145: api.ChronexAdminProtoService.UpdateVoucher:input_type -> api.UpdateVoucherRequest
+	98,  // 146: api.ChronexAdminProtoService.UpdateVoucherStatus:input_type -> api.UpdateVoucherStatusRequest
+	101, // 147: api.ChronexAdminProtoService.SavePriceRule:input_type -> api.SavePriceRuleRequest
+	103, // 148: api.ChronexAdminProtoService.GetAllPriceRule:input_type -> api.GetAllPriceRuleRequest
+	105, // 149: api.ChronexAdminProtoService.UpdatePriceRule:input_type -> api.UpdatePriceRuleRequest
+	107, // 150: api.ChronexAdminProtoService.UpdatePriceRuleStatus:input_type -> api.UpdatePriceRuleStatusRequest
+	109, // 151: api.ChronexAdminProtoService.DeletePriceRule:input_type -> api.DeletePriceRuleRequest
+	112, // 152: api.ChronexAdminProtoService.GetProductPriceHistory:input_type -> api.GetProductPriceHistoryRequest
+	115, // 153: api.ChronexAdminProtoService.GetAllTrash:input_type -> api.GetAllTrashRequest
+	117, // 154: api.ChronexAdminProtoService.RestoreTrash:input_type -> api.RestoreTrashRequest
+	119, // 155: api.ChronexAdminProtoService.PurgeTrash:input_type -> api.PurgeTrashRequest
+	122, // 156: api.ChronexAdminProtoService.GetAllCustomer:input_type -> api.GetAllCustomerRequest
+	124, // 157: api.ChronexAdminProtoService.GetCustomerById:input_type -> api.GetCustomerByIdRequest
+	127, // 158: api.ChronexAdminProtoService.LookupOrder:input_type -> api.LookupOrderRequest
+	129, // 159: api.ChronexAdminProtoService.CancelOrder:input_type -> api.CancelOrderRequest
+	131, // 160: api.ChronexAdminProtoService.PreviewOrderEmail:input_type -> api.PreviewOrderEmailRequest
+	134, // 161: api.ChronexAdminProtoService.GetAllEmailOutbox:input_type -> api.GetAllEmailOutboxRequest
+	136, // 162: api.ChronexAdminProtoService.ResendEmailOutbox:input_type -> api.ResendEmailOutboxRequest
+	138, // 163: api.ChronexAdminProtoService.SendOrderEmail:input_type -> api.SendOrderEmailRequest
+	140, // 164: api.ChronexAdminProtoService.SubmitContactForm:input_type -> api.SubmitContactFormRequest
+	143, // 165: api.ChronexAdminProtoService.GetOrderPayment:input_type -> api.GetOrderPaymentRequest
+	145, // 166: api.ChronexAdminProtoService.RecordPayment:input_type -> api.RecordPaymentRequest
+	147, // 167: api.ChronexAdminProtoService.UpdatePaymentStatus:input_type -> api.UpdatePaymentStatusRequest
+	149, // 168: api.ChronexAdminProtoService.HandlePaymentWebhook:input_type -> api.HandlePaymentWebhookRequest
+	153, // 169: api.ChronexAdminProtoService.SaveReturn:input_type -> api.SaveReturnRequest
+	155, // 170: api.ChronexAdminProtoService.RequestReturn:input_type -> api.RequestReturnRequest
+	157, // 171: api.ChronexAdminProtoService.GetAllReturn:input_type -> api.GetAllReturnRequest
+	159, // 172: api.ChronexAdminProtoService.GetReturnById:input_type -> api.GetReturnByIdRequest
+	161, // 173: api.ChronexAdminProtoService.UpdateReturnStatus:input_type -> api.UpdateReturnStatusRequest
+	163, // 174: api.ChronexAdminProtoService.ReceiveReturn:input_type -> api.ReceiveReturnRequest
+	165, // 175: api.ChronexAdminProtoService.RefundPayment:input_type -> api.RefundPaymentRequest
+	168, // 176: api.ChronexAdminProtoService.SaveShippingZone:input_type -> api.SaveShippingZoneRequest
+	170, // 177: api.ChronexAdminProtoService.GetAllShippingZone:input_type -> api.GetAllShippingZoneRequest
+	172, // 178: api.ChronexAdminProtoService.UpdateShippingZone:input_type -> api.UpdateShippingZoneRequest
+	174, // 179: api.ChronexAdminProtoService.UpdateShippingZoneStatus:input_type -> api.UpdateShippingZoneStatusRequest
+	176, // 180: api.ChronexAdminProtoService.DeleteShippingZone:input_type -> api.DeleteShippingZoneRequest
+	178, // 181: api.ChronexAdminProtoService.QuoteShipping:input_type -> api.QuoteShippingRequest
+	181, // 182: api.ChronexAdminProtoService.CreateShipment:input_type -> api.CreateShipmentRequest
+	183, // 183: api.ChronexAdminProtoService.CancelShipment:input_type -> api.CancelShipmentRequest
+	185, // 184: api.ChronexAdminProtoService.SyncTracking:input_type -> api.SyncTrackingRequest
+	187, // 185: api.ChronexAdminProtoService.HandleCourierWebhook:input_type -> api.HandleCourierWebhookRequest
+	189, // 186: api.ChronexAdminProtoService.GetOrderTimeline:input_type -> api.GetOrderTimelineRequest
+	191, // 187: api.ChronexAdminProtoService.GetOrderDocument:input_type -> api.GetOrderDocumentRequest
+	193, // 188: api.ChronexAdminProtoService.GetBulkOrderDocument:input_type -> api.GetBulkOrderDocumentRequest
+	196, // 189: api.ChronexAdminProtoService.BulkUpdateOrderStatus:input_type -> api.BulkUpdateOrderStatusRequest
+	198, // 190: api.ChronexAdminProtoService.BulkAssignTracking:input_type -> api.BulkAssignTrackingRequest
+	200, // 191: api.ChronexAdminProtoService.BulkAddOrderNote:input_type -> api.BulkAddOrderNoteRequest
+	202, // 192: api.ChronexAdminProtoService.ImportOrders:input_type -> api.ImportOrdersRequest
+	206, // 193: api.ChronexAdminProtoService.ImportProducts:input_type -> api.ImportCatalogueRequest
+	206, // 194: api.ChronexAdminProtoService.ImportFreebies:input_type -> api.ImportCatalogueRequest
+	209, // 195: api.ChronexAdminProtoService.UploadImage:input_type -> api.UploadImageRequest
+	214, // 196: api.ChronexAdminProtoService.SaveProductAttribute:input_type -> api.SaveProductAttributeRequest
+	216, // 197: api.ChronexAdminProtoService.GetAllProductAttribute:input_type -> api.GetAllProductAttributeRequest
+	218, // 198: api.ChronexAdminProtoService.UpdateProductAttribute:input_type -> api.UpdateProductAttributeRequest
+	220, // 199: api.ChronexAdminProtoService.UpdateProductAttributeStatus:input_type -> api.UpdateProductAttributeStatusRequest
+	222, // 200: api.ChronexAdminProtoService.DeleteProductAttribute:input_type -> api.DeleteProductAttributeRequest
+	224, // 201: api.ChronexAdminProtoService.CompareProducts:input_type -> api.CompareProductsRequest
+	6,   // 202: api.ChronexAdminProtoService.SaveProduct:output_type -> api.SaveProductResponse
+	23,  // 203: api.ChronexAdminProtoService.SaveFreebies:output_type -> api.SaveFreebiesResponse
+	38,  // 204: api.ChronexAdminProtoService.SaveReviews:output_type -> api.SaveReviewsResponse
+	49,  // 205: api.ChronexAdminProtoService.SaveOrder:output_type -> api.SaveOrderResponse
+	64,  // 206: api.ChronexAdminProtoService.SaveHomeImages:output_type -> api.SaveHomeImagesResponse
+	25,  // 207: api.ChronexAdminProtoService.GetAllFreebies:output_type -> api.GetAllFreebiesResponse
+	27,  // 208: api.ChronexAdminProtoService.GetAllFreebiesDropdown:output_type -> api.GetAllFreebiesDropdownResponse
+	12,  // 209: api.ChronexAdminProtoService.GetAllProduct:output_type -> api.GetAllProductResponse
+	40,  // 210: api.ChronexAdminProtoService.GetAllReviews:output_type -> api.GetAllReviewsResponse
+	51,  // 211: api.ChronexAdminProtoService.GetAllOrder:output_type -> api.GetAllOrderResponse
+	66,  // 212: api.ChronexAdminProtoService.GetAllHomeImages:output_type -> api.GetAllHomeImagesResponse
+	29,  // 213: api.ChronexAdminProtoService.GetAllFreebiesById:output_type -> api.GetAllFreebiesResponseById
+	14,  // 214: api.ChronexAdminProtoService.GetAllProductById:output_type -> api.GetAllProductResponseById
+	46,  // 215: api.ChronexAdminProtoService.GetAllReviewsById:output_type -> api.GetAllReviewsResponseById
+	31,  // 216: api.ChronexAdminProtoService.UpdateFreebies:output_type -> api.UpdateFreebiesResponse
+	33,  // 217: api.ChronexAdminProtoService.UpdateFreebiesQuantity:output_type -> api.UpdateFreebiesQuantityResponse
+	35,  // 218: api.ChronexAdminProtoService.UpdateFreebiesStatus:output_type -> api.UpdateFreebiesStatusResponse
+	42,  // 219: api.ChronexAdminProtoService.UpdateReviews:output_type -> api.UpdateReviewsResponse
+	44,  // 220: api.ChronexAdminProtoService.UpdateReviewsStatus:output_type -> api.UpdateReviewsStatusResponse
+	16,  // 221: api.ChronexAdminProtoService.UpdateProduct:output_type -> api.UpdateProductResponse
+	18,  // 222: api.ChronexAdminProtoService.UpdateProductQuantity:output_type -> api.UpdateProductQuantityResponse
+	20,  // 223: api.ChronexAdminProtoService.UpdateProductStatus:output_type -> api.UpdateProductStatusResponse
+	53,  // 224: api.ChronexAdminProtoService.UpdateOrder:output_type -> api.UpdateOrderResponse
+	55,  // 225: api.ChronexAdminProtoService.UpdateOrderStatus:output_type -> api.UpdateOrderStatusResponse
+	68,  // 226: api.ChronexAdminProtoService.UpdateHomeImages:output_type -> api.UpdateHomeImagesResponse
+	70,  // 227: api.ChronexAdminProtoService.DeleteHomeImages:output_type -> api.DeleteHomeImagesResponse
+	72,  // 228: api.ChronexAdminProtoService.DeleteProduct:output_type -> api.DeleteProductResponse
+	74,  // 229: api.ChronexAdminProtoService.DeleteFreebies:output_type -> api.DeleteFreebiesResponse
+	76,  // 230: api.ChronexAdminProtoService.DeleteReviews:output_type -> api.DeleteReviewsResponse
+	78,  // 231: api.ChronexAdminProtoService.DeleteOrder:output_type -> api.DeleteOrderResponse
+	57,  // 232: api.ChronexAdminProtoService.GetAllOrderRevenue:output_type -> api.GetAllOrderRevenueResponse
+	59,  // 233: api.ChronexAdminProtoService.GetAllTotalOrder:output_type -> api.GetAllTotalOrderResponse
+	61,  // 234: api.ChronexAdminProtoService.GetBestSellingProducts:output_type -> api.GetBestSellingProductsResponse
+	81,  // 235: api.ChronexAdminProtoService.SaveFreebiesRule:output_type -> api.SaveFreebiesRuleResponse
+	83,  // 236: api.ChronexAdminProtoService.GetAllFreebiesRule:output_type -> api.GetAllFreebiesRuleResponse
+	85,  // 237: api.ChronexAdminProtoService.UpdateFreebiesRule:output_type -> api.UpdateFreebiesRuleResponse
+	87,  // 238: api.ChronexAdminProtoService.UpdateFreebiesRuleStatus:output_type -> api.UpdateFreebiesRuleStatusResponse
+	90,  // 239: api.ChronexAdminProtoService.PreviewCart:output_type -> api.PreviewCartResponse
+	93,  // 240: api.ChronexAdminProtoService.SaveVoucher:output_type -> api.SaveVoucherResponse
+	95,  // 241: api.ChronexAdminProtoService.GetAllVoucher:output_type -> api.GetAllVoucherResponse
+	97,  // 242: api.ChronexAdminProtoService.UpdateVoucher:output_type -> api.UpdateVoucherResponse
+	99,  // 243: api.ChronexAdminProtoService.UpdateVoucherStatus:output_type -> api.UpdateVoucherStatusResponse
+	102, // 244: api.ChronexAdminProtoService.SavePriceRule:output_type -> api.SavePriceRuleResponse
+	104, // 245: api.ChronexAdminProtoService.GetAllPriceRule:output_type -> api.GetAllPriceRuleResponse
+	106, // 246: api.ChronexAdminProtoService.UpdatePriceRule:output_type -> api.UpdatePriceRuleResponse
+	108, // 247: api.ChronexAdminProtoService.UpdatePriceRuleStatus:output_type -> api.UpdatePriceRuleStatusResponse
+	110, // 248: api.ChronexAdminProtoService.DeletePriceRule:output_type -> api.DeletePriceRuleResponse
+	113, // 249: api.ChronexAdminProtoService.GetProductPriceHistory:output_type -> api.GetProductPriceHistoryResponse
+	116, // 250: api.ChronexAdminProtoService.GetAllTrash:output_type -> api.GetAllTrashResponse
+	118, // 251: api.ChronexAdminProtoService.RestoreTrash:output_type -> api.RestoreTrashResponse
+	120, // 252: api.ChronexAdminProtoService.PurgeTrash:output_type -> api.PurgeTrashResponse
+	123, // 253: api.ChronexAdminProtoService.GetAllCustomer:output_type -> api.GetAllCustomerResponse
+	125, // 254: api.ChronexAdminProtoService.GetCustomerById:output_type -> api.GetCustomerByIdResponse
+	128, // 255: api.ChronexAdminProtoService.LookupOrder:output_type -> api.LookupOrderResponse
+	130, // 256: api.ChronexAdminProtoService.CancelOrder:output_type -> api.CancelOrderResponse
+	132, // 257: api.ChronexAdminProtoService.PreviewOrderEmail:output_type -> api.PreviewOrderEmailResponse
+	135, // 258: api.ChronexAdminProtoService.GetAllEmailOutbox:output_type -> api.GetAllEmailOutboxResponse
+	137, // 259: api.ChronexAdminProtoService.ResendEmailOutbox:output_type -> api.ResendEmailOutboxResponse
+	139, // 260: api.ChronexAdminProtoService.SendOrderEmail:output_type -> api.SendOrderEmailResponse
+	141, // 261: api.ChronexAdminProtoService.SubmitContactForm:output_type -> api.SubmitContactFormResponse
+	144, // 262: api.ChronexAdminProtoService.GetOrderPayment:output_type -> api.GetOrderPaymentResponse
+	146, // 263: api.ChronexAdminProtoService.RecordPayment:output_type -> api.RecordPaymentResponse
+	148, // 264: api.ChronexAdminProtoService.UpdatePaymentStatus:output_type -> api.UpdatePaymentStatusResponse
+	150, // 265: api.ChronexAdminProtoService.HandlePaymentWebhook:output_type -> api.HandlePaymentWebhookResponse
+	154, // 266: api.ChronexAdminProtoService.SaveReturn:output_type -> api.SaveReturnResponse
+	156, // 267: api.ChronexAdminProtoService.RequestReturn:output_type -> api.RequestReturnResponse
+	158, // 268: api.ChronexAdminProtoService.GetAllReturn:output_type -> api.GetAllReturnResponse
+	160, // 269: api.ChronexAdminProtoService.GetReturnById:output_type -> api.GetReturnByIdResponse
+	162, // 270: api.ChronexAdminProtoService.UpdateReturnStatus:output_type -> api.UpdateReturnStatusResponse
+	164, // 271: api.ChronexAdminProtoService.ReceiveReturn:output_type -> api.ReceiveReturnResponse
+	166, // 272: api.ChronexAdminProtoService.RefundPayment:output_type -> api.RefundPaymentResponse
+	169, // 273: api.ChronexAdminProtoService.SaveShippingZone:output_type -> api.SaveShippingZoneResponse
+	171, // 274: api.ChronexAdminProtoService.GetAllShippingZone:output_type -> api.GetAllShippingZoneResponse
+	173, // 275: api.ChronexAdminProtoService.UpdateShippingZone:output_type -> api.UpdateShippingZoneResponse
+	175, // 276: api.ChronexAdminProtoService.UpdateShippingZoneStatus:output_type -> api.UpdateShippingZoneStatusResponse
+	177, // 277: api.ChronexAdminProtoService.DeleteShippingZone:output_type -> api.DeleteShippingZoneResponse
+	179, // 278: api.ChronexAdminProtoService.QuoteShipping:output_type -> api.QuoteShippingResponse
+	182, // 279: api.ChronexAdminProtoService.CreateShipment:output_type -> api.CreateShipmentResponse
+	184, // 280: api.ChronexAdminProtoService.CancelShipment:output_type -> api.CancelShipmentResponse
+	186, // 281: api.ChronexAdminProtoService.SyncTracking:output_type -> api.SyncTrackingResponse
+	188, // 282: api.ChronexAdminProtoService.HandleCourierWebhook:output_type -> api.HandleCourierWebhookResponse
+	190, // 283: api.ChronexAdminProtoService.GetOrderTimeline:output_type -> api.GetOrderTimelineResponse
+	192, // 284: api.ChronexAdminProtoService.GetOrderDocument:output_type -> api.GetOrderDocumentResponse
+	194, // 285: api.ChronexAdminProtoService.GetBulkOrderDocument:output_type -> api.GetBulkOrderDocumentResponse
+	197, // 286: api.ChronexAdminProtoService.BulkUpdateOrderStatus:output_type -> api.BulkUpdateOrderStatusResponse
+	199, // 287: api.ChronexAdminProtoService.BulkAssignTracking:output_type -> api.BulkAssignTrackingResponse
+	201, // 288: api.ChronexAdminProtoService.BulkAddOrderNote:output_type -> api.BulkAddOrderNoteResponse
+	205, // 289: api.ChronexAdminProtoService.ImportOrders:output_type -> api.ImportOrdersResponse
+	208, // 290: api.ChronexAdminProtoService.ImportProducts:output_type -> api.ImportCatalogueResponse
+	208, // 291: api.ChronexAdminProtoService.ImportFreebies:output_type -> api.ImportCatalogueResponse
+	212, // 292: api.ChronexAdminProtoService.UploadImage:output_type -> api.UploadImageResponse
+	215, // 293: api.ChronexAdminProtoService.SaveProductAttribute:output_type -> api.SaveProductAttributeResponse
+	217, // 294: api.ChronexAdminProtoService.GetAllProductAttribute:output_type -> api.GetAllProductAttributeResponse
+	219, // 295: api.ChronexAdminProtoService.UpdateProductAttribute:output_type -> api.UpdateProductAttributeResponse
+	221, // 296: api.ChronexAdminProtoService.UpdateProductAttributeStatus:output_type -> api.UpdateProductAttributeStatusResponse
+	223, // 297: api.ChronexAdminProtoService.DeleteProductAttribute:output_type -> api.DeleteProductAttributeResponse
+	227, // 298: api.ChronexAdminProtoService.CompareProducts:output_type -> api.CompareProductsResponse
+	202, // [202:299] is the sub-list for method output_type
+	105, // [105:202] is the sub-list for method input_type
+	105, // [105:105] is the sub-list for extension type_name
+	105, // [105:105] is the sub-list for extension extendee
+	0,   // [0:105] is the sub-list for field type_name
+}
+
+func init() { file_pkg_pb_chronexdata_proto_init() }
+func file_pkg_pb_chronexdata_proto_init() {
+	if File_pkg_pb_chronexdata_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_pb_chronexdata_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProductData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveProductResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FacetCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceRangeFacet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProductFacets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeFacet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllProductResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllProductRequestById); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllProductResponseById); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProductResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProductQuantityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProductQuantityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProductStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProductStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreebiesData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveFreebiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveFreebiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllFreebiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllFreebiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllFreebiesDropdownRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllFreebiesDropdownResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllFreebiesRequestById); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllFreebiesResponseById); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFreebiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFreebiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFreebiesQuantityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFreebiesQuantityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFreebiesStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFreebiesStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewsData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveReviewsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveReviewsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllReviewsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllReviewsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReviewsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReviewsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReviewsStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReviewsStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllReviewsRequestById); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllReviewsResponseById); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOrderStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOrderStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllOrderRevenueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllOrderRevenueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllTotalOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllTotalOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBestSellingProductsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBestSellingProductsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HomeImagesData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveHomeImagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveHomeImagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllHomeImagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllHomeImagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHomeImagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHomeImagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteHomeImagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteHomeImagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProductResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFreebiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFreebiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReviewsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReviewsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreebiesRuleData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveFreebiesRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveFreebiesRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllFreebiesRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllFreebiesRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFreebiesRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFreebiesRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFreebiesRuleStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFreebiesRuleStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CartFreebies); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewCartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewCartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoucherData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveVoucherRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveVoucherResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllVoucherRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllVoucherResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVoucherRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVoucherResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVoucherStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVoucherStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceRuleData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavePriceRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavePriceRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllPriceRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllPriceRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePriceRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePriceRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePriceRuleStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePriceRuleStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePriceRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePriceRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProductPriceHistoryData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProductPriceHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProductPriceHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrashData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllTrashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllTrashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreTrashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreTrashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeTrashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeTrashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllCustomerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllCustomerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCustomerByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCustomerByIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublicOrderData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewOrderEmailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewOrderEmailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmailOutboxData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllEmailOutboxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllEmailOutboxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResendEmailOutboxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResendEmailOutboxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendOrderEmailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendOrderEmailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitContactFormRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitContactFormResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderPaymentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordPaymentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePaymentStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePaymentStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandlePaymentWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandlePaymentWebhookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReturnData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5100,8 +21110,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveProductRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveReturnRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5112,8 +21122,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveProductResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveReturnResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5124,8 +21134,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllProductRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestReturnRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5136,8 +21146,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllProductResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestReturnResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5148,8 +21158,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllProductRequestById); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllReturnRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5160,8 +21170,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllProductResponseById); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllReturnResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5172,8 +21182,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProductRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReturnByIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5184,8 +21194,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProductResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReturnByIdResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5196,8 +21206,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProductQuantityRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReturnStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5208,8 +21218,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProductQuantityResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReturnStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5220,8 +21230,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProductStatusRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiveReturnRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5232,8 +21242,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProductStatusResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiveReturnResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5244,8 +21254,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FreebiesData); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundPaymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5256,8 +21266,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveFreebiesRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundPaymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5268,8 +21278,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveFreebiesResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShippingZoneData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5280,8 +21290,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllFreebiesRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveShippingZoneRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5292,8 +21302,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllFreebiesResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveShippingZoneResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5304,8 +21314,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllFreebiesDropdownRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllShippingZoneRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5316,8 +21326,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllFreebiesDropdownResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllShippingZoneResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5328,8 +21338,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllFreebiesRequestById); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateShippingZoneRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5340,8 +21350,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllFreebiesResponseById); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateShippingZoneResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5352,8 +21362,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateFreebiesRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateShippingZoneStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5364,8 +21374,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateFreebiesResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateShippingZoneStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5376,8 +21386,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateFreebiesQuantityRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteShippingZoneRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5388,8 +21398,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateFreebiesQuantityResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteShippingZoneResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5400,8 +21410,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateFreebiesStatusRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteShippingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5412,8 +21422,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateFreebiesStatusResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteShippingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5424,8 +21434,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReviewsData); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderEventData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5436,8 +21446,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveReviewsRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateShipmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5448,8 +21458,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveReviewsResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateShipmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5460,8 +21470,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllReviewsRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelShipmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5472,8 +21482,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllReviewsResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelShipmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5484,8 +21494,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateReviewsRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncTrackingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5496,8 +21506,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateReviewsResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncTrackingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5508,8 +21518,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateReviewsStatusRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandleCourierWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5520,8 +21530,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateReviewsStatusResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandleCourierWebhookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5532,8 +21542,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllReviewsRequestById); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderTimelineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5544,8 +21554,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllReviewsResponseById); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderTimelineResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5556,8 +21566,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderData); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderDocumentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5568,8 +21578,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveOrderRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderDocumentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5580,8 +21590,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveOrderResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBulkOrderDocumentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5592,8 +21602,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllOrderRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBulkOrderDocumentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5604,8 +21614,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllOrderResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkOrderResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5616,8 +21626,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrderRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkUpdateOrderStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5628,8 +21638,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrderResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkUpdateOrderStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5640,8 +21650,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrderStatusRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkAssignTrackingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5652,8 +21662,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrderStatusResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkAssignTrackingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5664,8 +21674,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllOrderRevenueRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkAddOrderNoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5676,8 +21686,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllOrderRevenueResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkAddOrderNoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5688,8 +21698,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllTotalOrderRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportOrdersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5700,8 +21710,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllTotalOrderResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRowError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5712,8 +21722,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBestSellingProductsRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportOrderResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5724,8 +21734,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBestSellingProductsResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportOrdersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5736,8 +21746,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HomeImagesData); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[202].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportCatalogueRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5748,8 +21758,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveHomeImagesRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[203].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportCatalogueResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5760,8 +21770,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveHomeImagesResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[204].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportCatalogueResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5772,8 +21782,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllHomeImagesRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[205].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadImageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5784,8 +21794,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllHomeImagesResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[206].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageThumbnail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5796,8 +21806,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateHomeImagesRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[207].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadedImage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5808,8 +21818,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateHomeImagesResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[208].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadImageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5820,8 +21830,8 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteHomeImagesRequest); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[209].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProductAttributeData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5832,8 +21842,164 @@ func file_pkg_pb_chronexdata_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_chronexdata_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteHomeImagesResponse); i {
+		file_pkg_pb_chronexdata_proto_msgTypes[210].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveProductAttributeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[211].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveProductAttributeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[212].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllProductAttributeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[213].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllProductAttributeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[214].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProductAttributeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[215].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProductAttributeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[216].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProductAttributeStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[217].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProductAttributeStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[218].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProductAttributeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[219].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProductAttributeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[220].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareProductsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[221].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComparedProduct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[222].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComparisonRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_chronexdata_proto_msgTypes[223].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareProductsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5851,7 +22017,7 @@ func file_pkg_pb_chronexdata_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_pb_chronexdata_proto_rawDesc,
 			NumEnums:      4,
-			NumMessages:   63,
+			NumMessages:   224,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -48,9 +48,77 @@ type ChronexAdminProtoServiceClient interface {
 	UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*UpdateOrderStatusResponse, error)
 	UpdateHomeImages(ctx context.Context, in *UpdateHomeImagesRequest, opts ...grpc.CallOption) (*UpdateHomeImagesResponse, error)
 	DeleteHomeImages(ctx context.Context, in *DeleteHomeImagesRequest, opts ...grpc.CallOption) (*DeleteHomeImagesResponse, error)
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	DeleteFreebies(ctx context.Context, in *DeleteFreebiesRequest, opts ...grpc.CallOption) (*DeleteFreebiesResponse, error)
+	DeleteReviews(ctx context.Context, in *DeleteReviewsRequest, opts ...grpc.CallOption) (*DeleteReviewsResponse, error)
+	DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*DeleteOrderResponse, error)
 	GetAllOrderRevenue(ctx context.Context, in *GetAllOrderRevenueRequest, opts ...grpc.CallOption) (*GetAllOrderRevenueResponse, error)
 	GetAllTotalOrder(ctx context.Context, in *GetAllTotalOrderRequest, opts ...grpc.CallOption) (*GetAllTotalOrderResponse, error)
 	GetBestSellingProducts(ctx context.Context, in *GetBestSellingProductsRequest, opts ...grpc.CallOption) (*GetBestSellingProductsResponse, error)
+	SaveFreebiesRule(ctx context.Context, in *SaveFreebiesRuleRequest, opts ...grpc.CallOption) (*SaveFreebiesRuleResponse, error)
+	GetAllFreebiesRule(ctx context.Context, in *GetAllFreebiesRuleRequest, opts ...grpc.CallOption) (*GetAllFreebiesRuleResponse, error)
+	UpdateFreebiesRule(ctx context.Context, in *UpdateFreebiesRuleRequest, opts ...grpc.CallOption) (*UpdateFreebiesRuleResponse, error)
+	UpdateFreebiesRuleStatus(ctx context.Context, in *UpdateFreebiesRuleStatusRequest, opts ...grpc.CallOption) (*UpdateFreebiesRuleStatusResponse, error)
+	PreviewCart(ctx context.Context, in *PreviewCartRequest, opts ...grpc.CallOption) (*PreviewCartResponse, error)
+	SaveVoucher(ctx context.Context, in *SaveVoucherRequest, opts ...grpc.CallOption) (*SaveVoucherResponse, error)
+	GetAllVoucher(ctx context.Context, in *GetAllVoucherRequest, opts ...grpc.CallOption) (*GetAllVoucherResponse, error)
+	UpdateVoucher(ctx context.Context, in *UpdateVoucherRequest, opts ...grpc.CallOption) (*UpdateVoucherResponse, error)
+	UpdateVoucherStatus(ctx context.Context, in *UpdateVoucherStatusRequest, opts ...grpc.CallOption) (*UpdateVoucherStatusResponse, error)
+	SavePriceRule(ctx context.Context, in *SavePriceRuleRequest, opts ...grpc.CallOption) (*SavePriceRuleResponse, error)
+	GetAllPriceRule(ctx context.Context, in *GetAllPriceRuleRequest, opts ...grpc.CallOption) (*GetAllPriceRuleResponse, error)
+	UpdatePriceRule(ctx context.Context, in *UpdatePriceRuleRequest, opts ...grpc.CallOption) (*UpdatePriceRuleResponse, error)
+	UpdatePriceRuleStatus(ctx context.Context, in *UpdatePriceRuleStatusRequest, opts ...grpc.CallOption) (*UpdatePriceRuleStatusResponse, error)
+	DeletePriceRule(ctx context.Context, in *DeletePriceRuleRequest, opts ...grpc.CallOption) (*DeletePriceRuleResponse, error)
+	GetProductPriceHistory(ctx context.Context, in *GetProductPriceHistoryRequest, opts ...grpc.CallOption) (*GetProductPriceHistoryResponse, error)
+	GetAllTrash(ctx context.Context, in *GetAllTrashRequest, opts ...grpc.CallOption) (*GetAllTrashResponse, error)
+	RestoreTrash(ctx context.Context, in *RestoreTrashRequest, opts ...grpc.CallOption) (*RestoreTrashResponse, error)
+	PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error)
+	GetAllCustomer(ctx context.Context, in *GetAllCustomerRequest, opts ...grpc.CallOption) (*GetAllCustomerResponse, error)
+	GetCustomerById(ctx context.Context, in *GetCustomerByIdRequest, opts ...grpc.CallOption) (*GetCustomerByIdResponse, error)
+	LookupOrder(ctx context.Context, in *LookupOrderRequest, opts ...grpc.CallOption) (*LookupOrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	PreviewOrderEmail(ctx context.Context, in *PreviewOrderEmailRequest, opts ...grpc.CallOption) (*PreviewOrderEmailResponse, error)
+	GetAllEmailOutbox(ctx context.Context, in *GetAllEmailOutboxRequest, opts ...grpc.CallOption) (*GetAllEmailOutboxResponse, error)
+	ResendEmailOutbox(ctx context.Context, in *ResendEmailOutboxRequest, opts ...grpc.CallOption) (*ResendEmailOutboxResponse, error)
+	SendOrderEmail(ctx context.Context, in *SendOrderEmailRequest, opts ...grpc.CallOption) (*SendOrderEmailResponse, error)
+	SubmitContactForm(ctx context.Context, in *SubmitContactFormRequest, opts ...grpc.CallOption) (*SubmitContactFormResponse, error)
+	GetOrderPayment(ctx context.Context, in *GetOrderPaymentRequest, opts ...grpc.CallOption) (*GetOrderPaymentResponse, error)
+	RecordPayment(ctx context.Context, in *RecordPaymentRequest, opts ...grpc.CallOption) (*RecordPaymentResponse, error)
+	UpdatePaymentStatus(ctx context.Context, in *UpdatePaymentStatusRequest, opts ...grpc.CallOption) (*UpdatePaymentStatusResponse, error)
+	HandlePaymentWebhook(ctx context.Context, in *HandlePaymentWebhookRequest, opts ...grpc.CallOption) (*HandlePaymentWebhookResponse, error)
+	SaveReturn(ctx context.Context, in *SaveReturnRequest, opts ...grpc.CallOption) (*SaveReturnResponse, error)
+	RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*RequestReturnResponse, error)
+	GetAllReturn(ctx context.Context, in *GetAllReturnRequest, opts ...grpc.CallOption) (*GetAllReturnResponse, error)
+	GetReturnById(ctx context.Context, in *GetReturnByIdRequest, opts ...grpc.CallOption) (*GetReturnByIdResponse, error)
+	UpdateReturnStatus(ctx context.Context, in *UpdateReturnStatusRequest, opts ...grpc.CallOption) (*UpdateReturnStatusResponse, error)
+	ReceiveReturn(ctx context.Context, in *ReceiveReturnRequest, opts ...grpc.CallOption) (*ReceiveReturnResponse, error)
+	RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error)
+	SaveShippingZone(ctx context.Context, in *SaveShippingZoneRequest, opts ...grpc.CallOption) (*SaveShippingZoneResponse, error)
+	GetAllShippingZone(ctx context.Context, in *GetAllShippingZoneRequest, opts ...grpc.CallOption) (*GetAllShippingZoneResponse, error)
+	UpdateShippingZone(ctx context.Context, in *UpdateShippingZoneRequest, opts ...grpc.CallOption) (*UpdateShippingZoneResponse, error)
+	UpdateShippingZoneStatus(ctx context.Context, in *UpdateShippingZoneStatusRequest, opts ...grpc.CallOption) (*UpdateShippingZoneStatusResponse, error)
+	DeleteShippingZone(ctx context.Context, in *DeleteShippingZoneRequest, opts ...grpc.CallOption) (*DeleteShippingZoneResponse, error)
+	QuoteShipping(ctx context.Context, in *QuoteShippingRequest, opts ...grpc.CallOption) (*QuoteShippingResponse, error)
+	CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*CreateShipmentResponse, error)
+	CancelShipment(ctx context.Context, in *CancelShipmentRequest, opts ...grpc.CallOption) (*CancelShipmentResponse, error)
+	SyncTracking(ctx context.Context, in *SyncTrackingRequest, opts ...grpc.CallOption) (*SyncTrackingResponse, error)
+	HandleCourierWebhook(ctx context.Context, in *HandleCourierWebhookRequest, opts ...grpc.CallOption) (*HandleCourierWebhookResponse, error)
+	GetOrderTimeline(ctx context.Context, in *GetOrderTimelineRequest, opts ...grpc.CallOption) (*GetOrderTimelineResponse, error)
+	GetOrderDocument(ctx context.Context, in *GetOrderDocumentRequest, opts ...grpc.CallOption) (*GetOrderDocumentResponse, error)
+	GetBulkOrderDocument(ctx context.Context, in *GetBulkOrderDocumentRequest, opts ...grpc.CallOption) (*GetBulkOrderDocumentResponse, error)
+	BulkUpdateOrderStatus(ctx context.Context, in *BulkUpdateOrderStatusRequest, opts ...grpc.CallOption) (*BulkUpdateOrderStatusResponse, error)
+	BulkAssignTracking(ctx context.Context, in *BulkAssignTrackingRequest, opts ...grpc.CallOption) (*BulkAssignTrackingResponse, error)
+	BulkAddOrderNote(ctx context.Context, in *BulkAddOrderNoteRequest, opts ...grpc.CallOption) (*BulkAddOrderNoteResponse, error)
+	ImportOrders(ctx context.Context, in *ImportOrdersRequest, opts ...grpc.CallOption) (*ImportOrdersResponse, error)
+	ImportProducts(ctx context.Context, in *ImportCatalogueRequest, opts ...grpc.CallOption) (*ImportCatalogueResponse, error)
+	ImportFreebies(ctx context.Context, in *ImportCatalogueRequest, opts ...grpc.CallOption) (*ImportCatalogueResponse, error)
+	UploadImage(ctx context.Context, in *UploadImageRequest, opts ...grpc.CallOption) (*UploadImageResponse, error)
+	SaveProductAttribute(ctx context.Context, in *SaveProductAttributeRequest, opts ...grpc.CallOption) (*SaveProductAttributeResponse, error)
+	GetAllProductAttribute(ctx context.Context, in *GetAllProductAttributeRequest, opts ...grpc.CallOption) (*GetAllProductAttributeResponse, error)
+	UpdateProductAttribute(ctx context.Context, in *UpdateProductAttributeRequest, opts ...grpc.CallOption) (*UpdateProductAttributeResponse, error)
+	UpdateProductAttributeStatus(ctx context.Context, in *UpdateProductAttributeStatusRequest, opts ...grpc.CallOption) (*UpdateProductAttributeStatusResponse, error)
+	DeleteProductAttribute(ctx context.Context, in *DeleteProductAttributeRequest, opts ...grpc.CallOption) (*DeleteProductAttributeResponse, error)
+	CompareProducts(ctx context.Context, in *CompareProductsRequest, opts ...grpc.CallOption) (*CompareProductsResponse, error)
 }
 
 type chronexAdminProtoServiceClient struct {
@@ -295,692 +363,2800 @@ func (c *chronexAdminProtoServiceClient) DeleteHomeImages(ctx context.Context, i
 	return out, nil
 }
 
+func (c *chronexAdminProtoServiceClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
+	out := new(DeleteProductResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/DeleteProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) DeleteFreebies(ctx context.Context, in *DeleteFreebiesRequest, opts ...grpc.CallOption) (*DeleteFreebiesResponse, error) {
+	out := new(DeleteFreebiesResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/DeleteFreebies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) DeleteReviews(ctx context.Context, in *DeleteReviewsRequest, opts ...grpc.CallOption) (*DeleteReviewsResponse, error) {
+	out := new(DeleteReviewsResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/DeleteReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*DeleteOrderResponse, error) {
+	out := new(DeleteOrderResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/DeleteOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chronexAdminProtoServiceClient) GetAllOrderRevenue(ctx context.Context, in *GetAllOrderRevenueRequest, opts ...grpc.CallOption) (*GetAllOrderRevenueResponse, error) {
 	out := new(GetAllOrderRevenueResponse)
 	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetAllOrderRevenue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetAllTotalOrder(ctx context.Context, in *GetAllTotalOrderRequest, opts ...grpc.CallOption) (*GetAllTotalOrderResponse, error) {
+	out := new(GetAllTotalOrderResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetAllTotalOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetBestSellingProducts(ctx context.Context, in *GetBestSellingProductsRequest, opts ...grpc.CallOption) (*GetBestSellingProductsResponse, error) {
+	out := new(GetBestSellingProductsResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetBestSellingProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) SaveFreebiesRule(ctx context.Context, in *SaveFreebiesRuleRequest, opts ...grpc.CallOption) (*SaveFreebiesRuleResponse, error) {
+	out := new(SaveFreebiesRuleResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/SaveFreebiesRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetAllFreebiesRule(ctx context.Context, in *GetAllFreebiesRuleRequest, opts ...grpc.CallOption) (*GetAllFreebiesRuleResponse, error) {
+	out := new(GetAllFreebiesRuleResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetAllFreebiesRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) UpdateFreebiesRule(ctx context.Context, in *UpdateFreebiesRuleRequest, opts ...grpc.CallOption) (*UpdateFreebiesRuleResponse, error) {
+	out := new(UpdateFreebiesRuleResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/UpdateFreebiesRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) UpdateFreebiesRuleStatus(ctx context.Context, in *UpdateFreebiesRuleStatusRequest, opts ...grpc.CallOption) (*UpdateFreebiesRuleStatusResponse, error) {
+	out := new(UpdateFreebiesRuleStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/UpdateFreebiesRuleStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) PreviewCart(ctx context.Context, in *PreviewCartRequest, opts ...grpc.CallOption) (*PreviewCartResponse, error) {
+	out := new(PreviewCartResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/PreviewCart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) SaveVoucher(ctx context.Context, in *SaveVoucherRequest, opts ...grpc.CallOption) (*SaveVoucherResponse, error) {
+	out := new(SaveVoucherResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/SaveVoucher", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetAllVoucher(ctx context.Context, in *GetAllVoucherRequest, opts ...grpc.CallOption) (*GetAllVoucherResponse, error) {
+	out := new(GetAllVoucherResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetAllVoucher", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) UpdateVoucher(ctx context.Context, in *UpdateVoucherRequest, opts ...grpc.CallOption) (*UpdateVoucherResponse, error) {
+	out := new(UpdateVoucherResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/UpdateVoucher", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) UpdateVoucherStatus(ctx context.Context, in *UpdateVoucherStatusRequest, opts ...grpc.CallOption) (*UpdateVoucherStatusResponse, error) {
+	out := new(UpdateVoucherStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/UpdateVoucherStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) SavePriceRule(ctx context.Context, in *SavePriceRuleRequest, opts ...grpc.CallOption) (*SavePriceRuleResponse, error) {
+	out := new(SavePriceRuleResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/SavePriceRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetAllPriceRule(ctx context.Context, in *GetAllPriceRuleRequest, opts ...grpc.CallOption) (*GetAllPriceRuleResponse, error) {
+	out := new(GetAllPriceRuleResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetAllPriceRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) UpdatePriceRule(ctx context.Context, in *UpdatePriceRuleRequest, opts ...grpc.CallOption) (*UpdatePriceRuleResponse, error) {
+	out := new(UpdatePriceRuleResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/UpdatePriceRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) UpdatePriceRuleStatus(ctx context.Context, in *UpdatePriceRuleStatusRequest, opts ...grpc.CallOption) (*UpdatePriceRuleStatusResponse, error) {
+	out := new(UpdatePriceRuleStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/UpdatePriceRuleStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) DeletePriceRule(ctx context.Context, in *DeletePriceRuleRequest, opts ...grpc.CallOption) (*DeletePriceRuleResponse, error) {
+	out := new(DeletePriceRuleResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/DeletePriceRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetProductPriceHistory(ctx context.Context, in *GetProductPriceHistoryRequest, opts ...grpc.CallOption) (*GetProductPriceHistoryResponse, error) {
+	out := new(GetProductPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetProductPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetAllTrash(ctx context.Context, in *GetAllTrashRequest, opts ...grpc.CallOption) (*GetAllTrashResponse, error) {
+	out := new(GetAllTrashResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetAllTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) RestoreTrash(ctx context.Context, in *RestoreTrashRequest, opts ...grpc.CallOption) (*RestoreTrashResponse, error) {
+	out := new(RestoreTrashResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/RestoreTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error) {
+	out := new(PurgeTrashResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/PurgeTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetAllCustomer(ctx context.Context, in *GetAllCustomerRequest, opts ...grpc.CallOption) (*GetAllCustomerResponse, error) {
+	out := new(GetAllCustomerResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetAllCustomer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetCustomerById(ctx context.Context, in *GetCustomerByIdRequest, opts ...grpc.CallOption) (*GetCustomerByIdResponse, error) {
+	out := new(GetCustomerByIdResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetCustomerById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) LookupOrder(ctx context.Context, in *LookupOrderRequest, opts ...grpc.CallOption) (*LookupOrderResponse, error) {
+	out := new(LookupOrderResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/LookupOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) PreviewOrderEmail(ctx context.Context, in *PreviewOrderEmailRequest, opts ...grpc.CallOption) (*PreviewOrderEmailResponse, error) {
+	out := new(PreviewOrderEmailResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/PreviewOrderEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetAllEmailOutbox(ctx context.Context, in *GetAllEmailOutboxRequest, opts ...grpc.CallOption) (*GetAllEmailOutboxResponse, error) {
+	out := new(GetAllEmailOutboxResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetAllEmailOutbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) ResendEmailOutbox(ctx context.Context, in *ResendEmailOutboxRequest, opts ...grpc.CallOption) (*ResendEmailOutboxResponse, error) {
+	out := new(ResendEmailOutboxResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/ResendEmailOutbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) SendOrderEmail(ctx context.Context, in *SendOrderEmailRequest, opts ...grpc.CallOption) (*SendOrderEmailResponse, error) {
+	out := new(SendOrderEmailResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/SendOrderEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) SubmitContactForm(ctx context.Context, in *SubmitContactFormRequest, opts ...grpc.CallOption) (*SubmitContactFormResponse, error) {
+	out := new(SubmitContactFormResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/SubmitContactForm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetOrderPayment(ctx context.Context, in *GetOrderPaymentRequest, opts ...grpc.CallOption) (*GetOrderPaymentResponse, error) {
+	out := new(GetOrderPaymentResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetOrderPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) RecordPayment(ctx context.Context, in *RecordPaymentRequest, opts ...grpc.CallOption) (*RecordPaymentResponse, error) {
+	out := new(RecordPaymentResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/RecordPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) UpdatePaymentStatus(ctx context.Context, in *UpdatePaymentStatusRequest, opts ...grpc.CallOption) (*UpdatePaymentStatusResponse, error) {
+	out := new(UpdatePaymentStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/UpdatePaymentStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) HandlePaymentWebhook(ctx context.Context, in *HandlePaymentWebhookRequest, opts ...grpc.CallOption) (*HandlePaymentWebhookResponse, error) {
+	out := new(HandlePaymentWebhookResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/HandlePaymentWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) SaveReturn(ctx context.Context, in *SaveReturnRequest, opts ...grpc.CallOption) (*SaveReturnResponse, error) {
+	out := new(SaveReturnResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/SaveReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*RequestReturnResponse, error) {
+	out := new(RequestReturnResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/RequestReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetAllReturn(ctx context.Context, in *GetAllReturnRequest, opts ...grpc.CallOption) (*GetAllReturnResponse, error) {
+	out := new(GetAllReturnResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetAllReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetReturnById(ctx context.Context, in *GetReturnByIdRequest, opts ...grpc.CallOption) (*GetReturnByIdResponse, error) {
+	out := new(GetReturnByIdResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetReturnById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) UpdateReturnStatus(ctx context.Context, in *UpdateReturnStatusRequest, opts ...grpc.CallOption) (*UpdateReturnStatusResponse, error) {
+	out := new(UpdateReturnStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/UpdateReturnStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) ReceiveReturn(ctx context.Context, in *ReceiveReturnRequest, opts ...grpc.CallOption) (*ReceiveReturnResponse, error) {
+	out := new(ReceiveReturnResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/ReceiveReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error) {
+	out := new(RefundPaymentResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/RefundPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) SaveShippingZone(ctx context.Context, in *SaveShippingZoneRequest, opts ...grpc.CallOption) (*SaveShippingZoneResponse, error) {
+	out := new(SaveShippingZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/SaveShippingZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetAllShippingZone(ctx context.Context, in *GetAllShippingZoneRequest, opts ...grpc.CallOption) (*GetAllShippingZoneResponse, error) {
+	out := new(GetAllShippingZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetAllShippingZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) UpdateShippingZone(ctx context.Context, in *UpdateShippingZoneRequest, opts ...grpc.CallOption) (*UpdateShippingZoneResponse, error) {
+	out := new(UpdateShippingZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/UpdateShippingZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) UpdateShippingZoneStatus(ctx context.Context, in *UpdateShippingZoneStatusRequest, opts ...grpc.CallOption) (*UpdateShippingZoneStatusResponse, error) {
+	out := new(UpdateShippingZoneStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/UpdateShippingZoneStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) DeleteShippingZone(ctx context.Context, in *DeleteShippingZoneRequest, opts ...grpc.CallOption) (*DeleteShippingZoneResponse, error) {
+	out := new(DeleteShippingZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/DeleteShippingZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) QuoteShipping(ctx context.Context, in *QuoteShippingRequest, opts ...grpc.CallOption) (*QuoteShippingResponse, error) {
+	out := new(QuoteShippingResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/QuoteShipping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*CreateShipmentResponse, error) {
+	out := new(CreateShipmentResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/CreateShipment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) CancelShipment(ctx context.Context, in *CancelShipmentRequest, opts ...grpc.CallOption) (*CancelShipmentResponse, error) {
+	out := new(CancelShipmentResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/CancelShipment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) SyncTracking(ctx context.Context, in *SyncTrackingRequest, opts ...grpc.CallOption) (*SyncTrackingResponse, error) {
+	out := new(SyncTrackingResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/SyncTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) HandleCourierWebhook(ctx context.Context, in *HandleCourierWebhookRequest, opts ...grpc.CallOption) (*HandleCourierWebhookResponse, error) {
+	out := new(HandleCourierWebhookResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/HandleCourierWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetOrderTimeline(ctx context.Context, in *GetOrderTimelineRequest, opts ...grpc.CallOption) (*GetOrderTimelineResponse, error) {
+	out := new(GetOrderTimelineResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetOrderTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetOrderDocument(ctx context.Context, in *GetOrderDocumentRequest, opts ...grpc.CallOption) (*GetOrderDocumentResponse, error) {
+	out := new(GetOrderDocumentResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetOrderDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetBulkOrderDocument(ctx context.Context, in *GetBulkOrderDocumentRequest, opts ...grpc.CallOption) (*GetBulkOrderDocumentResponse, error) {
+	out := new(GetBulkOrderDocumentResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetBulkOrderDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) BulkUpdateOrderStatus(ctx context.Context, in *BulkUpdateOrderStatusRequest, opts ...grpc.CallOption) (*BulkUpdateOrderStatusResponse, error) {
+	out := new(BulkUpdateOrderStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/BulkUpdateOrderStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) BulkAssignTracking(ctx context.Context, in *BulkAssignTrackingRequest, opts ...grpc.CallOption) (*BulkAssignTrackingResponse, error) {
+	out := new(BulkAssignTrackingResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/BulkAssignTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) BulkAddOrderNote(ctx context.Context, in *BulkAddOrderNoteRequest, opts ...grpc.CallOption) (*BulkAddOrderNoteResponse, error) {
+	out := new(BulkAddOrderNoteResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/BulkAddOrderNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) ImportOrders(ctx context.Context, in *ImportOrdersRequest, opts ...grpc.CallOption) (*ImportOrdersResponse, error) {
+	out := new(ImportOrdersResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/ImportOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) ImportProducts(ctx context.Context, in *ImportCatalogueRequest, opts ...grpc.CallOption) (*ImportCatalogueResponse, error) {
+	out := new(ImportCatalogueResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/ImportProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) ImportFreebies(ctx context.Context, in *ImportCatalogueRequest, opts ...grpc.CallOption) (*ImportCatalogueResponse, error) {
+	out := new(ImportCatalogueResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/ImportFreebies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) UploadImage(ctx context.Context, in *UploadImageRequest, opts ...grpc.CallOption) (*UploadImageResponse, error) {
+	out := new(UploadImageResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/UploadImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) SaveProductAttribute(ctx context.Context, in *SaveProductAttributeRequest, opts ...grpc.CallOption) (*SaveProductAttributeResponse, error) {
+	out := new(SaveProductAttributeResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/SaveProductAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) GetAllProductAttribute(ctx context.Context, in *GetAllProductAttributeRequest, opts ...grpc.CallOption) (*GetAllProductAttributeResponse, error) {
+	out := new(GetAllProductAttributeResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetAllProductAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) UpdateProductAttribute(ctx context.Context, in *UpdateProductAttributeRequest, opts ...grpc.CallOption) (*UpdateProductAttributeResponse, error) {
+	out := new(UpdateProductAttributeResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/UpdateProductAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) UpdateProductAttributeStatus(ctx context.Context, in *UpdateProductAttributeStatusRequest, opts ...grpc.CallOption) (*UpdateProductAttributeStatusResponse, error) {
+	out := new(UpdateProductAttributeStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/UpdateProductAttributeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) DeleteProductAttribute(ctx context.Context, in *DeleteProductAttributeRequest, opts ...grpc.CallOption) (*DeleteProductAttributeResponse, error) {
+	out := new(DeleteProductAttributeResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/DeleteProductAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chronexAdminProtoServiceClient) CompareProducts(ctx context.Context, in *CompareProductsRequest, opts ...grpc.CallOption) (*CompareProductsResponse, error) {
+	out := new(CompareProductsResponse)
+	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/CompareProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChronexAdminProtoServiceServer is the server API for ChronexAdminProtoService service.
+// All implementations must embed UnimplementedChronexAdminProtoServiceServer
+// for forward compatibility
+type ChronexAdminProtoServiceServer interface {
+	SaveProduct(context.Context, *SaveProductRequest) (*SaveProductResponse, error)
+	SaveFreebies(context.Context, *SaveFreebiesRequest) (*SaveFreebiesResponse, error)
+	SaveReviews(context.Context, *SaveReviewsRequest) (*SaveReviewsResponse, error)
+	SaveOrder(context.Context, *SaveOrderRequest) (*SaveOrderResponse, error)
+	SaveHomeImages(context.Context, *SaveHomeImagesRequest) (*SaveHomeImagesResponse, error)
+	GetAllFreebies(context.Context, *GetAllFreebiesRequest) (*GetAllFreebiesResponse, error)
+	GetAllFreebiesDropdown(context.Context, *GetAllFreebiesDropdownRequest) (*GetAllFreebiesDropdownResponse, error)
+	GetAllProduct(context.Context, *GetAllProductRequest) (*GetAllProductResponse, error)
+	GetAllReviews(context.Context, *GetAllReviewsRequest) (*GetAllReviewsResponse, error)
+	GetAllOrder(context.Context, *GetAllOrderRequest) (*GetAllOrderResponse, error)
+	GetAllHomeImages(context.Context, *GetAllHomeImagesRequest) (*GetAllHomeImagesResponse, error)
+	GetAllFreebiesById(context.Context, *GetAllFreebiesRequestById) (*GetAllFreebiesResponseById, error)
+	GetAllProductById(context.Context, *GetAllProductRequestById) (*GetAllProductResponseById, error)
+	GetAllReviewsById(context.Context, *GetAllReviewsRequestById) (*GetAllReviewsResponseById, error)
+	UpdateFreebies(context.Context, *UpdateFreebiesRequest) (*UpdateFreebiesResponse, error)
+	UpdateFreebiesQuantity(context.Context, *UpdateFreebiesQuantityRequest) (*UpdateFreebiesQuantityResponse, error)
+	UpdateFreebiesStatus(context.Context, *UpdateFreebiesStatusRequest) (*UpdateFreebiesStatusResponse, error)
+	UpdateReviews(context.Context, *UpdateReviewsRequest) (*UpdateReviewsResponse, error)
+	UpdateReviewsStatus(context.Context, *UpdateReviewsStatusRequest) (*UpdateReviewsStatusResponse, error)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
+	UpdateProductQuantity(context.Context, *UpdateProductQuantityRequest) (*UpdateProductQuantityResponse, error)
+	UpdateProductStatus(context.Context, *UpdateProductStatusRequest) (*UpdateProductStatusResponse, error)
+	UpdateOrder(context.Context, *UpdateOrderRequest) (*UpdateOrderResponse, error)
+	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error)
+	UpdateHomeImages(context.Context, *UpdateHomeImagesRequest) (*UpdateHomeImagesResponse, error)
+	DeleteHomeImages(context.Context, *DeleteHomeImagesRequest) (*DeleteHomeImagesResponse, error)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	DeleteFreebies(context.Context, *DeleteFreebiesRequest) (*DeleteFreebiesResponse, error)
+	DeleteReviews(context.Context, *DeleteReviewsRequest) (*DeleteReviewsResponse, error)
+	DeleteOrder(context.Context, *DeleteOrderRequest) (*DeleteOrderResponse, error)
+	GetAllOrderRevenue(context.Context, *GetAllOrderRevenueRequest) (*GetAllOrderRevenueResponse, error)
+	GetAllTotalOrder(context.Context, *GetAllTotalOrderRequest) (*GetAllTotalOrderResponse, error)
+	GetBestSellingProducts(context.Context, *GetBestSellingProductsRequest) (*GetBestSellingProductsResponse, error)
+	SaveFreebiesRule(context.Context, *SaveFreebiesRuleRequest) (*SaveFreebiesRuleResponse, error)
+	GetAllFreebiesRule(context.Context, *GetAllFreebiesRuleRequest) (*GetAllFreebiesRuleResponse, error)
+	UpdateFreebiesRule(context.Context, *UpdateFreebiesRuleRequest) (*UpdateFreebiesRuleResponse, error)
+	UpdateFreebiesRuleStatus(context.Context, *UpdateFreebiesRuleStatusRequest) (*UpdateFreebiesRuleStatusResponse, error)
+	PreviewCart(context.Context, *PreviewCartRequest) (*PreviewCartResponse, error)
+	SaveVoucher(context.Context, *SaveVoucherRequest) (*SaveVoucherResponse, error)
+	GetAllVoucher(context.Context, *GetAllVoucherRequest) (*GetAllVoucherResponse, error)
+	UpdateVoucher(context.Context, *UpdateVoucherRequest) (*UpdateVoucherResponse, error)
+	UpdateVoucherStatus(context.Context, *UpdateVoucherStatusRequest) (*UpdateVoucherStatusResponse, error)
+	SavePriceRule(context.Context, *SavePriceRuleRequest) (*SavePriceRuleResponse, error)
+	GetAllPriceRule(context.Context, *GetAllPriceRuleRequest) (*GetAllPriceRuleResponse, error)
+	UpdatePriceRule(context.Context, *UpdatePriceRuleRequest) (*UpdatePriceRuleResponse, error)
+	UpdatePriceRuleStatus(context.Context, *UpdatePriceRuleStatusRequest) (*UpdatePriceRuleStatusResponse, error)
+	DeletePriceRule(context.Context, *DeletePriceRuleRequest) (*DeletePriceRuleResponse, error)
+	GetProductPriceHistory(context.Context, *GetProductPriceHistoryRequest) (*GetProductPriceHistoryResponse, error)
+	GetAllTrash(context.Context, *GetAllTrashRequest) (*GetAllTrashResponse, error)
+	RestoreTrash(context.Context, *RestoreTrashRequest) (*RestoreTrashResponse, error)
+	PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error)
+	GetAllCustomer(context.Context, *GetAllCustomerRequest) (*GetAllCustomerResponse, error)
+	GetCustomerById(context.Context, *GetCustomerByIdRequest) (*GetCustomerByIdResponse, error)
+	LookupOrder(context.Context, *LookupOrderRequest) (*LookupOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	PreviewOrderEmail(context.Context, *PreviewOrderEmailRequest) (*PreviewOrderEmailResponse, error)
+	GetAllEmailOutbox(context.Context, *GetAllEmailOutboxRequest) (*GetAllEmailOutboxResponse, error)
+	ResendEmailOutbox(context.Context, *ResendEmailOutboxRequest) (*ResendEmailOutboxResponse, error)
+	SendOrderEmail(context.Context, *SendOrderEmailRequest) (*SendOrderEmailResponse, error)
+	SubmitContactForm(context.Context, *SubmitContactFormRequest) (*SubmitContactFormResponse, error)
+	GetOrderPayment(context.Context, *GetOrderPaymentRequest) (*GetOrderPaymentResponse, error)
+	RecordPayment(context.Context, *RecordPaymentRequest) (*RecordPaymentResponse, error)
+	UpdatePaymentStatus(context.Context, *UpdatePaymentStatusRequest) (*UpdatePaymentStatusResponse, error)
+	HandlePaymentWebhook(context.Context, *HandlePaymentWebhookRequest) (*HandlePaymentWebhookResponse, error)
+	SaveReturn(context.Context, *SaveReturnRequest) (*SaveReturnResponse, error)
+	RequestReturn(context.Context, *RequestReturnRequest) (*RequestReturnResponse, error)
+	GetAllReturn(context.Context, *GetAllReturnRequest) (*GetAllReturnResponse, error)
+	GetReturnById(context.Context, *GetReturnByIdRequest) (*GetReturnByIdResponse, error)
+	UpdateReturnStatus(context.Context, *UpdateReturnStatusRequest) (*UpdateReturnStatusResponse, error)
+	ReceiveReturn(context.Context, *ReceiveReturnRequest) (*ReceiveReturnResponse, error)
+	RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error)
+	SaveShippingZone(context.Context, *SaveShippingZoneRequest) (*SaveShippingZoneResponse, error)
+	GetAllShippingZone(context.Context, *GetAllShippingZoneRequest) (*GetAllShippingZoneResponse, error)
+	UpdateShippingZone(context.Context, *UpdateShippingZoneRequest) (*UpdateShippingZoneResponse, error)
+	UpdateShippingZoneStatus(context.Context, *UpdateShippingZoneStatusRequest) (*UpdateShippingZoneStatusResponse, error)
+	DeleteShippingZone(context.Context, *DeleteShippingZoneRequest) (*DeleteShippingZoneResponse, error)
+	QuoteShipping(context.Context, *QuoteShippingRequest) (*QuoteShippingResponse, error)
+	CreateShipment(context.Context, *CreateShipmentRequest) (*CreateShipmentResponse, error)
+	CancelShipment(context.Context, *CancelShipmentRequest) (*CancelShipmentResponse, error)
+	SyncTracking(context.Context, *SyncTrackingRequest) (*SyncTrackingResponse, error)
+	HandleCourierWebhook(context.Context, *HandleCourierWebhookRequest) (*HandleCourierWebhookResponse, error)
+	GetOrderTimeline(context.Context, *GetOrderTimelineRequest) (*GetOrderTimelineResponse, error)
+	GetOrderDocument(context.Context, *GetOrderDocumentRequest) (*GetOrderDocumentResponse, error)
+	GetBulkOrderDocument(context.Context, *GetBulkOrderDocumentRequest) (*GetBulkOrderDocumentResponse, error)
+	BulkUpdateOrderStatus(context.Context, *BulkUpdateOrderStatusRequest) (*BulkUpdateOrderStatusResponse, error)
+	BulkAssignTracking(context.Context, *BulkAssignTrackingRequest) (*BulkAssignTrackingResponse, error)
+	BulkAddOrderNote(context.Context, *BulkAddOrderNoteRequest) (*BulkAddOrderNoteResponse, error)
+	ImportOrders(context.Context, *ImportOrdersRequest) (*ImportOrdersResponse, error)
+	ImportProducts(context.Context, *ImportCatalogueRequest) (*ImportCatalogueResponse, error)
+	ImportFreebies(context.Context, *ImportCatalogueRequest) (*ImportCatalogueResponse, error)
+	UploadImage(context.Context, *UploadImageRequest) (*UploadImageResponse, error)
+	SaveProductAttribute(context.Context, *SaveProductAttributeRequest) (*SaveProductAttributeResponse, error)
+	GetAllProductAttribute(context.Context, *GetAllProductAttributeRequest) (*GetAllProductAttributeResponse, error)
+	UpdateProductAttribute(context.Context, *UpdateProductAttributeRequest) (*UpdateProductAttributeResponse, error)
+	UpdateProductAttributeStatus(context.Context, *UpdateProductAttributeStatusRequest) (*UpdateProductAttributeStatusResponse, error)
+	DeleteProductAttribute(context.Context, *DeleteProductAttributeRequest) (*DeleteProductAttributeResponse, error)
+	CompareProducts(context.Context, *CompareProductsRequest) (*CompareProductsResponse, error)
+	mustEmbedUnimplementedChronexAdminProtoServiceServer()
+}
+
+// UnimplementedChronexAdminProtoServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedChronexAdminProtoServiceServer struct {
+}
+
+func (UnimplementedChronexAdminProtoServiceServer) SaveProduct(context.Context, *SaveProductRequest) (*SaveProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveProduct not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) SaveFreebies(context.Context, *SaveFreebiesRequest) (*SaveFreebiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveFreebies not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) SaveReviews(context.Context, *SaveReviewsRequest) (*SaveReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveReviews not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) SaveOrder(context.Context, *SaveOrderRequest) (*SaveOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveOrder not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) SaveHomeImages(context.Context, *SaveHomeImagesRequest) (*SaveHomeImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveHomeImages not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllFreebies(context.Context, *GetAllFreebiesRequest) (*GetAllFreebiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllFreebies not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllFreebiesDropdown(context.Context, *GetAllFreebiesDropdownRequest) (*GetAllFreebiesDropdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllFreebiesDropdown not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllProduct(context.Context, *GetAllProductRequest) (*GetAllProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllProduct not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllReviews(context.Context, *GetAllReviewsRequest) (*GetAllReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllReviews not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllOrder(context.Context, *GetAllOrderRequest) (*GetAllOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrder not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllHomeImages(context.Context, *GetAllHomeImagesRequest) (*GetAllHomeImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllHomeImages not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllFreebiesById(context.Context, *GetAllFreebiesRequestById) (*GetAllFreebiesResponseById, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllFreebiesById not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllProductById(context.Context, *GetAllProductRequestById) (*GetAllProductResponseById, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllProductById not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllReviewsById(context.Context, *GetAllReviewsRequestById) (*GetAllReviewsResponseById, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllReviewsById not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateFreebies(context.Context, *UpdateFreebiesRequest) (*UpdateFreebiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFreebies not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateFreebiesQuantity(context.Context, *UpdateFreebiesQuantityRequest) (*UpdateFreebiesQuantityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFreebiesQuantity not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateFreebiesStatus(context.Context, *UpdateFreebiesStatusRequest) (*UpdateFreebiesStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFreebiesStatus not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateReviews(context.Context, *UpdateReviewsRequest) (*UpdateReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReviews not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateReviewsStatus(context.Context, *UpdateReviewsStatusRequest) (*UpdateReviewsStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReviewsStatus not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateProductQuantity(context.Context, *UpdateProductQuantityRequest) (*UpdateProductQuantityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductQuantity not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateProductStatus(context.Context, *UpdateProductStatusRequest) (*UpdateProductStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductStatus not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateOrder(context.Context, *UpdateOrderRequest) (*UpdateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrder not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateHomeImages(context.Context, *UpdateHomeImagesRequest) (*UpdateHomeImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHomeImages not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) DeleteHomeImages(context.Context, *DeleteHomeImagesRequest) (*DeleteHomeImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHomeImages not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) DeleteFreebies(context.Context, *DeleteFreebiesRequest) (*DeleteFreebiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFreebies not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) DeleteReviews(context.Context, *DeleteReviewsRequest) (*DeleteReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReviews not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) DeleteOrder(context.Context, *DeleteOrderRequest) (*DeleteOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrder not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllOrderRevenue(context.Context, *GetAllOrderRevenueRequest) (*GetAllOrderRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrderRevenue not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllTotalOrder(context.Context, *GetAllTotalOrderRequest) (*GetAllTotalOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllTotalOrder not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetBestSellingProducts(context.Context, *GetBestSellingProductsRequest) (*GetBestSellingProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBestSellingProducts not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) SaveFreebiesRule(context.Context, *SaveFreebiesRuleRequest) (*SaveFreebiesRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveFreebiesRule not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllFreebiesRule(context.Context, *GetAllFreebiesRuleRequest) (*GetAllFreebiesRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllFreebiesRule not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateFreebiesRule(context.Context, *UpdateFreebiesRuleRequest) (*UpdateFreebiesRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFreebiesRule not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateFreebiesRuleStatus(context.Context, *UpdateFreebiesRuleStatusRequest) (*UpdateFreebiesRuleStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFreebiesRuleStatus not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) PreviewCart(context.Context, *PreviewCartRequest) (*PreviewCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewCart not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) SaveVoucher(context.Context, *SaveVoucherRequest) (*SaveVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveVoucher not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllVoucher(context.Context, *GetAllVoucherRequest) (*GetAllVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllVoucher not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateVoucher(context.Context, *UpdateVoucherRequest) (*UpdateVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVoucher not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateVoucherStatus(context.Context, *UpdateVoucherStatusRequest) (*UpdateVoucherStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVoucherStatus not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) SavePriceRule(context.Context, *SavePriceRuleRequest) (*SavePriceRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePriceRule not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllPriceRule(context.Context, *GetAllPriceRuleRequest) (*GetAllPriceRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPriceRule not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdatePriceRule(context.Context, *UpdatePriceRuleRequest) (*UpdatePriceRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceRule not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdatePriceRuleStatus(context.Context, *UpdatePriceRuleStatusRequest) (*UpdatePriceRuleStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceRuleStatus not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) DeletePriceRule(context.Context, *DeletePriceRuleRequest) (*DeletePriceRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePriceRule not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetProductPriceHistory(context.Context, *GetProductPriceHistoryRequest) (*GetProductPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductPriceHistory not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllTrash(context.Context, *GetAllTrashRequest) (*GetAllTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllTrash not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) RestoreTrash(context.Context, *RestoreTrashRequest) (*RestoreTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTrash not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTrash not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllCustomer(context.Context, *GetAllCustomerRequest) (*GetAllCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCustomer not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetCustomerById(context.Context, *GetCustomerByIdRequest) (*GetCustomerByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerById not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) LookupOrder(context.Context, *LookupOrderRequest) (*LookupOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupOrder not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) PreviewOrderEmail(context.Context, *PreviewOrderEmailRequest) (*PreviewOrderEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewOrderEmail not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllEmailOutbox(context.Context, *GetAllEmailOutboxRequest) (*GetAllEmailOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllEmailOutbox not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) ResendEmailOutbox(context.Context, *ResendEmailOutboxRequest) (*ResendEmailOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendEmailOutbox not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) SendOrderEmail(context.Context, *SendOrderEmailRequest) (*SendOrderEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendOrderEmail not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) SubmitContactForm(context.Context, *SubmitContactFormRequest) (*SubmitContactFormResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitContactForm not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetOrderPayment(context.Context, *GetOrderPaymentRequest) (*GetOrderPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderPayment not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) RecordPayment(context.Context, *RecordPaymentRequest) (*RecordPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordPayment not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdatePaymentStatus(context.Context, *UpdatePaymentStatusRequest) (*UpdatePaymentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePaymentStatus not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) HandlePaymentWebhook(context.Context, *HandlePaymentWebhookRequest) (*HandlePaymentWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandlePaymentWebhook not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) SaveReturn(context.Context, *SaveReturnRequest) (*SaveReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveReturn not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) RequestReturn(context.Context, *RequestReturnRequest) (*RequestReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReturn not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllReturn(context.Context, *GetAllReturnRequest) (*GetAllReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllReturn not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetReturnById(context.Context, *GetReturnByIdRequest) (*GetReturnByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReturnById not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateReturnStatus(context.Context, *UpdateReturnStatusRequest) (*UpdateReturnStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReturnStatus not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) ReceiveReturn(context.Context, *ReceiveReturnRequest) (*ReceiveReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveReturn not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundPayment not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) SaveShippingZone(context.Context, *SaveShippingZoneRequest) (*SaveShippingZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveShippingZone not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllShippingZone(context.Context, *GetAllShippingZoneRequest) (*GetAllShippingZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllShippingZone not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateShippingZone(context.Context, *UpdateShippingZoneRequest) (*UpdateShippingZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShippingZone not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateShippingZoneStatus(context.Context, *UpdateShippingZoneStatusRequest) (*UpdateShippingZoneStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShippingZoneStatus not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) DeleteShippingZone(context.Context, *DeleteShippingZoneRequest) (*DeleteShippingZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShippingZone not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) QuoteShipping(context.Context, *QuoteShippingRequest) (*QuoteShippingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteShipping not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) CreateShipment(context.Context, *CreateShipmentRequest) (*CreateShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShipment not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) CancelShipment(context.Context, *CancelShipmentRequest) (*CancelShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelShipment not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) SyncTracking(context.Context, *SyncTrackingRequest) (*SyncTrackingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncTracking not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) HandleCourierWebhook(context.Context, *HandleCourierWebhookRequest) (*HandleCourierWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleCourierWebhook not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetOrderTimeline(context.Context, *GetOrderTimelineRequest) (*GetOrderTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderTimeline not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetOrderDocument(context.Context, *GetOrderDocumentRequest) (*GetOrderDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderDocument not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetBulkOrderDocument(context.Context, *GetBulkOrderDocumentRequest) (*GetBulkOrderDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBulkOrderDocument not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) BulkUpdateOrderStatus(context.Context, *BulkUpdateOrderStatusRequest) (*BulkUpdateOrderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateOrderStatus not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) BulkAssignTracking(context.Context, *BulkAssignTrackingRequest) (*BulkAssignTrackingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkAssignTracking not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) BulkAddOrderNote(context.Context, *BulkAddOrderNoteRequest) (*BulkAddOrderNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkAddOrderNote not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) ImportOrders(context.Context, *ImportOrdersRequest) (*ImportOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportOrders not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) ImportProducts(context.Context, *ImportCatalogueRequest) (*ImportCatalogueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportProducts not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) ImportFreebies(context.Context, *ImportCatalogueRequest) (*ImportCatalogueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFreebies not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UploadImage(context.Context, *UploadImageRequest) (*UploadImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadImage not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) SaveProductAttribute(context.Context, *SaveProductAttributeRequest) (*SaveProductAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveProductAttribute not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) GetAllProductAttribute(context.Context, *GetAllProductAttributeRequest) (*GetAllProductAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllProductAttribute not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateProductAttribute(context.Context, *UpdateProductAttributeRequest) (*UpdateProductAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductAttribute not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) UpdateProductAttributeStatus(context.Context, *UpdateProductAttributeStatusRequest) (*UpdateProductAttributeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductAttributeStatus not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) DeleteProductAttribute(context.Context, *DeleteProductAttributeRequest) (*DeleteProductAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductAttribute not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) CompareProducts(context.Context, *CompareProductsRequest) (*CompareProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareProducts not implemented")
+}
+func (UnimplementedChronexAdminProtoServiceServer) mustEmbedUnimplementedChronexAdminProtoServiceServer() {
+}
+
+// UnsafeChronexAdminProtoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChronexAdminProtoServiceServer will
+// result in compilation errors.
+type UnsafeChronexAdminProtoServiceServer interface {
+	mustEmbedUnimplementedChronexAdminProtoServiceServer()
+}
+
+func RegisterChronexAdminProtoServiceServer(s grpc.ServiceRegistrar, srv ChronexAdminProtoServiceServer) {
+	s.RegisterService(&ChronexAdminProtoService_ServiceDesc, srv)
+}
+
+func _ChronexAdminProtoService_SaveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).SaveProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/SaveProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).SaveProduct(ctx, req.(*SaveProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_SaveFreebies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveFreebiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).SaveFreebies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/SaveFreebies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).SaveFreebies(ctx, req.(*SaveFreebiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_SaveReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).SaveReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/SaveReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).SaveReviews(ctx, req.(*SaveReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_SaveOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).SaveOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/SaveOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).SaveOrder(ctx, req.(*SaveOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_SaveHomeImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveHomeImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).SaveHomeImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/SaveHomeImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).SaveHomeImages(ctx, req.(*SaveHomeImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllFreebies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllFreebiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllFreebies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllFreebies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllFreebies(ctx, req.(*GetAllFreebiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllFreebiesDropdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllFreebiesDropdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllFreebiesDropdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllFreebiesDropdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllFreebiesDropdown(ctx, req.(*GetAllFreebiesDropdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllProduct(ctx, req.(*GetAllProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllReviews(ctx, req.(*GetAllReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllOrder(ctx, req.(*GetAllOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllHomeImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllHomeImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllHomeImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllHomeImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllHomeImages(ctx, req.(*GetAllHomeImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllFreebiesById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllFreebiesRequestById)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllFreebiesById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllFreebiesById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllFreebiesById(ctx, req.(*GetAllFreebiesRequestById))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllProductById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllProductRequestById)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllProductById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllProductById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllProductById(ctx, req.(*GetAllProductRequestById))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllReviewsById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllReviewsRequestById)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllReviewsById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllReviewsById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllReviewsById(ctx, req.(*GetAllReviewsRequestById))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateFreebies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFreebiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateFreebies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateFreebies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateFreebies(ctx, req.(*UpdateFreebiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateFreebiesQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFreebiesQuantityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateFreebiesQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateFreebiesQuantity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateFreebiesQuantity(ctx, req.(*UpdateFreebiesQuantityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateFreebiesStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFreebiesStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateFreebiesStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateFreebiesStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateFreebiesStatus(ctx, req.(*UpdateFreebiesStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateReviews(ctx, req.(*UpdateReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateReviewsStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewsStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateReviewsStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateReviewsStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateReviewsStatus(ctx, req.(*UpdateReviewsStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateProductQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductQuantityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateProductQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateProductQuantity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateProductQuantity(ctx, req.(*UpdateProductQuantityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateProductStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateProductStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateProductStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateProductStatus(ctx, req.(*UpdateProductStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateOrder(ctx, req.(*UpdateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateOrderStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateOrderStatus(ctx, req.(*UpdateOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateHomeImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHomeImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateHomeImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateHomeImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateHomeImages(ctx, req.(*UpdateHomeImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_DeleteHomeImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHomeImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).DeleteHomeImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/DeleteHomeImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).DeleteHomeImages(ctx, req.(*DeleteHomeImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/DeleteProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_DeleteFreebies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFreebiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).DeleteFreebies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/DeleteFreebies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).DeleteFreebies(ctx, req.(*DeleteFreebiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_DeleteReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).DeleteReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/DeleteReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).DeleteReviews(ctx, req.(*DeleteReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_DeleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).DeleteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/DeleteOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).DeleteOrder(ctx, req.(*DeleteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllOrderRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllOrderRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllOrderRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllOrderRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllOrderRevenue(ctx, req.(*GetAllOrderRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllTotalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllTotalOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllTotalOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllTotalOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllTotalOrder(ctx, req.(*GetAllTotalOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetBestSellingProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBestSellingProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetBestSellingProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetBestSellingProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetBestSellingProducts(ctx, req.(*GetBestSellingProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_SaveFreebiesRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveFreebiesRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).SaveFreebiesRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/SaveFreebiesRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).SaveFreebiesRule(ctx, req.(*SaveFreebiesRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllFreebiesRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllFreebiesRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllFreebiesRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllFreebiesRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllFreebiesRule(ctx, req.(*GetAllFreebiesRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateFreebiesRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFreebiesRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateFreebiesRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateFreebiesRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateFreebiesRule(ctx, req.(*UpdateFreebiesRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateFreebiesRuleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFreebiesRuleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateFreebiesRuleStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateFreebiesRuleStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateFreebiesRuleStatus(ctx, req.(*UpdateFreebiesRuleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_PreviewCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).PreviewCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/PreviewCart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).PreviewCart(ctx, req.(*PreviewCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_SaveVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveVoucherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).SaveVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/SaveVoucher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).SaveVoucher(ctx, req.(*SaveVoucherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllVoucherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllVoucher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllVoucher(ctx, req.(*GetAllVoucherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVoucherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateVoucher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateVoucher(ctx, req.(*UpdateVoucherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdateVoucherStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVoucherStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdateVoucherStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdateVoucherStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdateVoucherStatus(ctx, req.(*UpdateVoucherStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_SavePriceRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePriceRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).SavePriceRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/SavePriceRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).SavePriceRule(ctx, req.(*SavePriceRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllPriceRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllPriceRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllPriceRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllPriceRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllPriceRule(ctx, req.(*GetAllPriceRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdatePriceRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePriceRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdatePriceRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdatePriceRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdatePriceRule(ctx, req.(*UpdatePriceRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_UpdatePriceRuleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePriceRuleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdatePriceRuleStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdatePriceRuleStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdatePriceRuleStatus(ctx, req.(*UpdatePriceRuleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_DeletePriceRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePriceRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).DeletePriceRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/DeletePriceRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).DeletePriceRule(ctx, req.(*DeletePriceRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetProductPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetProductPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetProductPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetProductPriceHistory(ctx, req.(*GetProductPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllTrash(ctx, req.(*GetAllTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_RestoreTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).RestoreTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/RestoreTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).RestoreTrash(ctx, req.(*RestoreTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_PurgeTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).PurgeTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/PurgeTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).PurgeTrash(ctx, req.(*PurgeTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllCustomer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllCustomer(ctx, req.(*GetAllCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetCustomerById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetCustomerById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetCustomerById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetCustomerById(ctx, req.(*GetCustomerByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_LookupOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).LookupOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/LookupOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).LookupOrder(ctx, req.(*LookupOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_PreviewOrderEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewOrderEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).PreviewOrderEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/PreviewOrderEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).PreviewOrderEmail(ctx, req.(*PreviewOrderEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_GetAllEmailOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllEmailOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllEmailOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllEmailOutbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllEmailOutbox(ctx, req.(*GetAllEmailOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_ResendEmailOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendEmailOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).ResendEmailOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/ResendEmailOutbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).ResendEmailOutbox(ctx, req.(*ResendEmailOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_SendOrderEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendOrderEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).SendOrderEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/SendOrderEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).SendOrderEmail(ctx, req.(*SendOrderEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChronexAdminProtoService_SubmitContactForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitContactFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).SubmitContactForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/SubmitContactForm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).SubmitContactForm(ctx, req.(*SubmitContactFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chronexAdminProtoServiceClient) GetAllTotalOrder(ctx context.Context, in *GetAllTotalOrderRequest, opts ...grpc.CallOption) (*GetAllTotalOrderResponse, error) {
-	out := new(GetAllTotalOrderResponse)
-	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetAllTotalOrder", in, out, opts...)
-	if err != nil {
+func _ChronexAdminProtoService_GetOrderPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderPaymentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetOrderPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetOrderPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetOrderPayment(ctx, req.(*GetOrderPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chronexAdminProtoServiceClient) GetBestSellingProducts(ctx context.Context, in *GetBestSellingProductsRequest, opts ...grpc.CallOption) (*GetBestSellingProductsResponse, error) {
-	out := new(GetBestSellingProductsResponse)
-	err := c.cc.Invoke(ctx, "/api.ChronexAdminProtoService/GetBestSellingProducts", in, out, opts...)
-	if err != nil {
+func _ChronexAdminProtoService_RecordPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordPaymentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).RecordPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/RecordPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).RecordPayment(ctx, req.(*RecordPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// ChronexAdminProtoServiceServer is the server API for ChronexAdminProtoService service.
-// All implementations must embed UnimplementedChronexAdminProtoServiceServer
-// for forward compatibility
-type ChronexAdminProtoServiceServer interface {
-	SaveProduct(context.Context, *SaveProductRequest) (*SaveProductResponse, error)
-	SaveFreebies(context.Context, *SaveFreebiesRequest) (*SaveFreebiesResponse, error)
-	SaveReviews(context.Context, *SaveReviewsRequest) (*SaveReviewsResponse, error)
-	SaveOrder(context.Context, *SaveOrderRequest) (*SaveOrderResponse, error)
-	SaveHomeImages(context.Context, *SaveHomeImagesRequest) (*SaveHomeImagesResponse, error)
-	GetAllFreebies(context.Context, *GetAllFreebiesRequest) (*GetAllFreebiesResponse, error)
-	GetAllFreebiesDropdown(context.Context, *GetAllFreebiesDropdownRequest) (*GetAllFreebiesDropdownResponse, error)
-	GetAllProduct(context.Context, *GetAllProductRequest) (*GetAllProductResponse, error)
-	GetAllReviews(context.Context, *GetAllReviewsRequest) (*GetAllReviewsResponse, error)
-	GetAllOrder(context.Context, *GetAllOrderRequest) (*GetAllOrderResponse, error)
-	GetAllHomeImages(context.Context, *GetAllHomeImagesRequest) (*GetAllHomeImagesResponse, error)
-	GetAllFreebiesById(context.Context, *GetAllFreebiesRequestById) (*GetAllFreebiesResponseById, error)
-	GetAllProductById(context.Context, *GetAllProductRequestById) (*GetAllProductResponseById, error)
-	GetAllReviewsById(context.Context, *GetAllReviewsRequestById) (*GetAllReviewsResponseById, error)
-	UpdateFreebies(context.Context, *UpdateFreebiesRequest) (*UpdateFreebiesResponse, error)
-	UpdateFreebiesQuantity(context.Context, *UpdateFreebiesQuantityRequest) (*UpdateFreebiesQuantityResponse, error)
-	UpdateFreebiesStatus(context.Context, *UpdateFreebiesStatusRequest) (*UpdateFreebiesStatusResponse, error)
-	UpdateReviews(context.Context, *UpdateReviewsRequest) (*UpdateReviewsResponse, error)
-	UpdateReviewsStatus(context.Context, *UpdateReviewsStatusRequest) (*UpdateReviewsStatusResponse, error)
-	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
-	UpdateProductQuantity(context.Context, *UpdateProductQuantityRequest) (*UpdateProductQuantityResponse, error)
-	UpdateProductStatus(context.Context, *UpdateProductStatusRequest) (*UpdateProductStatusResponse, error)
-	UpdateOrder(context.Context, *UpdateOrderRequest) (*UpdateOrderResponse, error)
-	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error)
-	UpdateHomeImages(context.Context, *UpdateHomeImagesRequest) (*UpdateHomeImagesResponse, error)
-	DeleteHomeImages(context.Context, *DeleteHomeImagesRequest) (*DeleteHomeImagesResponse, error)
-	GetAllOrderRevenue(context.Context, *GetAllOrderRevenueRequest) (*GetAllOrderRevenueResponse, error)
-	GetAllTotalOrder(context.Context, *GetAllTotalOrderRequest) (*GetAllTotalOrderResponse, error)
-	GetBestSellingProducts(context.Context, *GetBestSellingProductsRequest) (*GetBestSellingProductsResponse, error)
-	mustEmbedUnimplementedChronexAdminProtoServiceServer()
+func _ChronexAdminProtoService_UpdatePaymentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePaymentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).UpdatePaymentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/UpdatePaymentStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).UpdatePaymentStatus(ctx, req.(*UpdatePaymentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedChronexAdminProtoServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedChronexAdminProtoServiceServer struct {
+func _ChronexAdminProtoService_HandlePaymentWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandlePaymentWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).HandlePaymentWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/HandlePaymentWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).HandlePaymentWebhook(ctx, req.(*HandlePaymentWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedChronexAdminProtoServiceServer) SaveProduct(context.Context, *SaveProductRequest) (*SaveProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveProduct not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) SaveFreebies(context.Context, *SaveFreebiesRequest) (*SaveFreebiesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveFreebies not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) SaveReviews(context.Context, *SaveReviewsRequest) (*SaveReviewsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveReviews not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) SaveOrder(context.Context, *SaveOrderRequest) (*SaveOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveOrder not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) SaveHomeImages(context.Context, *SaveHomeImagesRequest) (*SaveHomeImagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveHomeImages not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) GetAllFreebies(context.Context, *GetAllFreebiesRequest) (*GetAllFreebiesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllFreebies not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) GetAllFreebiesDropdown(context.Context, *GetAllFreebiesDropdownRequest) (*GetAllFreebiesDropdownResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllFreebiesDropdown not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) GetAllProduct(context.Context, *GetAllProductRequest) (*GetAllProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllProduct not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) GetAllReviews(context.Context, *GetAllReviewsRequest) (*GetAllReviewsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllReviews not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) GetAllOrder(context.Context, *GetAllOrderRequest) (*GetAllOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrder not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) GetAllHomeImages(context.Context, *GetAllHomeImagesRequest) (*GetAllHomeImagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllHomeImages not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) GetAllFreebiesById(context.Context, *GetAllFreebiesRequestById) (*GetAllFreebiesResponseById, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllFreebiesById not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) GetAllProductById(context.Context, *GetAllProductRequestById) (*GetAllProductResponseById, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllProductById not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) GetAllReviewsById(context.Context, *GetAllReviewsRequestById) (*GetAllReviewsResponseById, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllReviewsById not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) UpdateFreebies(context.Context, *UpdateFreebiesRequest) (*UpdateFreebiesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateFreebies not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) UpdateFreebiesQuantity(context.Context, *UpdateFreebiesQuantityRequest) (*UpdateFreebiesQuantityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateFreebiesQuantity not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) UpdateFreebiesStatus(context.Context, *UpdateFreebiesStatusRequest) (*UpdateFreebiesStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateFreebiesStatus not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) UpdateReviews(context.Context, *UpdateReviewsRequest) (*UpdateReviewsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateReviews not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) UpdateReviewsStatus(context.Context, *UpdateReviewsStatusRequest) (*UpdateReviewsStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateReviewsStatus not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) UpdateProductQuantity(context.Context, *UpdateProductQuantityRequest) (*UpdateProductQuantityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductQuantity not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) UpdateProductStatus(context.Context, *UpdateProductStatusRequest) (*UpdateProductStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductStatus not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) UpdateOrder(context.Context, *UpdateOrderRequest) (*UpdateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrder not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) UpdateHomeImages(context.Context, *UpdateHomeImagesRequest) (*UpdateHomeImagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateHomeImages not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) DeleteHomeImages(context.Context, *DeleteHomeImagesRequest) (*DeleteHomeImagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteHomeImages not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) GetAllOrderRevenue(context.Context, *GetAllOrderRevenueRequest) (*GetAllOrderRevenueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrderRevenue not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) GetAllTotalOrder(context.Context, *GetAllTotalOrderRequest) (*GetAllTotalOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllTotalOrder not implemented")
-}
-func (UnimplementedChronexAdminProtoServiceServer) GetBestSellingProducts(context.Context, *GetBestSellingProductsRequest) (*GetBestSellingProductsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBestSellingProducts not implemented")
+func _ChronexAdminProtoService_SaveReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).SaveReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/SaveReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).SaveReturn(ctx, req.(*SaveReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChronexAdminProtoServiceServer) mustEmbedUnimplementedChronexAdminProtoServiceServer() {
+
+func _ChronexAdminProtoService_RequestReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).RequestReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/RequestReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).RequestReturn(ctx, req.(*RequestReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnsafeChronexAdminProtoServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ChronexAdminProtoServiceServer will
-// result in compilation errors.
-type UnsafeChronexAdminProtoServiceServer interface {
-	mustEmbedUnimplementedChronexAdminProtoServiceServer()
+func _ChronexAdminProtoService_GetAllReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetAllReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetAllReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetAllReturn(ctx, req.(*GetAllReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterChronexAdminProtoServiceServer(s grpc.ServiceRegistrar, srv ChronexAdminProtoServiceServer) {
-	s.RegisterService(&ChronexAdminProtoService_ServiceDesc, srv)
+func _ChronexAdminProtoService_GetReturnById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReturnByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChronexAdminProtoServiceServer).GetReturnById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ChronexAdminProtoService/GetReturnById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChronexAdminProtoServiceServer).GetReturnById(ctx, req.(*GetReturnByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_SaveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveProductRequest)
+func _ChronexAdminProtoService_UpdateReturnStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReturnStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).SaveProduct(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).UpdateReturnStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/SaveProduct",
+		FullMethod: "/api.ChronexAdminProtoService/UpdateReturnStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).SaveProduct(ctx, req.(*SaveProductRequest))
+		return srv.(ChronexAdminProtoServiceServer).UpdateReturnStatus(ctx, req.(*UpdateReturnStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_SaveFreebies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveFreebiesRequest)
+func _ChronexAdminProtoService_ReceiveReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveReturnRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).SaveFreebies(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).ReceiveReturn(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/SaveFreebies",
+		FullMethod: "/api.ChronexAdminProtoService/ReceiveReturn",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).SaveFreebies(ctx, req.(*SaveFreebiesRequest))
+		return srv.(ChronexAdminProtoServiceServer).ReceiveReturn(ctx, req.(*ReceiveReturnRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_SaveReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveReviewsRequest)
+func _ChronexAdminProtoService_RefundPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundPaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).SaveReviews(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).RefundPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/SaveReviews",
+		FullMethod: "/api.ChronexAdminProtoService/RefundPayment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).SaveReviews(ctx, req.(*SaveReviewsRequest))
+		return srv.(ChronexAdminProtoServiceServer).RefundPayment(ctx, req.(*RefundPaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_SaveOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveOrderRequest)
+func _ChronexAdminProtoService_SaveShippingZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveShippingZoneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).SaveOrder(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).SaveShippingZone(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/SaveOrder",
+		FullMethod: "/api.ChronexAdminProtoService/SaveShippingZone",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).SaveOrder(ctx, req.(*SaveOrderRequest))
+		return srv.(ChronexAdminProtoServiceServer).SaveShippingZone(ctx, req.(*SaveShippingZoneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_SaveHomeImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveHomeImagesRequest)
+func _ChronexAdminProtoService_GetAllShippingZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllShippingZoneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).SaveHomeImages(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).GetAllShippingZone(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/SaveHomeImages",
+		FullMethod: "/api.ChronexAdminProtoService/GetAllShippingZone",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).SaveHomeImages(ctx, req.(*SaveHomeImagesRequest))
+		return srv.(ChronexAdminProtoServiceServer).GetAllShippingZone(ctx, req.(*GetAllShippingZoneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_GetAllFreebies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllFreebiesRequest)
+func _ChronexAdminProtoService_UpdateShippingZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShippingZoneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).GetAllFreebies(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).UpdateShippingZone(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/GetAllFreebies",
+		FullMethod: "/api.ChronexAdminProtoService/UpdateShippingZone",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).GetAllFreebies(ctx, req.(*GetAllFreebiesRequest))
+		return srv.(ChronexAdminProtoServiceServer).UpdateShippingZone(ctx, req.(*UpdateShippingZoneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_GetAllFreebiesDropdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllFreebiesDropdownRequest)
+func _ChronexAdminProtoService_UpdateShippingZoneStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShippingZoneStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).GetAllFreebiesDropdown(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).UpdateShippingZoneStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/GetAllFreebiesDropdown",
+		FullMethod: "/api.ChronexAdminProtoService/UpdateShippingZoneStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).GetAllFreebiesDropdown(ctx, req.(*GetAllFreebiesDropdownRequest))
+		return srv.(ChronexAdminProtoServiceServer).UpdateShippingZoneStatus(ctx, req.(*UpdateShippingZoneStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_GetAllProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllProductRequest)
+func _ChronexAdminProtoService_DeleteShippingZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShippingZoneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).GetAllProduct(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).DeleteShippingZone(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/GetAllProduct",
+		FullMethod: "/api.ChronexAdminProtoService/DeleteShippingZone",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).GetAllProduct(ctx, req.(*GetAllProductRequest))
+		return srv.(ChronexAdminProtoServiceServer).DeleteShippingZone(ctx, req.(*DeleteShippingZoneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_GetAllReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllReviewsRequest)
+func _ChronexAdminProtoService_QuoteShipping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteShippingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).GetAllReviews(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).QuoteShipping(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/GetAllReviews",
+		FullMethod: "/api.ChronexAdminProtoService/QuoteShipping",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).GetAllReviews(ctx, req.(*GetAllReviewsRequest))
+		return srv.(ChronexAdminProtoServiceServer).QuoteShipping(ctx, req.(*QuoteShippingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_GetAllOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllOrderRequest)
+func _ChronexAdminProtoService_CreateShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShipmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).GetAllOrder(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).CreateShipment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/GetAllOrder",
+		FullMethod: "/api.ChronexAdminProtoService/CreateShipment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).GetAllOrder(ctx, req.(*GetAllOrderRequest))
+		return srv.(ChronexAdminProtoServiceServer).CreateShipment(ctx, req.(*CreateShipmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_GetAllHomeImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllHomeImagesRequest)
+func _ChronexAdminProtoService_CancelShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelShipmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).GetAllHomeImages(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).CancelShipment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/GetAllHomeImages",
+		FullMethod: "/api.ChronexAdminProtoService/CancelShipment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).GetAllHomeImages(ctx, req.(*GetAllHomeImagesRequest))
+		return srv.(ChronexAdminProtoServiceServer).CancelShipment(ctx, req.(*CancelShipmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_GetAllFreebiesById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllFreebiesRequestById)
+func _ChronexAdminProtoService_SyncTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncTrackingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).GetAllFreebiesById(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).SyncTracking(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/GetAllFreebiesById",
+		FullMethod: "/api.ChronexAdminProtoService/SyncTracking",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).GetAllFreebiesById(ctx, req.(*GetAllFreebiesRequestById))
+		return srv.(ChronexAdminProtoServiceServer).SyncTracking(ctx, req.(*SyncTrackingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_GetAllProductById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllProductRequestById)
+func _ChronexAdminProtoService_HandleCourierWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleCourierWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).GetAllProductById(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).HandleCourierWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/GetAllProductById",
+		FullMethod: "/api.ChronexAdminProtoService/HandleCourierWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).GetAllProductById(ctx, req.(*GetAllProductRequestById))
+		return srv.(ChronexAdminProtoServiceServer).HandleCourierWebhook(ctx, req.(*HandleCourierWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_GetAllReviewsById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllReviewsRequestById)
+func _ChronexAdminProtoService_GetOrderTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderTimelineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).GetAllReviewsById(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).GetOrderTimeline(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/GetAllReviewsById",
+		FullMethod: "/api.ChronexAdminProtoService/GetOrderTimeline",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).GetAllReviewsById(ctx, req.(*GetAllReviewsRequestById))
+		return srv.(ChronexAdminProtoServiceServer).GetOrderTimeline(ctx, req.(*GetOrderTimelineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_UpdateFreebies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateFreebiesRequest)
+func _ChronexAdminProtoService_GetOrderDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderDocumentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).UpdateFreebies(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).GetOrderDocument(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/UpdateFreebies",
+		FullMethod: "/api.ChronexAdminProtoService/GetOrderDocument",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).UpdateFreebies(ctx, req.(*UpdateFreebiesRequest))
+		return srv.(ChronexAdminProtoServiceServer).GetOrderDocument(ctx, req.(*GetOrderDocumentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_UpdateFreebiesQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateFreebiesQuantityRequest)
+func _ChronexAdminProtoService_GetBulkOrderDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBulkOrderDocumentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).UpdateFreebiesQuantity(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).GetBulkOrderDocument(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/UpdateFreebiesQuantity",
+		FullMethod: "/api.ChronexAdminProtoService/GetBulkOrderDocument",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).UpdateFreebiesQuantity(ctx, req.(*UpdateFreebiesQuantityRequest))
+		return srv.(ChronexAdminProtoServiceServer).GetBulkOrderDocument(ctx, req.(*GetBulkOrderDocumentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_UpdateFreebiesStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateFreebiesStatusRequest)
+func _ChronexAdminProtoService_BulkUpdateOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateOrderStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).UpdateFreebiesStatus(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).BulkUpdateOrderStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/UpdateFreebiesStatus",
+		FullMethod: "/api.ChronexAdminProtoService/BulkUpdateOrderStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).UpdateFreebiesStatus(ctx, req.(*UpdateFreebiesStatusRequest))
+		return srv.(ChronexAdminProtoServiceServer).BulkUpdateOrderStatus(ctx, req.(*BulkUpdateOrderStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_UpdateReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateReviewsRequest)
+func _ChronexAdminProtoService_BulkAssignTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkAssignTrackingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).UpdateReviews(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).BulkAssignTracking(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/UpdateReviews",
+		FullMethod: "/api.ChronexAdminProtoService/BulkAssignTracking",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).UpdateReviews(ctx, req.(*UpdateReviewsRequest))
+		return srv.(ChronexAdminProtoServiceServer).BulkAssignTracking(ctx, req.(*BulkAssignTrackingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_UpdateReviewsStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateReviewsStatusRequest)
+func _ChronexAdminProtoService_BulkAddOrderNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkAddOrderNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).UpdateReviewsStatus(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).BulkAddOrderNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/UpdateReviewsStatus",
+		FullMethod: "/api.ChronexAdminProtoService/BulkAddOrderNote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).UpdateReviewsStatus(ctx, req.(*UpdateReviewsStatusRequest))
+		return srv.(ChronexAdminProtoServiceServer).BulkAddOrderNote(ctx, req.(*BulkAddOrderNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductRequest)
+func _ChronexAdminProtoService_ImportOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).UpdateProduct(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).ImportOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/UpdateProduct",
+		FullMethod: "/api.ChronexAdminProtoService/ImportOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+		return srv.(ChronexAdminProtoServiceServer).ImportOrders(ctx, req.(*ImportOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_UpdateProductQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductQuantityRequest)
+func _ChronexAdminProtoService_ImportProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCatalogueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).UpdateProductQuantity(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).ImportProducts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/UpdateProductQuantity",
+		FullMethod: "/api.ChronexAdminProtoService/ImportProducts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).UpdateProductQuantity(ctx, req.(*UpdateProductQuantityRequest))
+		return srv.(ChronexAdminProtoServiceServer).ImportProducts(ctx, req.(*ImportCatalogueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_UpdateProductStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductStatusRequest)
+func _ChronexAdminProtoService_ImportFreebies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCatalogueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).UpdateProductStatus(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).ImportFreebies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/UpdateProductStatus",
+		FullMethod: "/api.ChronexAdminProtoService/ImportFreebies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).UpdateProductStatus(ctx, req.(*UpdateProductStatusRequest))
+		return srv.(ChronexAdminProtoServiceServer).ImportFreebies(ctx, req.(*ImportCatalogueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_UpdateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOrderRequest)
+func _ChronexAdminProtoService_UploadImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadImageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).UpdateOrder(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).UploadImage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/UpdateOrder",
+		FullMethod: "/api.ChronexAdminProtoService/UploadImage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).UpdateOrder(ctx, req.(*UpdateOrderRequest))
+		return srv.(ChronexAdminProtoServiceServer).UploadImage(ctx, req.(*UploadImageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_UpdateOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOrderStatusRequest)
+func _ChronexAdminProtoService_SaveProductAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveProductAttributeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).UpdateOrderStatus(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).SaveProductAttribute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/UpdateOrderStatus",
+		FullMethod: "/api.ChronexAdminProtoService/SaveProductAttribute",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).UpdateOrderStatus(ctx, req.(*UpdateOrderStatusRequest))
+		return srv.(ChronexAdminProtoServiceServer).SaveProductAttribute(ctx, req.(*SaveProductAttributeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_UpdateHomeImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHomeImagesRequest)
+func _ChronexAdminProtoService_GetAllProductAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllProductAttributeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).UpdateHomeImages(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).GetAllProductAttribute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/UpdateHomeImages",
+		FullMethod: "/api.ChronexAdminProtoService/GetAllProductAttribute",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).UpdateHomeImages(ctx, req.(*UpdateHomeImagesRequest))
+		return srv.(ChronexAdminProtoServiceServer).GetAllProductAttribute(ctx, req.(*GetAllProductAttributeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_DeleteHomeImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHomeImagesRequest)
+func _ChronexAdminProtoService_UpdateProductAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductAttributeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).DeleteHomeImages(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).UpdateProductAttribute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/DeleteHomeImages",
+		FullMethod: "/api.ChronexAdminProtoService/UpdateProductAttribute",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).DeleteHomeImages(ctx, req.(*DeleteHomeImagesRequest))
+		return srv.(ChronexAdminProtoServiceServer).UpdateProductAttribute(ctx, req.(*UpdateProductAttributeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_GetAllOrderRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllOrderRevenueRequest)
+func _ChronexAdminProtoService_UpdateProductAttributeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductAttributeStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).GetAllOrderRevenue(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).UpdateProductAttributeStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/GetAllOrderRevenue",
+		FullMethod: "/api.ChronexAdminProtoService/UpdateProductAttributeStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).GetAllOrderRevenue(ctx, req.(*GetAllOrderRevenueRequest))
+		return srv.(ChronexAdminProtoServiceServer).UpdateProductAttributeStatus(ctx, req.(*UpdateProductAttributeStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_GetAllTotalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllTotalOrderRequest)
+func _ChronexAdminProtoService_DeleteProductAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductAttributeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).GetAllTotalOrder(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).DeleteProductAttribute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/GetAllTotalOrder",
+		FullMethod: "/api.ChronexAdminProtoService/DeleteProductAttribute",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).GetAllTotalOrder(ctx, req.(*GetAllTotalOrderRequest))
+		return srv.(ChronexAdminProtoServiceServer).DeleteProductAttribute(ctx, req.(*DeleteProductAttributeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChronexAdminProtoService_GetBestSellingProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBestSellingProductsRequest)
+func _ChronexAdminProtoService_CompareProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareProductsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChronexAdminProtoServiceServer).GetBestSellingProducts(ctx, in)
+		return srv.(ChronexAdminProtoServiceServer).CompareProducts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ChronexAdminProtoService/GetBestSellingProducts",
+		FullMethod: "/api.ChronexAdminProtoService/CompareProducts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChronexAdminProtoServiceServer).GetBestSellingProducts(ctx, req.(*GetBestSellingProductsRequest))
+		return srv.(ChronexAdminProtoServiceServer).CompareProducts(ctx, req.(*CompareProductsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1096,6 +3272,22 @@ var ChronexAdminProtoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteHomeImages",
 			Handler:    _ChronexAdminProtoService_DeleteHomeImages_Handler,
 		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _ChronexAdminProtoService_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "DeleteFreebies",
+			Handler:    _ChronexAdminProtoService_DeleteFreebies_Handler,
+		},
+		{
+			MethodName: "DeleteReviews",
+			Handler:    _ChronexAdminProtoService_DeleteReviews_Handler,
+		},
+		{
+			MethodName: "DeleteOrder",
+			Handler:    _ChronexAdminProtoService_DeleteOrder_Handler,
+		},
 		{
 			MethodName: "GetAllOrderRevenue",
 			Handler:    _ChronexAdminProtoService_GetAllOrderRevenue_Handler,
@@ -1108,6 +3300,262 @@ var ChronexAdminProtoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBestSellingProducts",
 			Handler:    _ChronexAdminProtoService_GetBestSellingProducts_Handler,
 		},
+		{
+			MethodName: "SaveFreebiesRule",
+			Handler:    _ChronexAdminProtoService_SaveFreebiesRule_Handler,
+		},
+		{
+			MethodName: "GetAllFreebiesRule",
+			Handler:    _ChronexAdminProtoService_GetAllFreebiesRule_Handler,
+		},
+		{
+			MethodName: "UpdateFreebiesRule",
+			Handler:    _ChronexAdminProtoService_UpdateFreebiesRule_Handler,
+		},
+		{
+			MethodName: "UpdateFreebiesRuleStatus",
+			Handler:    _ChronexAdminProtoService_UpdateFreebiesRuleStatus_Handler,
+		},
+		{
+			MethodName: "PreviewCart",
+			Handler:    _ChronexAdminProtoService_PreviewCart_Handler,
+		},
+		{
+			MethodName: "SaveVoucher",
+			Handler:    _ChronexAdminProtoService_SaveVoucher_Handler,
+		},
+		{
+			MethodName: "GetAllVoucher",
+			Handler:    _ChronexAdminProtoService_GetAllVoucher_Handler,
+		},
+		{
+			MethodName: "UpdateVoucher",
+			Handler:    _ChronexAdminProtoService_UpdateVoucher_Handler,
+		},
+		{
+			MethodName: "UpdateVoucherStatus",
+			Handler:    _ChronexAdminProtoService_UpdateVoucherStatus_Handler,
+		},
+		{
+			MethodName: "SavePriceRule",
+			Handler:    _ChronexAdminProtoService_SavePriceRule_Handler,
+		},
+		{
+			MethodName: "GetAllPriceRule",
+			Handler:    _ChronexAdminProtoService_GetAllPriceRule_Handler,
+		},
+		{
+			MethodName: "UpdatePriceRule",
+			Handler:    _ChronexAdminProtoService_UpdatePriceRule_Handler,
+		},
+		{
+			MethodName: "UpdatePriceRuleStatus",
+			Handler:    _ChronexAdminProtoService_UpdatePriceRuleStatus_Handler,
+		},
+		{
+			MethodName: "DeletePriceRule",
+			Handler:    _ChronexAdminProtoService_DeletePriceRule_Handler,
+		},
+		{
+			MethodName: "GetProductPriceHistory",
+			Handler:    _ChronexAdminProtoService_GetProductPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetAllTrash",
+			Handler:    _ChronexAdminProtoService_GetAllTrash_Handler,
+		},
+		{
+			MethodName: "RestoreTrash",
+			Handler:    _ChronexAdminProtoService_RestoreTrash_Handler,
+		},
+		{
+			MethodName: "PurgeTrash",
+			Handler:    _ChronexAdminProtoService_PurgeTrash_Handler,
+		},
+		{
+			MethodName: "GetAllCustomer",
+			Handler:    _ChronexAdminProtoService_GetAllCustomer_Handler,
+		},
+		{
+			MethodName: "GetCustomerById",
+			Handler:    _ChronexAdminProtoService_GetCustomerById_Handler,
+		},
+		{
+			MethodName: "LookupOrder",
+			Handler:    _ChronexAdminProtoService_LookupOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _ChronexAdminProtoService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "PreviewOrderEmail",
+			Handler:    _ChronexAdminProtoService_PreviewOrderEmail_Handler,
+		},
+		{
+			MethodName: "GetAllEmailOutbox",
+			Handler:    _ChronexAdminProtoService_GetAllEmailOutbox_Handler,
+		},
+		{
+			MethodName: "ResendEmailOutbox",
+			Handler:    _ChronexAdminProtoService_ResendEmailOutbox_Handler,
+		},
+		{
+			MethodName: "SendOrderEmail",
+			Handler:    _ChronexAdminProtoService_SendOrderEmail_Handler,
+		},
+		{
+			MethodName: "SubmitContactForm",
+			Handler:    _ChronexAdminProtoService_SubmitContactForm_Handler,
+		},
+		{
+			MethodName: "GetOrderPayment",
+			Handler:    _ChronexAdminProtoService_GetOrderPayment_Handler,
+		},
+		{
+			MethodName: "RecordPayment",
+			Handler:    _ChronexAdminProtoService_RecordPayment_Handler,
+		},
+		{
+			MethodName: "UpdatePaymentStatus",
+			Handler:    _ChronexAdminProtoService_UpdatePaymentStatus_Handler,
+		},
+		{
+			MethodName: "HandlePaymentWebhook",
+			Handler:    _ChronexAdminProtoService_HandlePaymentWebhook_Handler,
+		},
+		{
+			MethodName: "SaveReturn",
+			Handler:    _ChronexAdminProtoService_SaveReturn_Handler,
+		},
+		{
+			MethodName: "RequestReturn",
+			Handler:    _ChronexAdminProtoService_RequestReturn_Handler,
+		},
+		{
+			MethodName: "GetAllReturn",
+			Handler:    _ChronexAdminProtoService_GetAllReturn_Handler,
+		},
+		{
+			MethodName: "GetReturnById",
+			Handler:    _ChronexAdminProtoService_GetReturnById_Handler,
+		},
+		{
+			MethodName: "UpdateReturnStatus",
+			Handler:    _ChronexAdminProtoService_UpdateReturnStatus_Handler,
+		},
+		{
+			MethodName: "ReceiveReturn",
+			Handler:    _ChronexAdminProtoService_ReceiveReturn_Handler,
+		},
+		{
+			MethodName: "RefundPayment",
+			Handler:    _ChronexAdminProtoService_RefundPayment_Handler,
+		},
+		{
+			MethodName: "SaveShippingZone",
+			Handler:    _ChronexAdminProtoService_SaveShippingZone_Handler,
+		},
+		{
+			MethodName: "GetAllShippingZone",
+			Handler:    _ChronexAdminProtoService_GetAllShippingZone_Handler,
+		},
+		{
+			MethodName: "UpdateShippingZone",
+			Handler:    _ChronexAdminProtoService_UpdateShippingZone_Handler,
+		},
+		{
+			MethodName: "UpdateShippingZoneStatus",
+			Handler:    _ChronexAdminProtoService_UpdateShippingZoneStatus_Handler,
+		},
+		{
+			MethodName: "DeleteShippingZone",
+			Handler:    _ChronexAdminProtoService_DeleteShippingZone_Handler,
+		},
+		{
+			MethodName: "QuoteShipping",
+			Handler:    _ChronexAdminProtoService_QuoteShipping_Handler,
+		},
+		{
+			MethodName: "CreateShipment",
+			Handler:    _ChronexAdminProtoService_CreateShipment_Handler,
+		},
+		{
+			MethodName: "CancelShipment",
+			Handler:    _ChronexAdminProtoService_CancelShipment_Handler,
+		},
+		{
+			MethodName: "SyncTracking",
+			Handler:    _ChronexAdminProtoService_SyncTracking_Handler,
+		},
+		{
+			MethodName: "HandleCourierWebhook",
+			Handler:    _ChronexAdminProtoService_HandleCourierWebhook_Handler,
+		},
+		{
+			MethodName: "GetOrderTimeline",
+			Handler:    _ChronexAdminProtoService_GetOrderTimeline_Handler,
+		},
+		{
+			MethodName: "GetOrderDocument",
+			Handler:    _ChronexAdminProtoService_GetOrderDocument_Handler,
+		},
+		{
+			MethodName: "GetBulkOrderDocument",
+			Handler:    _ChronexAdminProtoService_GetBulkOrderDocument_Handler,
+		},
+		{
+			MethodName: "BulkUpdateOrderStatus",
+			Handler:    _ChronexAdminProtoService_BulkUpdateOrderStatus_Handler,
+		},
+		{
+			MethodName: "BulkAssignTracking",
+			Handler:    _ChronexAdminProtoService_BulkAssignTracking_Handler,
+		},
+		{
+			MethodName: "BulkAddOrderNote",
+			Handler:    _ChronexAdminProtoService_BulkAddOrderNote_Handler,
+		},
+		{
+			MethodName: "ImportOrders",
+			Handler:    _ChronexAdminProtoService_ImportOrders_Handler,
+		},
+		{
+			MethodName: "ImportProducts",
+			Handler:    _ChronexAdminProtoService_ImportProducts_Handler,
+		},
+		{
+			MethodName: "ImportFreebies",
+			Handler:    _ChronexAdminProtoService_ImportFreebies_Handler,
+		},
+		{
+			MethodName: "UploadImage",
+			Handler:    _ChronexAdminProtoService_UploadImage_Handler,
+		},
+		{
+			MethodName: "SaveProductAttribute",
+			Handler:    _ChronexAdminProtoService_SaveProductAttribute_Handler,
+		},
+		{
+			MethodName: "GetAllProductAttribute",
+			Handler:    _ChronexAdminProtoService_GetAllProductAttribute_Handler,
+		},
+		{
+			MethodName: "UpdateProductAttribute",
+			Handler:    _ChronexAdminProtoService_UpdateProductAttribute_Handler,
+		},
+		{
+			MethodName: "UpdateProductAttributeStatus",
+			Handler:    _ChronexAdminProtoService_UpdateProductAttributeStatus_Handler,
+		},
+		{
+			MethodName: "DeleteProductAttribute",
+			Handler:    _ChronexAdminProtoService_DeleteProductAttribute_Handler,
+		},
+		{
+			MethodName: "CompareProducts",
+			Handler:    _ChronexAdminProtoService_CompareProducts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/pb/chronexdata.proto",
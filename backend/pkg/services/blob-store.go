@@ -0,0 +1,270 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ErrBlobNotFound is returned by BlobStore.Get for a key that holds nothing.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore keeps uploaded files, such as product images, under slash
+// separated keys like "product/<id>/original.jpg".
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get returns the stored bytes and their content type.
+	Get(ctx context.Context, key string) ([]byte, string, error)
+	Delete(ctx context.Context, key string) error
+	// URL is the address the storefront loads the blob from.
+	URL(key string) string
+}
+
+// checkBlobKey rejects keys that could escape the store, such as "../x".
+func checkBlobKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || strings.Contains(key, "..") {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+	return nil
+}
+
+// LocalBlobStore keeps blobs as files under Dir. BaseURL is where the files
+// are served, by the /media route or a web server in front of Dir.
+type LocalBlobStore struct {
+	Dir     string
+	BaseURL string
+}
+
+func (s LocalBlobStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if err := checkBlobKey(key); err != nil {
+		return err
+	}
+
+	file := filepath.Join(s.Dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never see half a blob
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, file)
+}
+
+func (s LocalBlobStore) Get(ctx context.Context, key string) ([]byte, string, error) {
+	if err := checkBlobKey(key); err != nil {
+		return nil, "", err
+	}
+
+	data, err := os.ReadFile(filepath.Join(s.Dir, filepath.FromSlash(key)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, "", ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+	return data, contentType, nil
+}
+
+func (s LocalBlobStore) Delete(ctx context.Context, key string) error {
+	if err := checkBlobKey(key); err != nil {
+		return err
+	}
+
+	err := os.Remove(filepath.Join(s.Dir, filepath.FromSlash(key)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (s LocalBlobStore) URL(key string) string {
+	return strings.TrimRight(s.BaseURL, "/") + "/" + key
+}
+
+// S3BlobStore keeps blobs in a bucket of any S3-compatible service, such as
+// AWS S3, MinIO, DigitalOcean Spaces or Cloudflare R2. Requests are signed
+// with AWS Signature Version 4. PathStyle addresses the bucket as
+// Endpoint/Bucket rather than Bucket.Endpoint, which most self-hosted
+// services need. PublicURL, when set, is the CDN or bucket website the
+// storefront loads blobs from.
+type S3BlobStore struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyId     string
+	SecretAccessKey string
+	PathStyle       bool
+	PublicURL       string
+	Client          *http.Client
+}
+
+func (s S3BlobStore) objectURL(key string) string {
+	endpoint, err := url.Parse(s.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		endpoint = &url.URL{Scheme: "https", Host: s.Endpoint}
+	}
+
+	escaped := (&url.URL{Path: "/" + key}).EscapedPath()
+	if s.PathStyle {
+		return fmt.Sprintf("%s://%s/%s%s", endpoint.Scheme, endpoint.Host, s.Bucket, escaped)
+	}
+	return fmt.Sprintf("%s://%s.%s%s", endpoint.Scheme, s.Bucket, endpoint.Host, escaped)
+}
+
+func (s S3BlobStore) do(ctx context.Context, method string, key string, body []byte, contentType string) (*http.Response, error) {
+	if err := checkBlobKey(key); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	s.sign(req, body, time.Now().UTC())
+
+	client := s.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return client.Do(req)
+}
+
+// sign adds the AWS Signature Version 4 headers to req.
+func (s S3BlobStore) sign(req *http.Request, body []byte, now time.Time) {
+	payloadHash := sha256Hex(body)
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	if req.Header.Get("Content-Type") != "" {
+		signedHeaders = []string{"content-type", "host", "x-amz-content-sha256", "x-amz-date"}
+	}
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaders {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+
+	scope := day + "/" + s.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.SecretAccessKey), day)
+	signingKey = hmacSHA256(signingKey, s.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.AccessKeyId, scope, strings.Join(signedHeaders, ";"), signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// s3Error reads the error a bucket answered with.
+func s3Error(method string, key string, res *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	return fmt.Errorf("s3 %s %s: %s: %s", method, key, res.Status, strings.TrimSpace(string(body)))
+}
+
+func (s S3BlobStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	res, err := s.do(ctx, http.MethodPut, key, data, contentType)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode/100 != 2 {
+		return s3Error(http.MethodPut, key, res)
+	}
+	return nil
+}
+
+func (s S3BlobStore) Get(ctx context.Context, key string) ([]byte, string, error) {
+	res, err := s.do(ctx, http.MethodGet, key, nil, "")
+	if err != nil {
+		return nil, "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, "", ErrBlobNotFound
+	}
+	if res.StatusCode/100 != 2 {
+		return nil, "", s3Error(http.MethodGet, key, res)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, res.Header.Get("Content-Type"), nil
+}
+
+func (s S3BlobStore) Delete(ctx context.Context, key string) error {
+	res, err := s.do(ctx, http.MethodDelete, key, nil, "")
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	// Deleting a missing object is not an error in S3
+	if res.StatusCode/100 != 2 && res.StatusCode != http.StatusNotFound {
+		return s3Error(http.MethodDelete, key, res)
+	}
+	return nil
+}
+
+func (s S3BlobStore) URL(key string) string {
+	if s.PublicURL != "" {
+		return strings.TrimRight(s.PublicURL, "/") + "/" + key
+	}
+	return s.objectURL(key)
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCheckBlobKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		wantErr bool
+	}{
+		{key: "product/1f0c/original.jpg"},
+		{key: "freebies/pouch.webp"},
+		{key: "", wantErr: true},
+		{key: "/etc/passwd", wantErr: true},
+		{key: "../secret", wantErr: true},
+		{key: "product/../../secret", wantErr: true},
+		{key: "product/..hidden", wantErr: true},
+		{key: "product//original.jpg", wantErr: true},
+		{key: "product/./original.jpg", wantErr: true},
+		{key: "product/", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if err := checkBlobKey(tt.key); (err != nil) != tt.wantErr {
+				t.Errorf("checkBlobKey(%q) error = %v, want error %v", tt.key, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLocalBlobStore(t *testing.T) {
+	ctx := context.Background()
+	store := LocalBlobStore{Dir: t.TempDir(), BaseURL: "http://localhost:8080/media/"}
+	key := "product/1f0c/original.png"
+	data := []byte("\x89PNG\r\n\x1a\nimage")
+
+	if err := store.Put(ctx, key, data, "image/png"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	got, contentType, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if !bytes.Equal(got, data) || contentType != "image/png" {
+		t.Errorf("Get() = %q, %q, want %q, image/png", got, contentType, data)
+	}
+	if url := store.URL(key); url != "http://localhost:8080/media/product/1f0c/original.png" {
+		t.Errorf("URL() = %q", url)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, _, err := store.Get(ctx, key); !errors.Is(err, ErrBlobNotFound) {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, ErrBlobNotFound)
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Errorf("Delete() of a missing blob error = %v, want none", err)
+	}
+
+	if err := store.Put(ctx, "../escape.png", data, "image/png"); err == nil {
+		t.Errorf("Put() outside the store succeeded")
+	}
+}
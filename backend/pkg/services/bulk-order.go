@@ -0,0 +1,289 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// maxBulkOrders caps how many orders one bulk operation may touch.
+const maxBulkOrders = 500
+
+const maxOrderNoteLength = 1000
+
+var orderStatuses = map[string]bool{"ACT": true, "PEN": true, "SHP": true, "DLV": true, "CAN": true}
+
+// bulkOrderReport collects the outcome of a bulk operation, one result per
+// order in the order they were given.
+type bulkOrderReport struct {
+	results   []*pb.BulkOrderResult
+	succeeded int32
+	failed    int32
+}
+
+func (r *bulkOrderReport) add(row int32, orderId string, order models.OrderData, err error) {
+	result := &pb.BulkOrderResult{Row: row, OrderId: orderId}
+	if order.OrderId != uuid.Nil {
+		result.OrderId = order.OrderId.String()
+		result.OrderNumber = order.OrderNumber
+	}
+
+	if err != nil {
+		result.Error = status.Convert(err).Message()
+		r.failed++
+	} else {
+		result.Success = true
+		result.Version = order.Version
+		r.succeeded++
+	}
+	r.results = append(r.results, result)
+}
+
+func checkBulkOrderIds(orderIds []string) error {
+	if len(orderIds) == 0 {
+		return status.Error(codes.InvalidArgument, "Select at least one order")
+	}
+	if len(orderIds) > maxBulkOrders {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("At most %d orders can be updated at once", maxBulkOrders))
+	}
+	return nil
+}
+
+// updateEachOrder runs update on every order in its own transaction, so one
+// failing order is rolled back alone and reported without stopping the rest.
+func (s *ChronexAdminService) updateEachOrder(orderIds []string, update func(tx *gorm.DB, order *models.OrderData) error) *bulkOrderReport {
+	report := &bulkOrderReport{}
+	seen := map[string]bool{}
+	for i, orderId := range orderIds {
+		orderId = strings.TrimSpace(orderId)
+		if seen[orderId] {
+			continue
+		}
+		seen[orderId] = true
+
+		if _, err := uuid.Parse(orderId); err != nil {
+			report.add(int32(i+1), orderId, models.OrderData{}, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid order id %s", orderId)))
+			continue
+		}
+
+		var orderData models.OrderData
+		err := s.DB.Transaction(func(tx *gorm.DB) error {
+			var err error
+			orderData, err = lockOrder(tx, orderId)
+			if err != nil {
+				return err
+			}
+			return update(tx, &orderData)
+		})
+		if err != nil {
+			log.Printf("Error in bulk update of Order %s: %v", orderId, err)
+		}
+		report.add(int32(i+1), orderId, orderData, err)
+	}
+
+	return report
+}
+
+// BulkUpdateOrderStatus moves many orders to one status, with the same stock,
+// payment, email and timeline side effects as updating them one by one.
+func (s *ChronexAdminService) BulkUpdateOrderStatus(ctx context.Context, req *pb.BulkUpdateOrderStatusRequest) (*pb.BulkUpdateOrderStatusResponse, error) {
+	if err := checkBulkOrderIds(req.OrderIds); err != nil {
+		return nil, err
+	}
+	orderStatus := strings.ToUpper(strings.TrimSpace(req.OrderStatus))
+	if !orderStatuses[orderStatus] {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid order status %s", req.OrderStatus))
+	}
+
+	report := s.updateEachOrder(req.OrderIds, func(tx *gorm.DB, order *models.OrderData) error {
+		return s.advanceOrderStatus(tx, order, orderStatus, "ADMIN")
+	})
+
+	return &pb.BulkUpdateOrderStatusResponse{
+		Results:   report.results,
+		Succeeded: report.succeeded,
+		Failed:    report.failed,
+	}, nil
+}
+
+// BulkAddOrderNote appends a note to the sticky notes of many orders and
+// records it on their timelines.
+func (s *ChronexAdminService) BulkAddOrderNote(ctx context.Context, req *pb.BulkAddOrderNoteRequest) (*pb.BulkAddOrderNoteResponse, error) {
+	if err := checkBulkOrderIds(req.OrderIds); err != nil {
+		return nil, err
+	}
+	note := plainText(req.Note, maxOrderNoteLength, true)
+	if note == "" {
+		return nil, status.Error(codes.InvalidArgument, "Note cannot be empty")
+	}
+
+	report := s.updateEachOrder(req.OrderIds, func(tx *gorm.DB, order *models.OrderData) error {
+		var notes string
+		if len(order.StickyNotes) > 0 && string(order.StickyNotes) != "null" {
+			if err := json.Unmarshal(order.StickyNotes, &notes); err != nil {
+				notes = string(order.StickyNotes)
+			}
+		}
+		if notes != "" {
+			notes += "\n"
+		}
+
+		sticky, err := json.Marshal(notes + note)
+		if err != nil {
+			return err
+		}
+		order.StickyNotes = json.RawMessage(sticky)
+
+		// Save the updated data back to the database using GORM
+		if err := models.SaveVersioned(tx, order, &order.Version); err != nil {
+			return versionError(err, "Order", order.OrderId.String())
+		}
+
+		event := models.OrderEventData{
+			OrderId:     order.OrderId,
+			EventType:   "NOTE",
+			Description: note,
+			Source:      "ADMIN",
+			OccurredAt:  time.Now(),
+		}
+		return tx.Create(&event).Error
+	})
+
+	return &pb.BulkAddOrderNoteResponse{
+		Results:   report.results,
+		Succeeded: report.succeeded,
+		Failed:    report.failed,
+	}, nil
+}
+
+// trackingAssignment is one row of an uploaded tracking file.
+type trackingAssignment struct {
+	row         int32
+	orderRef    string
+	trackingId  string
+	parseFailed string
+}
+
+// parseTrackingFile reads order number (or order id) and tracking id columns
+// from an uploaded file. A file without a recognisable header is read as
+// order number then tracking id.
+func parseTrackingFile(fileName string, data []byte) ([]trackingAssignment, error) {
+	rows, err := readSpreadsheet(fileName, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "The uploaded file has no rows")
+	}
+
+	orderColumn := findColumn(rows[0], "order number", "order no", "order", "order id", "orderNumber", "orderId")
+	trackingColumn := findColumn(rows[0], "tracking id", "tracking number", "tracking no", "tracking", "trackingId", "awb")
+	first := 1
+	if orderColumn < 0 && trackingColumn < 0 {
+		orderColumn, trackingColumn, first = 0, 1, 0
+	} else if orderColumn < 0 || trackingColumn < 0 {
+		return nil, status.Error(codes.InvalidArgument, "The file needs an order number and a tracking id column")
+	}
+
+	var assignments []trackingAssignment
+	for i := first; i < len(rows); i++ {
+		if blankRow(rows[i]) {
+			continue
+		}
+		assignment := trackingAssignment{
+			row:        int32(i + 1),
+			orderRef:   cellAt(rows[i], orderColumn),
+			trackingId: plainText(cellAt(rows[i], trackingColumn), 100, false),
+		}
+		switch {
+		case assignment.orderRef == "":
+			assignment.parseFailed = "Order number is missing"
+		case assignment.trackingId == "":
+			assignment.parseFailed = "Tracking id is missing"
+		}
+		assignments = append(assignments, assignment)
+	}
+	if len(assignments) > maxBulkOrders {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("At most %d orders can be updated at once", maxBulkOrders))
+	}
+
+	return assignments, nil
+}
+
+// BulkAssignTracking sets tracking ids from an uploaded CSV or XLSX file of
+// order numbers and tracking ids. With markShipped, open orders also move to
+// SHP. Each row is applied in its own transaction and reported by row number.
+func (s *ChronexAdminService) BulkAssignTracking(ctx context.Context, req *pb.BulkAssignTrackingRequest) (*pb.BulkAssignTrackingResponse, error) {
+	assignments, err := parseTrackingFile(req.FileName, req.File)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &bulkOrderReport{}
+	for _, assignment := range assignments {
+		if assignment.parseFailed != "" {
+			report.add(assignment.row, assignment.orderRef, models.OrderData{}, status.Error(codes.InvalidArgument, assignment.parseFailed))
+			continue
+		}
+
+		var orderData models.OrderData
+		err := s.DB.Transaction(func(tx *gorm.DB) error {
+			// Rows name orders by number; an order id works too
+			column := "order_number"
+			if _, err := uuid.Parse(assignment.orderRef); err == nil {
+				column = "order_id"
+			}
+			var found models.OrderData
+			if err := tx.Select("order_id").First(&found, column+" = ?", assignment.orderRef).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return status.Error(codes.NotFound, fmt.Sprintf("Order %s not found", assignment.orderRef))
+				}
+				return err
+			}
+
+			var err error
+			orderData, err = lockOrder(tx, found.OrderId)
+			if err != nil {
+				return err
+			}
+			if orderData.Courier != "" && orderData.TrackingId != assignment.trackingId {
+				return status.Error(codes.FailedPrecondition, fmt.Sprintf("Order %s is booked with courier %s", assignment.orderRef, orderData.Courier))
+			}
+			if orderData.OrderStatus == "CAN" {
+				return status.Error(codes.FailedPrecondition, fmt.Sprintf("Order %s is cancelled", assignment.orderRef))
+			}
+
+			orderData.TrackingId = assignment.trackingId
+			if req.MarkShipped && (orderData.OrderStatus == "ACT" || orderData.OrderStatus == "PEN") {
+				return s.advanceOrderStatus(tx, &orderData, "SHP", "ADMIN")
+			}
+
+			// Save the updated data back to the database using GORM
+			if err := models.SaveVersioned(tx, &orderData, &orderData.Version); err != nil {
+				return versionError(err, "Order", orderData.OrderId.String())
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("Error assigning tracking to Order %s: %v", assignment.orderRef, err)
+		}
+		report.add(assignment.row, assignment.orderRef, orderData, err)
+	}
+
+	return &pb.BulkAssignTrackingResponse{
+		Results:   report.results,
+		Succeeded: report.succeeded,
+		Failed:    report.failed,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"api/pkg/models"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func TestParseTrackingFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		file     string
+		want     []trackingAssignment
+		code     codes.Code
+	}{
+		{
+			name:     "header in any order",
+			fileName: "tracking.csv",
+			file:     "Tracking Number,Order No\nJT001,CX-2026-00001\nJT002,CX-2026-00002\n",
+			want: []trackingAssignment{
+				{row: 2, orderRef: "CX-2026-00001", trackingId: "JT001"},
+				{row: 3, orderRef: "CX-2026-00002", trackingId: "JT002"},
+			},
+		},
+		{
+			name:     "no header",
+			fileName: "TRACKING.CSV",
+			file:     "CX-2026-00001,JT001\n",
+			want:     []trackingAssignment{{row: 1, orderRef: "CX-2026-00001", trackingId: "JT001"}},
+		},
+		{
+			name:     "blank lines keep row numbers",
+			fileName: "tracking.csv",
+			file:     "order number,awb\n\nCX-2026-00001,JT001\n,\n",
+			want:     []trackingAssignment{{row: 3, orderRef: "CX-2026-00001", trackingId: "JT001"}},
+		},
+		{
+			name:     "missing values reported by row",
+			fileName: "tracking.csv",
+			file:     "order id,tracking id\n,JT001\nCX-2026-00002,\n",
+			want: []trackingAssignment{
+				{row: 2, trackingId: "JT001", parseFailed: "Order number is missing"},
+				{row: 3, orderRef: "CX-2026-00002", parseFailed: "Tracking id is missing"},
+			},
+		},
+		{name: "tracking column missing", fileName: "tracking.csv", file: "order number,courier\nCX-2026-00001,JT\n", code: codes.InvalidArgument},
+		{name: "empty file", fileName: "tracking.csv", file: "", code: codes.InvalidArgument},
+		{name: "unsupported file", fileName: "tracking.txt", file: "CX-2026-00001,JT001\n", code: codes.InvalidArgument},
+		{name: "too many rows", fileName: "tracking.csv", file: strings.Repeat("CX-2026-00001,JT001\n", maxBulkOrders+1), code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTrackingFile(tt.fileName, []byte(tt.file))
+			if status.Code(err) != tt.code {
+				t.Fatalf("parseTrackingFile() error = %v, want code %v", err, tt.code)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseTrackingFile() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateEachOrderReportsBadIds(t *testing.T) {
+	// No database is needed, as bad and repeated ids never reach it
+	svc := &ChronexAdminService{}
+	report := svc.updateEachOrder([]string{"watch", " watch ", "strap"}, func(tx *gorm.DB, order *models.OrderData) error {
+		t.Fatal("update called for a bad order id")
+		return nil
+	})
+
+	if report.succeeded != 0 || report.failed != 2 {
+		t.Fatalf("updateEachOrder() = %d succeeded, %d failed, want 0 and 2", report.succeeded, report.failed)
+	}
+	want := []struct {
+		row     int32
+		orderId string
+	}{{row: 1, orderId: "watch"}, {row: 3, orderId: "strap"}}
+	for i, result := range report.results {
+		if result.Row != want[i].row || result.OrderId != want[i].orderId || result.Success {
+			t.Errorf("result %d = row %d, order %q, success %v, want row %d, order %q, failed", i, result.Row, result.OrderId, result.Success, want[i].row, want[i].orderId)
+		}
+		if result.Error != "Invalid order id "+want[i].orderId {
+			t.Errorf("result %d error = %q, want %q", i, result.Error, "Invalid order id "+want[i].orderId)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"api/pkg/models"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// catalogueColumn is one column of a product or freebies sheet. The first
+// heading is the one exported; the importer accepts any of them.
+type catalogueColumn struct {
+	field    string
+	headings []string
+}
+
+var productColumns = []catalogueColumn{
+	{"id", []string{"PRODUCT ID", "productId", "id"}},
+	{"sku", []string{"SKU"}},
+	{"name", []string{"PRODUCT NAME", "productName", "name"}},
+	{"category", []string{"CATEGORY"}},
+	{"status", []string{"PRODUCT STATUS", "productStatus", "status"}},
+	{"supplierPrice", []string{"SUPPLIER PRICE"}},
+	{"originalPrice", []string{"ORIGINAL PRICE"}},
+	{"discount", []string{"DISCOUNT"}},
+	{"discountedPrice", []string{"DISCOUNTED PRICE", "price"}},
+	{"originalQuantity", []string{"ORIGINAL QUANTITY"}},
+	{"currentQuantity", []string{"CURRENT QUANTITY", "stock", "quantity"}},
+	{"productSold", []string{"PRODUCT SOLD", "sold"}},
+	{"weightGrams", []string{"WEIGHT GRAMS", "weight"}},
+	{"lengthCm", []string{"LENGTH CM", "length"}},
+	{"widthCm", []string{"WIDTH CM", "width"}},
+	{"heightCm", []string{"HEIGHT CM", "height"}},
+	{"attributes", []string{"ATTRIBUTES", "specifications"}},
+	{"description1", []string{"DESCRIPTION", "description1"}},
+	{"description2", []string{"DETAILS", "description2"}},
+	{"productFreebies", []string{"FREEBIES", "productFreebies"}},
+	{"img", []string{"IMAGES", "image urls", "img"}},
+	{"version", []string{"VERSION"}},
+}
+
+var freebiesColumns = []catalogueColumn{
+	{"id", []string{"FREEBIES ID", "freebiesId", "id"}},
+	{"sku", []string{"SKU"}},
+	{"name", []string{"FREEBIES NAME", "freebiesName", "name"}},
+	{"status", []string{"FREEBIES STATUS", "freebiesStatus", "status"}},
+	{"storePrice", []string{"STORE PRICE", "freebiesStorePrice", "price"}},
+	{"originalQuantity", []string{"ORIGINAL QUANTITY", "freebiesOriginalQuantity"}},
+	{"currentQuantity", []string{"CURRENT QUANTITY", "freebiesCurrentQuantity", "stock", "quantity"}},
+	{"img", []string{"IMAGE", "image url", "freebiesImg"}},
+	{"version", []string{"VERSION"}},
+}
+
+func catalogueHeader(columns []catalogueColumn) []string {
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.headings[0]
+	}
+	return header
+}
+
+func formatQuantity(quantity float64) string {
+	return strconv.FormatFloat(quantity, 'f', -1, 64)
+}
+
+// storedJSONText returns the JSON a product field was saved from. SaveProduct
+// stores the JSON text sent by the admin as a JSON string, so it is unwrapped
+// once; values saved as plain JSON come back as they are.
+func storedJSONText(value json.RawMessage) string {
+	if len(value) == 0 || string(value) == "null" {
+		return ""
+	}
+
+	var text string
+	if err := json.Unmarshal(value, &text); err == nil {
+		return text
+	}
+	return string(value)
+}
+
+// productImages returns the URLs of a product's image list.
+func productImages(img json.RawMessage) []string {
+	var urls []string
+	if err := json.Unmarshal([]byte(storedJSONText(img)), &urls); err != nil {
+		return nil
+	}
+	return urls
+}
+
+// imageLink returns value when it is an http or https link, or "".
+func imageLink(value string) string {
+	link := strings.TrimSpace(value)
+	if parsed, err := url.Parse(link); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ""
+	}
+	return link
+}
+
+func productExportRow(product models.ProductData) []string {
+	return []string{
+		product.ProductId.String(), product.Sku, product.ProductName, product.Category, product.ProductStatus,
+		formatAmount(product.SupplierPrice), formatAmount(product.OriginalPrice), formatQuantity(product.Discount), formatAmount(product.DiscountedPrice),
+		formatQuantity(product.OriginalQuantity), formatQuantity(product.CurrentQuantity), formatQuantity(product.ProductSold),
+		formatQuantity(product.WeightGrams), formatQuantity(product.LengthCm), formatQuantity(product.WidthCm), formatQuantity(product.HeightCm),
+		product.GetAttributes(), product.Description1, storedJSONText(product.Description2), storedJSONText(product.ProductFreebies),
+		strings.Join(productImages(product.Img), "\n"), fmt.Sprintf("%d", product.Version),
+	}
+}
+
+func freebiesExportRow(freebies models.FreebiesData, imageURL string) []string {
+	return []string{
+		freebies.FreebiesId.String(), freebies.Sku, freebies.FreebiesName, freebies.FreebiesStatus,
+		formatAmount(freebies.FreebiesStorePrice), formatQuantity(freebies.FreebiesOriginalQuantity), formatQuantity(freebies.FreebiesCurrentQuantity),
+		imageURL, fmt.Sprintf("%d", freebies.Version),
+	}
+}
+
+// ExportProducts streams every product to out as CSV or XLSX in the layout
+// ImportProducts reads back, so the catalogue can be edited in a spreadsheet.
+func (s *ChronexAdminService) ExportProducts(format string, out io.Writer) error {
+	query := s.DB.Model(&models.ProductData{}).Order("category ASC, product_name ASC")
+
+	return exportCatalogue(s.DB, query, format, out, "Products", productColumns, func(rows scanner) ([]string, error) {
+		var product models.ProductData
+		if err := rows(&product); err != nil {
+			return nil, err
+		}
+		return productExportRow(product), nil
+	})
+}
+
+// ExportFreebies streams every freebies to out as CSV or XLSX in the layout
+// ImportFreebies reads back.
+func (s *ChronexAdminService) ExportFreebies(format string, out io.Writer) error {
+	query := s.DB.Model(&models.FreebiesData{}).Order("freebies_name ASC")
+
+	return exportCatalogue(s.DB, query, format, out, "Freebies", freebiesColumns, func(rows scanner) ([]string, error) {
+		var freebies models.FreebiesData
+		if err := rows(&freebies); err != nil {
+			return nil, err
+		}
+		return freebiesExportRow(freebies, s.imageURL(freebies.FreebiesImgKey)), nil
+	})
+}
+
+// scanner scans the current row of an export cursor into a model.
+type scanner func(dest interface{}) error
+
+func exportCatalogue(db *gorm.DB, query *gorm.DB, format string, out io.Writer, sheetName string, columns []catalogueColumn, row func(scan scanner) ([]string, error)) error {
+	writer, err := newTableWriter(format, out, sheetName, catalogueHeader(columns))
+	if err != nil {
+		return err
+	}
+
+	// Stream the rows through a cursor rather than loading the catalogue
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	scan := func(dest interface{}) error {
+		return db.ScanRows(rows, dest)
+	}
+	for rows.Next() {
+		cells, err := row(scan)
+		if err != nil {
+			return err
+		}
+		if err := writer.Write(cells); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
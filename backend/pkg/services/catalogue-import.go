@@ -0,0 +1,447 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// catalogueRow is one data row of a product or freebies sheet. Cells are read
+// by field and every problem is collected against its cell, so a row reports
+// all of its errors at once.
+type catalogueRow struct {
+	row     int32
+	cells   []string
+	indexes map[string]int
+	header  []string
+	errors  []*pb.ImportRowError
+}
+
+// has reports whether the sheet has the field's column and the row fills it.
+// Blank cells leave the existing value alone.
+func (r *catalogueRow) has(field string) bool {
+	return r.text(field) != ""
+}
+
+func (r *catalogueRow) text(field string) string {
+	index, ok := r.indexes[field]
+	if !ok {
+		return ""
+	}
+	return cellAt(r.cells, index)
+}
+
+func (r *catalogueRow) fail(field string, message string) {
+	column := ""
+	if index, ok := r.indexes[field]; ok {
+		column = r.header[index]
+	}
+	r.errors = append(r.errors, &pb.ImportRowError{Row: r.row, Column: column, Message: message})
+}
+
+// number sets *target from the field's cell when it is filled.
+func (r *catalogueRow) number(field string, target *float64) {
+	if !r.has(field) {
+		return
+	}
+	value, err := parseImportAmount(r.text(field))
+	if err != nil {
+		r.fail(field, err.Error())
+		return
+	}
+	*target = value
+}
+
+// jsonField sets *target to the field's cell when it holds valid JSON, stored
+// the way SaveProduct stores it.
+func (r *catalogueRow) jsonField(field string, target *json.RawMessage) {
+	if !r.has(field) {
+		return
+	}
+	text := r.text(field)
+	if !json.Valid([]byte(text)) {
+		r.fail(field, "Invalid JSON")
+		return
+	}
+	stored, err := json.Marshal(text)
+	if err != nil {
+		r.fail(field, err.Error())
+		return
+	}
+	*target = json.RawMessage(stored)
+}
+
+// imageURLs splits the field's cell into links, one per line or separated by
+// "|" or spaces.
+func (r *catalogueRow) imageURLs(field string) ([]string, bool) {
+	if !r.has(field) {
+		return nil, false
+	}
+
+	var links []string
+	for _, link := range strings.FieldsFunc(r.text(field), func(c rune) bool {
+		return c == '|' || c == '\n' || c == '\r' || c == ' ' || c == '\t'
+	}) {
+		parsed, err := url.Parse(link)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			r.fail(field, fmt.Sprintf("Invalid image URL %s", link))
+			return nil, false
+		}
+		links = append(links, link)
+	}
+	return links, true
+}
+
+// statusField reads a status cell, which may not be DEL; the trash is reached
+// through the delete endpoints.
+func (r *catalogueRow) statusField(field string, target *string) {
+	if !r.has(field) {
+		return
+	}
+	value := strings.ToUpper(plainText(r.text(field), 10, false))
+	if value == "DEL" {
+		r.fail(field, "Use the trash to delete")
+		return
+	}
+	*target = value
+}
+
+// checkVersion compares the VERSION cell with the stored version, so rows
+// edited in a stale export are not applied over newer changes.
+func (r *catalogueRow) checkVersion(current int64) {
+	if !r.has("version") {
+		return
+	}
+	version, err := strconv.ParseInt(r.text("version"), 10, 64)
+	if err != nil {
+		r.fail("version", fmt.Sprintf("Invalid version %q", r.text("version")))
+		return
+	}
+	if version != current {
+		r.fail("version", fmt.Sprintf("The row was exported at version %d but is now at version %d", version, current))
+	}
+}
+
+// findCatalogueRecord looks up the record a row updates: by id when the id
+// column is filled, else by SKU. found is false for a new record.
+func findCatalogueRecord(tx *gorm.DB, row *catalogueRow, record interface{}, idColumn string, label string) (found bool, err error) {
+	var query *gorm.DB
+	switch {
+	case row.has("id"):
+		if _, err := uuid.Parse(row.text("id")); err != nil {
+			row.fail("id", fmt.Sprintf("Invalid %s id %s", label, row.text("id")))
+			return false, nil
+		}
+		query = tx.Where(idColumn+" = ?", row.text("id"))
+	case row.has("sku"):
+		query = tx.Where("sku = ?", plainText(row.text("sku"), maxSkuLength, false))
+	default:
+		return false, nil
+	}
+
+	err = query.First(record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if row.has("id") {
+			row.fail("id", fmt.Sprintf("No %s has id %s", label, row.text("id")))
+		}
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// importCatalogue reads a product or freebies sheet and runs save on each data
+// row in its own transaction. A row fails on its own, with its cell errors,
+// without stopping the rest; a dry run rolls every row back.
+func (s *ChronexAdminService) importCatalogue(req *pb.ImportCatalogueRequest, columns []catalogueColumn, save func(tx *gorm.DB, row *catalogueRow, result *pb.ImportCatalogueResult) error) (*pb.ImportCatalogueResponse, error) {
+	rows, err := readSpreadsheet(req.FileName, req.File)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) < 2 {
+		return nil, status.Error(codes.InvalidArgument, "The file has no data rows")
+	}
+	if len(rows)-1 > maxImportRows {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("At most %d rows can be imported at once", maxImportRows))
+	}
+
+	header := rows[0]
+	indexes := map[string]int{}
+	for _, column := range columns {
+		if index := findColumn(header, column.headings...); index >= 0 {
+			indexes[column.field] = index
+		}
+	}
+	if _, ok := indexes["name"]; !ok {
+		if _, ok := indexes["id"]; !ok {
+			if _, ok := indexes["sku"]; !ok {
+				return nil, status.Error(codes.InvalidArgument, "The file needs an id, SKU or name column")
+			}
+		}
+	}
+
+	response := &pb.ImportCatalogueResponse{DryRun: req.DryRun}
+	seen := map[string]int32{}
+	for i := 1; i < len(rows); i++ {
+		if blankRow(rows[i]) {
+			continue
+		}
+		row := &catalogueRow{row: int32(i + 1), cells: rows[i], indexes: indexes, header: header}
+		result := &pb.ImportCatalogueResult{Row: row.row, Id: row.text("id"), Sku: row.text("sku"), Name: row.text("name")}
+		response.Results = append(response.Results, result)
+
+		// The same record twice in one file would be applied twice
+		for _, key := range []string{"id", "sku"} {
+			if !row.has(key) {
+				continue
+			}
+			seenKey := key + ":" + strings.ToLower(row.text(key))
+			if first, ok := seen[seenKey]; ok {
+				row.fail(key, fmt.Sprintf("Also on row %d", first))
+			} else {
+				seen[seenKey] = row.row
+			}
+		}
+
+		if len(row.errors) == 0 {
+			err = s.DB.Transaction(func(tx *gorm.DB) error {
+				if err := save(tx, row, result); err != nil {
+					return err
+				}
+				if len(row.errors) > 0 || req.DryRun {
+					return errDryRun
+				}
+				return nil
+			})
+			if err != nil && !errors.Is(err, errDryRun) {
+				log.Printf("Error importing catalogue row %d: %v", row.row, err)
+				row.errors = append(row.errors, &pb.ImportRowError{Row: row.row, Message: status.Convert(err).Message()})
+			}
+		}
+
+		if len(row.errors) > 0 {
+			result.Outcome = "FAILED"
+			result.Errors = row.errors
+			result.Version = 0
+			response.Failed++
+			continue
+		}
+		switch result.Outcome {
+		case "CREATED":
+			response.Created++
+			if req.DryRun {
+				result.Id = ""
+			}
+		case "UPDATED":
+			response.Updated++
+		}
+	}
+
+	return response, nil
+}
+
+// ImportProducts creates and updates products from a CSV or XLSX sheet in the
+// ExportProducts layout. Rows match existing products by id, then by SKU;
+// other rows create products. Blank cells keep the current values.
+func (s *ChronexAdminService) ImportProducts(ctx context.Context, req *pb.ImportCatalogueRequest) (*pb.ImportCatalogueResponse, error) {
+	now := time.Now()
+	priceRules, err := loadActivePriceRules(s.DB, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.importCatalogue(req, productColumns, func(tx *gorm.DB, row *catalogueRow, result *pb.ImportCatalogueResult) error {
+		var product models.ProductData
+		found, err := findCatalogueRecord(tx, row, &product, "product_id", "product")
+		if err != nil {
+			return err
+		}
+		if found {
+			row.checkVersion(product.Version)
+		} else {
+			product.ProductStatus = "ACT"
+			product.Img = json.RawMessage(`"[]"`)
+		}
+		originalPrice, discountedPrice := product.OriginalPrice, product.DiscountedPrice
+
+		if row.has("sku") {
+			product.Sku, err = checkSku(tx, &models.ProductData{}, "product_id", product.ProductId, row.text("sku"))
+			if status.Code(err) == codes.AlreadyExists {
+				row.fail("sku", status.Convert(err).Message())
+			} else if err != nil {
+				return err
+			}
+		}
+		if row.has("name") {
+			product.ProductName = plainText(row.text("name"), 200, false)
+		}
+		if row.has("category") {
+			product.Category = plainText(row.text("category"), 100, false)
+		}
+		row.statusField("status", &product.ProductStatus)
+		row.number("supplierPrice", &product.SupplierPrice)
+		row.number("originalPrice", &product.OriginalPrice)
+		row.number("discount", &product.Discount)
+		row.number("discountedPrice", &product.DiscountedPrice)
+		row.number("originalQuantity", &product.OriginalQuantity)
+		row.number("currentQuantity", &product.CurrentQuantity)
+		row.number("productSold", &product.ProductSold)
+		row.number("weightGrams", &product.WeightGrams)
+		row.number("lengthCm", &product.LengthCm)
+		row.number("widthCm", &product.WidthCm)
+		row.number("heightCm", &product.HeightCm)
+		if row.has("description1") {
+			product.Description1 = row.text("description1")
+		}
+		row.jsonField("description2", &product.Description2)
+		row.jsonField("productFreebies", &product.ProductFreebies)
+		if links, ok := row.imageURLs("img"); ok {
+			list, _ := json.Marshal(links)
+			stored, _ := json.Marshal(string(list))
+			product.Img = json.RawMessage(stored)
+		}
+
+		// Which attributes apply depends on the category, so they are checked
+		// again when either changes
+		if !found || row.has("attributes") || row.has("category") {
+			attributes := product.GetAttributes()
+			if row.has("attributes") {
+				attributes = row.text("attributes")
+			}
+			checked, err := checkProductAttributes(tx, product.Category, attributes)
+			if status.Code(err) == codes.InvalidArgument {
+				row.fail("attributes", status.Convert(err).Message())
+			} else if err != nil {
+				return err
+			} else {
+				product.Attributes = checked
+			}
+		}
+
+		if !found {
+			if product.ProductName == "" {
+				row.fail("name", "Product name is required for a new product")
+			}
+			if !row.has("originalPrice") {
+				row.fail("originalPrice", "Original price is required for a new product")
+			}
+			if !row.has("discountedPrice") {
+				row.fail("discountedPrice", "Discounted price is required for a new product")
+			}
+		}
+		if product.DiscountedPrice > product.OriginalPrice {
+			row.fail("discountedPrice", "Discounted price cannot be more than the original price")
+		}
+		if product.CurrentQuantity > product.OriginalQuantity && row.has("originalQuantity") {
+			row.fail("currentQuantity", "Current quantity cannot be more than the original quantity")
+		}
+		if len(row.errors) > 0 {
+			return nil
+		}
+
+		if found {
+			if err := models.SaveVersioned(tx, &product, &product.Version); err != nil {
+				return versionError(err, "Product", product.ProductId.String())
+			}
+			result.Outcome = "UPDATED"
+		} else {
+			if err := tx.Create(&product).Error; err != nil {
+				return err
+			}
+			result.Outcome = "CREATED"
+		}
+
+		// Start or continue the price history like a manual edit would
+		if !found || product.OriginalPrice != originalPrice || product.DiscountedPrice != discountedPrice {
+			if err := recordPriceHistory(tx, product, priceRules, now, "IMPORT"); err != nil {
+				return err
+			}
+		}
+
+		result.Id = product.ProductId.String()
+		result.Sku = product.Sku
+		result.Name = product.ProductName
+		result.Version = product.Version
+		return nil
+	})
+}
+
+// ImportFreebies creates and updates freebies from a CSV or XLSX sheet in the
+// ExportFreebies layout, matched by id, then by SKU.
+func (s *ChronexAdminService) ImportFreebies(ctx context.Context, req *pb.ImportCatalogueRequest) (*pb.ImportCatalogueResponse, error) {
+	return s.importCatalogue(req, freebiesColumns, func(tx *gorm.DB, row *catalogueRow, result *pb.ImportCatalogueResult) error {
+		var freebies models.FreebiesData
+		found, err := findCatalogueRecord(tx, row, &freebies, "freebies_id", "freebies")
+		if err != nil {
+			return err
+		}
+		if found {
+			row.checkVersion(freebies.Version)
+		} else {
+			freebies.FreebiesStatus = "ACT"
+		}
+
+		if row.has("sku") {
+			freebies.Sku, err = checkSku(tx, &models.FreebiesData{}, "freebies_id", freebies.FreebiesId, row.text("sku"))
+			if status.Code(err) == codes.AlreadyExists {
+				row.fail("sku", status.Convert(err).Message())
+			} else if err != nil {
+				return err
+			}
+		}
+		if row.has("name") {
+			freebies.FreebiesName = plainText(row.text("name"), 200, false)
+		}
+		row.statusField("status", &freebies.FreebiesStatus)
+		row.number("storePrice", &freebies.FreebiesStorePrice)
+		row.number("originalQuantity", &freebies.FreebiesOriginalQuantity)
+		row.number("currentQuantity", &freebies.FreebiesCurrentQuantity)
+		if links, ok := row.imageURLs("img"); ok {
+			if len(links) != 1 {
+				row.fail("img", "Give one image URL")
+			} else {
+				freebies.FreebiesImgKey = s.imageKeyOf(links[0])
+			}
+		}
+
+		if !found && freebies.FreebiesName == "" {
+			row.fail("name", "Freebies name is required for a new freebies")
+		}
+		if freebies.FreebiesCurrentQuantity > freebies.FreebiesOriginalQuantity && row.has("originalQuantity") {
+			row.fail("currentQuantity", "Current quantity cannot be more than the original quantity")
+		}
+		if len(row.errors) > 0 {
+			return nil
+		}
+
+		if found {
+			if err := models.SaveVersioned(tx, &freebies, &freebies.Version); err != nil {
+				return versionError(err, "Freebies", freebies.FreebiesId.String())
+			}
+			result.Outcome = "UPDATED"
+		} else {
+			if err := tx.Create(&freebies).Error; err != nil {
+				return err
+			}
+			result.Outcome = "CREATED"
+		}
+
+		result.Id = freebies.FreebiesId.String()
+		result.Sku = freebies.Sku
+		result.Name = freebies.FreebiesName
+		result.Version = freebies.Version
+		return nil
+	})
+}
@@ -0,0 +1,237 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxComparedProducts is how many products can be compared side by side.
+const maxComparedProducts = 4
+
+// The sections of a comparison: the summary every product has, then the
+// attributes that apply to them.
+const (
+	comparisonSummary    = "SUMMARY"
+	comparisonAttributes = "ATTRIBUTES"
+)
+
+// comparisonBest says which way a summary row is better, so the best products
+// can be highlighted. Rows missing from it have no best value.
+var comparisonBest = map[string]int{"price": -1, "discount": 1, "rating": 1}
+
+// attributeText formats an attribute value for display.
+func attributeText(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case float64:
+		return formatQuantity(value)
+	case bool:
+		if value {
+			return "Yes"
+		}
+		return "No"
+	default:
+		encoded, _ := json.Marshal(value)
+		return string(encoded)
+	}
+}
+
+// newComparisonRow builds a row from one value per product, marking whether
+// they differ.
+func newComparisonRow(section, code, name, valueType, unit string, values []string) *pb.ComparisonRow {
+	row := &pb.ComparisonRow{
+		Section: section,
+		Code:    code,
+		Name:    name,
+		Type:    valueType,
+		Unit:    unit,
+		Values:  values,
+		Best:    []int32{},
+	}
+	for _, value := range values[1:] {
+		if value != values[0] {
+			row.Differs = true
+		}
+	}
+	return row
+}
+
+// markBest highlights the products with the best of numbers on row, lowest
+// first when direction is negative. Products without a number are skipped,
+// and nothing is marked when every product ties.
+func markBest(row *pb.ComparisonRow, numbers []float64, has []bool, direction int) {
+	best, found := 0.0, false
+	for i, number := range numbers {
+		if has[i] && (!found || float64(direction)*(number-best) > 0) {
+			best, found = number, true
+		}
+	}
+	if !found || !row.Differs {
+		return
+	}
+	for i, number := range numbers {
+		if has[i] && number == best {
+			row.Best = append(row.Best, int32(i))
+		}
+	}
+}
+
+// CompareProducts lines up two to four products side by side: their prices
+// after sales, stock, ratings and the attributes that apply to any of them,
+// flagging the rows where they differ.
+func (s *ChronexAdminService) CompareProducts(ctx context.Context, req *pb.CompareProductsRequest) (*pb.CompareProductsResponse, error) {
+	// Keep the products in the order asked for, each once
+	productIds := []string{}
+	seen := map[string]bool{}
+	for _, productId := range req.ProductIds {
+		id, err := uuid.Parse(strings.TrimSpace(productId))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid product id %s", productId))
+		}
+		if !seen[id.String()] {
+			seen[id.String()] = true
+			productIds = append(productIds, id.String())
+		}
+	}
+	if len(productIds) < 2 || len(productIds) > maxComparedProducts {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Compare between 2 and %d different products", maxComparedProducts))
+	}
+
+	var productDataValue []models.ProductData
+	if err := s.DB.Where("product_id IN ?", productIds).Find(&productDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch product data: %v", err))
+	}
+	byId := map[string]models.ProductData{}
+	for _, product := range productDataValue {
+		byId[product.ProductId.String()] = product
+	}
+
+	now := time.Now()
+	priceRules, err := loadActivePriceRules(s.DB, now)
+	if err != nil {
+		return nil, err
+	}
+	ratings, err := loadProductRatings(s.DB, productIds)
+	if err != nil {
+		return nil, err
+	}
+	definitions, err := loadProductAttributes(s.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.CompareProductsResponse{
+		Products:    []*pb.ComparedProduct{},
+		Rows:        []*pb.ComparisonRow{},
+		Differences: []string{},
+	}
+	products := make([]models.ProductData, len(productIds))
+	attributes := make([]map[string]interface{}, len(productIds))
+	for i, productId := range productIds {
+		product, ok := byId[productId]
+		if !ok {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("Product with ID %s not found", productId))
+		}
+		products[i] = product
+		json.Unmarshal([]byte(product.GetAttributes()), &attributes[i])
+
+		productData := productToPb(product)
+		productData.AverageRating = ratings[productId].Average
+		productData.ReviewCount = ratings[productId].Count
+		applyEffectivePrice(productData, product, priceRules, now)
+
+		compared := &pb.ComparedProduct{
+			ProductData: productData,
+			InStock:     product.ProductStatus == "ACT" && product.CurrentQuantity > 0,
+		}
+		if product.OriginalPrice > 0 && productData.EffectivePrice < product.OriginalPrice {
+			compared.DiscountPercent = (product.OriginalPrice - productData.EffectivePrice) * 100 / product.OriginalPrice
+		}
+		response.Products = append(response.Products, compared)
+	}
+
+	// Summary rows
+	count := len(products)
+	summary := func(code, name, valueType, unit string, cell func(i int) (string, float64, bool)) {
+		values := make([]string, count)
+		numbers := make([]float64, count)
+		has := make([]bool, count)
+		for i := range products {
+			values[i], numbers[i], has[i] = cell(i)
+		}
+		row := newComparisonRow(comparisonSummary, code, name, valueType, unit, values)
+		if direction, ok := comparisonBest[code]; ok {
+			markBest(row, numbers, has, direction)
+		}
+		response.Rows = append(response.Rows, row)
+	}
+	summary("price", "Price", "NUMBER", "", func(i int) (string, float64, bool) {
+		price := response.Products[i].ProductData.EffectivePrice
+		return formatAmount(price), price, true
+	})
+	summary("originalPrice", "Original price", "NUMBER", "", func(i int) (string, float64, bool) {
+		return formatAmount(products[i].OriginalPrice), products[i].OriginalPrice, true
+	})
+	summary("discount", "Discount", "NUMBER", "%", func(i int) (string, float64, bool) {
+		discount := response.Products[i].DiscountPercent
+		return fmt.Sprintf("%.0f", discount), discount, discount > 0
+	})
+	summary("stock", "Availability", "TEXT", "", func(i int) (string, float64, bool) {
+		if response.Products[i].InStock {
+			return "In stock", 0, false
+		}
+		return "Out of stock", 0, false
+	})
+	summary("rating", "Rating", "NUMBER", "", func(i int) (string, float64, bool) {
+		rating, ok := ratings[productIds[i]]
+		if !ok {
+			return "", 0, false
+		}
+		return fmt.Sprintf("%.1f", rating.Average), rating.Average, true
+	})
+	summary("category", "Category", "TEXT", "", func(i int) (string, float64, bool) {
+		return products[i].Category, 0, false
+	})
+
+	// Attribute rows, for the active attributes any of the products has
+	for _, definition := range definitions {
+		if definition.AttributeStatus != "ACT" {
+			continue
+		}
+
+		values := make([]string, count)
+		filled := false
+		for i, product := range products {
+			if !definition.AppliesTo(product.Category) {
+				continue
+			}
+			values[i] = attributeText(attributes[i][definition.AttributeCode])
+			filled = filled || values[i] != ""
+		}
+		if !filled {
+			continue
+		}
+		response.Rows = append(response.Rows, newComparisonRow(comparisonAttributes, definition.AttributeCode,
+			definition.AttributeName, definition.AttributeType, definition.Unit, values))
+	}
+
+	for _, row := range response.Rows {
+		if row.Differs {
+			response.Differences = append(response.Differences, row.Name)
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"api/pkg/pb"
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAttributeText(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{name: "missing", value: nil, want: ""},
+		{name: "text", value: "Automatic", want: "Automatic"},
+		{name: "whole number", value: 42.0, want: "42"},
+		{name: "decimal", value: 40.5, want: "40.5"},
+		{name: "yes", value: true, want: "Yes"},
+		{name: "no", value: false, want: "No"},
+		{name: "list", value: []interface{}{"a", "b"}, want: `["a","b"]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := attributeText(tt.value); got != tt.want {
+				t.Errorf("attributeText(%v) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewComparisonRow(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   bool
+	}{
+		{name: "all the same", values: []string{"Steel", "Steel", "Steel"}},
+		{name: "last differs", values: []string{"Steel", "Steel", "Gold"}, want: true},
+		{name: "first differs", values: []string{"Gold", "Steel", "Steel"}, want: true},
+		{name: "one missing", values: []string{"Steel", ""}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := newComparisonRow(comparisonAttributes, "material", "Material", "TEXT", "", tt.values)
+			if row.Differs != tt.want {
+				t.Errorf("newComparisonRow(%v).Differs = %v, want %v", tt.values, row.Differs, tt.want)
+			}
+			if len(row.Best) != 0 {
+				t.Errorf("newComparisonRow(%v).Best = %v, want none", tt.values, row.Best)
+			}
+		})
+	}
+}
+
+func TestMarkBest(t *testing.T) {
+	tests := []struct {
+		name      string
+		numbers   []float64
+		has       []bool
+		direction int
+		want      []int32
+	}{
+		{name: "lowest price", numbers: []float64{1500, 1200, 1800}, has: []bool{true, true, true}, direction: -1, want: []int32{1}},
+		{name: "highest rating", numbers: []float64{4.5, 3, 4.5}, has: []bool{true, true, true}, direction: 1, want: []int32{0, 2}},
+		{name: "products without a number skipped", numbers: []float64{0, 3, 4}, has: []bool{false, true, true}, direction: -1, want: []int32{1}},
+		{name: "every product ties", numbers: []float64{10, 10}, has: []bool{true, true}, direction: 1, want: []int32{}},
+		{name: "no numbers", numbers: []float64{0, 0}, has: []bool{false, false}, direction: 1, want: []int32{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make([]string, len(tt.numbers))
+			for i, number := range tt.numbers {
+				if tt.has[i] {
+					values[i] = formatQuantity(number)
+				}
+			}
+			row := newComparisonRow(comparisonSummary, "price", "Price", "NUMBER", "", values)
+			markBest(row, tt.numbers, tt.has, tt.direction)
+			if !reflect.DeepEqual(row.Best, tt.want) {
+				t.Errorf("markBest(%v) = %v, want %v", tt.numbers, row.Best, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareProductsChecksBeforeLoading(t *testing.T) {
+	first := "00000000-0000-0000-0000-000000000001"
+
+	tests := []struct {
+		name       string
+		productIds []string
+	}{
+		{name: "not a product id", productIds: []string{first, "watch"}},
+		{name: "one product", productIds: []string{first}},
+		{name: "the same product twice", productIds: []string{first, " " + first + " "}},
+		{name: "too many products", productIds: []string{
+			first,
+			"00000000-0000-0000-0000-000000000002",
+			"00000000-0000-0000-0000-000000000003",
+			"00000000-0000-0000-0000-000000000004",
+			"00000000-0000-0000-0000-000000000005",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// No database is needed, as each fails before the products are loaded
+			_, err := (&ChronexAdminService{}).CompareProducts(context.Background(), &pb.CompareProductsRequest{ProductIds: tt.productIds})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("CompareProducts(%v) error = %v, want code %v", tt.productIds, err, codes.InvalidArgument)
+			}
+		})
+	}
+}
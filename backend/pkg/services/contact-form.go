@@ -0,0 +1,212 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxContactNameLength    = 100
+	maxContactSubjectLength = 150
+	maxContactMessageLength = 5000
+)
+
+var contactTemplate = template.Must(template.ParseFS(emailTemplateFS, "templates/email/contact-message.html"))
+
+var htmlTags = regexp.MustCompile(`<[^>]*>`)
+
+// plainText strips markup and control characters from user input, trims it
+// and cuts it to maxLength runes. Line breaks are kept only when multiline is
+// set, so single-line values can never carry header injection.
+func plainText(value string, maxLength int, multiline bool) string {
+	value = strings.ReplaceAll(value, "\r\n", "\n")
+	value = htmlTags.ReplaceAllString(value, "")
+	value = strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' && multiline:
+			return r
+		case r == '\n' || r == '\r' || r == '\t':
+			return ' '
+		case unicode.IsControl(r) || r == utf8.RuneError:
+			return -1
+		}
+		return r
+	}, value)
+	value = strings.TrimSpace(value)
+
+	if maxLength > 0 && utf8.RuneCountInString(value) > maxLength {
+		value = strings.TrimSpace(string([]rune(value)[:maxLength]))
+	}
+
+	return value
+}
+
+// plainTextLines splits sanitized multi-line text into paragraphs for the
+// email templates, dropping blank lines.
+func plainTextLines(value string) []string {
+	lines := []string{}
+	for _, line := range strings.Split(plainText(value, maxContactMessageLength, true), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
+
+// CaptchaVerifier checks a captcha token submitted with a public form.
+type CaptchaVerifier interface {
+	Verify(token string, remoteIp string) (bool, error)
+}
+
+// SiteVerifyCaptcha verifies tokens against a reCAPTCHA, hCaptcha or Turnstile
+// style siteverify endpoint.
+type SiteVerifyCaptcha struct {
+	URL    string
+	Secret string
+}
+
+func (c SiteVerifyCaptcha) Verify(token string, remoteIp string) (bool, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+	res, err := client.PostForm(c.URL, url.Values{
+		"secret":   {c.Secret},
+		"response": {token},
+		"remoteip": {remoteIp},
+	})
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return false, err
+	}
+
+	return result.Success, nil
+}
+
+// SubmitContactForm queues a contact form message to the shop's own inbox.
+// Submissions that fill the hidden honeypot field are accepted and dropped so
+// bots cannot tell they were caught.
+func (s *ChronexAdminService) SubmitContactForm(ctx context.Context, req *pb.SubmitContactFormRequest) (*pb.SubmitContactFormResponse, error) {
+	response := &pb.SubmitContactFormResponse{
+		Message: "Thank you, we will get back to you soon",
+	}
+
+	if s.ContactRecipient == "" {
+		return nil, status.Error(codes.Unavailable, "Contact form is not configured")
+	}
+
+	if strings.TrimSpace(req.Website) != "" {
+		log.Printf("Dropped contact form submission from %s: honeypot filled", req.RemoteIp)
+		return response, nil
+	}
+
+	if s.Captcha != nil {
+		if req.CaptchaToken == "" {
+			return nil, status.Error(codes.InvalidArgument, "Captcha token is required")
+		}
+
+		ok, err := s.Captcha.Verify(req.CaptchaToken, req.RemoteIp)
+		if err != nil {
+			log.Printf("Error verifying captcha: %v", err)
+			return nil, status.Error(codes.Unavailable, "Failed to verify captcha")
+		}
+		if !ok {
+			return nil, status.Error(codes.PermissionDenied, "Captcha verification failed")
+		}
+	}
+
+	name := plainText(req.Name, maxContactNameLength, false)
+	subject := plainText(req.Subject, maxContactSubjectLength, false)
+	messageLines := plainTextLines(req.Message)
+
+	emailAddress, err := mail.ParseAddress(plainText(req.EmailAddress, 254, false))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "A valid email address is required")
+	}
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "Name is required")
+	}
+	if len(messageLines) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Message is required")
+	}
+	if subject == "" {
+		subject = "Website enquiry"
+	}
+
+	var body bytes.Buffer
+	if err := contactTemplate.ExecuteTemplate(&body, "contact", map[string]interface{}{
+		"Name":         name,
+		"EmailAddress": emailAddress.Address,
+		"Subject":      subject,
+		"RemoteIp":     req.RemoteIp,
+		"MessageLines": messageLines,
+	}); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to render contact email: %v", err))
+	}
+
+	if _, err := queueEmail(s.DB, "CONTACT_FORM", nil, s.ContactRecipient, "[Contact] "+subject+" - "+name, body.String()); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// SendOrderEmail queues a templated email to the customer of an order. Any
+// order event can be re-sent; ORDER_MESSAGE carries the admin's own subject
+// and plain-text message. The per-event switches do not apply here.
+func (s *ChronexAdminService) SendOrderEmail(ctx context.Context, req *pb.SendOrderEmailRequest) (*pb.SendOrderEmailResponse, error) {
+	event := strings.ToUpper(req.Event)
+	message := plainText(req.Message, maxContactMessageLength, true)
+	if event == OrderEmailMessage && message == "" {
+		return nil, status.Error(codes.InvalidArgument, "Message is required")
+	}
+
+	// Retrieve existing OrderData from the database
+	var orderData models.OrderData
+	if err := s.DB.First(&orderData, "order_id = ?", req.GetOrderId()).Error; err != nil {
+		log.Printf("Error retrieving Order data: %v", err)
+		return nil, err
+	}
+
+	to, subject, body, err := s.renderOrderEmail(event, orderData, message)
+	if err != nil {
+		return nil, err
+	}
+	if to == "" {
+		return nil, status.Error(codes.FailedPrecondition, "Order has no customer email address")
+	}
+	if custom := plainText(req.Subject, maxContactSubjectLength, false); event == OrderEmailMessage && custom != "" {
+		subject = custom
+	}
+
+	email, err := queueEmail(s.DB, event, &orderData.OrderId, to, subject, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SendOrderEmailResponse{
+		EmailOutboxData: emailOutboxToPb(email),
+	}, nil
+}
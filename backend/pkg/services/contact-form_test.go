@@ -0,0 +1,54 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlainText(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		maxLength int
+		multiline bool
+		want      string
+	}{
+		{name: "trimmed", value: "  Ana Cruz  ", want: "Ana Cruz"},
+		{name: "markup stripped", value: `<b>Hello</b> <script>alert(1)</script>there`, want: "Hello alert(1)there"},
+		{name: "header injection flattened", value: "Hi\r\nBcc: spam@example.com", want: "Hi Bcc: spam@example.com"},
+		{name: "tabs become spaces", value: "a\tb", want: "a b"},
+		{name: "control characters dropped", value: "a\x00b\x07c", want: "abc"},
+		{name: "invalid UTF-8 dropped", value: "a\xffb", want: "ab"},
+		{name: "line breaks kept when multiline", value: "line one\r\nline two\n", multiline: true, want: "line one\nline two"},
+		{name: "cut to runes", value: "ñañaña", maxLength: 4, want: "ñaña"},
+		{name: "cut then trimmed", value: "abc def", maxLength: 4, want: "abc"},
+		{name: "no limit", value: "abcdef", maxLength: 0, want: "abcdef"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := plainText(tt.value, tt.maxLength, tt.multiline); got != tt.want {
+				t.Errorf("plainText(%q, %d, %v) = %q, want %q", tt.value, tt.maxLength, tt.multiline, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlainTextLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{name: "empty", value: "  ", want: []string{}},
+		{name: "blank lines dropped", value: "Hello,\r\n\r\n  I have a question. \n\nThanks", want: []string{"Hello,", "I have a question.", "Thanks"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := plainTextLines(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("plainTextLines(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"api/pkg/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tracking statuses couriers report, normalized across couriers.
+const (
+	TrackingCreated        = "CRE"
+	TrackingPickedUp       = "PCK"
+	TrackingInTransit      = "TRN"
+	TrackingOutForDelivery = "OFD"
+	TrackingDelivered      = "DLV"
+	TrackingFailedAttempt  = "FAI"
+	TrackingReturned       = "RTS"
+	TrackingCancelled      = "CAN"
+)
+
+// Shipment is a parcel booked with a courier.
+type Shipment struct {
+	TrackingId string
+}
+
+// TrackingEvent is one scan of a shipment. Status is one of the Tracking
+// constants.
+type TrackingEvent struct {
+	TrackingId  string
+	Status      string
+	Description string
+	Location    string
+	OccurredAt  time.Time
+}
+
+// Courier books and tracks shipments with a delivery company.
+type Courier interface {
+	Name() string
+	// CreateShipment books a pickup for the order and returns its tracking id.
+	CreateShipment(ctx context.Context, order models.OrderData) (Shipment, error)
+	// TrackingEvents returns every scan of a shipment so far, oldest first.
+	// A shipment that has not been picked up yet may have none.
+	TrackingEvents(ctx context.Context, trackingId string) ([]TrackingEvent, error)
+	CancelShipment(ctx context.Context, trackingId string) error
+	// ParseWebhook verifies a status push and decodes its events.
+	ParseWebhook(payload []byte, signature string) ([]TrackingEvent, error)
+}
+
+// FakeCourier is an in-process courier for local testing. Shipments live in
+// memory; Push adds scans as if the courier had made them, and a webhook
+// signed with SignWebhook delivers them like a real status push.
+type FakeCourier struct {
+	WebhookSecret []byte
+
+	mu        sync.Mutex
+	shipments map[string][]TrackingEvent
+}
+
+func NewFakeCourier(webhookSecret []byte) *FakeCourier {
+	return &FakeCourier{
+		WebhookSecret: webhookSecret,
+		shipments:     map[string][]TrackingEvent{},
+	}
+}
+
+func (c *FakeCourier) Name() string {
+	return "fake"
+}
+
+func (c *FakeCourier) CreateShipment(ctx context.Context, order models.OrderData) (Shipment, error) {
+	trackingId := "FK" + strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", "")[:12])
+	c.mu.Lock()
+	if c.shipments == nil {
+		c.shipments = map[string][]TrackingEvent{}
+	}
+	c.shipments[trackingId] = []TrackingEvent{}
+	c.mu.Unlock()
+
+	return Shipment{TrackingId: trackingId}, nil
+}
+
+func (c *FakeCourier) TrackingEvents(ctx context.Context, trackingId string) ([]TrackingEvent, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	events, ok := c.shipments[trackingId]
+	if !ok {
+		return nil, fmt.Errorf("unknown shipment %s", trackingId)
+	}
+
+	return append([]TrackingEvent(nil), events...), nil
+}
+
+func (c *FakeCourier) CancelShipment(ctx context.Context, trackingId string) error {
+	events, err := c.TrackingEvents(ctx, trackingId)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		if event.Status == TrackingDelivered || event.Status == TrackingCancelled {
+			return fmt.Errorf("shipment %s can no longer be cancelled", trackingId)
+		}
+	}
+
+	c.Push(trackingId, TrackingCancelled, "Shipment cancelled", "")
+
+	return nil
+}
+
+// Push records a scan of a fake shipment.
+func (c *FakeCourier) Push(trackingId string, status string, description string, location string) TrackingEvent {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.shipments == nil {
+		c.shipments = map[string][]TrackingEvent{}
+	}
+	event := TrackingEvent{
+		TrackingId:  trackingId,
+		Status:      status,
+		Description: description,
+		Location:    location,
+		OccurredAt:  time.Now().Truncate(time.Second),
+	}
+	c.shipments[trackingId] = append(c.shipments[trackingId], event)
+
+	return event
+}
+
+func (c *FakeCourier) ParseWebhook(payload []byte, signature string) ([]TrackingEvent, error) {
+	if !verifyHMACSignature(c.WebhookSecret, payload, signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	var push struct {
+		TrackingId  string `json:"trackingId"`
+		Status      string `json:"status"`
+		Description string `json:"description"`
+		Location    string `json:"location"`
+		OccurredAt  int64  `json:"occurredAt"`
+	}
+	if err := json.Unmarshal(payload, &push); err != nil {
+		return nil, err
+	}
+
+	statuses := map[string]string{
+		"created":          TrackingCreated,
+		"picked_up":        TrackingPickedUp,
+		"in_transit":       TrackingInTransit,
+		"out_for_delivery": TrackingOutForDelivery,
+		"delivered":        TrackingDelivered,
+		"failed_attempt":   TrackingFailedAttempt,
+		"returned":         TrackingReturned,
+		"cancelled":        TrackingCancelled,
+	}
+	status, ok := statuses[strings.ToLower(push.Status)]
+	if !ok {
+		return nil, fmt.Errorf("unknown tracking status %q", push.Status)
+	}
+
+	occurredAt := time.Now().Truncate(time.Second)
+	if push.OccurredAt > 0 {
+		occurredAt = time.Unix(push.OccurredAt, 0)
+	}
+
+	return []TrackingEvent{{
+		TrackingId:  push.TrackingId,
+		Status:      status,
+		Description: push.Description,
+		Location:    push.Location,
+		OccurredAt:  occurredAt,
+	}}, nil
+}
+
+// SignWebhook returns the signature the fake courier expects for payload.
+func (c *FakeCourier) SignWebhook(payload []byte) string {
+	return FakePaymentProvider{WebhookSecret: c.WebhookSecret}.SignWebhook(payload)
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"api/pkg/models"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFakeCourierShipment(t *testing.T) {
+	ctx := context.Background()
+	courier := NewFakeCourier([]byte("whsec_test"))
+
+	shipment, err := courier.CreateShipment(ctx, models.OrderData{})
+	if err != nil {
+		t.Fatalf("CreateShipment() error = %v", err)
+	}
+	if len(shipment.TrackingId) != 14 || shipment.TrackingId[:2] != "FK" {
+		t.Errorf("TrackingId = %q, want FK and 12 characters", shipment.TrackingId)
+	}
+
+	events, err := courier.TrackingEvents(ctx, shipment.TrackingId)
+	if err != nil || len(events) != 0 {
+		t.Fatalf("TrackingEvents() of a new shipment = %v, %v, want no scans", events, err)
+	}
+
+	courier.Push(shipment.TrackingId, TrackingPickedUp, "Picked up", "Makati")
+	courier.Push(shipment.TrackingId, TrackingInTransit, "In transit", "Pasig")
+	events, err = courier.TrackingEvents(ctx, shipment.TrackingId)
+	if err != nil {
+		t.Fatalf("TrackingEvents() error = %v", err)
+	}
+	if len(events) != 2 || events[0].Status != TrackingPickedUp || events[1].Status != TrackingInTransit {
+		t.Fatalf("TrackingEvents() = %+v, want the two scans oldest first", events)
+	}
+
+	// Callers get a copy they cannot change the shipment through
+	events[0].Status = TrackingDelivered
+	if again, _ := courier.TrackingEvents(ctx, shipment.TrackingId); again[0].Status != TrackingPickedUp {
+		t.Errorf("changing the returned scans changed the shipment")
+	}
+
+	if _, err := courier.TrackingEvents(ctx, "FKUNKNOWN"); err == nil {
+		t.Errorf("TrackingEvents() of an unknown shipment succeeded")
+	}
+}
+
+func TestFakeCourierCancelShipment(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		scans   []string
+		wantErr bool
+	}{
+		{name: "not picked up", scans: nil},
+		{name: "in transit", scans: []string{TrackingPickedUp, TrackingInTransit}},
+		{name: "delivered", scans: []string{TrackingPickedUp, TrackingDelivered}, wantErr: true},
+		{name: "already cancelled", scans: []string{TrackingCancelled}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courier := NewFakeCourier(nil)
+			shipment, _ := courier.CreateShipment(ctx, models.OrderData{})
+			for _, scan := range tt.scans {
+				courier.Push(shipment.TrackingId, scan, "", "")
+			}
+
+			err := courier.CancelShipment(ctx, shipment.TrackingId)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CancelShipment() error = %v, want error %v", err, tt.wantErr)
+			}
+
+			events, _ := courier.TrackingEvents(ctx, shipment.TrackingId)
+			cancelled := len(events) > len(tt.scans) && events[len(events)-1].Status == TrackingCancelled
+			if cancelled == tt.wantErr {
+				t.Errorf("cancel scan recorded = %v, want %v", cancelled, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFakeCourierParseWebhook(t *testing.T) {
+	courier := NewFakeCourier([]byte("whsec_test"))
+
+	tests := []struct {
+		name       string
+		payload    string
+		sign       bool
+		wantStatus string
+		wantAt     time.Time
+		wantSigErr bool
+		wantErr    bool
+	}{
+		{
+			name:       "delivered at a time",
+			payload:    `{"trackingId":"FK1","status":"delivered","location":"Quezon City","occurredAt":1767225600}`,
+			sign:       true,
+			wantStatus: TrackingDelivered,
+			wantAt:     time.Unix(1767225600, 0),
+		},
+		{name: "status in any case", payload: `{"trackingId":"FK1","status":"Out_For_Delivery"}`, sign: true, wantStatus: TrackingOutForDelivery},
+		{name: "unknown status", payload: `{"trackingId":"FK1","status":"lost"}`, sign: true, wantErr: true},
+		{name: "unsigned", payload: `{"trackingId":"FK1","status":"delivered"}`, wantSigErr: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signature := ""
+			if tt.sign {
+				signature = courier.SignWebhook([]byte(tt.payload))
+			}
+
+			events, err := courier.ParseWebhook([]byte(tt.payload), signature)
+			if (err != nil) != tt.wantErr || errors.Is(err, ErrInvalidSignature) != tt.wantSigErr {
+				t.Fatalf("ParseWebhook() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(events) != 1 || events[0].TrackingId != "FK1" || events[0].Status != tt.wantStatus {
+				t.Fatalf("ParseWebhook() = %+v, want one %s scan of FK1", events, tt.wantStatus)
+			}
+			if !tt.wantAt.IsZero() && !events[0].OccurredAt.Equal(tt.wantAt) {
+				t.Errorf("OccurredAt = %v, want %v", events[0].OccurredAt, tt.wantAt)
+			}
+			if tt.wantAt.IsZero() && events[0].OccurredAt.IsZero() {
+				t.Errorf("OccurredAt is not set when the push has no time")
+			}
+		})
+	}
+}
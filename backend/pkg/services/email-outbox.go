@@ -0,0 +1,232 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// maxEmailAttempts is how many times an email is tried before it is dead-lettered.
+	maxEmailAttempts = 6
+	// emailBatchSize is how many due emails one worker run claims.
+	emailBatchSize = 20
+	// emailLease holds claimed emails back from other workers while they send.
+	emailLease = 5 * time.Minute
+)
+
+// emailBackoff is the wait before retry number attempts: 1m, 2m, 4m, ... capped at 1h.
+func emailBackoff(attempts int64) time.Duration {
+	backoff := time.Minute
+	for i := int64(1); i < attempts && backoff < time.Hour; i++ {
+		backoff *= 2
+	}
+	if backoff > time.Hour {
+		backoff = time.Hour
+	}
+
+	return backoff
+}
+
+// emailSendUpdate is the outbox columns to save after a send that failed with
+// sendErr, or succeeded when it is nil, of an email tried attempts times
+// before. A failure is retried after emailBackoff, or dead-lettered once
+// maxEmailAttempts is reached.
+func emailSendUpdate(attempts int64, sendErr error, now time.Time) map[string]interface{} {
+	columns := map[string]interface{}{
+		"attempts": attempts + 1,
+	}
+
+	if sendErr == nil {
+		columns["email_status"] = "SNT"
+		columns["sent_at"] = now
+		return columns
+	}
+
+	columns["last_error"] = sendErr.Error()
+	if attempts+1 >= maxEmailAttempts {
+		columns["email_status"] = "DLQ"
+	} else {
+		columns["next_attempt_at"] = now.Add(emailBackoff(attempts + 1))
+	}
+
+	return columns
+}
+
+func emailOutboxToPb(data models.EmailOutboxData) *pb.EmailOutboxData {
+	return &pb.EmailOutboxData{
+		EmailOutboxId: data.GetEmailOutboxId().String(),
+		EmailEvent:    data.EmailEvent,
+		OrderId:       data.GetOrderId(),
+		Recipient:     data.Recipient,
+		Subject:       data.Subject,
+		HtmlBody:      data.HtmlBody,
+		EmailStatus:   data.EmailStatus,
+		Attempts:      data.Attempts,
+		NextAttemptAt: data.NextAttemptAt.Unix(),
+		LastError:     data.LastError,
+		SentAt:        data.GetSentAt(),
+		CreatedBy:     data.CreatedBy.String(),
+		CreatedAt:     data.CreatedAt.Unix(),
+		UpdatedBy:     data.UpdatedBy.String(),
+		UpdatedAt:     data.UpdatedAt.Unix(),
+	}
+}
+
+// queueEmail adds an email to the outbox for the worker to deliver.
+func queueEmail(tx *gorm.DB, event string, orderId *uuid.UUID, to string, subject string, htmlBody string) (models.EmailOutboxData, error) {
+	email := models.EmailOutboxData{
+		EmailEvent:    event,
+		OrderId:       orderId,
+		Recipient:     to,
+		Subject:       subject,
+		HtmlBody:      htmlBody,
+		EmailStatus:   "PEN",
+		NextAttemptAt: time.Now(),
+	}
+	if err := tx.Create(&email).Error; err != nil {
+		log.Printf("Error queueing email: %v", err)
+		return models.EmailOutboxData{}, err
+	}
+
+	return email, nil
+}
+
+// StartEmailWorker runs RunEmailOutbox every interval until ctx is done.
+func (s *ChronexAdminService) StartEmailWorker(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := s.RunEmailOutbox(time.Now()); err != nil {
+				log.Printf("Error running email outbox: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// RunEmailOutbox sends the emails that are due. Claimed emails are leased so
+// a second worker skips them; a failed send is retried with exponential
+// backoff and dead-lettered after maxEmailAttempts.
+func (s *ChronexAdminService) RunEmailOutbox(now time.Time) error {
+	if s.Mailer == nil {
+		return nil
+	}
+
+	var due []models.EmailOutboxData
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("email_status = ? AND next_attempt_at <= ?", "PEN", now).
+			Order("next_attempt_at ASC").
+			Limit(emailBatchSize).
+			Find(&due).Error; err != nil {
+			return err
+		}
+		if len(due) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, 0, len(due))
+		for _, email := range due {
+			ids = append(ids, email.EmailOutboxId)
+		}
+
+		return tx.Model(&models.EmailOutboxData{}).
+			Where("email_outbox_id IN ?", ids).
+			Update("next_attempt_at", now.Add(emailLease)).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, email := range due {
+		err := s.Mailer.Send(email.Recipient, email.Subject, email.HtmlBody)
+		if err != nil {
+			log.Printf("Error sending email %s to %s: %v", email.EmailOutboxId, email.Recipient, err)
+		}
+
+		columns := emailSendUpdate(email.Attempts, err, time.Now())
+		if err := s.DB.Model(&email).Updates(columns).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ChronexAdminService) GetAllEmailOutbox(ctx context.Context, req *pb.GetAllEmailOutboxRequest) (*pb.GetAllEmailOutboxResponse, error) {
+	response := &pb.GetAllEmailOutboxResponse{
+		EmailOutboxData: []*pb.EmailOutboxData{},
+	}
+
+	// Build your query based on the request parameters
+	query := s.DB.Model(&models.EmailOutboxData{}).Order("created_at DESC")
+
+	// Handle searching
+	if req.Search != "" {
+		searchParam := "%" + req.Search + "%"
+		query = query.Where("(recipient ILIKE ? OR subject ILIKE ?)", searchParam, searchParam)
+	}
+
+	// Filter by status and order
+	if req.EmailStatus != "" {
+		query = query.Where("email_status = ?", req.EmailStatus)
+	}
+	if req.OrderId != "" {
+		query = query.Where("order_id = ?", req.OrderId)
+	}
+
+	// Execute the query
+	var emailOutboxDataValue []models.EmailOutboxData
+	if err := query.Limit(500).Find(&emailOutboxDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch email outbox data: %v", err))
+	}
+
+	// Map the retrieved data to protobuf message
+	for _, data := range emailOutboxDataValue {
+		response.EmailOutboxData = append(response.EmailOutboxData, emailOutboxToPb(data))
+	}
+
+	return response, nil
+}
+
+// ResendEmailOutbox puts an email back in the queue with a fresh set of
+// attempts so the worker sends it on its next run.
+func (s *ChronexAdminService) ResendEmailOutbox(ctx context.Context, req *pb.ResendEmailOutboxRequest) (*pb.ResendEmailOutboxResponse, error) {
+	// Retrieve existing EmailOutboxData from the database
+	var existingEmailOutboxData models.EmailOutboxData
+	if err := s.DB.First(&existingEmailOutboxData, "email_outbox_id = ?", req.GetEmailOutboxId()).Error; err != nil {
+		log.Printf("Error retrieving Email Outbox data: %v", err)
+		return nil, err
+	}
+
+	existingEmailOutboxData.EmailStatus = "PEN"
+	existingEmailOutboxData.Attempts = 0
+	existingEmailOutboxData.NextAttemptAt = time.Now()
+
+	// Save the updated data back to the database using GORM
+	if err := s.DB.Save(&existingEmailOutboxData).Error; err != nil {
+		log.Printf("Error updating Email Outbox data: %v", err)
+		return nil, err
+	}
+
+	return &pb.ResendEmailOutboxResponse{
+		EmailOutboxData: emailOutboxToPb(existingEmailOutboxData),
+	}, nil
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEmailBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int64
+		want     time.Duration
+	}{
+		{attempts: 0, want: time.Minute},
+		{attempts: 1, want: time.Minute},
+		{attempts: 2, want: 2 * time.Minute},
+		{attempts: 3, want: 4 * time.Minute},
+		{attempts: 6, want: 32 * time.Minute},
+		{attempts: 7, want: time.Hour},
+		{attempts: 100, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := emailBackoff(tt.attempts); got != tt.want {
+			t.Errorf("emailBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestEmailSendUpdate(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	failed := errors.New("connection refused")
+
+	tests := []struct {
+		name        string
+		attempts    int64
+		sendErr     error
+		wantStatus  string
+		wantRetryAt time.Time
+	}{
+		{name: "sent", attempts: 2, wantStatus: "SNT"},
+		{name: "first failure retried in a minute", attempts: 0, sendErr: failed, wantRetryAt: now.Add(time.Minute)},
+		{name: "third failure retried in four minutes", attempts: 2, sendErr: failed, wantRetryAt: now.Add(4 * time.Minute)},
+		{name: "fifth failure still retried", attempts: maxEmailAttempts - 2, sendErr: failed, wantRetryAt: now.Add(16 * time.Minute)},
+		{name: "sixth failure dead-lettered", attempts: maxEmailAttempts - 1, sendErr: failed, wantStatus: "DLQ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columns := emailSendUpdate(tt.attempts, tt.sendErr, now)
+
+			if columns["attempts"] != tt.attempts+1 {
+				t.Errorf("attempts = %v, want %d", columns["attempts"], tt.attempts+1)
+			}
+			if got, _ := columns["email_status"].(string); got != tt.wantStatus {
+				t.Errorf("email_status = %q, want %q", got, tt.wantStatus)
+			}
+			if got, _ := columns["next_attempt_at"].(time.Time); !got.Equal(tt.wantRetryAt) {
+				t.Errorf("next_attempt_at = %v, want %v", got, tt.wantRetryAt)
+			}
+			if tt.sendErr != nil && columns["last_error"] != tt.sendErr.Error() {
+				t.Errorf("last_error = %v, want %q", columns["last_error"], tt.sendErr.Error())
+			}
+			if tt.sendErr == nil && columns["sent_at"] != now {
+				t.Errorf("sent_at = %v, want %v", columns["sent_at"], now)
+			}
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// cartLine is one entry of the order `product` JSON array sent by the storefront.
+type cartLine struct {
+	ProductID       string  `json:"productId"`
+	ProductName     string  `json:"productName"`
+	Quantity        int     `json:"quantity"`
+	DiscountedPrice float64 `json:"discountedPrice"`
+	Freebies        string  `json:"freebies"`
+}
+
+// parseCart decodes the order `product` JSON. Every line must name a product
+// by id and buy at least one of it, since a zero or negative quantity would
+// take money off the total.
+func parseCart(product string) ([]cartLine, error) {
+	var lines []cartLine
+	if strings.TrimSpace(product) == "" {
+		return lines, nil
+	}
+	if err := json.Unmarshal([]byte(product), &lines); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid product data: %v", err))
+	}
+
+	for _, line := range lines {
+		if _, err := uuid.Parse(line.ProductID); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid product id %q", line.ProductID))
+		}
+		if line.Quantity <= 0 {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid quantity %d for product %s", line.Quantity, line.ProductID))
+		}
+	}
+
+	return lines, nil
+}
+
+// priceCart loads the products referenced by the cart and totals it using the
+// stored prices rather than the ones sent by the storefront. Running price
+// rules are applied, so the returned products carry their effective price in
+// DiscountedPrice.
+func priceCart(db *gorm.DB, lines []cartLine) (map[string]models.ProductData, float64, error) {
+	productIds := make([]string, 0, len(lines))
+	for _, line := range lines {
+		productIds = append(productIds, line.ProductID)
+	}
+
+	var products []models.ProductData
+	if len(productIds) > 0 {
+		if err := db.Where("product_id IN ?", productIds).Find(&products).Error; err != nil {
+			return nil, 0, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch product data: %v", err))
+		}
+	}
+	now := time.Now()
+	priceRules, err := loadActivePriceRules(db, now)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	productById := make(map[string]models.ProductData, len(products))
+	for _, product := range products {
+		product.DiscountedPrice, _ = models.EffectivePrice(product, priceRules, now)
+		productById[product.ProductId.String()] = product
+	}
+
+	var subtotal float64
+	for _, line := range lines {
+		product, ok := productById[line.ProductID]
+		if !ok {
+			return nil, 0, status.Error(codes.NotFound, fmt.Sprintf("Product with ID %s not found", line.ProductID))
+		}
+		subtotal += product.DiscountedPrice * float64(line.Quantity)
+	}
+
+	return productById, subtotal, nil
+}
+
+// pricedCart re-encodes the order `product` JSON with the catalogue name and
+// effective price of each line from priceCart, so invoices, emails and refund
+// caps read the prices the total was worked out from. Other keys the
+// storefront sent on a line are kept.
+func pricedCart(product string, lines []cartLine, productById map[string]models.ProductData) (json.RawMessage, error) {
+	if strings.TrimSpace(product) == "" {
+		return nil, nil
+	}
+
+	var encoded []map[string]interface{}
+	if err := json.Unmarshal([]byte(product), &encoded); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid product data: %v", err))
+	}
+	for i, line := range lines {
+		catalogued := productById[line.ProductID]
+		encoded[i]["productName"] = catalogued.ProductName
+		encoded[i]["discountedPrice"] = catalogued.DiscountedPrice
+	}
+
+	priced, err := json.Marshal(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(priced), nil
+}
+
+// scopedCartTotals returns the spend and quantity of the cart lines that fall
+// inside a category/product scope, and whether any line did. An empty scope
+// covers the whole cart.
+func scopedCartTotals(lines []cartLine, productById map[string]models.ProductData, category string, productId string) (float64, int64, bool) {
+	var spend float64
+	var quantity int64
+	var inScope bool
+	for _, line := range lines {
+		product := productById[line.ProductID]
+		if category != "" && !strings.EqualFold(category, product.Category) {
+			continue
+		}
+		if productId != "" && productId != line.ProductID {
+			continue
+		}
+		inScope = true
+		spend += product.DiscountedPrice * float64(line.Quantity)
+		quantity += int64(line.Quantity)
+	}
+
+	return spend, quantity, inScope
+}
+
+// parseCustomerEmail returns the normalized email address from the order
+// `customer` JSON, or "" when it is missing.
+func parseCustomerEmail(customer string) string {
+	var c struct {
+		EmailAddress string `json:"emailAddress"`
+	}
+	if err := json.Unmarshal([]byte(customer), &c); err != nil {
+		return ""
+	}
+
+	return normalizeEmail(c.EmailAddress)
+}
+
+// ruleMatchesCart reports whether a rule is running at now and the cart meets
+// its scope, minimum spend and minimum quantity. The per-customer limit needs
+// the customer's past orders and is checked by the caller.
+func ruleMatchesCart(rule models.FreebiesRuleData, lines []cartLine, productById map[string]models.ProductData, now time.Time) bool {
+	if !rule.ActiveAt(now) {
+		return false
+	}
+
+	spend, quantity, inScope := scopedCartTotals(lines, productById, rule.Category, rule.ProductId)
+	if (rule.Category != "" || rule.ProductId != "") && !inScope {
+		return false
+	}
+	if rule.MinSpend > 0 && spend < rule.MinSpend {
+		return false
+	}
+	if rule.MinQuantity > 0 && quantity < rule.MinQuantity {
+		return false
+	}
+
+	return true
+}
+
+// grantRuleFreebie picks the first of candidates, the rule's primary freebie
+// then its alternate, with stock for the rule quantity beyond what earlier
+// rules reserved, and reserves it. Empty candidates stand for freebies that
+// are missing or inactive. It returns nil when no candidate can be given.
+func grantRuleFreebie(rule models.FreebiesRuleData, candidates []models.FreebiesData, reserved map[uuid.UUID]int64) *pb.CartFreebies {
+	giftQuantity := rule.FreebiesQuantity
+	if giftQuantity <= 0 {
+		giftQuantity = 1
+	}
+
+	for i, freebie := range candidates {
+		if freebie.FreebiesId == uuid.Nil {
+			continue
+		}
+		if freebie.FreebiesCurrentQuantity-float64(reserved[freebie.FreebiesId]) < float64(giftQuantity) {
+			continue
+		}
+
+		reserved[freebie.FreebiesId] += giftQuantity
+		return &pb.CartFreebies{
+			FreebiesRuleId: rule.FreebiesRuleId.String(),
+			RuleName:       rule.RuleName,
+			FreebiesId:     freebie.FreebiesId.String(),
+			FreebiesName:   freebie.FreebiesName,
+			Quantity:       giftQuantity,
+			Alternate:      i > 0,
+		}
+	}
+
+	return nil
+}
+
+// evaluateFreebiesRules returns the freebies every matching rule grants to a
+// cart priced by priceCart, in priority order. Stock is checked against the
+// freebies table; when the primary freebie cannot cover the rule quantity the
+// alternate is used, and when neither can the rule is skipped. With lock set
+// a rule with a per-customer limit is locked for the rest of the transaction
+// before its allocations are counted, so concurrent orders from the same
+// customer cannot both get the last allowed gift.
+func evaluateFreebiesRules(db *gorm.DB, lines []cartLine, productById map[string]models.ProductData, customerEmail string, now time.Time, lock bool) ([]*pb.CartFreebies, error) {
+	cartFreebies := []*pb.CartFreebies{}
+
+	var rules []models.FreebiesRuleData
+	if err := db.Where("rule_status = ?", "ACT").Order("priority DESC, created_at ASC").Find(&rules).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch freebies rule data: %v", err))
+	}
+
+	// Stock already promised to earlier rules in this same cart
+	reserved := make(map[uuid.UUID]int64)
+
+	for _, rule := range rules {
+		if !ruleMatchesCart(rule, lines, productById, now) {
+			continue
+		}
+
+		if rule.PerCustomerLimit > 0 && customerEmail != "" {
+			if lock {
+				if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("freebies_rule_id = ?", rule.FreebiesRuleId).Take(&models.FreebiesRuleData{}).Error; err != nil {
+					return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to lock freebies rule: %v", err))
+				}
+			}
+
+			var used int64
+			if err := db.Model(&models.FreebiesAllocationData{}).
+				Joins("JOIN chronex_product_order ON chronex_product_order.order_id = chronex_freebies_allocation.order_id").
+				Where("chronex_freebies_allocation.freebies_rule_id = ? AND chronex_freebies_allocation.customer_email = ?", rule.FreebiesRuleId, customerEmail).
+				Where("chronex_product_order.order_status != ? AND chronex_product_order.deleted_at IS NULL", "CAN").
+				Count(&used).Error; err != nil {
+				return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to count freebies allocation: %v", err))
+			}
+			if used >= rule.PerCustomerLimit {
+				continue
+			}
+		}
+
+		candidateIds := []uuid.UUID{rule.FreebiesId}
+		if rule.AlternateFreebiesId != nil {
+			candidateIds = append(candidateIds, *rule.AlternateFreebiesId)
+		}
+
+		// Missing or inactive freebies stay in the list as empty rows
+		candidates := make([]models.FreebiesData, len(candidateIds))
+		for i, freebiesId := range candidateIds {
+			if err := db.Where("freebies_id = ? AND freebies_status = ?", freebiesId, "ACT").Limit(1).Find(&candidates[i]).Error; err != nil {
+				return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch freebies data: %v", err))
+			}
+		}
+
+		cartFreebie := grantRuleFreebie(rule, candidates, reserved)
+		if cartFreebie == nil {
+			continue
+		}
+		cartFreebies = append(cartFreebies, cartFreebie)
+
+		if rule.Exclusive {
+			break
+		}
+	}
+
+	return cartFreebies, nil
+}
+
+// adjustRuleFreebiesStock moves the stock of the rule-granted freebies stored on
+// an order by sign (-1 to deduct, +1 to restore).
+func adjustRuleFreebiesStock(db *gorm.DB, freebies json.RawMessage, sign float64) error {
+	if len(freebies) == 0 {
+		return nil
+	}
+
+	var cartFreebies []*pb.CartFreebies
+	if err := json.Unmarshal(freebies, &cartFreebies); err != nil {
+		return err
+	}
+
+	for _, cartFreebie := range cartFreebies {
+		if err := db.Model(&models.FreebiesData{}).
+			Where("freebies_id = ?", cartFreebie.FreebiesId).
+			Updates(map[string]interface{}{
+				"freebies_current_quantity": gorm.Expr("freebies_current_quantity + ?", sign*float64(cartFreebie.Quantity)),
+				"version":                   gorm.Expr("version + 1"),
+			}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
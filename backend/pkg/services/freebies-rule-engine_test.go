@@ -0,0 +1,214 @@
+package services
+
+import (
+	"api/pkg/models"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	watchId = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	strapId = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+)
+
+// testProducts is a cart catalogue of a watch and a strap, priced after sales.
+var testProducts = map[string]models.ProductData{
+	watchId.String(): {ProductId: watchId, Category: "Watches", DiscountedPrice: 1500},
+	strapId.String(): {ProductId: strapId, Category: "Straps", DiscountedPrice: 250},
+}
+
+func TestParseCart(t *testing.T) {
+	tests := []struct {
+		name    string
+		product string
+		want    int
+		code    codes.Code
+	}{
+		{name: "empty cart", product: "  ", want: 0},
+		{name: "two lines", product: `[{"productId":"` + watchId.String() + `","quantity":1},{"productId":"` + strapId.String() + `","quantity":2}]`, want: 2},
+		{name: "not JSON", product: `{"productId"`, code: codes.InvalidArgument},
+		{name: "missing product id", product: `[{"quantity":1}]`, code: codes.InvalidArgument},
+		{name: "invalid product id", product: `[{"productId":"watch","quantity":1}]`, code: codes.InvalidArgument},
+		{name: "zero quantity", product: `[{"productId":"` + watchId.String() + `","quantity":0}]`, code: codes.InvalidArgument},
+		{name: "negative quantity", product: `[{"productId":"` + watchId.String() + `","quantity":-3}]`, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := parseCart(tt.product)
+			if status.Code(err) != tt.code {
+				t.Fatalf("parseCart(%q) error = %v, want code %v", tt.product, err, tt.code)
+			}
+			if len(lines) != tt.want {
+				t.Errorf("parseCart(%q) = %d lines, want %d", tt.product, len(lines), tt.want)
+			}
+		})
+	}
+}
+
+func TestScopedCartTotals(t *testing.T) {
+	lines := []cartLine{
+		{ProductID: watchId.String(), Quantity: 1},
+		{ProductID: strapId.String(), Quantity: 3},
+	}
+
+	tests := []struct {
+		name         string
+		category     string
+		productId    string
+		wantSpend    float64
+		wantQuantity int64
+		wantInScope  bool
+	}{
+		{name: "whole cart", wantSpend: 2250, wantQuantity: 4, wantInScope: true},
+		{name: "category, any case", category: "straps", wantSpend: 750, wantQuantity: 3, wantInScope: true},
+		{name: "product", productId: watchId.String(), wantSpend: 1500, wantQuantity: 1, wantInScope: true},
+		{name: "category and product disagree", category: "Straps", productId: watchId.String()},
+		{name: "category not in cart", category: "Boxes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spend, quantity, inScope := scopedCartTotals(lines, testProducts, tt.category, tt.productId)
+			if spend != tt.wantSpend || quantity != tt.wantQuantity || inScope != tt.wantInScope {
+				t.Errorf("scopedCartTotals() = %v, %v, %v, want %v, %v, %v",
+					spend, quantity, inScope, tt.wantSpend, tt.wantQuantity, tt.wantInScope)
+			}
+		})
+	}
+}
+
+func TestRuleMatchesCart(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	yesterday := now.AddDate(0, 0, -1)
+	tomorrow := now.AddDate(0, 0, 1)
+	lines := []cartLine{
+		{ProductID: watchId.String(), Quantity: 1},
+		{ProductID: strapId.String(), Quantity: 2},
+	}
+
+	tests := []struct {
+		name string
+		rule models.FreebiesRuleData
+		want bool
+	}{
+		{name: "no conditions", rule: models.FreebiesRuleData{RuleStatus: "ACT"}, want: true},
+		{name: "inactive", rule: models.FreebiesRuleData{RuleStatus: "INA"}, want: false},
+		{name: "not started", rule: models.FreebiesRuleData{RuleStatus: "ACT", StartAt: &tomorrow}, want: false},
+		{name: "ended", rule: models.FreebiesRuleData{RuleStatus: "ACT", EndAt: &yesterday}, want: false},
+		{name: "inside window", rule: models.FreebiesRuleData{RuleStatus: "ACT", StartAt: &yesterday, EndAt: &tomorrow}, want: true},
+		{name: "min spend met", rule: models.FreebiesRuleData{RuleStatus: "ACT", MinSpend: 2000}, want: true},
+		{name: "min spend missed", rule: models.FreebiesRuleData{RuleStatus: "ACT", MinSpend: 2001}, want: false},
+		{name: "min spend counts only the scope", rule: models.FreebiesRuleData{RuleStatus: "ACT", Category: "Straps", MinSpend: 1000}, want: false},
+		{name: "min quantity met in scope", rule: models.FreebiesRuleData{RuleStatus: "ACT", Category: "Straps", MinQuantity: 2}, want: true},
+		{name: "min quantity missed", rule: models.FreebiesRuleData{RuleStatus: "ACT", MinQuantity: 4}, want: false},
+		{name: "scope not in cart", rule: models.FreebiesRuleData{RuleStatus: "ACT", Category: "Boxes"}, want: false},
+		{name: "product scope", rule: models.FreebiesRuleData{RuleStatus: "ACT", ProductId: watchId.String(), MinSpend: 1500}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ruleMatchesCart(tt.rule, lines, testProducts, now); got != tt.want {
+				t.Errorf("ruleMatchesCart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrantRuleFreebie(t *testing.T) {
+	pouchId := uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	clothId := uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+	pouch := models.FreebiesData{FreebiesId: pouchId, FreebiesName: "Pouch", FreebiesCurrentQuantity: 2}
+	cloth := models.FreebiesData{FreebiesId: clothId, FreebiesName: "Cloth", FreebiesCurrentQuantity: 5}
+
+	tests := []struct {
+		name          string
+		quantity      int64
+		candidates    []models.FreebiesData
+		reserved      map[uuid.UUID]int64
+		wantName      string
+		wantQuantity  int64
+		wantAlternate bool
+	}{
+		{name: "primary in stock", quantity: 2, candidates: []models.FreebiesData{pouch, cloth}, wantName: "Pouch", wantQuantity: 2},
+		{name: "quantity defaults to one", quantity: 0, candidates: []models.FreebiesData{pouch}, wantName: "Pouch", wantQuantity: 1},
+		{name: "primary short, alternate used", quantity: 3, candidates: []models.FreebiesData{pouch, cloth}, wantName: "Cloth", wantQuantity: 3, wantAlternate: true},
+		{name: "primary reserved by an earlier rule", quantity: 1, candidates: []models.FreebiesData{pouch, cloth}, reserved: map[uuid.UUID]int64{pouchId: 2}, wantName: "Cloth", wantQuantity: 1, wantAlternate: true},
+		{name: "primary missing", quantity: 1, candidates: []models.FreebiesData{{}, cloth}, wantName: "Cloth", wantQuantity: 1, wantAlternate: true},
+		{name: "nothing in stock", quantity: 6, candidates: []models.FreebiesData{pouch, cloth}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reserved := map[uuid.UUID]int64{}
+			for id, quantity := range tt.reserved {
+				reserved[id] = quantity
+			}
+
+			rule := models.FreebiesRuleData{RuleName: "Gift", FreebiesQuantity: tt.quantity}
+			got := grantRuleFreebie(rule, tt.candidates, reserved)
+			if tt.wantName == "" {
+				if got != nil {
+					t.Fatalf("grantRuleFreebie() = %v, want nothing", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("grantRuleFreebie() = nil, want %s", tt.wantName)
+			}
+			if got.FreebiesName != tt.wantName || got.Quantity != tt.wantQuantity || got.Alternate != tt.wantAlternate {
+				t.Errorf("grantRuleFreebie() = %s x%d (alternate %v), want %s x%d (alternate %v)",
+					got.FreebiesName, got.Quantity, got.Alternate, tt.wantName, tt.wantQuantity, tt.wantAlternate)
+			}
+			if id := uuid.MustParse(got.FreebiesId); reserved[id] != tt.reserved[id]+tt.wantQuantity {
+				t.Errorf("reserved %d of %s, want %d", reserved[id], got.FreebiesName, tt.reserved[id]+tt.wantQuantity)
+			}
+		})
+	}
+}
+
+func TestPricedCart(t *testing.T) {
+	products := map[string]models.ProductData{
+		watchId.String(): {ProductId: watchId, ProductName: "Diver 200m", DiscountedPrice: 1350},
+		strapId.String(): {ProductId: strapId, ProductName: "Leather strap", DiscountedPrice: 250},
+	}
+
+	tests := []struct {
+		name    string
+		product string
+		want    string
+	}{
+		{name: "empty cart", product: "", want: ""},
+		{
+			name:    "client prices and names replaced",
+			product: `[{"productId":"` + watchId.String() + `","productName":"Cheap watch","quantity":1,"discountedPrice":1}]`,
+			want:    `[{"discountedPrice":1350,"productId":"` + watchId.String() + `","productName":"Diver 200m","quantity":1}]`,
+		},
+		{
+			name:    "other keys kept",
+			product: `[{"productId":"` + strapId.String() + `","quantity":2,"img":"strap.jpg","freebies":"pouch"}]`,
+			want:    `[{"discountedPrice":250,"freebies":"pouch","img":"strap.jpg","productId":"` + strapId.String() + `","productName":"Leather strap","quantity":2}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := parseCart(tt.product)
+			if err != nil {
+				t.Fatalf("parseCart() error = %v", err)
+			}
+
+			got, err := pricedCart(tt.product, lines, products)
+			if err != nil {
+				t.Fatalf("pricedCart() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("pricedCart() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
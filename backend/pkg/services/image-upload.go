@@ -0,0 +1,329 @@
+package services
+
+import (
+	"api/pkg/pb"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxImageBytes caps one uploaded image.
+	maxImageBytes = 5 << 20
+	// maxImagePixels caps the decoded size of an image, so a small file
+	// cannot expand into gigabytes of pixels.
+	maxImagePixels = 40_000_000
+)
+
+// imageKinds are what images are uploaded for. The kind is the first part of
+// every key.
+var imageKinds = map[string]bool{"product": true, "freebies": true, "home": true}
+
+// imageExtensions are the accepted image types, sniffed from the bytes rather
+// than trusted from the file name.
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+	"image/gif":  ".gif",
+}
+
+// thumbnailSizes are the longest sides, in pixels, of the thumbnails made of
+// every upload. Images smaller than a size keep their own size.
+var thumbnailSizes = []int{160, 480, 1024}
+
+// imageURL is where the storefront loads the image stored under key. Images
+// hosted elsewhere are referenced by their full link and returned as is.
+func (s *ChronexAdminService) imageURL(key string) string {
+	if key == "" || strings.HasPrefix(key, "http://") || strings.HasPrefix(key, "https://") {
+		return key
+	}
+	if s.Blobs == nil {
+		return ""
+	}
+	return s.Blobs.URL(key)
+}
+
+// thumbnail scales img down to fit in a size by size square.
+func thumbnail(img image.Image, size int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= size && height <= size {
+		return img
+	}
+	if width >= height {
+		width, height = size, height*size/width
+	} else {
+		width, height = width*size/height, size
+	}
+	if width < 1 {
+		width = 1
+	}
+	if height < 1 {
+		height = 1
+	}
+
+	scaled := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(scaled, scaled.Bounds(), img, bounds, draw.Over, nil)
+	return scaled
+}
+
+func decodeImage(data []byte, contentType string) (image.Image, error) {
+	reader := bytes.NewReader(data)
+	switch contentType {
+	case "image/jpeg":
+		return jpeg.Decode(reader)
+	case "image/png":
+		return png.Decode(reader)
+	case "image/webp":
+		return webp.Decode(reader)
+	case "image/gif":
+		return gif.Decode(reader)
+	}
+	return nil, fmt.Errorf("unsupported image type %s", contentType)
+}
+
+func decodeImageConfig(data []byte, contentType string) (image.Config, error) {
+	reader := bytes.NewReader(data)
+	switch contentType {
+	case "image/jpeg":
+		return jpeg.DecodeConfig(reader)
+	case "image/png":
+		return png.DecodeConfig(reader)
+	case "image/webp":
+		return webp.DecodeConfig(reader)
+	case "image/gif":
+		return gif.DecodeConfig(reader)
+	}
+	return image.Config{}, fmt.Errorf("unsupported image type %s", contentType)
+}
+
+// storeImage validates an image, stores it under "<kind>/<id>/original.<ext>"
+// and stores a thumbnail per thumbnailSizes next to it. Thumbnails of JPEG
+// images are JPEG; the rest are PNG to keep transparency.
+func (s *ChronexAdminService) storeImage(ctx context.Context, kind string, data []byte) (*pb.UploadedImage, error) {
+	if s.Blobs == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Image storage is not configured")
+	}
+	if !imageKinds[kind] {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid image kind %s", kind))
+	}
+	if len(data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "The uploaded image is empty")
+	}
+	if len(data) > maxImageBytes {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Images can be at most %d MB", maxImageBytes>>20))
+	}
+
+	contentType := http.DetectContentType(data)
+	extension, ok := imageExtensions[contentType]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Upload a JPEG, PNG, WebP or GIF image, not %s", contentType))
+	}
+
+	// Check the dimensions before decoding the pixels
+	config, err := decodeImageConfig(data, contentType)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid image: %v", err))
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("The image is %dx%d; images can have at most %d megapixels", config.Width, config.Height, maxImagePixels/1_000_000))
+	}
+	img, err := decodeImage(data, contentType)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid image: %v", err))
+	}
+
+	prefix := kind + "/" + uuid.New().String() + "/"
+	uploaded := &pb.UploadedImage{
+		Key:         prefix + "original" + extension,
+		ContentType: contentType,
+		Width:       int32(config.Width),
+		Height:      int32(config.Height),
+		Size:        int64(len(data)),
+	}
+
+	var stored []string
+	put := func(key string, data []byte, contentType string) error {
+		if err := s.Blobs.Put(ctx, key, data, contentType); err != nil {
+			return err
+		}
+		stored = append(stored, key)
+		return nil
+	}
+	err = put(uploaded.Key, data, contentType)
+	for _, size := range thumbnailSizes {
+		if err != nil {
+			break
+		}
+
+		scaled := thumbnail(img, size)
+		var out bytes.Buffer
+		key, thumbnailType := fmt.Sprintf("%s%d.png", prefix, size), "image/png"
+		if contentType == "image/jpeg" {
+			key, thumbnailType = fmt.Sprintf("%s%d.jpg", prefix, size), "image/jpeg"
+			err = jpeg.Encode(&out, scaled, &jpeg.Options{Quality: 85})
+		} else {
+			err = png.Encode(&out, scaled)
+		}
+		if err != nil {
+			break
+		}
+		if err = put(key, out.Bytes(), thumbnailType); err != nil {
+			break
+		}
+
+		uploaded.Thumbnails = append(uploaded.Thumbnails, &pb.ImageThumbnail{
+			Size:   int32(size),
+			Key:    key,
+			Url:    s.Blobs.URL(key),
+			Width:  int32(scaled.Bounds().Dx()),
+			Height: int32(scaled.Bounds().Dy()),
+		})
+	}
+	if err != nil {
+		// Leave nothing half uploaded behind
+		for _, key := range stored {
+			if err := s.Blobs.Delete(ctx, key); err != nil {
+				log.Printf("Error deleting blob %s: %v", key, err)
+			}
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to store image: %v", err))
+	}
+
+	uploaded.Url = s.Blobs.URL(uploaded.Key)
+	return uploaded, nil
+}
+
+// UploadImage stores an image for a product, freebies or home banner and
+// returns its key, URL and thumbnails. Products and home banners list the
+// URLs in their image lists; freebies reference the key.
+func (s *ChronexAdminService) UploadImage(ctx context.Context, req *pb.UploadImageRequest) (*pb.UploadImageResponse, error) {
+	uploaded, err := s.storeImage(ctx, strings.ToLower(strings.TrimSpace(req.Kind)), req.File)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UploadImageResponse{Image: uploaded}, nil
+}
+
+// GetImage returns a stored blob, for serving a LocalBlobStore over HTTP.
+func (s *ChronexAdminService) GetImage(ctx context.Context, key string) ([]byte, string, error) {
+	if s.Blobs == nil {
+		return nil, "", ErrBlobNotFound
+	}
+	return s.Blobs.Get(ctx, key)
+}
+
+// legacyImageBytes returns the image in a freebiesImg value from before
+// freebies images moved to the BlobStore: raw bytes, or a base64 data URL as
+// the admin used to send.
+func legacyImageBytes(value []byte) []byte {
+	text := strings.TrimSpace(string(value))
+	if strings.HasPrefix(text, "data:") {
+		if comma := strings.Index(text, ","); comma >= 0 {
+			if decoded, err := base64.StdEncoding.DecodeString(text[comma+1:]); err == nil {
+				return decoded
+			}
+		}
+	}
+	return value
+}
+
+// storeFreebiesImage turns a freebiesImg value into an image key: a link is
+// kept as it is, anything else is stored as an uploaded image.
+func (s *ChronexAdminService) storeFreebiesImage(ctx context.Context, value []byte) (string, error) {
+	if link := imageLink(string(value)); link != "" {
+		return link, nil
+	}
+
+	uploaded, err := s.storeImage(ctx, "freebies", legacyImageBytes(value))
+	if err != nil {
+		return "", err
+	}
+	return uploaded.Key, nil
+}
+
+// imageKeyOf maps the URL of a stored image back to its key. Links to images
+// hosted elsewhere are returned as they are.
+func (s *ChronexAdminService) imageKeyOf(link string) string {
+	if s.Blobs != nil {
+		if base := s.Blobs.URL(""); base != "" && strings.HasPrefix(link, base) {
+			return strings.TrimPrefix(link, base)
+		}
+	}
+	return link
+}
+
+// freebiesImageKey returns the image key a freebies is saved with: the key of
+// an uploaded image or a link, or, from clients that still send the image
+// itself, the key it is stored under.
+func (s *ChronexAdminService) freebiesImageKey(ctx context.Context, key string, image []byte) (string, error) {
+	if len(image) > 0 {
+		return s.storeFreebiesImage(ctx, image)
+	}
+
+	key = strings.TrimSpace(key)
+	if key == "" || imageLink(key) != "" {
+		return key, nil
+	}
+	if err := checkBlobKey(key); err != nil || !strings.HasPrefix(key, "freebies/") {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid freebies image key %s", key))
+	}
+	return key, nil
+}
+
+// MigrateFreebiesImages moves freebies images still kept in the database into
+// the BlobStore and references them by key. A freebies whose image cannot be
+// stored is logged and left for the next run.
+func (s *ChronexAdminService) MigrateFreebiesImages(ctx context.Context) (int, error) {
+	if s.Blobs == nil {
+		return 0, nil
+	}
+
+	var pending []string
+	if err := s.DB.Table("chronex_product_freebies").
+		Where("freebies_img IS NOT NULL AND length(freebies_img) > 0").
+		Pluck("freebies_id", &pending).Error; err != nil {
+		return 0, err
+	}
+
+	migrated := 0
+	for _, freebiesId := range pending {
+		// Load one image at a time; they are what made the table heavy
+		var image []byte
+		if err := s.DB.Table("chronex_product_freebies").Select("freebies_img").
+			Where("freebies_id = ?", freebiesId).Row().Scan(&image); err != nil {
+			return migrated, err
+		}
+
+		key, err := s.storeFreebiesImage(ctx, image)
+		if err != nil {
+			log.Printf("Error migrating image of Freebies %s: %v", freebiesId, err)
+			continue
+		}
+
+		// Leave the version alone; nothing an admin edits has changed
+		if err := s.DB.Exec("UPDATE chronex_product_freebies SET freebies_img_key = ?, freebies_img = NULL WHERE freebies_id = ?",
+			key, freebiesId).Error; err != nil {
+			return migrated, err
+		}
+		migrated++
+	}
+
+	return migrated, nil
+}
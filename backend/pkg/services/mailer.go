@@ -0,0 +1,81 @@
+package services
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"gopkg.in/mail.v2"
+)
+
+// Mailer delivers a rendered HTML email.
+type Mailer interface {
+	Send(to string, subject string, htmlBody string) error
+}
+
+// SMTPMailer sends email through an authenticated SMTP server. TLSMode is
+// "starttls" (the default, STARTTLS required), "ssl" (implicit TLS, usually
+// port 465) or "none" (plain text, for local relays only).
+type SMTPMailer struct {
+	Host               string
+	Port               int
+	Username           string
+	Password           string
+	From               string
+	TLSMode            string
+	InsecureSkipVerify bool
+}
+
+func (m SMTPMailer) Send(to string, subject string, htmlBody string) error {
+	message := mail.NewMessage()
+	message.SetHeader("From", m.From)
+	message.SetHeader("To", to)
+	message.SetHeader("Subject", subject)
+	message.SetBody("text/html", htmlBody)
+
+	dialer := mail.NewDialer(m.Host, m.Port, m.Username, m.Password)
+	dialer.TLSConfig = &tls.Config{ServerName: m.Host, InsecureSkipVerify: m.InsecureSkipVerify}
+	switch m.TLSMode {
+	case "ssl":
+		dialer.SSL = true
+	case "none":
+		dialer.SSL = false
+		dialer.StartTLSPolicy = mail.NoStartTLS
+	default:
+		dialer.SSL = false
+		dialer.StartTLSPolicy = mail.MandatoryStartTLS
+	}
+
+	return dialer.DialAndSend(message)
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._@-]+`)
+
+// FileMailer writes every email to Dir as an .html file instead of sending
+// it, for local testing.
+type FileMailer struct {
+	Dir string
+}
+
+func (m FileMailer) Send(to string, subject string, htmlBody string) error {
+	if err := os.MkdirAll(m.Dir, 0o755); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s.html", time.Now().Format("20060102-150405.000000000"), unsafeFileChars.ReplaceAllString(to, "_"))
+	content := fmt.Sprintf("<!-- To: %s -->\n<!-- Subject: %s -->\n%s", to, subject, htmlBody)
+
+	return os.WriteFile(filepath.Join(m.Dir, name), []byte(content), 0o644)
+}
+
+// LogMailer logs the recipient and subject of every email instead of sending it.
+type LogMailer struct{}
+
+func (LogMailer) Send(to string, subject string, htmlBody string) error {
+	log.Printf("Email to %s: %s (%d bytes)", to, subject, len(htmlBody))
+	return nil
+}
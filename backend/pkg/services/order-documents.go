@@ -0,0 +1,537 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/boombuler/barcode/code128"
+	"github.com/jung-kurt/gofpdf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// Printable order documents.
+const (
+	OrderDocumentInvoice     = "INVOICE"
+	OrderDocumentPackingSlip = "PACKING_SLIP"
+	OrderDocumentLabel       = "LABEL"
+)
+
+// maxBulkDocuments caps how many orders one merged PDF may hold.
+const maxBulkDocuments = 200
+
+var orderDocumentFileNames = map[string]string{
+	OrderDocumentInvoice:     "invoice",
+	OrderDocumentPackingSlip: "packing-slip",
+	OrderDocumentLabel:       "label",
+}
+
+// SellerInfo is the business printed on invoices and as the label sender.
+type SellerInfo struct {
+	Name          string
+	Address       string
+	TaxId         string
+	ContactNumber string
+}
+
+// orderDocument is an order decoded for printing.
+type orderDocument struct {
+	order      models.OrderData
+	customer   orderCustomer
+	address    string
+	lines      []cartLine
+	freebies   []*pb.CartFreebies
+	subtotal   float64
+	codBalance float64
+}
+
+func loadOrderDocument(db *gorm.DB, order models.OrderData) (orderDocument, error) {
+	document := orderDocument{order: order, address: formatAddress(order.CompleteAddress)}
+
+	if len(order.Customer) > 0 {
+		if err := json.Unmarshal(order.Customer, &document.customer); err != nil {
+			return document, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid customer on order %s: %v", order.OrderId, err))
+		}
+	}
+
+	lines, err := parseCart(string(order.Product))
+	if err != nil {
+		return document, err
+	}
+	document.lines = lines
+	for _, line := range lines {
+		document.subtotal += line.DiscountedPrice * float64(line.Quantity)
+	}
+
+	if len(order.Freebies) > 0 {
+		if err := json.Unmarshal(order.Freebies, &document.freebies); err != nil {
+			return document, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid freebies on order %s: %v", order.OrderId, err))
+		}
+	}
+
+	// Cash still to collect on delivery
+	if err := db.Model(&models.PaymentData{}).
+		Where("order_id = ? AND payment_method = ? AND payment_status = ?", order.OrderId, "COD", "PEN").
+		Select("COALESCE(SUM(amount), 0)").Scan(&document.codBalance).Error; err != nil {
+		return document, err
+	}
+
+	return document, nil
+}
+
+func (d orderDocument) customerName() string {
+	return strings.TrimSpace(d.customer.FirstName + " " + d.customer.LastName)
+}
+
+// formatPdfAmount writes an amount for the PDF core fonts, which have no peso
+// sign.
+func formatPdfAmount(amount float64) string {
+	return strings.Replace(formatPeso(amount), "₱", "PHP ", 1)
+}
+
+// orderPdf wraps a gofpdf document with a translator for the core fonts'
+// cp1252 encoding, so names like "Peñaflor" print correctly.
+type orderPdf struct {
+	*gofpdf.Fpdf
+	tr func(string) string
+}
+
+func newOrderPdf(document string) *orderPdf {
+	var pdf *gofpdf.Fpdf
+	if document == OrderDocumentLabel {
+		// 4x6 inch thermal label
+		pdf = gofpdf.NewCustom(&gofpdf.InitType{
+			OrientationStr: "P",
+			UnitStr:        "mm",
+			Size:           gofpdf.SizeType{Wd: 101.6, Ht: 152.4},
+		})
+		pdf.SetMargins(5, 5, 5)
+		pdf.SetAutoPageBreak(false, 5)
+	} else {
+		pdf = gofpdf.New("P", "mm", "A4", "")
+		pdf.SetMargins(15, 15, 15)
+		pdf.SetAutoPageBreak(true, 15)
+	}
+
+	return &orderPdf{Fpdf: pdf, tr: pdf.UnicodeTranslatorFromDescriptor("")}
+}
+
+func (p *orderPdf) text(w, h float64, txt string, border string, ln int, align string) {
+	p.CellFormat(w, h, p.tr(txt), border, ln, align, false, 0, "")
+}
+
+func (p *orderPdf) multiText(w, h float64, txt string, border string, align string) {
+	p.MultiCell(w, h, p.tr(txt), border, align, false)
+}
+
+// barcode draws content as a Code128 barcode filling the w x h box at x, y.
+func (p *orderPdf) barcode(content string, x, y, w, h float64) error {
+	code, err := code128.Encode(content)
+	if err != nil {
+		return err
+	}
+
+	modules := code.Bounds().Dx()
+	moduleWidth := w / float64(modules)
+	p.SetFillColor(0, 0, 0)
+	for i := 0; i < modules; i++ {
+		if r, _, _, _ := code.At(i, 0).RGBA(); r == 0 {
+			p.Rect(x+float64(i)*moduleWidth, y, moduleWidth, h, "F")
+		}
+	}
+
+	return nil
+}
+
+func (p *orderPdf) pageWidth() float64 {
+	width, _ := p.GetPageSize()
+	left, _, right, _ := p.GetMargins()
+	return width - left - right
+}
+
+// header prints the seller on the left and the document title on the right.
+func (s *ChronexAdminService) pdfHeader(p *orderPdf, title string, document orderDocument) {
+	width := p.pageWidth()
+	top := p.GetY()
+
+	p.SetFont("Helvetica", "B", 14)
+	p.text(width/2, 7, s.Seller.Name, "", 2, "L")
+	p.SetFont("Helvetica", "", 9)
+	if s.Seller.Address != "" {
+		p.multiText(width/2, 4.5, s.Seller.Address, "", "L")
+	}
+	if s.Seller.TaxId != "" {
+		p.text(width/2, 4.5, "TIN: "+s.Seller.TaxId, "", 2, "L")
+	}
+	if s.Seller.ContactNumber != "" {
+		p.text(width/2, 4.5, s.Seller.ContactNumber, "", 2, "L")
+	}
+	bottom := p.GetY()
+
+	left, _, _, _ := p.GetMargins()
+	p.SetXY(left+width/2, top)
+	p.SetFont("Helvetica", "B", 18)
+	p.text(width/2, 8, title, "", 2, "R")
+	p.SetFont("Helvetica", "B", 10)
+	p.text(width/2, 5, "Order "+document.order.GetOrderNumber(), "", 2, "R")
+	p.SetFont("Helvetica", "", 9)
+	p.text(width/2, 4.5, "Date "+document.order.CreatedAt.Format("January 2, 2006"), "", 2, "R")
+
+	if p.GetY() > bottom {
+		bottom = p.GetY()
+	}
+	p.SetXY(left, bottom+6)
+}
+
+// pdfAddressBlock prints the customer's name, contacts and address under label.
+func pdfAddressBlock(p *orderPdf, label string, document orderDocument) {
+	width := p.pageWidth()
+
+	p.SetFont("Helvetica", "B", 9)
+	p.text(width, 5, label, "", 1, "L")
+	p.SetFont("Helvetica", "", 10)
+	p.text(width, 5, document.customerName(), "", 1, "L")
+	for _, contact := range []string{document.customer.ContactNumber, document.customer.EmailAddress} {
+		if contact != "" {
+			p.text(width, 5, contact, "", 1, "L")
+		}
+	}
+	if document.address != "" {
+		p.multiText(width, 5, document.address, "", "L")
+	}
+	p.Ln(6)
+}
+
+func (s *ChronexAdminService) renderInvoice(p *orderPdf, document orderDocument) {
+	p.AddPage()
+	s.pdfHeader(p, "INVOICE", document)
+	pdfAddressBlock(p, "BILL TO", document)
+
+	width := p.pageWidth()
+	columns := []float64{width - 75, 15, 30, 30}
+
+	p.SetFont("Helvetica", "B", 9)
+	p.SetFillColor(235, 235, 235)
+	for i, heading := range []string{"ITEM", "QTY", "UNIT PRICE", "AMOUNT"} {
+		align := "R"
+		if i == 0 {
+			align = "L"
+		}
+		p.CellFormat(columns[i], 7, heading, "B", 0, align, true, 0, "")
+	}
+	p.Ln(-1)
+
+	for _, line := range document.lines {
+		p.SetFont("Helvetica", "", 9)
+		p.text(columns[0], 6, line.ProductName, "", 0, "L")
+		p.text(columns[1], 6, fmt.Sprintf("%d", line.Quantity), "", 0, "R")
+		p.text(columns[2], 6, formatPdfAmount(line.DiscountedPrice), "", 0, "R")
+		p.text(columns[3], 6, formatPdfAmount(line.DiscountedPrice*float64(line.Quantity)), "", 1, "R")
+		if line.Freebies != "" {
+			p.SetFont("Helvetica", "I", 8)
+			p.text(columns[0], 5, "  Free: "+line.Freebies, "", 1, "L")
+		}
+	}
+	for _, freebie := range document.freebies {
+		p.SetFont("Helvetica", "I", 9)
+		p.text(columns[0], 6, freebie.FreebiesName+" (freebie)", "", 0, "L")
+		p.text(columns[1], 6, fmt.Sprintf("%d", freebie.Quantity), "", 0, "R")
+		p.text(columns[2], 6, formatPdfAmount(0), "", 0, "R")
+		p.text(columns[3], 6, formatPdfAmount(0), "", 1, "R")
+	}
+	p.Line(p.GetX(), p.GetY(), p.GetX()+width, p.GetY())
+	p.Ln(2)
+
+	order := document.order
+	totals := [][2]string{{"Subtotal", formatPdfAmount(document.subtotal)}}
+	if order.VoucherDiscount > 0 {
+		label := "Voucher"
+		if order.VoucherCode != "" {
+			label += " (" + order.VoucherCode + ")"
+		}
+		totals = append(totals, [2]string{label, formatPdfAmount(-order.VoucherDiscount)})
+	}
+	shipping := "Free"
+	if order.ShippingFee > 0 {
+		shipping = formatPdfAmount(order.ShippingFee)
+	}
+	totals = append(totals, [2]string{"Shipping", shipping})
+
+	labelWidth := columns[1] + columns[2]
+	offset := width - labelWidth - columns[3]
+	left, _, _, _ := p.GetMargins()
+	for _, total := range totals {
+		p.SetX(left + offset)
+		p.SetFont("Helvetica", "", 9)
+		p.text(labelWidth, 6, total[0], "", 0, "R")
+		p.text(columns[3], 6, total[1], "", 1, "R")
+	}
+	p.SetX(left + offset)
+	p.SetFont("Helvetica", "B", 11)
+	p.text(labelWidth, 8, "TOTAL", "T", 0, "R")
+	p.text(columns[3], 8, formatPdfAmount(order.Total), "T", 1, "R")
+
+	// Prices include VAT, so the VAT is broken out of the total
+	p.SetFont("Helvetica", "", 8)
+	if s.VATRate > 0 {
+		vatable := order.Total / (1 + s.VATRate)
+		p.SetX(left + offset)
+		p.text(labelWidth, 5, "VATable sales", "", 0, "R")
+		p.text(columns[3], 5, formatPdfAmount(vatable), "", 1, "R")
+		p.SetX(left + offset)
+		p.text(labelWidth, 5, fmt.Sprintf("VAT (%g%%)", s.VATRate*100), "", 0, "R")
+		p.text(columns[3], 5, formatPdfAmount(order.Total-vatable), "", 1, "R")
+	} else {
+		p.SetX(left + offset)
+		p.text(labelWidth+columns[3], 5, "VAT-exempt sale", "", 1, "R")
+	}
+
+	p.Ln(8)
+	p.SetFont("Helvetica", "", 9)
+	p.text(width, 5, "Payment status: "+order.PaymentStatus, "", 1, "L")
+	if document.codBalance > 0 {
+		p.text(width, 5, "Cash on delivery: "+formatPdfAmount(document.codBalance), "", 1, "L")
+	}
+}
+
+func (s *ChronexAdminService) renderPackingSlip(p *orderPdf, document orderDocument) error {
+	p.AddPage()
+	s.pdfHeader(p, "PACKING SLIP", document)
+	pdfAddressBlock(p, "SHIP TO", document)
+
+	width := p.pageWidth()
+	columns := []float64{width - 40, 20, 20}
+
+	p.SetFont("Helvetica", "B", 9)
+	p.SetFillColor(235, 235, 235)
+	p.CellFormat(columns[0], 7, "ITEM", "B", 0, "L", true, 0, "")
+	p.CellFormat(columns[1], 7, "QTY", "B", 0, "R", true, 0, "")
+	p.CellFormat(columns[2], 7, "PACKED", "B", 1, "C", true, 0, "")
+
+	row := func(name string, quantity int64, style string) {
+		p.SetFont("Helvetica", style, 10)
+		p.text(columns[0], 7, name, "B", 0, "L")
+		p.text(columns[1], 7, fmt.Sprintf("%d", quantity), "B", 0, "R")
+		x, y := p.GetX(), p.GetY()
+		p.text(columns[2], 7, "", "B", 1, "C")
+		p.Rect(x+columns[2]/2-2, y+1.5, 4, 4, "D")
+	}
+	for _, line := range document.lines {
+		row(line.ProductName, int64(line.Quantity), "")
+		if line.Freebies != "" {
+			row("  Free: "+line.Freebies, int64(line.Quantity), "I")
+		}
+	}
+	for _, freebie := range document.freebies {
+		row(freebie.FreebiesName+" (freebie)", freebie.Quantity, "I")
+	}
+
+	p.Ln(10)
+	left, _, _, _ := p.GetMargins()
+	if err := p.barcode(document.order.OrderId.String(), left, p.GetY(), 90, 14); err != nil {
+		return err
+	}
+	p.SetY(p.GetY() + 15)
+	p.SetFont("Courier", "", 8)
+	p.text(90, 4, document.order.OrderId.String(), "", 1, "C")
+	p.SetFont("Helvetica", "B", 10)
+	p.text(90, 5, document.order.GetOrderNumber(), "", 1, "C")
+
+	return nil
+}
+
+func (s *ChronexAdminService) renderLabel(p *orderPdf, document orderDocument) error {
+	p.AddPage()
+	width := p.pageWidth()
+	left, top, _, _ := p.GetMargins()
+	order := document.order
+
+	// Sender
+	p.SetFont("Helvetica", "B", 8)
+	p.text(width, 4, "FROM", "", 1, "L")
+	p.SetFont("Helvetica", "", 8)
+	p.text(width, 4, s.Seller.Name, "", 1, "L")
+	if s.Seller.Address != "" {
+		p.multiText(width, 3.5, s.Seller.Address, "", "L")
+	}
+	if s.Seller.ContactNumber != "" {
+		p.text(width, 4, s.Seller.ContactNumber, "", 1, "L")
+	}
+	p.Ln(2)
+	p.Line(left, p.GetY(), left+width, p.GetY())
+	p.Ln(3)
+
+	// Recipient
+	p.SetFont("Helvetica", "B", 9)
+	p.text(width, 5, "SHIP TO", "", 1, "L")
+	p.SetFont("Helvetica", "B", 14)
+	p.multiText(width, 6.5, document.customerName(), "", "L")
+	p.SetFont("Helvetica", "", 11)
+	if document.customer.ContactNumber != "" {
+		p.text(width, 5.5, document.customer.ContactNumber, "", 1, "L")
+	}
+	p.multiText(width, 5.5, document.address, "", "L")
+	p.Ln(3)
+	p.Line(left, p.GetY(), left+width, p.GetY())
+	p.Ln(3)
+
+	// Order, courier and payment
+	p.SetFont("Helvetica", "B", 11)
+	p.text(width, 6, "Order "+order.GetOrderNumber(), "", 1, "L")
+	p.SetFont("Helvetica", "", 9)
+	if order.Courier != "" {
+		p.text(width, 5, "Courier: "+strings.ToUpper(order.Courier), "", 1, "L")
+	}
+	if order.TrackingId != "" {
+		p.text(width, 5, "Tracking: "+order.TrackingId, "", 1, "L")
+	}
+	p.SetFont("Helvetica", "B", 12)
+	if document.codBalance > 0 {
+		p.text(width, 7, "COD: "+formatPdfAmount(document.codBalance), "", 1, "L")
+	} else {
+		p.text(width, 7, "PREPAID", "", 1, "L")
+	}
+
+	// Order barcode at the foot of the label
+	_, height := p.GetPageSize()
+	barcodeTop := height - top - 30
+	if err := p.barcode(order.OrderId.String(), left, barcodeTop, width, 22); err != nil {
+		return err
+	}
+	p.SetXY(left, barcodeTop+23)
+	p.SetFont("Courier", "", 8)
+	p.text(width, 4, order.OrderId.String(), "", 1, "C")
+
+	return nil
+}
+
+// renderOrderDocuments prints document for every order into one PDF, one
+// page (or more for long invoices) per order.
+func (s *ChronexAdminService) renderOrderDocuments(document string, orders []models.OrderData) ([]byte, error) {
+	p := newOrderPdf(document)
+	p.SetTitle(p.tr(orderDocumentFileNames[document]), false)
+	p.SetCreator(p.tr(s.Seller.Name), false)
+
+	for _, order := range orders {
+		data, err := loadOrderDocument(s.DB, order)
+		if err != nil {
+			return nil, err
+		}
+
+		switch document {
+		case OrderDocumentInvoice:
+			s.renderInvoice(p, data)
+		case OrderDocumentPackingSlip:
+			err = s.renderPackingSlip(p, data)
+		case OrderDocumentLabel:
+			err = s.renderLabel(p, data)
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to print order %s: %v", order.OrderId, err))
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := p.Output(&buf); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to render PDF: %v", err))
+	}
+
+	return buf.Bytes(), nil
+}
+
+func parseOrderDocument(document string) (string, error) {
+	document = strings.ToUpper(strings.TrimSpace(document))
+	if _, ok := orderDocumentFileNames[document]; !ok {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid document %s", document))
+	}
+
+	return document, nil
+}
+
+// GetOrderDocument prints the invoice, packing slip or shipping label of an
+// order as a PDF.
+func (s *ChronexAdminService) GetOrderDocument(ctx context.Context, req *pb.GetOrderDocumentRequest) (*pb.GetOrderDocumentResponse, error) {
+	document, err := parseOrderDocument(req.Document)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve existing OrderData from the database
+	var orderData models.OrderData
+	if err := s.DB.First(&orderData, "order_id = ?", req.GetOrderId()).Error; err != nil {
+		log.Printf("Error retrieving Order data: %v", err)
+		return nil, err
+	}
+
+	pdf, err := s.renderOrderDocuments(document, []models.OrderData{orderData})
+	if err != nil {
+		log.Printf("Error printing %s: %v", document, err)
+		return nil, err
+	}
+
+	return &pb.GetOrderDocumentResponse{
+		Pdf:      pdf,
+		FileName: fmt.Sprintf("%s-%s.pdf", orderDocumentFileNames[document], orderData.GetOrderNumber()),
+	}, nil
+}
+
+// GetBulkOrderDocument prints one document type for several orders merged
+// into a single PDF, in the order the ids were given.
+func (s *ChronexAdminService) GetBulkOrderDocument(ctx context.Context, req *pb.GetBulkOrderDocumentRequest) (*pb.GetBulkOrderDocumentResponse, error) {
+	document := req.Document
+	if document == "" {
+		document = OrderDocumentPackingSlip
+	}
+	document, err := parseOrderDocument(document)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.OrderIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Select at least one order")
+	}
+	if len(req.OrderIds) > maxBulkDocuments {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("At most %d orders can be printed at once", maxBulkDocuments))
+	}
+
+	var found []models.OrderData
+	if err := s.DB.Where("order_id IN ?", req.OrderIds).Find(&found).Error; err != nil {
+		log.Printf("Error retrieving Order data: %v", err)
+		return nil, err
+	}
+	byId := map[string]models.OrderData{}
+	for _, order := range found {
+		byId[order.OrderId.String()] = order
+	}
+
+	orders := make([]models.OrderData, 0, len(req.OrderIds))
+	seen := map[string]bool{}
+	for _, orderId := range req.OrderIds {
+		order, ok := byId[strings.ToLower(orderId)]
+		if !ok {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("Order %s not found", orderId))
+		}
+		if seen[order.OrderId.String()] {
+			continue
+		}
+		seen[order.OrderId.String()] = true
+		orders = append(orders, order)
+	}
+
+	pdf, err := s.renderOrderDocuments(document, orders)
+	if err != nil {
+		log.Printf("Error printing %s: %v", document, err)
+		return nil, err
+	}
+
+	return &pb.GetBulkOrderDocumentResponse{
+		Pdf:      pdf,
+		FileName: fmt.Sprintf("%ss.pdf", orderDocumentFileNames[document]),
+	}, nil
+}
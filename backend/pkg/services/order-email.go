@@ -0,0 +1,263 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+//go:embed templates/email/*.html
+var emailTemplateFS embed.FS
+
+// orderEmailEvent is an order lifecycle email. OrderStatus is the status that
+// triggers it; the confirmation is sent when the order is saved.
+type orderEmailEvent struct {
+	template    string
+	subject     string
+	orderStatus string
+}
+
+const (
+	OrderEmailConfirmation = "ORDER_CONFIRMATION"
+	OrderEmailShipped      = "ORDER_SHIPPED"
+	OrderEmailDelivered    = "ORDER_DELIVERED"
+	OrderEmailMessage      = "ORDER_MESSAGE"
+)
+
+var orderEmailEvents = map[string]orderEmailEvent{
+	OrderEmailConfirmation: {template: "order-confirmation.html", subject: "We received your order %s"},
+	OrderEmailShipped:      {template: "order-shipped.html", subject: "Your order %s has shipped", orderStatus: "SHP"},
+	OrderEmailDelivered:    {template: "order-delivered.html", subject: "Your order %s has been delivered", orderStatus: "DLV"},
+	OrderEmailMessage:      {template: "order-message.html", subject: "A message about your order %s"},
+}
+
+var orderEmailTemplates = func() map[string]*template.Template {
+	funcs := template.FuncMap{"peso": formatPeso}
+
+	templates := map[string]*template.Template{}
+	for event, definition := range orderEmailEvents {
+		templates[event] = template.Must(template.New(definition.template).Funcs(funcs).ParseFS(emailTemplateFS,
+			"templates/email/layout.html", "templates/email/"+definition.template))
+	}
+
+	return templates
+}()
+
+type orderEmailItem struct {
+	ProductName string
+	Quantity    int
+	Price       float64
+	LineTotal   float64
+	Freebies    string
+}
+
+type orderEmailFreebie struct {
+	Name     string
+	Quantity int64
+}
+
+type orderEmailData struct {
+	Subject         string
+	OrderId         string
+	OrderNumber     string
+	OrderDate       string
+	CustomerName    string
+	Address         string
+	Items           []orderEmailItem
+	Freebies        []orderEmailFreebie
+	VoucherCode     string
+	VoucherDiscount float64
+	ShippingFee     float64
+	Total           float64
+	TrackingId      string
+	OrderLink       string
+	MessageLines    []string
+}
+
+// formatPeso writes an amount as ₱1,234.50.
+func formatPeso(amount float64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	whole, fraction, _ := strings.Cut(fmt.Sprintf("%.2f", amount), ".")
+	for i := len(whole) - 3; i > 0; i -= 3 {
+		whole = whole[:i] + "," + whole[i:]
+	}
+
+	return sign + "₱" + whole + "." + fraction
+}
+
+func formatAddress(completeAddress json.RawMessage) string {
+	var address orderAddress
+	if err := json.Unmarshal(completeAddress, &address); err != nil {
+		return ""
+	}
+
+	parts := []string{}
+	for _, part := range []string{address.HouseNumber, address.Address, address.Barangay, address.City, address.Province, address.Region} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	formatted := strings.Join(parts, ", ")
+	if landMark := strings.TrimSpace(address.LandMark); landMark != "" {
+		formatted += " (near " + landMark + ")"
+	}
+
+	return formatted
+}
+
+// orderLink is the storefront page where the customer can look up the order,
+// or "" when the storefront URL or link secret is not configured.
+func (s *ChronexAdminService) orderLink(orderId string) string {
+	token := s.signOrderLink(orderId, time.Now())
+	if s.StorefrontURL == "" || token == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/order-lookup?orderId=%s&token=%s",
+		strings.TrimRight(s.StorefrontURL, "/"), url.QueryEscape(orderId), url.QueryEscape(token))
+}
+
+// renderOrderEmail renders the email for event and returns the recipient,
+// subject and HTML body. Message is the plain-text note shown in an
+// ORDER_MESSAGE email.
+func (s *ChronexAdminService) renderOrderEmail(event string, order models.OrderData, message string) (string, string, string, error) {
+	definition, ok := orderEmailEvents[event]
+	if !ok {
+		return "", "", "", status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid email event %s", event))
+	}
+
+	var customer orderCustomer
+	if err := json.Unmarshal(order.Customer, &customer); err != nil {
+		return "", "", "", status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid customer: %v", err))
+	}
+
+	lines, err := parseCart(string(order.Product))
+	if err != nil {
+		return "", "", "", err
+	}
+
+	var cartFreebies []*pb.CartFreebies
+	if len(order.Freebies) > 0 {
+		if err := json.Unmarshal(order.Freebies, &cartFreebies); err != nil {
+			return "", "", "", status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid freebies: %v", err))
+		}
+	}
+
+	orderId := order.OrderId.String()
+	data := orderEmailData{
+		Subject:         fmt.Sprintf(definition.subject, order.GetOrderNumber()),
+		OrderId:         orderId,
+		OrderNumber:     order.GetOrderNumber(),
+		OrderDate:       order.CreatedAt.Format("January 2, 2006"),
+		CustomerName:    strings.TrimSpace(customer.FirstName + " " + customer.LastName),
+		Address:         formatAddress(order.CompleteAddress),
+		VoucherCode:     order.VoucherCode,
+		VoucherDiscount: order.VoucherDiscount,
+		ShippingFee:     order.ShippingFee,
+		Total:           order.Total,
+		TrackingId:      order.TrackingId,
+		OrderLink:       s.orderLink(orderId),
+		MessageLines:    plainTextLines(message),
+	}
+	for _, line := range lines {
+		data.Items = append(data.Items, orderEmailItem{
+			ProductName: line.ProductName,
+			Quantity:    line.Quantity,
+			Price:       line.DiscountedPrice,
+			LineTotal:   line.DiscountedPrice * float64(line.Quantity),
+			Freebies:    line.Freebies,
+		})
+	}
+	for _, cartFreebie := range cartFreebies {
+		data.Freebies = append(data.Freebies, orderEmailFreebie{
+			Name:     cartFreebie.FreebiesName,
+			Quantity: cartFreebie.Quantity,
+		})
+	}
+
+	var body bytes.Buffer
+	if err := orderEmailTemplates[event].ExecuteTemplate(&body, "layout", data); err != nil {
+		return "", "", "", status.Error(codes.Internal, fmt.Sprintf("Failed to render %s email: %v", event, err))
+	}
+
+	return normalizeEmail(customer.EmailAddress), data.Subject, body.String(), nil
+}
+
+// queueOrderEmail renders event for order and adds it to the email outbox in
+// tx. Nothing is queued when the event is switched off or the customer left no
+// email address, and a template that fails to render is logged rather than
+// failing the order.
+func (s *ChronexAdminService) queueOrderEmail(tx *gorm.DB, event string, order models.OrderData) error {
+	if !s.OrderEmailEnabled[event] {
+		return nil
+	}
+
+	to, subject, body, err := s.renderOrderEmail(event, order, "")
+	if err != nil {
+		log.Printf("Error rendering %s email for order %s: %v", event, order.OrderId, err)
+		return nil
+	}
+	if to == "" {
+		return nil
+	}
+
+	_, err = queueEmail(tx, event, &order.OrderId, to, subject, body)
+	return err
+}
+
+// queueOrderStatusEmail queues the lifecycle email for the order's new status
+// when it differs from previousStatus.
+func (s *ChronexAdminService) queueOrderStatusEmail(tx *gorm.DB, previousStatus string, order models.OrderData) error {
+	if order.OrderStatus == previousStatus {
+		return nil
+	}
+
+	for event, definition := range orderEmailEvents {
+		if definition.orderStatus != "" && definition.orderStatus == order.OrderStatus {
+			if err := s.queueOrderEmail(tx, event, order); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *ChronexAdminService) PreviewOrderEmail(ctx context.Context, req *pb.PreviewOrderEmailRequest) (*pb.PreviewOrderEmailResponse, error) {
+	// Retrieve existing OrderData from the database
+	var orderData models.OrderData
+	if err := s.DB.First(&orderData, "order_id = ?", req.GetOrderId()).Error; err != nil {
+		log.Printf("Error retrieving Order data: %v", err)
+		return nil, err
+	}
+
+	to, subject, body, err := s.renderOrderEmail(strings.ToUpper(req.Event), orderData, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PreviewOrderEmailResponse{
+		To:      to,
+		Subject: subject,
+		Html:    body,
+		Enabled: s.OrderEmailEnabled[strings.ToUpper(req.Event)],
+	}, nil
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"api/pkg/models"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrderExportFilter selects the orders to export with the same search, status
+// and sort as GetAllOrder. An empty OrderStatus exports every status and zero
+// times leave the date range open.
+type OrderExportFilter struct {
+	Search          string
+	OrderStatus     string
+	SortOptionOrder string
+	CreatedFrom     time.Time
+	CreatedTo       time.Time
+}
+
+var orderExportHeader = []string{
+	"ORDER NUMBER", "ORDER ID", "ORDER DATE", "ORDER STATUS", "PAYMENT STATUS",
+	"FIRST NAME", "LAST NAME", "EMAIL ADDRESS", "CONTACT NUMBER",
+	"HOUSE NUMBER", "ADDRESS", "BARANGAY", "CITY", "PROVINCE", "REGION", "LANDMARK",
+	"LINE", "PRODUCT ID", "PRODUCT NAME", "QUANTITY", "UNIT PRICE", "LINE TOTAL", "LINE FREEBIES",
+	"ORDER FREEBIES", "VOUCHER CODE", "VOUCHER DISCOUNT", "SHIPPING FEE", "ORDER TOTAL",
+	"COURIER", "TRACKING ID", "CHANNEL", "EXTERNAL ORDER ID",
+}
+
+type orderAddress struct {
+	HouseNumber string `json:"houseNumber"`
+	Address     string `json:"address"`
+	Barangay    string `json:"barangay"`
+	City        string `json:"city"`
+	Province    string `json:"province"`
+	Region      string `json:"region"`
+	LandMark    string `json:"landMark"`
+}
+
+func formatAmount(amount float64) string {
+	return fmt.Sprintf("%.2f", amount)
+}
+
+// orderExportRows flattens an order into one row per line item, repeating the
+// order, customer and address columns on each. An order without lines still
+// gets one row.
+func orderExportRows(order models.OrderData) [][]string {
+	var customer orderCustomer
+	_ = json.Unmarshal(order.Customer, &customer)
+	var address orderAddress
+	_ = json.Unmarshal(order.CompleteAddress, &address)
+	lines, _ := parseCart(string(order.Product))
+
+	var freebies []string
+	var cartFreebies []struct {
+		FreebiesName string `json:"freebiesName"`
+		Quantity     int64  `json:"quantity"`
+	}
+	_ = json.Unmarshal(order.Freebies, &cartFreebies)
+	for _, freebie := range cartFreebies {
+		freebies = append(freebies, fmt.Sprintf("%s x%d", freebie.FreebiesName, freebie.Quantity))
+	}
+
+	head := []string{
+		order.OrderNumber, order.OrderId.String(), order.CreatedAt.Format(time.RFC3339), order.OrderStatus, order.PaymentStatus,
+		customer.FirstName, customer.LastName, customer.EmailAddress, customer.ContactNumber,
+		address.HouseNumber, address.Address, address.Barangay, address.City, address.Province, address.Region, address.LandMark,
+	}
+	tail := []string{
+		strings.Join(freebies, "; "), order.VoucherCode, formatAmount(order.VoucherDiscount), formatAmount(order.ShippingFee), formatAmount(order.Total),
+		order.Courier, order.TrackingId, order.Channel, order.ExternalOrderId,
+	}
+
+	if len(lines) == 0 {
+		lines = []cartLine{{}}
+	}
+	rows := make([][]string, 0, len(lines))
+	for i, line := range lines {
+		row := append([]string{}, head...)
+		row = append(row,
+			fmt.Sprintf("%d", i+1), line.ProductID, line.ProductName, fmt.Sprintf("%d", line.Quantity),
+			formatAmount(line.DiscountedPrice), formatAmount(line.DiscountedPrice*float64(line.Quantity)), line.Freebies)
+		row = append(row, tail...)
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// ExportOrders streams the filtered orders to out as CSV or XLSX, one row per
+// line item.
+func (s *ChronexAdminService) ExportOrders(filter OrderExportFilter, format string, out io.Writer) error {
+	if filter.OrderStatus != "" && !orderStatuses[filter.OrderStatus] {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid order status %s", filter.OrderStatus))
+	}
+
+	// Build your query based on the request parameters
+	query := rankOrders(searchOrders(s.DB.Model(&models.OrderData{}), filter.Search), filter.Search)
+	if filter.OrderStatus != "" {
+		query = query.Where("order_status = ?", filter.OrderStatus)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		query = query.Where("created_at >= ?", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		query = query.Where("created_at < ?", filter.CreatedTo)
+	}
+
+	query = sortOrders(query, filter.SortOptionOrder, filter.Search)
+
+	writer, err := newTableWriter(format, out, "Orders", orderExportHeader)
+	if err != nil {
+		return err
+	}
+
+	// Stream the rows through a cursor rather than loading every order
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var order models.OrderData
+		if err := s.DB.ScanRows(rows, &order); err != nil {
+			return err
+		}
+		for _, row := range orderExportRows(order) {
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
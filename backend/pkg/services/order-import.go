@@ -0,0 +1,610 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tealeg/xlsx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// maxImportRows caps the data rows of one import file.
+const maxImportRows = 5000
+
+// errDryRun rolls back an import transaction that has otherwise succeeded.
+var errDryRun = errors.New("dry run")
+
+// Fields an import file can be mapped onto. Rows sharing an externalOrderId
+// are lines of the same order; the order-level fields are read from its first
+// row.
+var importFields = []string{
+	"externalOrderId", "orderDate", "orderStatus",
+	"fullName", "firstName", "lastName", "emailAddress", "contactNumber",
+	"houseNumber", "address", "barangay", "city", "province", "region", "landMark",
+	"productId", "sku", "productName", "quantity", "unitPrice",
+	"shippingFee", "trackingId",
+}
+
+// OrderImportMapping maps import fields to the spreadsheet columns holding
+// them, and marketplace order statuses to ours. A field may list several
+// column names; the first one present in the file is used.
+type OrderImportMapping struct {
+	Columns  map[string][]string `json:"columns"`
+	Statuses map[string]string   `json:"statuses"`
+}
+
+// orderImportPresets are the column layouts of common marketplace exports.
+var orderImportPresets = map[string]OrderImportMapping{
+	"SHOPEE": {
+		Columns: map[string][]string{
+			"externalOrderId": {"Order ID"},
+			"orderDate":       {"Order Creation Date"},
+			"orderStatus":     {"Order Status"},
+			"fullName":        {"Receiver Name"},
+			"contactNumber":   {"Phone Number"},
+			"address":         {"Delivery Address"},
+			"barangay":        {"District", "Town"},
+			"city":            {"City"},
+			"province":        {"Province"},
+			"sku":             {"SKU Reference No.", "Parent SKU Reference No."},
+			"productName":     {"Product Name"},
+			"quantity":        {"Quantity"},
+			"unitPrice":       {"Deal Price"},
+			"shippingFee":     {"Buyer Paid Shipping Fee"},
+			"trackingId":      {"Tracking Number*", "Tracking Number"},
+		},
+		Statuses: map[string]string{
+			"unpaid":    "PEN",
+			"to ship":   "ACT",
+			"shipping":  "SHP",
+			"completed": "DLV",
+			"cancelled": "CAN",
+		},
+	},
+	"LAZADA": {
+		Columns: map[string][]string{
+			"externalOrderId": {"orderNumber"},
+			"orderDate":       {"createTime"},
+			"orderStatus":     {"status"},
+			"fullName":        {"shippingName", "customerName"},
+			"emailAddress":    {"customerEmail"},
+			"contactNumber":   {"shippingPhone"},
+			"address":         {"shippingAddress"},
+			"barangay":        {"shippingAddress5"},
+			"city":            {"shippingAddress4", "shippingCity"},
+			"province":        {"shippingAddress3"},
+			"region":          {"shippingRegion"},
+			"sku":             {"sellerSku"},
+			"productName":     {"itemName"},
+			"unitPrice":       {"paidPrice", "unitPrice"},
+			"shippingFee":     {"shippingFee"},
+			"trackingId":      {"trackingCode"},
+		},
+		Statuses: map[string]string{
+			"pending":        "ACT",
+			"ready_to_ship":  "ACT",
+			"shipped":        "SHP",
+			"delivered":      "DLV",
+			"canceled":       "CAN",
+			"cancelled":      "CAN",
+			"failed":         "CAN",
+			"returned":       "CAN",
+			"packed":         "ACT",
+			"unpaid":         "PEN",
+			"confirmed":      "DLV",
+			"lost_by_3pl":    "CAN",
+			"damaged_by_3pl": "CAN",
+		},
+	},
+}
+
+// parseOrderImportMapping starts from the preset, if any, and overlays the
+// columns and statuses of mappingJSON.
+func parseOrderImportMapping(preset string, mappingJSON string) (OrderImportMapping, error) {
+	mapping := OrderImportMapping{Columns: map[string][]string{}, Statuses: map[string]string{}}
+
+	if preset = strings.ToUpper(strings.TrimSpace(preset)); preset != "" {
+		base, ok := orderImportPresets[preset]
+		if !ok {
+			return mapping, status.Error(codes.InvalidArgument, fmt.Sprintf("Unknown import preset %s", preset))
+		}
+		for field, columns := range base.Columns {
+			mapping.Columns[field] = columns
+		}
+		for value, orderStatus := range base.Statuses {
+			mapping.Statuses[value] = orderStatus
+		}
+	}
+
+	if strings.TrimSpace(mappingJSON) != "" {
+		var custom struct {
+			Columns  map[string]json.RawMessage `json:"columns"`
+			Statuses map[string]string          `json:"statuses"`
+		}
+		if err := json.Unmarshal([]byte(mappingJSON), &custom); err != nil {
+			return mapping, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid column mapping: %v", err))
+		}
+
+		known := map[string]bool{}
+		for _, field := range importFields {
+			known[field] = true
+		}
+		for field, raw := range custom.Columns {
+			if !known[field] {
+				return mapping, status.Error(codes.InvalidArgument, fmt.Sprintf("Unknown import field %s", field))
+			}
+			// A field maps to one column name or a list of them
+			var columns []string
+			var column string
+			if err := json.Unmarshal(raw, &column); err == nil {
+				columns = []string{column}
+			} else if err := json.Unmarshal(raw, &columns); err != nil {
+				return mapping, status.Error(codes.InvalidArgument, fmt.Sprintf("Column for %s must be a name or a list of names", field))
+			}
+			mapping.Columns[field] = columns
+		}
+		for value, orderStatus := range custom.Statuses {
+			orderStatus = strings.ToUpper(strings.TrimSpace(orderStatus))
+			if !orderStatuses[orderStatus] {
+				return mapping, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid order status %s for %q", orderStatus, value))
+			}
+			mapping.Statuses[strings.ToLower(strings.TrimSpace(value))] = orderStatus
+		}
+	}
+
+	if len(mapping.Columns["externalOrderId"]) == 0 {
+		return mapping, status.Error(codes.InvalidArgument, "Map a column to externalOrderId")
+	}
+	if len(mapping.Columns["productId"]) == 0 && len(mapping.Columns["sku"]) == 0 && len(mapping.Columns["productName"]) == 0 {
+		return mapping, status.Error(codes.InvalidArgument, "Map a column to productId, sku or productName")
+	}
+
+	return mapping, nil
+}
+
+// importRow is a data row with its cells looked up by import field.
+type importRow struct {
+	row     int32
+	cells   map[string]string
+	columns map[string]string
+}
+
+func (r importRow) get(field string) string {
+	return r.cells[field]
+}
+
+func (r importRow) rowError(field string, message string) *pb.ImportRowError {
+	return &pb.ImportRowError{Row: r.row, Column: r.columns[field], Message: message}
+}
+
+// importOrder is the rows of one marketplace order.
+type importOrder struct {
+	externalOrderId string
+	rows            []importRow
+}
+
+var importDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"02 Jan 2006 15:04",
+	"02 Jan 2006",
+	"01/02/2006 15:04",
+	"01/02/2006",
+	"01-02-06",
+}
+
+// parseImportDate reads a date cell as text or as an Excel date serial.
+func parseImportDate(value string) (time.Time, error) {
+	for _, layout := range importDateLayouts {
+		if parsed, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return parsed, nil
+		}
+	}
+	if serial, err := strconv.ParseFloat(value, 64); err == nil && serial > 0 {
+		return xlsx.TimeFromExcelTime(serial, false), nil
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognised date %q", value)
+}
+
+// parseImportAmount reads a money cell, ignoring currency signs and thousands
+// separators.
+func parseImportAmount(value string) (float64, error) {
+	cleaned := strings.NewReplacer("₱", "", "PHP", "", "php", "", ",", "", " ", "").Replace(value)
+	amount, err := strconv.ParseFloat(cleaned, 64)
+	if err != nil || amount < 0 || math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	return amount, nil
+}
+
+// groupImportRows maps the file's columns to import fields and groups the data
+// rows into orders in file order.
+func groupImportRows(rows [][]string, mapping OrderImportMapping) ([]importOrder, error) {
+	if len(rows) < 2 {
+		return nil, status.Error(codes.InvalidArgument, "The file has no data rows")
+	}
+	if len(rows)-1 > maxImportRows {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("At most %d rows can be imported at once", maxImportRows))
+	}
+
+	header := rows[0]
+	indexes := map[string]int{}
+	columns := map[string]string{}
+	for _, field := range importFields {
+		if names := mapping.Columns[field]; len(names) > 0 {
+			index := findColumn(header, names...)
+			if index < 0 {
+				if field == "externalOrderId" {
+					return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Column %s for externalOrderId is not in the file", strings.Join(names, " / ")))
+				}
+				continue
+			}
+			indexes[field] = index
+			columns[field] = header[index]
+		}
+	}
+	_, hasProductId := indexes["productId"]
+	_, hasSku := indexes["sku"]
+	_, hasProductName := indexes["productName"]
+	if !hasProductId && !hasSku && !hasProductName {
+		return nil, status.Error(codes.InvalidArgument, "The file has no product id, SKU or product name column")
+	}
+
+	var orders []importOrder
+	byExternalId := map[string]int{}
+	for i := 1; i < len(rows); i++ {
+		if blankRow(rows[i]) {
+			continue
+		}
+		row := importRow{row: int32(i + 1), cells: map[string]string{}, columns: columns}
+		for field, index := range indexes {
+			row.cells[field] = cellAt(rows[i], index)
+		}
+
+		externalId := row.get("externalOrderId")
+		position, ok := byExternalId[externalId]
+		if !ok {
+			position = len(orders)
+			byExternalId[externalId] = position
+			orders = append(orders, importOrder{externalOrderId: externalId})
+		}
+		orders[position].rows = append(orders[position].rows, row)
+	}
+
+	return orders, nil
+}
+
+// matchImportProduct finds the product of an order line by our product id, by
+// SKU, or by name, in that order. A SKU we do not know falls back to the name,
+// since marketplace listings may carry their own SKUs. field is the column the
+// product was looked up by.
+func matchImportProduct(tx *gorm.DB, row importRow) (product models.ProductData, field string, err error) {
+	if productId := row.get("productId"); productId != "" {
+		if _, err := uuid.Parse(productId); err != nil {
+			return product, "productId", status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid product id %s", productId))
+		}
+		return product, "productId", tx.First(&product, "product_id = ?", productId).Error
+	}
+	if sku := row.get("sku"); sku != "" {
+		err = tx.First(&product, "sku = ?", sku).Error
+		if !errors.Is(err, gorm.ErrRecordNotFound) || row.get("productName") == "" {
+			return product, "sku", err
+		}
+	}
+	if productName := row.get("productName"); productName != "" {
+		return product, "productName", tx.First(&product, "lower(product_name) = lower(?)", productName).Error
+	}
+
+	return product, "", gorm.ErrRecordNotFound
+}
+
+// buildImportedOrder validates an order's rows and turns them into an order,
+// matching each line to one of our products. Every problem is reported
+// against its row and column.
+func buildImportedOrder(tx *gorm.DB, channel string, group importOrder, mapping OrderImportMapping) (models.OrderData, string, []*pb.ImportRowError) {
+	var rowErrors []*pb.ImportRowError
+	first := group.rows[0]
+
+	if group.externalOrderId == "" {
+		for _, row := range group.rows {
+			rowErrors = append(rowErrors, row.rowError("externalOrderId", "Order id is missing"))
+		}
+		return models.OrderData{}, "", rowErrors
+	}
+
+	orderDate := time.Now()
+	if value := first.get("orderDate"); value != "" {
+		parsed, err := parseImportDate(value)
+		if err != nil {
+			rowErrors = append(rowErrors, first.rowError("orderDate", err.Error()))
+		} else {
+			orderDate = parsed
+		}
+	}
+
+	orderStatus := "ACT"
+	if value := first.get("orderStatus"); value != "" {
+		mapped, ok := mapping.Statuses[strings.ToLower(value)]
+		if !ok && orderStatuses[strings.ToUpper(value)] {
+			mapped, ok = strings.ToUpper(value), true
+		}
+		if !ok {
+			rowErrors = append(rowErrors, first.rowError("orderStatus", fmt.Sprintf("No order status is mapped for %q", value)))
+		} else {
+			orderStatus = mapped
+		}
+	}
+
+	customer := orderCustomer{
+		FirstName:     plainText(first.get("firstName"), maxContactNameLength, false),
+		LastName:      plainText(first.get("lastName"), maxContactNameLength, false),
+		EmailAddress:  normalizeEmail(first.get("emailAddress")),
+		ContactNumber: plainText(first.get("contactNumber"), 50, false),
+	}
+	if fullName := plainText(first.get("fullName"), maxContactNameLength*2, false); fullName != "" && customer.FirstName == "" && customer.LastName == "" {
+		if space := strings.LastIndex(fullName, " "); space > 0 {
+			customer.FirstName, customer.LastName = fullName[:space], fullName[space+1:]
+		} else {
+			customer.FirstName = fullName
+		}
+	}
+	if customer.FirstName == "" && customer.LastName == "" {
+		rowErrors = append(rowErrors, first.rowError("fullName", "Customer name is missing"))
+	}
+
+	address := orderAddress{
+		HouseNumber: plainText(first.get("houseNumber"), 200, false),
+		Address:     plainText(first.get("address"), 500, false),
+		Barangay:    plainText(first.get("barangay"), 200, false),
+		City:        plainText(first.get("city"), 200, false),
+		Province:    plainText(first.get("province"), 200, false),
+		Region:      plainText(first.get("region"), 200, false),
+		LandMark:    plainText(first.get("landMark"), 200, false),
+	}
+	if address.Address == "" && address.City == "" {
+		rowErrors = append(rowErrors, first.rowError("address", "Delivery address is missing"))
+	}
+
+	var shippingFee float64
+	if value := first.get("shippingFee"); value != "" {
+		fee, err := parseImportAmount(value)
+		if err != nil {
+			rowErrors = append(rowErrors, first.rowError("shippingFee", err.Error()))
+		}
+		shippingFee = fee
+	}
+
+	// Match every line to a product
+	var lines []cartLine
+	var subtotal float64
+	for _, row := range group.rows {
+		product, field, err := matchImportProduct(tx, row)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if field == "" {
+				rowErrors = append(rowErrors, row.rowError("productName", "Product is missing"))
+			} else {
+				rowErrors = append(rowErrors, row.rowError(field, fmt.Sprintf("No product matches %q", row.get(field))))
+			}
+			continue
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, row.rowError(field, err.Error()))
+			continue
+		}
+
+		quantity := 1
+		if value := row.get("quantity"); value != "" {
+			quantity, err = strconv.Atoi(value)
+			if err != nil || quantity <= 0 {
+				rowErrors = append(rowErrors, row.rowError("quantity", fmt.Sprintf("Invalid quantity %q", value)))
+				continue
+			}
+		}
+
+		unitPrice := product.DiscountedPrice
+		if value := row.get("unitPrice"); value != "" {
+			unitPrice, err = parseImportAmount(value)
+			if err != nil {
+				rowErrors = append(rowErrors, row.rowError("unitPrice", err.Error()))
+				continue
+			}
+		}
+
+		lines = append(lines, cartLine{
+			ProductID:       product.ProductId.String(),
+			ProductName:     product.ProductName,
+			Quantity:        quantity,
+			DiscountedPrice: unitPrice,
+		})
+		subtotal += unitPrice * float64(quantity)
+	}
+	if len(rowErrors) > 0 {
+		return models.OrderData{}, "", rowErrors
+	}
+
+	customerJSON, _ := json.Marshal(customer)
+	addressJSON, _ := json.Marshal(address)
+	productJSON, _ := json.Marshal(lines)
+
+	orderData := models.OrderData{
+		Customer:        json.RawMessage(customerJSON),
+		CompleteAddress: json.RawMessage(addressJSON),
+		Product:         json.RawMessage(productJSON),
+		Freebies:        json.RawMessage("[]"),
+		Total:           math.Round((subtotal+shippingFee)*100) / 100,
+		ShippingFee:     shippingFee,
+		OrderStatus:     "PEN",
+		PaymentStatus:   "UNP",
+		TrackingId:      plainText(first.get("trackingId"), 100, false),
+		Channel:         channel,
+		ExternalOrderId: group.externalOrderId,
+		CreatedAt:       orderDate,
+	}
+
+	return orderData, orderStatus, nil
+}
+
+// saveImportedOrder saves one marketplace order in tx: its number, customer, stock
+// for shipped orders, the marketplace payment and a timeline entry.
+func (s *ChronexAdminService) saveImportedOrder(tx *gorm.DB, orderData *models.OrderData, orderStatus string) error {
+	customerId, err := matchCustomer(tx, string(orderData.Customer), orderData.CompleteAddress)
+	if err != nil {
+		return err
+	}
+	orderData.CustomerId = customerId
+
+	// Shipped and delivered orders take their stock like any other
+	if err := moveOrderStock(tx, *orderData, orderStatus); err != nil {
+		return err
+	}
+	orderData.OrderStatus = orderStatus
+
+	orderData.OrderNumber, err = models.NextOrderNumber(tx, orderData.CreatedAt.Year())
+	if err != nil {
+		return err
+	}
+
+	// Save the data to the database using GORM
+	if err := tx.Create(orderData).Error; err != nil {
+		return err
+	}
+
+	// The marketplace collected the payment, so it counts as captured
+	if orderStatus != "CAN" && orderData.Total > 0 {
+		capturedAt := orderData.CreatedAt
+		payment := models.PaymentData{
+			OrderId:           orderData.OrderId,
+			PaymentMethod:     "MARKETPLACE",
+			Provider:          strings.ToLower(orderData.Channel),
+			Amount:            orderData.Total,
+			PaymentStatus:     "CAP",
+			ProviderReference: orderData.ExternalOrderId,
+			CapturedAt:        &capturedAt,
+		}
+		if err := tx.Create(&payment).Error; err != nil {
+			return err
+		}
+		if err := refreshOrderPaymentStatus(tx, orderData); err != nil {
+			return err
+		}
+	}
+
+	return recordOrderStatusEvent(tx, "", *orderData, "IMPORT")
+}
+
+// recordImportedOrder fills in the result of an order that saved. A dry run
+// rolls the order back, so it has no id or number to report.
+func recordImportedOrder(result *pb.ImportOrderResult, orderData models.OrderData, dryRun bool) {
+	if dryRun {
+		result.Outcome = "VALID"
+		return
+	}
+
+	result.Outcome = "IMPORTED"
+	result.OrderId = orderData.OrderId.String()
+	result.OrderNumber = orderData.OrderNumber
+}
+
+// ImportOrders ingests a marketplace order export. Rows are grouped into
+// orders by their marketplace order id and each order is imported in its own
+// transaction; orders already imported from the same channel are skipped. A
+// dry run validates everything, including product matches and stock, and
+// rolls every order back.
+func (s *ChronexAdminService) ImportOrders(ctx context.Context, req *pb.ImportOrdersRequest) (*pb.ImportOrdersResponse, error) {
+	channel := strings.ToUpper(plainText(req.Channel, 50, false))
+	if channel == "" {
+		channel = strings.ToUpper(strings.TrimSpace(req.Preset))
+	}
+	if channel == "" {
+		return nil, status.Error(codes.InvalidArgument, "Channel cannot be empty")
+	}
+
+	mapping, err := parseOrderImportMapping(req.Preset, req.ColumnMapping)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := readSpreadsheet(req.FileName, req.File)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := groupImportRows(rows, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ImportOrdersResponse{DryRun: req.DryRun}
+	for _, group := range groups {
+		result := &pb.ImportOrderResult{ExternalOrderId: group.externalOrderId}
+		for _, row := range group.rows {
+			result.Rows = append(result.Rows, row.row)
+		}
+		response.Results = append(response.Results, result)
+
+		err := s.DB.Transaction(func(tx *gorm.DB) error {
+			var existing models.OrderData
+			err := tx.Select("order_id", "order_number").
+				First(&existing, "channel = ? AND external_order_id = ?", channel, group.externalOrderId).Error
+			if err == nil {
+				result.Outcome = "SKIPPED"
+				result.OrderId = existing.OrderId.String()
+				result.OrderNumber = existing.OrderNumber
+				return nil
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+
+			orderData, orderStatus, rowErrors := buildImportedOrder(tx, channel, group, mapping)
+			if len(rowErrors) > 0 {
+				result.Errors = rowErrors
+				return errDryRun
+			}
+
+			if err := s.saveImportedOrder(tx, &orderData, orderStatus); err != nil {
+				return err
+			}
+			recordImportedOrder(result, orderData, req.DryRun)
+
+			if req.DryRun {
+				return errDryRun
+			}
+			return nil
+		})
+
+		switch {
+		case len(result.Errors) > 0:
+			result.Outcome = "FAILED"
+		case err != nil && !errors.Is(err, errDryRun):
+			log.Printf("Error importing %s order %s: %v", channel, group.externalOrderId, err)
+			result.Outcome = "FAILED"
+			result.OrderNumber = ""
+			result.Errors = []*pb.ImportRowError{{Row: group.rows[0].row, Message: status.Convert(err).Message()}}
+		}
+
+		switch result.Outcome {
+		case "IMPORTED", "VALID":
+			response.Imported++
+		case "SKIPPED":
+			response.Skipped++
+		default:
+			response.Failed++
+		}
+	}
+
+	return response, nil
+}
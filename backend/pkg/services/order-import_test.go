@@ -0,0 +1,227 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseOrderImportMapping(t *testing.T) {
+	tests := []struct {
+		name        string
+		preset      string
+		mapping     string
+		wantColumns map[string][]string
+		wantStatus  map[string]string
+		code        codes.Code
+	}{
+		{
+			name:        "preset in any case",
+			preset:      " shopee ",
+			wantColumns: map[string][]string{"externalOrderId": {"Order ID"}, "sku": {"SKU Reference No.", "Parent SKU Reference No."}},
+			wantStatus:  map[string]string{"to ship": "ACT", "completed": "DLV"},
+		},
+		{
+			name:        "custom columns over a preset",
+			preset:      "LAZADA",
+			mapping:     `{"columns":{"externalOrderId":"Order No","productId":["Item ID","Product ID"]},"statuses":{" Ready ":"act"}}`,
+			wantColumns: map[string][]string{"externalOrderId": {"Order No"}, "productId": {"Item ID", "Product ID"}, "sku": {"sellerSku"}},
+			wantStatus:  map[string]string{"ready": "ACT", "shipped": "SHP"},
+		},
+		{
+			name:        "custom only",
+			mapping:     `{"columns":{"externalOrderId":"Order","productName":"Item"}}`,
+			wantColumns: map[string][]string{"externalOrderId": {"Order"}, "productName": {"Item"}},
+		},
+		{name: "unknown preset", preset: "AMAZON", code: codes.InvalidArgument},
+		{name: "not JSON", mapping: `{"columns"`, code: codes.InvalidArgument},
+		{name: "unknown field", mapping: `{"columns":{"externalOrderId":"Order","sku":"SKU","colour":"Colour"}}`, code: codes.InvalidArgument},
+		{name: "column is not a name", mapping: `{"columns":{"externalOrderId":7,"sku":"SKU"}}`, code: codes.InvalidArgument},
+		{name: "unknown status", preset: "SHOPEE", mapping: `{"statuses":{"lost":"GONE"}}`, code: codes.InvalidArgument},
+		{name: "no order id column", mapping: `{"columns":{"sku":"SKU"}}`, code: codes.InvalidArgument},
+		{name: "no product column", mapping: `{"columns":{"externalOrderId":"Order"}}`, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapping, err := parseOrderImportMapping(tt.preset, tt.mapping)
+			if status.Code(err) != tt.code {
+				t.Fatalf("parseOrderImportMapping() error = %v, want code %v", err, tt.code)
+			}
+			if err != nil {
+				return
+			}
+			for field, want := range tt.wantColumns {
+				if got := mapping.Columns[field]; !reflect.DeepEqual(got, want) {
+					t.Errorf("Columns[%s] = %q, want %q", field, got, want)
+				}
+			}
+			for value, want := range tt.wantStatus {
+				if got := mapping.Statuses[value]; got != want {
+					t.Errorf("Statuses[%q] = %q, want %q", value, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseOrderImportMappingLeavesPresetAlone(t *testing.T) {
+	if _, err := parseOrderImportMapping("SHOPEE", `{"columns":{"externalOrderId":"Order No"}}`); err != nil {
+		t.Fatalf("parseOrderImportMapping() error = %v", err)
+	}
+	if got := orderImportPresets["SHOPEE"].Columns["externalOrderId"]; !reflect.DeepEqual(got, []string{"Order ID"}) {
+		t.Errorf("preset externalOrderId = %q after a custom mapping, want it unchanged", got)
+	}
+}
+
+func TestGroupImportRows(t *testing.T) {
+	mapping := OrderImportMapping{Columns: map[string][]string{
+		"externalOrderId": {"Order ID"},
+		"sku":             {"SKU"},
+		"quantity":        {"Qty", "Quantity"},
+		"trackingId":      {"Tracking"},
+	}}
+
+	t.Run("rows grouped by order in file order", func(t *testing.T) {
+		rows := [][]string{
+			{"order id", "SKU", "Quantity"},
+			{"B-2", "STRAP", "2"},
+			{"A-1", "WATCH", "1"},
+			{"", "", ""},
+			{"B-2", "BOX"},
+		}
+
+		orders, err := groupImportRows(rows, mapping)
+		if err != nil {
+			t.Fatalf("groupImportRows() error = %v", err)
+		}
+		if len(orders) != 2 || orders[0].externalOrderId != "B-2" || orders[1].externalOrderId != "A-1" {
+			t.Fatalf("groupImportRows() = %+v, want orders B-2 then A-1", orders)
+		}
+
+		lines := orders[0].rows
+		if len(lines) != 2 || lines[0].row != 2 || lines[1].row != 5 {
+			t.Fatalf("order B-2 rows = %+v, want file rows 2 and 5", lines)
+		}
+		if lines[0].get("quantity") != "2" || lines[1].get("sku") != "BOX" || lines[1].get("quantity") != "" {
+			t.Errorf("order B-2 cells = %v, %v", lines[0].cells, lines[1].cells)
+		}
+		if got := lines[0].rowError("quantity", "bad"); got.Column != "Quantity" || got.Row != 2 {
+			t.Errorf("rowError() = %+v, want row 2 column Quantity", got)
+		}
+	})
+
+	tests := []struct {
+		name string
+		rows [][]string
+	}{
+		{name: "header only", rows: [][]string{{"Order ID", "SKU"}}},
+		{name: "order id column missing", rows: [][]string{{"Order", "SKU"}, {"A-1", "WATCH"}}},
+		{name: "no product column", rows: [][]string{{"Order ID", "Tracking"}, {"A-1", "FK1"}}},
+		{name: "too many rows", rows: make([][]string, maxImportRows+2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := groupImportRows(tt.rows, mapping); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("groupImportRows() error = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestParseImportAmount(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    float64
+		wantErr bool
+	}{
+		{value: "1500", want: 1500},
+		{value: "₱1,234.50", want: 1234.5},
+		{value: "PHP 99.99", want: 99.99},
+		{value: " 0 ", want: 0},
+		{value: "-5", wantErr: true},
+		{value: "NaN", wantErr: true},
+		{value: "Inf", wantErr: true},
+		{value: "free", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseImportAmount(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseImportAmount(%q) error = %v, want error %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseImportAmount(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseImportDate(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "2026-03-05T10:30:00Z", want: time.Date(2026, 3, 5, 10, 30, 0, 0, time.UTC)},
+		{value: "2026-03-05 10:30:15", want: time.Date(2026, 3, 5, 10, 30, 15, 0, time.Local)},
+		{value: "2026-03-05 10:30", want: time.Date(2026, 3, 5, 10, 30, 0, 0, time.Local)},
+		{value: "2026-03-05", want: time.Date(2026, 3, 5, 0, 0, 0, 0, time.Local)},
+		{value: "05 Mar 2026 10:30", want: time.Date(2026, 3, 5, 10, 30, 0, 0, time.Local)},
+		{value: "05 Mar 2026", want: time.Date(2026, 3, 5, 0, 0, 0, 0, time.Local)},
+		{value: "03/05/2026 10:30", want: time.Date(2026, 3, 5, 10, 30, 0, 0, time.Local)},
+		{value: "03/05/2026", want: time.Date(2026, 3, 5, 0, 0, 0, 0, time.Local)},
+		{value: "03-05-26", want: time.Date(2026, 3, 5, 0, 0, 0, 0, time.Local)},
+		{value: "46086", want: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{value: "0", wantErr: true},
+		{value: "yesterday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseImportDate(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseImportDate(%q) error = %v, want error %v", tt.value, err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseImportDate(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecordImportedOrder(t *testing.T) {
+	orderData := models.OrderData{OrderId: uuid.MustParse("00000000-0000-0000-0000-0000000000aa"), OrderNumber: "CHX-2026-000042"}
+
+	tests := []struct {
+		name            string
+		dryRun          bool
+		wantOutcome     string
+		wantOrderId     string
+		wantOrderNumber string
+	}{
+		{name: "imported", wantOutcome: "IMPORTED", wantOrderId: orderData.OrderId.String(), wantOrderNumber: "CHX-2026-000042"},
+		{name: "dry run", dryRun: true, wantOutcome: "VALID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := &pb.ImportOrderResult{ExternalOrderId: "A-1"}
+			recordImportedOrder(result, orderData, tt.dryRun)
+
+			if result.Outcome != tt.wantOutcome || result.OrderId != tt.wantOrderId || result.OrderNumber != tt.wantOrderNumber {
+				t.Errorf("recordImportedOrder() = %s %q %q, want %s %q %q",
+					result.Outcome, result.OrderId, result.OrderNumber, tt.wantOutcome, tt.wantOrderId, tt.wantOrderNumber)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// orderLinkLifetime is how long a signed order link keeps working.
+const orderLinkLifetime = 90 * 24 * time.Hour
+
+// errCustomerOrderNotFound is every failed customer lookup, so the public
+// endpoints do not reveal which order ids exist.
+var errCustomerOrderNotFound = status.Error(codes.NotFound, "No order matches the details provided")
+
+// signOrderLink returns the token that lets a customer open their order
+// without logging in until orderLinkLifetime after now, or "" when no signing
+// secret is configured. The token is the expiry time and its signature.
+func (s *ChronexAdminService) signOrderLink(orderId string, now time.Time) string {
+	if len(s.OrderLinkSecret) == 0 {
+		return ""
+	}
+
+	expires := strconv.FormatInt(now.Add(orderLinkLifetime).Unix(), 10)
+	return expires + "." + s.orderLinkSignature(orderId, expires)
+}
+
+func (s *ChronexAdminService) orderLinkSignature(orderId string, expires string) string {
+	mac := hmac.New(sha256.New, s.OrderLinkSecret)
+	mac.Write([]byte("order:" + orderId + ":" + expires))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verifyOrderLink reports whether token was signed by signOrderLink for
+// orderId and has not expired at now.
+func (s *ChronexAdminService) verifyOrderLink(orderId string, token string, now time.Time) bool {
+	if len(s.OrderLinkSecret) == 0 {
+		return false
+	}
+
+	expires, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || now.Unix() > expiresAt {
+		return false
+	}
+
+	return hmac.Equal([]byte(s.orderLinkSignature(orderId, expires)), []byte(signature))
+}
+
+// ownsOrder reports whether the normalized emailAddress or the link token
+// proves the customer owns orderData. A token, when given, is all that is
+// checked.
+func (s *ChronexAdminService) ownsOrder(orderData models.OrderData, emailAddress string, token string, now time.Time) bool {
+	if token != "" {
+		return s.verifyOrderLink(orderData.OrderId.String(), token, now)
+	}
+
+	return emailAddress != "" && parseCustomerEmail(string(orderData.Customer)) == emailAddress
+}
+
+func orderCancellable(orderStatus string) bool {
+	return orderStatus == "PEN" || orderStatus == "ACT"
+}
+
+func publicOrderToPb(data models.OrderData) *pb.PublicOrderData {
+	return &pb.PublicOrderData{
+		OrderId:         data.GetOrderId().String(),
+		OrderNumber:     data.OrderNumber,
+		OrderStatus:     data.GetOrderStatus(),
+		TrackingId:      data.GetTrackingId(),
+		Product:         string(data.GetProduct()),
+		Freebies:        string(data.GetFreebies()),
+		Total:           data.GetTotal(),
+		VoucherDiscount: data.GetVoucherDiscount(),
+		ShippingFee:     data.GetShippingFee(),
+		Cancellable:     orderCancellable(data.GetOrderStatus()),
+		CreatedAt:       data.CreatedAt.Unix(),
+		UpdatedAt:       data.UpdatedAt.Unix(),
+	}
+}
+
+// findCustomerOrder loads an order for a customer who proved they own it with
+// either the order's email address or its signed link token. Every failure is
+// reported as errCustomerOrderNotFound.
+func (s *ChronexAdminService) findCustomerOrder(db *gorm.DB, orderId string, emailAddress string, token string) (models.OrderData, error) {
+	if _, err := uuid.Parse(orderId); err != nil {
+		return models.OrderData{}, errCustomerOrderNotFound
+	}
+	emailAddress = normalizeEmail(emailAddress)
+	if emailAddress == "" && token == "" {
+		return models.OrderData{}, status.Error(codes.InvalidArgument, "Email address or order link token is required")
+	}
+
+	var orderData models.OrderData
+	result := db.Where("order_id = ?", orderId).Limit(1).Find(&orderData)
+	if result.Error != nil {
+		return models.OrderData{}, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch order data: %v", result.Error))
+	}
+	if result.RowsAffected == 0 || !s.ownsOrder(orderData, emailAddress, token, time.Now()) {
+		return models.OrderData{}, errCustomerOrderNotFound
+	}
+
+	return orderData, nil
+}
+
+func (s *ChronexAdminService) LookupOrder(ctx context.Context, req *pb.LookupOrderRequest) (*pb.LookupOrderResponse, error) {
+	orderData, err := s.findCustomerOrder(s.DB, req.OrderId, req.EmailAddress, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LookupOrderResponse{
+		OrderData: publicOrderToPb(orderData),
+	}, nil
+}
+
+// CancelOrder lets a customer cancel their own order while it is still
+// pending or active, moving stock the same way UpdateOrder does.
+func (s *ChronexAdminService) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.CancelOrderResponse, error) {
+	var orderData models.OrderData
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		orderData, err = s.findCustomerOrder(tx.Clauses(clause.Locking{Strength: "UPDATE"}), req.OrderId, req.EmailAddress, req.Token)
+		if err != nil {
+			return err
+		}
+
+		if !orderCancellable(orderData.OrderStatus) {
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("Order with status %s can no longer be cancelled", orderData.OrderStatus))
+		}
+
+		if err := moveOrderStock(tx, orderData, "CAN"); err != nil {
+			return err
+		}
+		previousStatus := orderData.OrderStatus
+		orderData.OrderStatus = "CAN"
+
+		// Save the updated data back to the database using GORM
+		if err := models.SaveVersioned(tx, &orderData, &orderData.Version); err != nil {
+			log.Printf("Error cancelling Order data: %v", err)
+			return versionError(err, "Order", req.OrderId)
+		}
+
+		if err := syncOrderPayments(tx, previousStatus, &orderData); err != nil {
+			return err
+		}
+
+		return recordOrderStatusEvent(tx, previousStatus, orderData, "CUSTOMER")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelOrderResponse{
+		OrderData: publicOrderToPb(orderData),
+	}, nil
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"api/pkg/models"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestVerifyOrderLink(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	orderId := "00000000-0000-0000-0000-0000000000aa"
+	svc := &ChronexAdminService{OrderLinkSecret: []byte("link-secret")}
+	token := svc.signOrderLink(orderId, now)
+	expires, signature, _ := strings.Cut(token, ".")
+	tampered := []byte(signature)
+	tampered[0] ^= 1
+
+	tests := []struct {
+		name    string
+		svc     *ChronexAdminService
+		orderId string
+		token   string
+		at      time.Time
+		want    bool
+	}{
+		{name: "round trip", svc: svc, orderId: orderId, token: token, at: now, want: true},
+		{name: "last moment", svc: svc, orderId: orderId, token: token, at: now.Add(orderLinkLifetime), want: true},
+		{name: "expired", svc: svc, orderId: orderId, token: token, at: now.Add(orderLinkLifetime + time.Second)},
+		{name: "other order", svc: svc, orderId: "00000000-0000-0000-0000-0000000000bb", token: token, at: now},
+		{name: "tampered signature", svc: svc, orderId: orderId, token: expires + "." + string(tampered), at: now},
+		{name: "expiry pushed back", svc: svc, orderId: orderId, token: "9999999999." + signature, at: now},
+		{name: "no expiry", svc: svc, orderId: orderId, token: svc.orderLinkSignature(orderId, ""), at: now},
+		{name: "other secret", svc: &ChronexAdminService{OrderLinkSecret: []byte("other")}, orderId: orderId, token: token, at: now},
+		{name: "no secret", svc: &ChronexAdminService{}, orderId: orderId, token: token, at: now},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.svc.verifyOrderLink(tt.orderId, tt.token, tt.at); got != tt.want {
+				t.Errorf("verifyOrderLink() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := (&ChronexAdminService{}).signOrderLink(orderId, now); got != "" {
+		t.Errorf("signOrderLink() without a secret = %q, want no token", got)
+	}
+}
+
+func TestOwnsOrder(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	svc := &ChronexAdminService{OrderLinkSecret: []byte("link-secret")}
+	customer, _ := json.Marshal(orderCustomer{EmailAddress: "Juan@Example.com"})
+	order := models.OrderData{OrderId: uuid.MustParse("00000000-0000-0000-0000-0000000000aa"), Customer: customer}
+	token := svc.signOrderLink(order.OrderId.String(), now)
+
+	tests := []struct {
+		name         string
+		emailAddress string
+		token        string
+		want         bool
+	}{
+		{name: "email", emailAddress: "juan@example.com", want: true},
+		{name: "other email", emailAddress: "maria@example.com"},
+		{name: "token", token: token, want: true},
+		{name: "bad token with the right email", emailAddress: "juan@example.com", token: "0.forged"},
+		{name: "nothing", emailAddress: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := svc.ownsOrder(order, tt.emailAddress, tt.token, now); got != tt.want {
+				t.Errorf("ownsOrder(%q, %q) = %v, want %v", tt.emailAddress, tt.token, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindCustomerOrderChecksBeforeLoading(t *testing.T) {
+	svc := &ChronexAdminService{OrderLinkSecret: []byte("link-secret")}
+
+	tests := []struct {
+		name         string
+		orderId      string
+		emailAddress string
+		token        string
+		want         error
+		code         codes.Code
+	}{
+		{name: "not an order id", orderId: "1", emailAddress: "juan@example.com", want: errCustomerOrderNotFound, code: codes.NotFound},
+		{name: "no email or token", orderId: "00000000-0000-0000-0000-0000000000aa", emailAddress: " ", code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// No database is needed, as both fail before the order is loaded
+			_, err := svc.findCustomerOrder(nil, tt.orderId, tt.emailAddress, tt.token)
+			if status.Code(err) != tt.code {
+				t.Fatalf("findCustomerOrder() error = %v, want code %v", err, tt.code)
+			}
+			if tt.want != nil && err != tt.want {
+				t.Errorf("findCustomerOrder() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
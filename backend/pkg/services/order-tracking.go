@@ -0,0 +1,421 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func orderEventToPb(data models.OrderEventData) *pb.OrderEventData {
+	return &pb.OrderEventData{
+		OrderEventId: data.GetOrderEventId().String(),
+		OrderId:      data.OrderId.String(),
+		EventType:    data.EventType,
+		EventStatus:  data.EventStatus,
+		Description:  data.Description,
+		Location:     data.Location,
+		Source:       data.Source,
+		OccurredAt:   data.OccurredAt.Unix(),
+		CreatedBy:    data.CreatedBy.String(),
+		CreatedAt:    data.CreatedAt.Unix(),
+	}
+}
+
+// recordOrderStatusEvent adds a status change to the order's timeline. It is
+// a no-op when the status did not change.
+func recordOrderStatusEvent(tx *gorm.DB, previousStatus string, order models.OrderData, source string) error {
+	if order.OrderStatus == previousStatus {
+		return nil
+	}
+
+	description := fmt.Sprintf("Order placed as %s", order.OrderStatus)
+	if previousStatus != "" {
+		description = fmt.Sprintf("Status changed from %s to %s", previousStatus, order.OrderStatus)
+	}
+
+	event := models.OrderEventData{
+		OrderId:     order.OrderId,
+		EventType:   "STATUS",
+		EventStatus: order.OrderStatus,
+		Description: description,
+		Source:      source,
+		OccurredAt:  time.Now(),
+	}
+
+	return tx.Create(&event).Error
+}
+
+// advanceOrderStatus moves a locked order to orderStatus with the same side
+// effects as an admin status change: stock, payments, email and timeline.
+func (s *ChronexAdminService) advanceOrderStatus(tx *gorm.DB, order *models.OrderData, orderStatus string, source string) error {
+	previousStatus := order.OrderStatus
+	if previousStatus == orderStatus {
+		return nil
+	}
+
+	if err := moveOrderStock(tx, *order, orderStatus); err != nil {
+		return err
+	}
+	order.OrderStatus = orderStatus
+
+	if err := models.SaveVersioned(tx, order, &order.Version); err != nil {
+		return versionError(err, "Order", order.OrderId.String())
+	}
+	if err := syncOrderPayments(tx, previousStatus, order); err != nil {
+		return err
+	}
+	if err := s.queueOrderStatusEmail(tx, previousStatus, *order); err != nil {
+		return err
+	}
+
+	return recordOrderStatusEvent(tx, previousStatus, *order, source)
+}
+
+// applyTrackingEvents records courier scans on a locked order's timeline and
+// moves the order along: a pickup or transit scan ships an open order and a
+// delivery scan delivers it. Scans already on the timeline are skipped, so
+// webhooks and polling can report the same scan safely.
+func (s *ChronexAdminService) applyTrackingEvents(tx *gorm.DB, order *models.OrderData, events []TrackingEvent) ([]models.OrderEventData, error) {
+	var recorded []models.OrderEventData
+	for _, trackingEvent := range events {
+		event := models.OrderEventData{
+			OrderId:     order.OrderId,
+			EventType:   "TRACKING",
+			EventStatus: trackingEvent.Status,
+			Description: trackingEvent.Description,
+			Location:    trackingEvent.Location,
+			Source:      "COURIER",
+			OccurredAt:  trackingEvent.OccurredAt,
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&event)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+		recorded = append(recorded, event)
+
+		switch trackingEvent.Status {
+		case TrackingPickedUp, TrackingInTransit, TrackingOutForDelivery:
+			if order.OrderStatus == "ACT" || order.OrderStatus == "PEN" {
+				if err := s.advanceOrderStatus(tx, order, "SHP", "COURIER"); err != nil {
+					return nil, err
+				}
+			}
+		case TrackingDelivered:
+			if order.OrderStatus != "DLV" && order.OrderStatus != "CAN" {
+				if err := s.advanceOrderStatus(tx, order, "DLV", "COURIER"); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return recorded, nil
+}
+
+func (s *ChronexAdminService) requireCourier() error {
+	if s.Courier == nil {
+		return status.Error(codes.FailedPrecondition, "No courier is configured")
+	}
+	return nil
+}
+
+// CreateShipment books the order with the courier and stores its tracking id.
+// The order ships once the courier reports the pickup.
+func (s *ChronexAdminService) CreateShipment(ctx context.Context, req *pb.CreateShipmentRequest) (*pb.CreateShipmentResponse, error) {
+	if err := s.requireCourier(); err != nil {
+		return nil, err
+	}
+
+	var orderData models.OrderData
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		orderData, err = lockOrder(tx, req.GetOrderId())
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(req.ExpectedVersion, orderData.Version, "Order", req.GetOrderId()); err != nil {
+			return err
+		}
+		if orderData.OrderStatus != "ACT" && orderData.OrderStatus != "PEN" {
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("Order with status %s cannot be shipped", orderData.OrderStatus))
+		}
+		if orderData.Courier != "" {
+			return status.Error(codes.AlreadyExists, fmt.Sprintf("Order %s already has a %s shipment", req.GetOrderId(), orderData.Courier))
+		}
+
+		shipment, err := s.Courier.CreateShipment(ctx, orderData)
+		if err != nil {
+			return status.Error(codes.Unavailable, fmt.Sprintf("Courier %s could not book the shipment: %v", s.Courier.Name(), err))
+		}
+
+		orderData.TrackingId = shipment.TrackingId
+		orderData.Courier = s.Courier.Name()
+
+		// Save the updated data back to the database using GORM
+		if err := models.SaveVersioned(tx, &orderData, &orderData.Version); err != nil {
+			return versionError(err, "Order", req.GetOrderId())
+		}
+
+		_, err = s.applyTrackingEvents(tx, &orderData, []TrackingEvent{{
+			TrackingId:  shipment.TrackingId,
+			Status:      TrackingCreated,
+			Description: fmt.Sprintf("Shipment booked with %s", orderData.Courier),
+			OccurredAt:  time.Now().Truncate(time.Second),
+		}})
+		return err
+	})
+	if err != nil {
+		log.Printf("Error creating shipment: %v", err)
+		return nil, err
+	}
+
+	return &pb.CreateShipmentResponse{
+		OrderData: orderToPb(orderData),
+	}, nil
+}
+
+// CancelShipment cancels the order's courier booking. A shipped order goes
+// back to ACT so it can be booked again.
+func (s *ChronexAdminService) CancelShipment(ctx context.Context, req *pb.CancelShipmentRequest) (*pb.CancelShipmentResponse, error) {
+	if err := s.requireCourier(); err != nil {
+		return nil, err
+	}
+
+	var orderData models.OrderData
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		orderData, err = lockOrder(tx, req.GetOrderId())
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(req.ExpectedVersion, orderData.Version, "Order", req.GetOrderId()); err != nil {
+			return err
+		}
+		if orderData.Courier != s.Courier.Name() {
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("Order %s has no %s shipment", req.GetOrderId(), s.Courier.Name()))
+		}
+		if orderData.OrderStatus == "DLV" {
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("Order %s has already been delivered", req.GetOrderId()))
+		}
+
+		if err := s.Courier.CancelShipment(ctx, orderData.TrackingId); err != nil {
+			return status.Error(codes.Unavailable, fmt.Sprintf("Courier %s could not cancel the shipment: %v", s.Courier.Name(), err))
+		}
+
+		event := models.OrderEventData{
+			OrderId:     orderData.OrderId,
+			EventType:   "TRACKING",
+			EventStatus: TrackingCancelled,
+			Description: fmt.Sprintf("Shipment %s cancelled", orderData.TrackingId),
+			Source:      "ADMIN",
+			OccurredAt:  time.Now(),
+		}
+		if err := tx.Create(&event).Error; err != nil {
+			return err
+		}
+
+		orderData.TrackingId = ""
+		orderData.Courier = ""
+		if orderData.OrderStatus == "SHP" {
+			return s.advanceOrderStatus(tx, &orderData, "ACT", "ADMIN")
+		}
+
+		// Save the updated data back to the database using GORM
+		if err := models.SaveVersioned(tx, &orderData, &orderData.Version); err != nil {
+			return versionError(err, "Order", req.GetOrderId())
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Error cancelling shipment: %v", err)
+		return nil, err
+	}
+
+	return &pb.CancelShipmentResponse{
+		OrderData: orderToPb(orderData),
+	}, nil
+}
+
+// syncOrderTracking fetches the courier's scans for one order and applies the
+// new ones.
+func (s *ChronexAdminService) syncOrderTracking(ctx context.Context, orderId interface{}) (models.OrderData, []models.OrderEventData, error) {
+	var orderData models.OrderData
+	var recorded []models.OrderEventData
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		orderData, err = lockOrder(tx, orderId)
+		if err != nil {
+			return err
+		}
+		if orderData.Courier != s.Courier.Name() || orderData.TrackingId == "" {
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("Order %v has no %s shipment", orderId, s.Courier.Name()))
+		}
+
+		events, err := s.Courier.TrackingEvents(ctx, orderData.TrackingId)
+		if err != nil {
+			return status.Error(codes.Unavailable, fmt.Sprintf("Courier %s could not return tracking: %v", s.Courier.Name(), err))
+		}
+
+		recorded, err = s.applyTrackingEvents(tx, &orderData, events)
+		return err
+	})
+
+	return orderData, recorded, err
+}
+
+// SyncTracking pulls the latest scans of the order's shipment from the courier.
+func (s *ChronexAdminService) SyncTracking(ctx context.Context, req *pb.SyncTrackingRequest) (*pb.SyncTrackingResponse, error) {
+	if err := s.requireCourier(); err != nil {
+		return nil, err
+	}
+
+	orderData, recorded, err := s.syncOrderTracking(ctx, req.GetOrderId())
+	if err != nil {
+		log.Printf("Error syncing tracking: %v", err)
+		return nil, err
+	}
+
+	response := &pb.SyncTrackingResponse{
+		OrderData: orderToPb(orderData),
+	}
+	for _, event := range recorded {
+		response.OrderEventData = append(response.OrderEventData, orderEventToPb(event))
+	}
+
+	return response, nil
+}
+
+// HandleCourierWebhook applies a signed courier status push to the orders it
+// mentions.
+func (s *ChronexAdminService) HandleCourierWebhook(ctx context.Context, req *pb.HandleCourierWebhookRequest) (*pb.HandleCourierWebhookResponse, error) {
+	if s.Courier == nil || s.Courier.Name() != req.Courier {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("Unknown courier %s", req.Courier))
+	}
+
+	events, err := s.Courier.ParseWebhook(req.Payload, req.Signature)
+	if errors.Is(err, ErrInvalidSignature) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid webhook payload: %v", err))
+	}
+
+	// Group the scans by shipment, keeping their order
+	var trackingIds []string
+	byTrackingId := map[string][]TrackingEvent{}
+	for _, event := range events {
+		if event.TrackingId == "" {
+			return nil, status.Error(codes.InvalidArgument, "Webhook is missing a tracking id")
+		}
+		if _, ok := byTrackingId[event.TrackingId]; !ok {
+			trackingIds = append(trackingIds, event.TrackingId)
+		}
+		byTrackingId[event.TrackingId] = append(byTrackingId[event.TrackingId], event)
+	}
+
+	response := &pb.HandleCourierWebhookResponse{}
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		for _, trackingId := range trackingIds {
+			var found models.OrderData
+			if err := tx.Select("order_id").First(&found, "courier = ? AND tracking_id = ?", req.Courier, trackingId).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return status.Error(codes.NotFound, fmt.Sprintf("No order has shipment %s", trackingId))
+				}
+				return err
+			}
+
+			orderData, err := lockOrder(tx, found.OrderId)
+			if err != nil {
+				return err
+			}
+			if _, err := s.applyTrackingEvents(tx, &orderData, byTrackingId[trackingId]); err != nil {
+				return err
+			}
+			response.OrderData = append(response.OrderData, orderToPb(orderData))
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Error handling %s webhook: %v", req.Courier, err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetOrderTimeline lists the order's status changes and tracking scans,
+// oldest first.
+func (s *ChronexAdminService) GetOrderTimeline(ctx context.Context, req *pb.GetOrderTimelineRequest) (*pb.GetOrderTimelineResponse, error) {
+	var orderData models.OrderData
+	if err := s.DB.Select("order_id").First(&orderData, "order_id = ?", req.GetOrderId()).Error; err != nil {
+		log.Printf("Error retrieving Order data: %v", err)
+		return nil, err
+	}
+
+	var events []models.OrderEventData
+	if err := s.DB.Where("order_id = ?", orderData.OrderId).Order("occurred_at ASC, created_at ASC").Find(&events).Error; err != nil {
+		log.Printf("Error retrieving Order timeline: %v", err)
+		return nil, err
+	}
+
+	response := &pb.GetOrderTimelineResponse{}
+	for _, event := range events {
+		response.OrderEventData = append(response.OrderEventData, orderEventToPb(event))
+	}
+
+	return response, nil
+}
+
+// StartTrackingSync polls the courier for shipped orders every interval until
+// ctx is done. It backs up the webhook for pushes that never arrive.
+func (s *ChronexAdminService) StartTrackingSync(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := s.RunTrackingSync(ctx); err != nil {
+				log.Printf("Error syncing tracking: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// RunTrackingSync syncs the tracking of every shipped order booked with the
+// configured courier. One failing shipment does not stop the others.
+func (s *ChronexAdminService) RunTrackingSync(ctx context.Context) error {
+	if s.Courier == nil {
+		return nil
+	}
+
+	var orders []models.OrderData
+	if err := s.DB.Select("order_id").Where("order_status = ? AND courier = ? AND tracking_id <> ''", "SHP", s.Courier.Name()).Find(&orders).Error; err != nil {
+		return err
+	}
+
+	for _, order := range orders {
+		if _, _, err := s.syncOrderTracking(ctx, order.OrderId); err != nil {
+			log.Printf("Error syncing tracking of Order %s: %v", order.OrderId, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"api/pkg/models"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestVerifyHMACSignature(t *testing.T) {
+	secret := []byte("whsec_test")
+	payload := []byte(`{"reference":"fake_1","status":"captured"}`)
+	signature := FakePaymentProvider{WebhookSecret: secret}.SignWebhook(payload)
+
+	tests := []struct {
+		name      string
+		secret    []byte
+		payload   []byte
+		signature string
+		want      bool
+	}{
+		{name: "valid", secret: secret, payload: payload, signature: signature, want: true},
+		{name: "sha256 prefix", secret: secret, payload: payload, signature: "sha256=" + signature, want: true},
+		{name: "surrounding spaces", secret: secret, payload: payload, signature: " " + signature + " ", want: true},
+		{name: "uppercase hex", secret: secret, payload: payload, signature: strings.ToUpper(signature), want: true},
+		{name: "tampered payload", secret: secret, payload: []byte(`{"reference":"fake_1","status":"voided"}`), signature: signature, want: false},
+		{name: "wrong secret", secret: []byte("other"), payload: payload, signature: signature, want: false},
+		{name: "no secret configured", secret: nil, payload: payload, signature: signature, want: false},
+		{name: "not hex", secret: secret, payload: payload, signature: "not-a-signature", want: false},
+		{name: "missing signature", secret: secret, payload: payload, signature: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verifyHMACSignature(tt.secret, tt.payload, tt.signature); got != tt.want {
+				t.Errorf("verifyHMACSignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFakePaymentProviderCreatePayment(t *testing.T) {
+	provider := FakePaymentProvider{WebhookSecret: []byte("whsec_test")}
+
+	first, err := provider.CreatePayment(context.Background(), models.PaymentData{Amount: 1500})
+	if err != nil {
+		t.Fatalf("CreatePayment() error = %v", err)
+	}
+	second, err := provider.CreatePayment(context.Background(), models.PaymentData{Amount: 1500})
+	if err != nil {
+		t.Fatalf("CreatePayment() error = %v", err)
+	}
+
+	if !strings.HasPrefix(first.Reference, "fake_") {
+		t.Errorf("Reference = %q, want a fake_ reference", first.Reference)
+	}
+	if first.Reference == second.Reference {
+		t.Errorf("two payments share the reference %q", first.Reference)
+	}
+	if !strings.HasSuffix(first.CheckoutUrl, "/"+first.Reference) {
+		t.Errorf("CheckoutUrl = %q, want it to end in the reference", first.CheckoutUrl)
+	}
+}
+
+func TestFakePaymentProviderParseWebhook(t *testing.T) {
+	provider := FakePaymentProvider{WebhookSecret: []byte("whsec_test")}
+
+	tests := []struct {
+		name    string
+		payload string
+		sign    bool
+		want    PaymentEvent
+		wantErr error
+	}{
+		{
+			name:    "captured",
+			payload: `{"reference":"fake_1","status":"captured","amount":1500}`,
+			sign:    true,
+			want:    PaymentEvent{Reference: "fake_1", PaymentStatus: "CAP", Amount: 1500},
+		},
+		{
+			name:    "failed, any case",
+			payload: `{"reference":"fake_1","status":"FAILED","failureReason":"Card declined"}`,
+			sign:    true,
+			want:    PaymentEvent{Reference: "fake_1", PaymentStatus: "FAI", FailureReason: "Card declined"},
+		},
+		{
+			name:    "voided",
+			payload: `{"reference":"fake_1","status":"voided"}`,
+			sign:    true,
+			want:    PaymentEvent{Reference: "fake_1", PaymentStatus: "VOI"},
+		},
+		{
+			name:    "unknown status",
+			payload: `{"reference":"fake_1","status":"pending"}`,
+			sign:    true,
+			want:    PaymentEvent{Reference: "fake_1"},
+		},
+		{
+			name:    "unsigned",
+			payload: `{"reference":"fake_1","status":"captured","amount":1500}`,
+			wantErr: ErrInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signature := "0000"
+			if tt.sign {
+				signature = provider.SignWebhook([]byte(tt.payload))
+			}
+
+			got, err := provider.ParseWebhook([]byte(tt.payload), signature)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseWebhook() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseWebhook() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
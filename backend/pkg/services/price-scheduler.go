@@ -0,0 +1,122 @@
+package services
+
+import (
+	"api/pkg/models"
+	"context"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// StartPriceScheduler runs RunPriceSchedule every interval until ctx is done.
+func (s *ChronexAdminService) StartPriceScheduler(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := s.RunPriceSchedule(time.Now()); err != nil {
+				log.Printf("Error running price schedule: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Price history sources of the scheduler's transitions.
+const (
+	priceRuleStart = "RULE_START"
+	priceRuleEnd   = "RULE_END"
+)
+
+// priceRuleTransition returns the transition due for a rule at now:
+// priceRuleStart once its window has opened, priceRuleEnd once a started rule
+// has closed, been switched off or been deleted, or "" when none is due.
+func priceRuleTransition(rule models.PriceRuleData, now time.Time) string {
+	live := !rule.DeletedAt.Valid && rule.ActiveAt(now)
+
+	switch {
+	case rule.StartedAt == nil && live:
+		return priceRuleStart
+	case rule.StartedAt != nil && rule.EndedAt == nil && !live:
+		return priceRuleEnd
+	}
+
+	return ""
+}
+
+// RunPriceSchedule starts the price rules whose window has opened and ends the
+// ones whose window has closed or that were switched off or deleted, writing
+// a price history entry for every product they cover.
+func (s *ChronexAdminService) RunPriceSchedule(now time.Time) error {
+	// Deleted rules are loaded too, so one deleted while running still ends
+	var rules []models.PriceRuleData
+	if err := s.DB.Unscoped().Where("ended_at IS NULL").Find(&rules).Error; err != nil {
+		return err
+	}
+
+	var starting, ending []models.PriceRuleData
+	for _, rule := range rules {
+		switch priceRuleTransition(rule, now) {
+		case priceRuleStart:
+			starting = append(starting, rule)
+		case priceRuleEnd:
+			ending = append(ending, rule)
+		}
+	}
+
+	for _, rule := range starting {
+		if err := s.DB.Transaction(func(tx *gorm.DB) error {
+			return transitionPriceRule(tx, rule, now, priceRuleStart, "started_at")
+		}); err != nil {
+			return err
+		}
+	}
+	for _, rule := range ending {
+		if err := s.DB.Transaction(func(tx *gorm.DB) error {
+			return transitionPriceRule(tx, rule, now, priceRuleEnd, "ended_at")
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// transitionPriceRule records the price history of every product the rule
+// covers, priced by the rules active in tx at now, and stamps column on the
+// rule.
+func transitionPriceRule(tx *gorm.DB, rule models.PriceRuleData, now time.Time, source string, column string) error {
+	rules, err := loadActivePriceRules(tx, now)
+	if err != nil {
+		return err
+	}
+
+	query := tx.Model(&models.ProductData{})
+	if rule.ProductId != "" {
+		query = query.Where("product_id = ?", rule.ProductId)
+	}
+	if rule.Category != "" {
+		// Matches the way PriceRuleData.Covers compares categories
+		query = query.Where("lower(category) = lower(?)", rule.Category)
+	}
+
+	var products []models.ProductData
+	if err := query.Find(&products).Error; err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		if err := recordPriceHistory(tx, product, rules, now, source); err != nil {
+			return err
+		}
+	}
+
+	return tx.Unscoped().Model(&rule).Update(column, now).Error
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"api/pkg/models"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func TestPriceRuleTransition(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	deleted := gorm.DeletedAt{Time: now, Valid: true}
+
+	window := func(rule models.PriceRuleData, start time.Time, end time.Time) models.PriceRuleData {
+		rule.StartAt = start
+		rule.EndAt = end
+		return rule
+	}
+	open := func(rule models.PriceRuleData) models.PriceRuleData {
+		return window(rule, now.Add(-time.Hour), now.Add(time.Hour))
+	}
+
+	tests := []struct {
+		name string
+		rule models.PriceRuleData
+		want string
+	}{
+		{name: "window opened", rule: open(models.PriceRuleData{RuleStatus: "ACT"}), want: priceRuleStart},
+		{name: "window not open yet", rule: window(models.PriceRuleData{RuleStatus: "ACT"}, now.Add(time.Minute), now.Add(time.Hour))},
+		{name: "switched off before it started", rule: open(models.PriceRuleData{RuleStatus: "INA"})},
+		{name: "deleted before it started", rule: open(models.PriceRuleData{RuleStatus: "ACT", DeletedAt: deleted})},
+		{name: "running", rule: open(models.PriceRuleData{RuleStatus: "ACT", StartedAt: &earlier})},
+		{name: "window closed", rule: window(models.PriceRuleData{RuleStatus: "ACT", StartedAt: &earlier}, earlier, now), want: priceRuleEnd},
+		{name: "switched off while running", rule: open(models.PriceRuleData{RuleStatus: "INA", StartedAt: &earlier}), want: priceRuleEnd},
+		{name: "deleted while running", rule: open(models.PriceRuleData{RuleStatus: "ACT", StartedAt: &earlier, DeletedAt: deleted}), want: priceRuleEnd},
+		{name: "already ended", rule: window(models.PriceRuleData{RuleStatus: "ACT", StartedAt: &earlier, EndedAt: &earlier}, earlier, now)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := priceRuleTransition(tt.rule, now); got != tt.want {
+				t.Errorf("priceRuleTransition() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
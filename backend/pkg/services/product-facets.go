@@ -0,0 +1,300 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// maxProductPageSize caps the limit of one GetAllProduct page.
+const maxProductPageSize = 100
+
+// priceFacetBounds split the price facet into ranges: below the first bound,
+// between each pair of bounds, and from the last bound up.
+var priceFacetBounds = []float64{5000, 10000, 20000, 50000, 100000}
+
+// discountFacetSteps are the "at least n% off" choices of the discount facet.
+var discountFacetSteps = []float64{10, 20, 30, 50}
+
+// ratingFacetSteps are the "n stars and up" choices of the rating facet.
+var ratingFacetSteps = []float64{4, 3, 2, 1}
+
+const (
+	// productDiscountSQL is how much of its original price a product is off,
+	// in percent.
+	productDiscountSQL = "(CASE WHEN original_price > 0 THEN (original_price - discounted_price) * 100 / original_price ELSE 0 END)"
+	// productRatingSQL is the average star rating of a product's shown reviews.
+	productRatingSQL = "(SELECT avg(reviews_star_rating) FROM chronex_product_reviews " +
+		"WHERE chronex_product_reviews.product_id = chronex_product_data.product_id::text " +
+		"AND chronex_product_reviews.reviews_status = 'ACT' AND chronex_product_reviews.deleted_at IS NULL)"
+)
+
+// The facets of a product listing. Each facet is counted with every filter
+// but its own, so its counts show what choosing another value would give.
+const (
+	facetCategory = "category"
+	facetPrice    = "price"
+	facetStock    = "stock"
+	facetDiscount = "discount"
+	facetRating   = "rating"
+)
+
+func checkProductFilters(req *pb.GetAllProductRequest) error {
+	if req.MinPrice < 0 || req.MaxPrice < 0 {
+		return status.Error(codes.InvalidArgument, "Prices cannot be negative")
+	}
+	if req.MaxPrice > 0 && req.MinPrice > req.MaxPrice {
+		return status.Error(codes.InvalidArgument, "The minimum price cannot be more than the maximum price")
+	}
+	if req.MinDiscount < 0 || req.MinDiscount > 100 {
+		return status.Error(codes.InvalidArgument, "The minimum discount must be between 0 and 100 percent")
+	}
+	if req.MinRating < 0 || req.MinRating > 5 {
+		return status.Error(codes.InvalidArgument, "The minimum rating must be between 0 and 5 stars")
+	}
+	if req.Limit < 0 || req.Limit > maxProductPageSize {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("The limit must be between 1 and %d", maxProductPageSize))
+	}
+	if req.Offset < 0 {
+		return status.Error(codes.InvalidArgument, "The offset cannot be negative")
+	}
+	return nil
+}
+
+// filterProducts narrows query to the products matching the search and
+// filters of req and its attribute filters, leaving out the filter of the
+// facet named skip. Prices are filtered on the listed discounted price; sales
+// from price rules are applied to the results afterwards.
+func filterProducts(query *gorm.DB, req *pb.GetAllProductRequest, attributes []attributeFilter, skip string) *gorm.DB {
+	query = searchProducts(query, req.Search)
+
+	if req.ProductStatus != "" {
+		query = query.Where("product_status = ?", strings.ToUpper(req.ProductStatus))
+	}
+	if skip != facetCategory && len(req.Categories) > 0 {
+		query = query.Where("category IN ?", req.Categories)
+	}
+	if skip != facetPrice {
+		if req.MinPrice > 0 {
+			query = query.Where("discounted_price >= ?", req.MinPrice)
+		}
+		if req.MaxPrice > 0 {
+			query = query.Where("discounted_price <= ?", req.MaxPrice)
+		}
+	}
+	if skip != facetStock && req.InStock {
+		query = query.Where("current_quantity > 0")
+	}
+	if skip != facetDiscount && req.MinDiscount > 0 {
+		query = query.Where(productDiscountSQL+" >= ?", req.MinDiscount)
+	}
+	if skip != facetRating && req.MinRating > 0 {
+		query = query.Where(productRatingSQL+" >= ?", req.MinRating)
+	}
+
+	return filterAttributes(query, attributes, skip)
+}
+
+// facetRow is one value of a facet and how many products have it.
+type facetRow struct {
+	Value string
+	Count int64
+}
+
+// productFacets counts the products of each facet value for req.
+func (s *ChronexAdminService) productFacets(req *pb.GetAllProductRequest, attributes []attributeFilter) (*pb.ProductFacets, error) {
+	facets := &pb.ProductFacets{
+		Categories:  []*pb.FacetCount{},
+		PriceRanges: []*pb.PriceRangeFacet{},
+		Discounts:   []*pb.FacetCount{},
+		Ratings:     []*pb.FacetCount{},
+		Attributes:  []*pb.AttributeFacet{},
+	}
+	products := func(skip string) *gorm.DB {
+		return filterProducts(s.DB.Model(&models.ProductData{}), req, attributes, skip)
+	}
+
+	// Categories
+	var categories []facetRow
+	if err := products(facetCategory).Select("category AS value, count(*) AS count").
+		Group("category").Order("count DESC, value ASC").Scan(&categories).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to count categories: %v", err))
+	}
+	for _, category := range categories {
+		facets.Categories = append(facets.Categories, &pb.FacetCount{Value: category.Value, Count: category.Count})
+	}
+
+	// Price ranges, numbered by width_bucket from 0 below the first bound
+	if err := products(facetPrice).Select("coalesce(min(discounted_price), 0), coalesce(max(discounted_price), 0)").
+		Row().Scan(&facets.MinPrice, &facets.MaxPrice); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to count prices: %v", err))
+	}
+	bounds := make([]string, len(priceFacetBounds))
+	for i, bound := range priceFacetBounds {
+		bounds[i] = strconv.FormatFloat(bound, 'f', -1, 64)
+	}
+	var buckets []struct {
+		Bucket int
+		Count  int64
+	}
+	if err := products(facetPrice).
+		Select("width_bucket(discounted_price, ARRAY[" + strings.Join(bounds, ",") + "]::numeric[]) AS bucket, count(*) AS count").
+		Group("bucket").Order("bucket ASC").Scan(&buckets).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to count prices: %v", err))
+	}
+	for _, bucket := range buckets {
+		facets.PriceRanges = append(facets.PriceRanges, priceRangeFacet(bucket.Bucket, bucket.Count))
+	}
+
+	// Stock
+	if err := products(facetStock).Where("current_quantity > 0").Count(&facets.InStock).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to count stock: %v", err))
+	}
+
+	// Discounts and ratings, each step counting the products at or above it
+	var err error
+	if facets.Discounts, err = countFacetSteps(products(facetDiscount), productDiscountSQL, discountFacetSteps); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to count discounts: %v", err))
+	}
+	if facets.Ratings, err = countFacetSteps(products(facetRating), productRatingSQL, ratingFacetSteps); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to count ratings: %v", err))
+	}
+
+	// Attributes of the chosen categories, or of any category when none is
+	// chosen
+	definitions, err := loadProductAttributes(s.DB)
+	if err != nil {
+		return nil, err
+	}
+	for _, definition := range definitions {
+		if definition.AttributeStatus != "ACT" || !appliesToAny(definition, req.Categories) {
+			continue
+		}
+		code := definition.AttributeCode
+		facet := &pb.AttributeFacet{
+			Code:   code,
+			Name:   definition.AttributeName,
+			Type:   definition.AttributeType,
+			Unit:   definition.Unit,
+			Values: []*pb.FacetCount{},
+		}
+
+		if definition.AttributeType == "NUMBER" {
+			var min, max *float64
+			if err := products(attributeFacet(code)).Select("min("+attributeNumberSQL+"), max("+attributeNumberSQL+")", code, code, code, code).
+				Row().Scan(&min, &max); err != nil {
+				return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to count %s: %v", definition.AttributeName, err))
+			}
+			if min == nil {
+				continue
+			}
+			facet.Min, facet.Max = *min, *max
+		} else {
+			var values []facetRow
+			if err := products(attributeFacet(code)).Where("attributes->(?::text) IS NOT NULL", code).
+				Select("attributes->>(?::text) AS value, count(*) AS count", code).
+				Group("value").Order("count DESC, value ASC").Scan(&values).Error; err != nil {
+				return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to count %s: %v", definition.AttributeName, err))
+			}
+			if len(values) == 0 {
+				continue
+			}
+			for _, value := range values {
+				facet.Values = append(facet.Values, &pb.FacetCount{Value: value.Value, Count: value.Count})
+			}
+		}
+		facets.Attributes = append(facets.Attributes, facet)
+	}
+
+	return facets, nil
+}
+
+// priceRangeFacet is the price range of a width_bucket number over
+// priceFacetBounds. The range below the first bound has no minimum and the
+// range from the last bound up has no maximum.
+func priceRangeFacet(bucket int, count int64) *pb.PriceRangeFacet {
+	priceRange := &pb.PriceRangeFacet{Count: count}
+	if bucket > 0 {
+		priceRange.Min = priceFacetBounds[bucket-1]
+	}
+	if bucket < len(priceFacetBounds) {
+		priceRange.Max = priceFacetBounds[bucket]
+	}
+	return priceRange
+}
+
+// appliesToAny reports whether attribute applies to one of categories, or to
+// any category when none are given.
+func appliesToAny(attribute models.ProductAttributeData, categories []string) bool {
+	if len(categories) == 0 {
+		return true
+	}
+	for _, category := range categories {
+		if attribute.AppliesTo(category) {
+			return true
+		}
+	}
+	return false
+}
+
+// countFacetSteps counts the products whose value is at least each step.
+func countFacetSteps(query *gorm.DB, valueSQL string, steps []float64) ([]*pb.FacetCount, error) {
+	columns := make([]string, len(steps))
+	args := make([]interface{}, len(steps))
+	for i, step := range steps {
+		columns[i] = fmt.Sprintf("count(*) FILTER (WHERE value >= ?) AS step%d", i)
+		args[i] = step
+	}
+
+	// Work out each product's value once, then count it against every step
+	values := query.Select(valueSQL + " AS value")
+	counts := make([]int64, len(steps))
+	dest := make([]interface{}, len(steps))
+	for i := range counts {
+		dest[i] = &counts[i]
+	}
+	if err := query.Session(&gorm.Session{NewDB: true}).Table("(?) AS facet_values", values).
+		Select(strings.Join(columns, ", "), args...).Row().Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	facets := make([]*pb.FacetCount, len(steps))
+	for i, step := range steps {
+		facets[i] = &pb.FacetCount{Value: strconv.FormatFloat(step, 'f', -1, 64), Count: counts[i]}
+	}
+	return facets, nil
+}
+
+// productRating is the average star rating of a product's shown reviews.
+type productRating struct {
+	ProductId string
+	Average   float64
+	Count     int64
+}
+
+// loadProductRatings returns the ratings of the given products by id.
+// Products without reviews are left out.
+func loadProductRatings(db *gorm.DB, productIds []string) (map[string]productRating, error) {
+	ratings := map[string]productRating{}
+	if len(productIds) == 0 {
+		return ratings, nil
+	}
+
+	var rows []productRating
+	if err := db.Model(&models.ReviewsData{}).
+		Select("product_id, avg(reviews_star_rating) AS average, count(*) AS count").
+		Where("product_id IN ? AND reviews_status = ?", productIds, "ACT").
+		Group("product_id").Scan(&rows).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch product ratings: %v", err))
+	}
+	for _, row := range rows {
+		ratings[row.ProductId] = row
+	}
+	return ratings, nil
+}
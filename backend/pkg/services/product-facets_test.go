@@ -0,0 +1,86 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"encoding/json"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckProductFilters(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.GetAllProductRequest
+		code codes.Code
+	}{
+		{name: "no filters", req: &pb.GetAllProductRequest{}},
+		{name: "all filters", req: &pb.GetAllProductRequest{MinPrice: 100, MaxPrice: 200, MinDiscount: 20, MinRating: 4, Limit: maxProductPageSize, Offset: 20}},
+		{name: "minimum price only", req: &pb.GetAllProductRequest{MinPrice: 100}},
+		{name: "equal prices", req: &pb.GetAllProductRequest{MinPrice: 100, MaxPrice: 100}},
+		{name: "negative price", req: &pb.GetAllProductRequest{MinPrice: -1}, code: codes.InvalidArgument},
+		{name: "minimum over maximum", req: &pb.GetAllProductRequest{MinPrice: 200, MaxPrice: 100}, code: codes.InvalidArgument},
+		{name: "discount over 100", req: &pb.GetAllProductRequest{MinDiscount: 101}, code: codes.InvalidArgument},
+		{name: "rating over 5", req: &pb.GetAllProductRequest{MinRating: 6}, code: codes.InvalidArgument},
+		{name: "limit too large", req: &pb.GetAllProductRequest{Limit: maxProductPageSize + 1}, code: codes.InvalidArgument},
+		{name: "negative offset", req: &pb.GetAllProductRequest{Offset: -1}, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkProductFilters(tt.req); status.Code(err) != tt.code {
+				t.Errorf("checkProductFilters() error = %v, want code %v", err, tt.code)
+			}
+		})
+	}
+}
+
+func TestPriceRangeFacet(t *testing.T) {
+	tests := []struct {
+		name   string
+		bucket int
+		min    float64
+		max    float64
+	}{
+		{name: "below the first bound", bucket: 0, max: 5000},
+		{name: "between the first bounds", bucket: 1, min: 5000, max: 10000},
+		{name: "between the last bounds", bucket: len(priceFacetBounds) - 1, min: 50000, max: 100000},
+		{name: "from the last bound up", bucket: len(priceFacetBounds), min: 100000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := priceRangeFacet(tt.bucket, 3)
+			if got.Min != tt.min || got.Max != tt.max || got.Count != 3 {
+				t.Errorf("priceRangeFacet(%d) = %v-%v (%d), want %v-%v (3)", tt.bucket, got.Min, got.Max, got.Count, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestAppliesToAny(t *testing.T) {
+	everywhere := models.ProductAttributeData{AttributeCode: "material"}
+	watchesOnly := models.ProductAttributeData{AttributeCode: "case_diameter", Categories: json.RawMessage(`["Watches"]`)}
+
+	tests := []struct {
+		name       string
+		attribute  models.ProductAttributeData
+		categories []string
+		want       bool
+	}{
+		{name: "no categories chosen", attribute: watchesOnly, want: true},
+		{name: "attribute of every category", attribute: everywhere, categories: []string{"Straps"}, want: true},
+		{name: "one of the chosen categories", attribute: watchesOnly, categories: []string{"Straps", "watches"}, want: true},
+		{name: "none of the chosen categories", attribute: watchesOnly, categories: []string{"Straps", "Boxes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := appliesToAny(tt.attribute, tt.categories); got != tt.want {
+				t.Errorf("appliesToAny(%v) = %v, want %v", tt.categories, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,376 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// orderCustomer is the `customer` JSON stored on every order.
+type orderCustomer struct {
+	FirstName     string `json:"firstName"`
+	LastName      string `json:"lastName"`
+	EmailAddress  string `json:"emailAddress"`
+	ContactNumber string `json:"contactNumber"`
+}
+
+// customerStats is a customer row with its lifetime totals over orders that
+// were not cancelled.
+type customerStats struct {
+	models.CustomerData
+	OrderCount    int64
+	LifetimeSpend float64
+	LastOrderAt   *time.Time
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// normalizeContactNumber keeps only the digits and writes Philippine mobile
+// numbers in the local 09XXXXXXXXX form, so +63 917 123 4567, 639171234567
+// and 0917-123-4567 all match.
+func normalizeContactNumber(number string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, number)
+
+	switch {
+	case len(digits) == 12 && strings.HasPrefix(digits, "639"):
+		return "0" + digits[2:]
+	case len(digits) == 10 && strings.HasPrefix(digits, "9"):
+		return "0" + digits
+	}
+
+	return digits
+}
+
+// canonicalJSON re-encodes value with sorted keys so equal addresses compare
+// equal regardless of key order or whitespace.
+func canonicalJSON(value json.RawMessage) ([]byte, error) {
+	var decoded interface{}
+	if err := json.Unmarshal(value, &decoded); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(decoded)
+}
+
+// appendAddress adds address to the customer's address list unless an equal
+// one is already there.
+func appendAddress(addresses json.RawMessage, address json.RawMessage) (json.RawMessage, error) {
+	list := []json.RawMessage{}
+	if len(addresses) > 0 {
+		if err := json.Unmarshal(addresses, &list); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(address) == 0 || string(address) == "null" {
+		return json.Marshal(list)
+	}
+
+	canonical, err := canonicalJSON(address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid complete address: %v", err))
+	}
+
+	for _, existing := range list {
+		existingCanonical, err := canonicalJSON(existing)
+		if err == nil && bytes.Equal(existingCanonical, canonical) {
+			return json.Marshal(list)
+		}
+	}
+
+	return json.Marshal(append(list, json.RawMessage(canonical)))
+}
+
+// findCustomer looks a customer up by normalized email first and contact
+// number second, locking the row for the rest of the transaction.
+func findCustomer(tx *gorm.DB, email string, contactNumber string) (models.CustomerData, bool, error) {
+	lookups := []struct {
+		column string
+		value  string
+	}{
+		{"email_address", email},
+		{"contact_number", contactNumber},
+	}
+
+	for _, lookup := range lookups {
+		if lookup.value == "" {
+			continue
+		}
+
+		var customer models.CustomerData
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(lookup.column+" = ?", lookup.value).
+			Limit(1).
+			Find(&customer)
+		if result.Error != nil {
+			return models.CustomerData{}, false, result.Error
+		}
+		if result.RowsAffected > 0 {
+			return customer, true, nil
+		}
+	}
+
+	return models.CustomerData{}, false, nil
+}
+
+// claimableContact reports whether value can be written to column on the given
+// customer without taking it from another customer.
+func claimableContact(tx *gorm.DB, column string, value string, customerId uuid.UUID) (bool, error) {
+	var count int64
+	if err := tx.Model(&models.CustomerData{}).
+		Where(column+" = ? AND customer_id != ?", value, customerId).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count == 0, nil
+}
+
+// parseOrderCustomer decodes the order `customer` JSON and returns it with its
+// normalized email address and contact number, the keys customers are
+// deduplicated on.
+func parseOrderCustomer(customerJSON string) (orderCustomer, string, string, error) {
+	var c orderCustomer
+	if err := json.Unmarshal([]byte(customerJSON), &c); err != nil {
+		return orderCustomer{}, "", "", status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid customer: %v", err))
+	}
+
+	return c, normalizeEmail(c.EmailAddress), normalizeContactNumber(c.ContactNumber), nil
+}
+
+// updateCustomerName copies the name an order was placed under onto the
+// customer record, keeping the recorded name for any part left blank.
+func updateCustomerName(customer *models.CustomerData, c orderCustomer) {
+	if name := strings.TrimSpace(c.FirstName); name != "" {
+		customer.FirstName = name
+	}
+	if name := strings.TrimSpace(c.LastName); name != "" {
+		customer.LastName = name
+	}
+}
+
+// matchCustomer links an order to a customer record, deduplicating on the
+// normalized email address and contact number. A new customer is created when
+// neither matches; otherwise the matched record picks up the latest name, any
+// contact detail it was missing and the delivery address. Orders without an
+// email or contact number are left unlinked.
+func matchCustomer(tx *gorm.DB, customerJSON string, address json.RawMessage) (*uuid.UUID, error) {
+	c, email, contactNumber, err := parseOrderCustomer(customerJSON)
+	if err != nil {
+		return nil, err
+	}
+	if email == "" && contactNumber == "" {
+		return nil, nil
+	}
+
+	customer, found, err := findCustomer(tx, email, contactNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		addresses, err := appendAddress(nil, address)
+		if err != nil {
+			return nil, err
+		}
+
+		customer = models.CustomerData{
+			FirstName:     strings.TrimSpace(c.FirstName),
+			LastName:      strings.TrimSpace(c.LastName),
+			EmailAddress:  email,
+			ContactNumber: contactNumber,
+			Addresses:     addresses,
+		}
+
+		// A concurrent order for the same customer may win the insert; fall back to its row
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&customer)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected > 0 {
+			return &customer.CustomerId, nil
+		}
+
+		customer, found, err = findCustomer(tx, email, contactNumber)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, status.Error(codes.Internal, "Failed to match customer")
+		}
+	}
+
+	// Keep the latest name the customer ordered with
+	updateCustomerName(&customer, c)
+
+	// Fill in contact details the record was missing unless another customer owns them
+	if customer.EmailAddress == "" && email != "" {
+		claimable, err := claimableContact(tx, "email_address", email, customer.CustomerId)
+		if err != nil {
+			return nil, err
+		}
+		if claimable {
+			customer.EmailAddress = email
+		}
+	}
+	if customer.ContactNumber == "" && contactNumber != "" {
+		claimable, err := claimableContact(tx, "contact_number", contactNumber, customer.CustomerId)
+		if err != nil {
+			return nil, err
+		}
+		if claimable {
+			customer.ContactNumber = contactNumber
+		}
+	}
+
+	customer.Addresses, err = appendAddress(customer.Addresses, address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Save(&customer).Error; err != nil {
+		return nil, err
+	}
+
+	return &customer.CustomerId, nil
+}
+
+func customerToPb(data customerStats) *pb.CustomerData {
+	customerData := &pb.CustomerData{
+		CustomerId:    data.GetCustomerId().String(),
+		FirstName:     data.GetFirstName(),
+		LastName:      data.GetLastName(),
+		EmailAddress:  data.GetEmailAddress(),
+		ContactNumber: data.GetContactNumber(),
+		Addresses:     string(data.GetAddresses()),
+		OrderCount:    data.OrderCount,
+		LifetimeSpend: data.LifetimeSpend,
+		CreatedBy:     data.CreatedBy.String(),
+		CreatedAt:     data.CreatedAt.Unix(),
+		UpdatedBy:     data.UpdatedBy.String(),
+		UpdatedAt:     data.UpdatedAt.Unix(),
+	}
+	if data.LastOrderAt != nil {
+		customerData.LastOrderAt = data.LastOrderAt.Unix()
+	}
+
+	return customerData
+}
+
+// customerStatsQuery selects customers with their order count, spend and last
+// order date. Cancelled and deleted orders do not count.
+func customerStatsQuery(db *gorm.DB) *gorm.DB {
+	return db.Model(&models.CustomerData{}).
+		Select("chronex_customer.*, " +
+			"COUNT(o.order_id) AS order_count, " +
+			"COALESCE(SUM(o.total), 0) AS lifetime_spend, " +
+			"MAX(o.created_at) AS last_order_at").
+		Joins("LEFT JOIN chronex_product_order o ON o.customer_id = chronex_customer.customer_id " +
+			"AND o.order_status != 'CAN' AND o.deleted_at IS NULL").
+		Group("chronex_customer.customer_id")
+}
+
+func (s *ChronexAdminService) GetAllCustomer(ctx context.Context, req *pb.GetAllCustomerRequest) (*pb.GetAllCustomerResponse, error) {
+	response := &pb.GetAllCustomerResponse{
+		CustomerData: []*pb.CustomerData{},
+	}
+
+	// Build your query based on the request parameters
+	query := customerStatsQuery(s.DB)
+
+	// Handle sorting
+	switch strings.ToUpper(req.SortOption) {
+	case "ATOZ":
+		query = query.Order("chronex_customer.first_name ASC, chronex_customer.last_name ASC")
+	case "ZTOA":
+		query = query.Order("chronex_customer.first_name DESC, chronex_customer.last_name DESC")
+	case "SPEND_HIGH_TO_LOW":
+		query = query.Order("lifetime_spend DESC")
+	case "ORDERS_HIGH_TO_LOW":
+		query = query.Order("order_count DESC")
+	case "LAST_ORDER_DATE":
+		query = query.Order("last_order_at DESC NULLS LAST")
+	default:
+		query = query.Order("chronex_customer.created_at DESC")
+	}
+
+	// Handle searching
+	if req.Search != "" {
+		searchParam := "%" + strings.ToLower(req.Search) + "%"
+		conditions := "(chronex_customer.first_name ILIKE ? OR " +
+			"chronex_customer.last_name ILIKE ? OR " +
+			"chronex_customer.email_address ILIKE ?"
+		params := []interface{}{searchParam, searchParam, searchParam}
+
+		// Searches that look like a phone number also match the normalized form
+		if contactNumber := normalizeContactNumber(req.Search); contactNumber != "" && strings.IndexFunc(req.Search, unicode.IsLetter) < 0 {
+			conditions += " OR chronex_customer.contact_number LIKE ?"
+			params = append(params, "%"+contactNumber+"%")
+		}
+
+		query = query.Where(conditions+")", params...)
+	}
+
+	// Execute the query
+	var customerDataValue []customerStats
+	if err := query.Scan(&customerDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch customer data: %v", err))
+	}
+
+	// Map the retrieved data to protobuf message
+	for _, data := range customerDataValue {
+		response.CustomerData = append(response.CustomerData, customerToPb(data))
+	}
+
+	return response, nil
+}
+
+func (s *ChronexAdminService) GetCustomerById(ctx context.Context, req *pb.GetCustomerByIdRequest) (*pb.GetCustomerByIdResponse, error) {
+	customerId, err := uuid.Parse(req.CustomerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid customer ID %s", req.CustomerId))
+	}
+
+	var customerDataValue []customerStats
+	if err := customerStatsQuery(s.DB).Where("chronex_customer.customer_id = ?", customerId).Scan(&customerDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch customer data: %v", err))
+	}
+	if len(customerDataValue) == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("Customer with ID %s not found", req.CustomerId))
+	}
+
+	// Retrieve the customer's orders, newest first
+	var orderDataValue []models.OrderData
+	if err := s.DB.Where("customer_id = ?", customerId).Order("created_at DESC").Find(&orderDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch order data: %v", err))
+	}
+
+	response := &pb.GetCustomerByIdResponse{
+		CustomerData: customerToPb(customerDataValue[0]),
+		OrderData:    []*pb.OrderData{},
+	}
+	for _, data := range orderDataValue {
+		response.OrderData = append(response.OrderData, orderToPb(data))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"api/pkg/models"
+	"encoding/json"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNormalizeContactNumber(t *testing.T) {
+	tests := []struct {
+		number string
+		want   string
+	}{
+		{number: "+63 917 123 4567", want: "09171234567"},
+		{number: "639171234567", want: "09171234567"},
+		{number: "0917-123-4567", want: "09171234567"},
+		{number: "917 123 4567", want: "09171234567"},
+		{number: "(02) 8123 4567", want: "0281234567"},
+		{number: "+1 415 555 0100", want: "14155550100"},
+		{number: "n/a", want: ""},
+		{number: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.number, func(t *testing.T) {
+			if got := normalizeContactNumber(tt.number); got != tt.want {
+				t.Errorf("normalizeContactNumber(%q) = %q, want %q", tt.number, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseOrderCustomer(t *testing.T) {
+	tests := []struct {
+		name              string
+		customer          string
+		wantEmail         string
+		wantContactNumber string
+		code              codes.Code
+	}{
+		{
+			name:              "both keys normalized",
+			customer:          `{"firstName":"Juan","emailAddress":" Juan@Example.COM ","contactNumber":"+63 917 123 4567"}`,
+			wantEmail:         "juan@example.com",
+			wantContactNumber: "09171234567",
+		},
+		{name: "email only", customer: `{"emailAddress":"juan@example.com"}`, wantEmail: "juan@example.com"},
+		{name: "no keys", customer: `{"firstName":"Juan"}`},
+		{name: "not JSON", customer: `{"firstName"`, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, email, contactNumber, err := parseOrderCustomer(tt.customer)
+			if status.Code(err) != tt.code {
+				t.Fatalf("parseOrderCustomer() error = %v, want code %v", err, tt.code)
+			}
+			if email != tt.wantEmail || contactNumber != tt.wantContactNumber {
+				t.Errorf("parseOrderCustomer() = %q, %q, want %q, %q", email, contactNumber, tt.wantEmail, tt.wantContactNumber)
+			}
+		})
+	}
+}
+
+func TestUpdateCustomerName(t *testing.T) {
+	tests := []struct {
+		name      string
+		order     orderCustomer
+		wantFirst string
+		wantLast  string
+	}{
+		{name: "latest name kept", order: orderCustomer{FirstName: " Juanito ", LastName: "Cruz"}, wantFirst: "Juanito", wantLast: "Cruz"},
+		{name: "blank parts ignored", order: orderCustomer{FirstName: "  ", LastName: "Cruz"}, wantFirst: "Juan", wantLast: "Cruz"},
+		{name: "no name", wantFirst: "Juan", wantLast: "Dela Cruz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			customer := models.CustomerData{FirstName: "Juan", LastName: "Dela Cruz"}
+			updateCustomerName(&customer, tt.order)
+			if customer.FirstName != tt.wantFirst || customer.LastName != tt.wantLast {
+				t.Errorf("name = %q %q, want %q %q", customer.FirstName, customer.LastName, tt.wantFirst, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestAppendAddress(t *testing.T) {
+	home := `{"address":"12 Mabini St","city":"Makati"}`
+
+	tests := []struct {
+		name      string
+		addresses string
+		address   string
+		want      int
+		wantErr   bool
+	}{
+		{name: "first address", addresses: "", address: home, want: 1},
+		{name: "same address, other key order", addresses: `[` + home + `]`, address: `{ "city":"Makati", "address":"12 Mabini St" }`, want: 1},
+		{name: "new address", addresses: `[` + home + `]`, address: `{"address":"5 Rizal Ave","city":"Pasig"}`, want: 2},
+		{name: "no address", addresses: `[` + home + `]`, address: "null", want: 1},
+		{name: "broken address", addresses: "", address: `{"address"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := appendAddress(json.RawMessage(tt.addresses), json.RawMessage(tt.address))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("appendAddress() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			var list []json.RawMessage
+			if err := json.Unmarshal(got, &list); err != nil {
+				t.Fatalf("appendAddress() = %s, not a list: %v", got, err)
+			}
+			if len(list) != tt.want {
+				t.Errorf("appendAddress() = %s, want %d addresses", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func parseOptionalUUID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func unixToTime(value int64) *time.Time {
+	if value == 0 {
+		return nil
+	}
+
+	t := time.Unix(value, 0)
+	return &t
+}
+
+func freebiesRuleToPb(data models.FreebiesRuleData) *pb.FreebiesRuleData {
+	return &pb.FreebiesRuleData{
+		FreebiesRuleId:      data.GetFreebiesRuleId().String(),
+		RuleName:            data.RuleName,
+		MinSpend:            data.MinSpend,
+		MinQuantity:         data.MinQuantity,
+		Category:            data.Category,
+		ProductId:           data.ProductId,
+		FreebiesId:          data.FreebiesId.String(),
+		AlternateFreebiesId: data.GetAlternateFreebiesId(),
+		FreebiesQuantity:    data.FreebiesQuantity,
+		PerCustomerLimit:    data.PerCustomerLimit,
+		Priority:            data.Priority,
+		Exclusive:           data.Exclusive,
+		StartAt:             data.GetStartAt(),
+		EndAt:               data.GetEndAt(),
+		RuleStatus:          data.RuleStatus,
+		CreatedBy:           data.CreatedBy.String(),
+		CreatedAt:           data.CreatedAt.Unix(),
+		UpdatedBy:           data.UpdatedBy.String(),
+		UpdatedAt:           data.UpdatedAt.Unix(),
+	}
+}
+
+func (s *ChronexAdminService) SaveFreebiesRule(ctx context.Context, req *pb.SaveFreebiesRuleRequest) (*pb.SaveFreebiesRuleResponse, error) {
+	freebiesId, err := uuid.Parse(req.FreebiesId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid freebies ID %s", req.FreebiesId))
+	}
+
+	alternateFreebiesId, err := parseOptionalUUID(req.AlternateFreebiesId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid alternate freebies ID %s", req.AlternateFreebiesId))
+	}
+
+	ruleStatus := req.RuleStatus
+	if ruleStatus == "" {
+		ruleStatus = "ACT"
+	}
+
+	// Create a new FreebiesRuleData instance
+	freebiesRuleData := models.FreebiesRuleData{
+		RuleName:            req.RuleName,
+		MinSpend:            req.MinSpend,
+		MinQuantity:         req.MinQuantity,
+		Category:            req.Category,
+		ProductId:           req.ProductId,
+		FreebiesId:          freebiesId,
+		AlternateFreebiesId: alternateFreebiesId,
+		FreebiesQuantity:    req.FreebiesQuantity,
+		PerCustomerLimit:    req.PerCustomerLimit,
+		Priority:            req.Priority,
+		Exclusive:           req.Exclusive,
+		StartAt:             unixToTime(req.StartAt),
+		EndAt:               unixToTime(req.EndAt),
+		RuleStatus:          ruleStatus,
+	}
+
+	// Save the data to the database using GORM
+	if err := s.DB.Create(&freebiesRuleData).Error; err != nil {
+		log.Printf("Error saving Freebies Rule data: %v", err)
+		return nil, err
+	}
+
+	return &pb.SaveFreebiesRuleResponse{
+		FreebiesRuleData: freebiesRuleToPb(freebiesRuleData),
+	}, nil
+}
+
+func (s *ChronexAdminService) GetAllFreebiesRule(ctx context.Context, req *pb.GetAllFreebiesRuleRequest) (*pb.GetAllFreebiesRuleResponse, error) {
+	response := &pb.GetAllFreebiesRuleResponse{
+		FreebiesRuleData: []*pb.FreebiesRuleData{},
+	}
+
+	// Build your query based on the request parameters
+	query := s.DB.Model(&models.FreebiesRuleData{}).Order("priority DESC, created_at ASC")
+
+	// Filter by status
+	if req.RuleStatus != "" {
+		query = query.Where("rule_status = ?", req.RuleStatus)
+	} else {
+		query = query.Where("rule_status != ?", "DEL")
+	}
+
+	// Execute the query
+	var freebiesRuleDataValue []models.FreebiesRuleData
+	if err := query.Find(&freebiesRuleDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch freebies rule data: %v", err))
+	}
+
+	// Map the retrieved data to protobuf message
+	for _, data := range freebiesRuleDataValue {
+		response.FreebiesRuleData = append(response.FreebiesRuleData, freebiesRuleToPb(data))
+	}
+
+	return response, nil
+}
+
+func (s *ChronexAdminService) UpdateFreebiesRule(ctx context.Context, req *pb.UpdateFreebiesRuleRequest) (*pb.UpdateFreebiesRuleResponse, error) {
+	mask, err := newUpdateMask(req.ProtoReflect().Descriptor(), req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve existing FreebiesRuleData from the database
+	var existingFreebiesRuleData models.FreebiesRuleData
+	if err := s.DB.First(&existingFreebiesRuleData, "freebies_rule_id = ?", req.GetFreebiesRuleId()).Error; err != nil {
+		log.Printf("Error retrieving Freebies Rule data: %v", err)
+		return nil, err
+	}
+
+	// Update the existing FreebiesRuleData with the provided or masked values
+	if mask.has("ruleName", req.RuleName != "") {
+		existingFreebiesRuleData.RuleName = req.RuleName
+	}
+	if mask.has("minSpend", req.MinSpend != 0) {
+		existingFreebiesRuleData.MinSpend = req.MinSpend
+	}
+	if mask.has("minQuantity", req.MinQuantity != 0) {
+		existingFreebiesRuleData.MinQuantity = req.MinQuantity
+	}
+	if mask.has("category", req.Category != "") {
+		existingFreebiesRuleData.Category = req.Category
+	}
+	if mask.has("productId", req.ProductId != "") {
+		existingFreebiesRuleData.ProductId = req.ProductId
+	}
+	if mask.has("freebiesId", req.FreebiesId != "") {
+		freebiesId, err := uuid.Parse(req.FreebiesId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid freebies ID %s", req.FreebiesId))
+		}
+		existingFreebiesRuleData.FreebiesId = freebiesId
+	}
+	if mask.has("alternateFreebiesId", req.AlternateFreebiesId != "") {
+		alternateFreebiesId, err := parseOptionalUUID(req.AlternateFreebiesId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid alternate freebies ID %s", req.AlternateFreebiesId))
+		}
+		existingFreebiesRuleData.AlternateFreebiesId = alternateFreebiesId
+	}
+	if mask.has("freebiesQuantity", req.FreebiesQuantity != 0) {
+		existingFreebiesRuleData.FreebiesQuantity = req.FreebiesQuantity
+	}
+	if mask.has("perCustomerLimit", req.PerCustomerLimit != 0) {
+		existingFreebiesRuleData.PerCustomerLimit = req.PerCustomerLimit
+	}
+	if mask.has("priority", req.Priority != 0) {
+		existingFreebiesRuleData.Priority = req.Priority
+	}
+	if mask.has("exclusive", req.Exclusive) {
+		existingFreebiesRuleData.Exclusive = req.Exclusive
+	}
+	if mask.has("startAt", req.StartAt != 0) {
+		existingFreebiesRuleData.StartAt = unixToTime(req.StartAt)
+	}
+	if mask.has("endAt", req.EndAt != 0) {
+		existingFreebiesRuleData.EndAt = unixToTime(req.EndAt)
+	}
+
+	// Save the updated data back to the database using GORM
+	if err := s.DB.Save(&existingFreebiesRuleData).Error; err != nil {
+		log.Printf("Error updating Freebies Rule data: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdateFreebiesRuleResponse{
+		FreebiesRuleData: freebiesRuleToPb(existingFreebiesRuleData),
+	}, nil
+}
+
+func (s *ChronexAdminService) UpdateFreebiesRuleStatus(ctx context.Context, req *pb.UpdateFreebiesRuleStatusRequest) (*pb.UpdateFreebiesRuleStatusResponse, error) {
+	// Retrieve existing FreebiesRuleData from the database
+	var existingFreebiesRuleData models.FreebiesRuleData
+	if err := s.DB.First(&existingFreebiesRuleData, "freebies_rule_id = ?", req.GetFreebiesRuleId()).Error; err != nil {
+		log.Printf("Error retrieving Freebies Rule data: %v", err)
+		return nil, err
+	}
+
+	if req.RuleStatus != "" {
+		existingFreebiesRuleData.RuleStatus = req.RuleStatus
+	}
+
+	// Save the updated data back to the database using GORM
+	if err := s.DB.Save(&existingFreebiesRuleData).Error; err != nil {
+		log.Printf("Error updating Freebies Rule data: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdateFreebiesRuleStatusResponse{
+		FreebiesRuleData: freebiesRuleToPb(existingFreebiesRuleData),
+	}, nil
+}
+
+// PreviewCart runs the freebies rules, voucher checks and, given an address,
+// the shipping quote against a cart without saving anything so the storefront
+// can show the gift, discount and fees up front.
+func (s *ChronexAdminService) PreviewCart(ctx context.Context, req *pb.PreviewCartRequest) (*pb.PreviewCartResponse, error) {
+	lines, err := parseCart(req.Product)
+	if err != nil {
+		return nil, err
+	}
+
+	productById, subtotal, err := priceCart(s.DB, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	customerEmail := parseCustomerEmail(req.Customer)
+	cartFreebies, err := evaluateFreebiesRules(s.DB, lines, productById, customerEmail, time.Now(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	var voucherDiscount float64
+	if req.VoucherCode != "" {
+		_, voucherDiscount, err = applyVoucher(s.DB, req.VoucherCode, lines, productById, customerEmail, time.Now(), false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Shipping is added once the storefront knows where the order goes
+	var shippingFee float64
+	if req.CompleteAddress != "" {
+		shipping, err := quoteShipping(s.DB, lines, productById, subtotal, rawJSON(req.CompleteAddress))
+		if err != nil {
+			return nil, err
+		}
+		shippingFee = shipping.ShippingFee
+	}
+
+	return &pb.PreviewCartResponse{
+		Subtotal:        subtotal,
+		CartFreebies:    cartFreebies,
+		VoucherDiscount: voucherDiscount,
+		Total:           orderTotal(subtotal, voucherDiscount, shippingFee),
+		ShippingFee:     shippingFee,
+	}, nil
+}
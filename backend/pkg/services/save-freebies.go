@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
@@ -18,16 +19,44 @@ import (
 	// "github.com/aws/aws-sdk-go/aws"
 )
 
+func (s *ChronexAdminService) freebiesToPb(data models.FreebiesData) *pb.FreebiesData {
+	return &pb.FreebiesData{
+		FreebiesId:               data.FreebiesId.String(),
+		FreebiesName:             data.FreebiesName,
+		FreebiesImgKey:           data.FreebiesImgKey,
+		FreebiesImgUrl:           s.imageURL(data.FreebiesImgKey),
+		FreebiesStorePrice:       data.FreebiesStorePrice,
+		FreebiesOriginalQuantity: data.FreebiesOriginalQuantity,
+		FreebiesCurrentQuantity:  data.FreebiesCurrentQuantity,
+		FreebiesStatus:           data.FreebiesStatus,
+		Sku:                      data.Sku,
+		CreatedBy:                data.CreatedBy.String(),
+		CreatedAt:                data.CreatedAt.Unix(),
+		UpdatedBy:                data.UpdatedBy.String(),
+		UpdatedAt:                data.UpdatedAt.Unix(),
+		Version:                  data.GetVersion(),
+	}
+}
+
 func (s *ChronexAdminService) SaveFreebies(ctx context.Context, req *pb.SaveFreebiesRequest) (*pb.SaveFreebiesResponse, error) {
+	sku, err := checkSku(s.DB, &models.FreebiesData{}, "freebies_id", uuid.Nil, req.Sku)
+	if err != nil {
+		return nil, err
+	}
+	imageKey, err := s.freebiesImageKey(ctx, req.FreebiesImgKey, req.FreebiesImg)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create a new FreebiesData instance
 	freebiesData := models.FreebiesData{
 		FreebiesName:             req.FreebiesName,
-		FreebiesImg:              req.FreebiesImg,
+		FreebiesImgKey:           imageKey,
 		FreebiesStorePrice:       req.FreebiesStorePrice,
 		FreebiesOriginalQuantity: req.FreebiesOriginalQuantity,
 		FreebiesCurrentQuantity:  req.FreebiesCurrentQuantity,
 		FreebiesStatus:           req.FreebiesStatus,
+		Sku:                      sku,
 	}
 
 	// Save the data to the database using GORM
@@ -40,15 +69,18 @@ func (s *ChronexAdminService) SaveFreebies(ctx context.Context, req *pb.SaveFree
 	response := &pb.SaveFreebiesResponse{
 		FreebiesData: &pb.FreebiesData{
 			FreebiesName:             freebiesData.FreebiesName,
-			FreebiesImg:              freebiesData.FreebiesImg,
+			FreebiesImgKey:           freebiesData.FreebiesImgKey,
+			FreebiesImgUrl:           s.imageURL(freebiesData.FreebiesImgKey),
 			FreebiesStorePrice:       freebiesData.FreebiesStorePrice,
 			FreebiesOriginalQuantity: freebiesData.FreebiesOriginalQuantity,
 			FreebiesCurrentQuantity:  freebiesData.FreebiesCurrentQuantity,
 			FreebiesStatus:           freebiesData.FreebiesStatus,
+			Sku:                      freebiesData.Sku,
 			CreatedBy:                freebiesData.CreatedBy.String(),
 			CreatedAt:                freebiesData.CreatedAt.Unix(),
 			UpdatedBy:                freebiesData.UpdatedBy.String(),
 			UpdatedAt:                freebiesData.UpdatedAt.Unix(),
+			Version:                  freebiesData.GetVersion(),
 		},
 	}
 
@@ -104,12 +136,9 @@ func (s *ChronexAdminService) GetAllFreebies(ctx context.Context, req *pb.GetAll
 
 	// Handle searching
 	if req.Search != "" {
-		query = query.Where("freebies_name ILIKE ?", "%"+req.Search+"%")
+		query = query.Where("(freebies_name ILIKE ? OR sku ILIKE ?)", "%"+req.Search+"%", "%"+req.Search+"%")
 	}
 
-	// Filter by active status
-	query = query.Where("freebies_status != ?", "DEL")
-
 	// Execute the query
 	var freebiesDataValue []models.FreebiesData
 	if err := query.Find(&freebiesDataValue).Error; err != nil {
@@ -121,15 +150,18 @@ func (s *ChronexAdminService) GetAllFreebies(ctx context.Context, req *pb.GetAll
 		response.FreebiesData = append(response.FreebiesData, &pb.FreebiesData{
 			FreebiesId:               data.FreebiesId.String(),
 			FreebiesName:             data.FreebiesName,
-			FreebiesImg:              data.FreebiesImg,
+			FreebiesImgKey:           data.FreebiesImgKey,
+			FreebiesImgUrl:           s.imageURL(data.FreebiesImgKey),
 			FreebiesStorePrice:       data.FreebiesStorePrice,
 			FreebiesOriginalQuantity: data.FreebiesOriginalQuantity,
 			FreebiesCurrentQuantity:  data.FreebiesCurrentQuantity,
 			FreebiesStatus:           data.FreebiesStatus,
+			Sku:                      data.Sku,
 			CreatedBy:                data.CreatedBy.String(),
 			CreatedAt:                data.CreatedAt.Unix(),
 			UpdatedBy:                data.UpdatedBy.String(),
 			UpdatedAt:                data.UpdatedAt.Unix(),
+			Version:                  data.GetVersion(),
 		})
 	}
 
@@ -158,15 +190,18 @@ func (s *ChronexAdminService) GetAllFreebiesDropdown(ctx context.Context, req *p
 		response.FreebiesData = append(response.FreebiesData, &pb.FreebiesData{
 			FreebiesId:               data.FreebiesId.String(),
 			FreebiesName:             data.FreebiesName,
-			FreebiesImg:              data.FreebiesImg,
+			FreebiesImgKey:           data.FreebiesImgKey,
+			FreebiesImgUrl:           s.imageURL(data.FreebiesImgKey),
 			FreebiesStorePrice:       data.FreebiesStorePrice,
 			FreebiesOriginalQuantity: data.FreebiesOriginalQuantity,
 			FreebiesCurrentQuantity:  data.FreebiesCurrentQuantity,
 			FreebiesStatus:           data.FreebiesStatus,
+			Sku:                      data.Sku,
 			CreatedBy:                data.CreatedBy.String(),
 			CreatedAt:                data.CreatedAt.Unix(),
 			UpdatedBy:                data.UpdatedBy.String(),
 			UpdatedAt:                data.UpdatedAt.Unix(),
+			Version:                  data.GetVersion(),
 		})
 	}
 
@@ -180,7 +215,7 @@ func (s *ChronexAdminService) GetAllFreebiesById(ctx context.Context, req *pb.Ge
 
 	// Fetch the freebie by its ID and status
 	var freebie models.FreebiesData
-	if err := s.DB.Where("freebies_id = ? AND freebies_status != ?", req.FreebiesId, "DEL").Find(&freebie).Error; err != nil {
+	if err := s.DB.Where("freebies_id = ?", req.FreebiesId).Find(&freebie).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, status.Error(codes.NotFound, fmt.Sprintf("Freebies with ID %s not found", req.FreebiesId))
 		}
@@ -191,21 +226,32 @@ func (s *ChronexAdminService) GetAllFreebiesById(ctx context.Context, req *pb.Ge
 	response.FreebiesData = append(response.FreebiesData, &pb.FreebiesData{
 		FreebiesId:               freebie.FreebiesId.String(),
 		FreebiesName:             freebie.FreebiesName,
-		FreebiesImg:              freebie.FreebiesImg,
+		FreebiesImgKey:           freebie.FreebiesImgKey,
+		FreebiesImgUrl:           s.imageURL(freebie.FreebiesImgKey),
 		FreebiesStorePrice:       freebie.FreebiesStorePrice,
 		FreebiesOriginalQuantity: freebie.FreebiesOriginalQuantity,
 		FreebiesCurrentQuantity:  freebie.FreebiesCurrentQuantity,
 		FreebiesStatus:           freebie.FreebiesStatus,
+		Sku:                      freebie.Sku,
 		CreatedBy:                freebie.CreatedBy.String(),
 		CreatedAt:                freebie.CreatedAt.Unix(),
 		UpdatedBy:                freebie.UpdatedBy.String(),
 		UpdatedAt:                freebie.UpdatedAt.Unix(),
+		Version:                  freebie.GetVersion(),
 	})
 
 	return response, nil
 }
 
 func (s *ChronexAdminService) UpdateFreebies(ctx context.Context, req *pb.UpdateFreebiesRequest) (*pb.UpdateFreebiesResponse, error) {
+	mask, err := newUpdateMask(req.ProtoReflect().Descriptor(), req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+	if req.FreebiesStatus == "DEL" {
+		return nil, status.Error(codes.InvalidArgument, "Use DeleteFreebies to move a freebies to the trash")
+	}
+
 	// Retrieve existing FreebiesData from the database
 	var existingFreebiesData models.FreebiesData
 	if err := s.DB.First(&existingFreebiesData, "freebies_id = ?", req.GetFreebiesId()).First(&existingFreebiesData).Error; err != nil {
@@ -213,24 +259,39 @@ func (s *ChronexAdminService) UpdateFreebies(ctx context.Context, req *pb.Update
 		return nil, err
 	}
 
-	// Update the existing FreebiesData with new values if they are not nil
-	if req.FreebiesName != "" {
+	if err := checkVersion(req.ExpectedVersion, existingFreebiesData.Version, "Freebies", req.GetFreebiesId()); err != nil {
+		return nil, err
+	}
+
+	// Update the existing FreebiesData with the provided or masked values
+	if mask.has("freebiesName", req.FreebiesName != "") {
 		existingFreebiesData.FreebiesName = req.FreebiesName
 	}
-	if req.FreebiesImg != nil {
-		existingFreebiesData.FreebiesImg = req.FreebiesImg
+	if mask.has("sku", req.Sku != "") {
+		sku, err := checkSku(s.DB, &models.FreebiesData{}, "freebies_id", existingFreebiesData.FreebiesId, req.Sku)
+		if err != nil {
+			return nil, err
+		}
+		existingFreebiesData.Sku = sku
+	}
+	if mask.has("freebiesImgKey", req.FreebiesImgKey != "") || mask.has("freebiesImg", req.FreebiesImg != nil) {
+		imageKey, err := s.freebiesImageKey(ctx, req.FreebiesImgKey, req.FreebiesImg)
+		if err != nil {
+			return nil, err
+		}
+		existingFreebiesData.FreebiesImgKey = imageKey
 	}
-	if req.FreebiesStorePrice != 0 {
+	if mask.has("freebiesStorePrice", req.FreebiesStorePrice != 0) {
 		existingFreebiesData.FreebiesStorePrice = req.FreebiesStorePrice
 	}
-	if req.FreebiesStatus != "" {
+	if mask.has("freebiesStatus", req.FreebiesStatus != "") {
 		existingFreebiesData.FreebiesStatus = req.FreebiesStatus
 	}
 
 	// Save the updated data back to the database using GORM
-	if err := s.DB.Save(&existingFreebiesData).Error; err != nil {
+	if err := models.SaveVersioned(s.DB, &existingFreebiesData, &existingFreebiesData.Version); err != nil {
 		log.Printf("Error updating Freebies data: %v", err)
-		return nil, err
+		return nil, versionError(err, "Freebies", req.GetFreebiesId())
 	}
 
 	// Create and return the response
@@ -238,15 +299,18 @@ func (s *ChronexAdminService) UpdateFreebies(ctx context.Context, req *pb.Update
 		FreebiesData: &pb.FreebiesData{
 			FreebiesId:               existingFreebiesData.GetFreebiesId().String(),
 			FreebiesName:             existingFreebiesData.GetFreebiesName(),
-			FreebiesImg:              []byte(existingFreebiesData.GetFreebiesImg()),
+			FreebiesImgKey:           existingFreebiesData.FreebiesImgKey,
+			FreebiesImgUrl:           s.imageURL(existingFreebiesData.FreebiesImgKey),
 			FreebiesStorePrice:       existingFreebiesData.GetFreebiesStorePrice(),
 			FreebiesOriginalQuantity: existingFreebiesData.GetFreebiesOriginalQuantity(),
 			FreebiesCurrentQuantity:  existingFreebiesData.GetFreebiesCurrentQuantity(),
 			FreebiesStatus:           existingFreebiesData.GetFreebiesStatus(),
+			Sku:                      existingFreebiesData.Sku,
 			CreatedBy:                existingFreebiesData.CreatedBy.String(),
 			CreatedAt:                existingFreebiesData.CreatedAt.Unix(),
 			UpdatedBy:                existingFreebiesData.UpdatedBy.String(),
 			UpdatedAt:                existingFreebiesData.UpdatedAt.Unix(),
+			Version:                  existingFreebiesData.GetVersion(),
 		},
 	}
 
@@ -254,6 +318,11 @@ func (s *ChronexAdminService) UpdateFreebies(ctx context.Context, req *pb.Update
 }
 
 func (s *ChronexAdminService) UpdateFreebiesQuantity(ctx context.Context, req *pb.UpdateFreebiesQuantityRequest) (*pb.UpdateFreebiesQuantityResponse, error) {
+	mask, err := newUpdateMask(req.ProtoReflect().Descriptor(), req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+
 	// Retrieve existing FreebiesData from the database
 	var existingFreebiesData models.FreebiesData
 	if err := s.DB.First(&existingFreebiesData, "freebies_id = ?", req.GetFreebiesId()).First(&existingFreebiesData).Error; err != nil {
@@ -261,18 +330,22 @@ func (s *ChronexAdminService) UpdateFreebiesQuantity(ctx context.Context, req *p
 		return nil, err
 	}
 
-	// Update the existing FreebiesData with new values if they are not nil
-	if req.FreebiesOriginalQuantity != 0 {
+	if err := checkVersion(req.ExpectedVersion, existingFreebiesData.Version, "Freebies", req.GetFreebiesId()); err != nil {
+		return nil, err
+	}
+
+	// Update the existing FreebiesData with the provided or masked values
+	if mask.has("freebiesOriginalQuantity", req.FreebiesOriginalQuantity != 0) {
 		existingFreebiesData.FreebiesOriginalQuantity = req.FreebiesOriginalQuantity
 	}
-	if req.FreebiesCurrentQuantity != 0 {
+	if mask.has("freebiesCurrentQuantity", req.FreebiesCurrentQuantity != 0) {
 		existingFreebiesData.FreebiesCurrentQuantity = req.FreebiesCurrentQuantity
 	}
 
 	// Save the updated data back to the database using GORM
-	if err := s.DB.Save(&existingFreebiesData).Error; err != nil {
+	if err := models.SaveVersioned(s.DB, &existingFreebiesData, &existingFreebiesData.Version); err != nil {
 		log.Printf("Error updating Freebies data: %v", err)
-		return nil, err
+		return nil, versionError(err, "Freebies", req.GetFreebiesId())
 	}
 
 	// Create and return the response
@@ -280,15 +353,18 @@ func (s *ChronexAdminService) UpdateFreebiesQuantity(ctx context.Context, req *p
 		FreebiesData: &pb.FreebiesData{
 			FreebiesId:               existingFreebiesData.GetFreebiesId().String(),
 			FreebiesName:             existingFreebiesData.GetFreebiesName(),
-			FreebiesImg:              []byte(existingFreebiesData.GetFreebiesImg()),
+			FreebiesImgKey:           existingFreebiesData.FreebiesImgKey,
+			FreebiesImgUrl:           s.imageURL(existingFreebiesData.FreebiesImgKey),
 			FreebiesStorePrice:       existingFreebiesData.GetFreebiesStorePrice(),
 			FreebiesOriginalQuantity: existingFreebiesData.GetFreebiesOriginalQuantity(),
 			FreebiesCurrentQuantity:  existingFreebiesData.GetFreebiesCurrentQuantity(),
 			FreebiesStatus:           existingFreebiesData.GetFreebiesStatus(),
+			Sku:                      existingFreebiesData.Sku,
 			CreatedBy:                existingFreebiesData.CreatedBy.String(),
 			CreatedAt:                existingFreebiesData.CreatedAt.Unix(),
 			UpdatedBy:                existingFreebiesData.UpdatedBy.String(),
 			UpdatedAt:                existingFreebiesData.UpdatedAt.Unix(),
+			Version:                  existingFreebiesData.GetVersion(),
 		},
 	}
 
@@ -303,15 +379,28 @@ func (s *ChronexAdminService) UpdateFreebiesStatus(ctx context.Context, req *pb.
 		return nil, err
 	}
 
+	if err := checkVersion(req.ExpectedVersion, existingFreebiesData.Version, "Freebies", req.GetFreebiesId()); err != nil {
+		return nil, err
+	}
+
+	// "DEL" from older clients moves the freebies to the trash
+	if req.FreebiesStatus == "DEL" {
+		deleted, err := s.DeleteFreebies(ctx, &pb.DeleteFreebiesRequest{FreebiesId: req.GetFreebiesId()})
+		if err != nil {
+			return nil, err
+		}
+		return &pb.UpdateFreebiesStatusResponse{FreebiesData: deleted.FreebiesData}, nil
+	}
+
 	// Update the existing FreebiesData with new values if they are not nil
 	if req.FreebiesStatus != "" {
 		existingFreebiesData.FreebiesStatus = req.FreebiesStatus
 	}
 
 	// Save the updated data back to the database using GORM
-	if err := s.DB.Save(&existingFreebiesData).Error; err != nil {
+	if err := models.SaveVersioned(s.DB, &existingFreebiesData, &existingFreebiesData.Version); err != nil {
 		log.Printf("Error updating Freebies data: %v", err)
-		return nil, err
+		return nil, versionError(err, "Freebies", req.GetFreebiesId())
 	}
 
 	// Create and return the response
@@ -319,17 +408,39 @@ func (s *ChronexAdminService) UpdateFreebiesStatus(ctx context.Context, req *pb.
 		FreebiesData: &pb.FreebiesData{
 			FreebiesId:               existingFreebiesData.GetFreebiesId().String(),
 			FreebiesName:             existingFreebiesData.GetFreebiesName(),
-			FreebiesImg:              []byte(existingFreebiesData.GetFreebiesImg()),
+			FreebiesImgKey:           existingFreebiesData.FreebiesImgKey,
+			FreebiesImgUrl:           s.imageURL(existingFreebiesData.FreebiesImgKey),
 			FreebiesStorePrice:       existingFreebiesData.GetFreebiesStorePrice(),
 			FreebiesOriginalQuantity: existingFreebiesData.GetFreebiesOriginalQuantity(),
 			FreebiesCurrentQuantity:  existingFreebiesData.GetFreebiesCurrentQuantity(),
 			FreebiesStatus:           existingFreebiesData.GetFreebiesStatus(),
+			Sku:                      existingFreebiesData.Sku,
 			CreatedBy:                existingFreebiesData.CreatedBy.String(),
 			CreatedAt:                existingFreebiesData.CreatedAt.Unix(),
 			UpdatedBy:                existingFreebiesData.UpdatedBy.String(),
 			UpdatedAt:                existingFreebiesData.UpdatedAt.Unix(),
+			Version:                  existingFreebiesData.GetVersion(),
 		},
 	}
 
 	return response, nil
 }
+
+func (s *ChronexAdminService) DeleteFreebies(ctx context.Context, req *pb.DeleteFreebiesRequest) (*pb.DeleteFreebiesResponse, error) {
+	// Retrieve existing FreebiesData from the database
+	var existingFreebiesData models.FreebiesData
+	if err := s.DB.First(&existingFreebiesData, "freebies_id = ?", req.GetFreebiesId()).Error; err != nil {
+		log.Printf("Error retrieving Freebies data: %v", err)
+		return nil, err
+	}
+
+	// Soft delete the freebies, it stays in the trash until it is restored or purged
+	if err := s.DB.Delete(&existingFreebiesData).Error; err != nil {
+		log.Printf("Error deleting Freebies data: %v", err)
+		return nil, err
+	}
+
+	return &pb.DeleteFreebiesResponse{
+		FreebiesData: s.freebiesToPb(existingFreebiesData),
+	}, nil
+}
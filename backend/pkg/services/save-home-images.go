@@ -40,6 +40,7 @@ func (s *ChronexAdminService) SaveHomeImages(ctx context.Context, req *pb.SaveHo
 			CreatedAt:    homeImagesData.CreatedAt.Unix(),
 			UpdatedBy:    homeImagesData.UpdatedBy.String(),
 			UpdatedAt:    homeImagesData.UpdatedAt.Unix(),
+			Version:      homeImagesData.GetVersion(),
 		},
 	}
 
@@ -69,6 +70,7 @@ func (s *ChronexAdminService) GetAllHomeImages(ctx context.Context, req *pb.GetA
 			CreatedAt:    data.CreatedAt.Unix(),
 			UpdatedBy:    data.UpdatedBy.String(),
 			UpdatedAt:    data.UpdatedAt.Unix(),
+			Version:      data.GetVersion(),
 		})
 	}
 
@@ -83,16 +85,6 @@ func (s *ChronexAdminService) UpdateHomeImages(ctx context.Context, req *pb.Upda
 		return nil, err
 	}
 
-	if len(newImages) == 0 {
-		// Delete all data with corresponding homeImagesId
-		if err := s.DB.Where("home_images_id = ?", req.GetHomeImagesId()).Delete(&models.HomeImagesData{}).Error; err != nil {
-			log.Printf("Error deleting Home Images data: %v", err)
-			return nil, err
-		}
-		// No need to proceed further, return here
-		return &pb.UpdateHomeImagesResponse{}, nil
-	}
-
 	// Retrieve existing HomeImagesData from the database
 	var existingHomeImagesData models.HomeImagesData
 	if err := s.DB.First(&existingHomeImagesData, "home_images_id = ?", req.GetHomeImagesId()).Error; err != nil {
@@ -100,6 +92,24 @@ func (s *ChronexAdminService) UpdateHomeImages(ctx context.Context, req *pb.Upda
 		return nil, err
 	}
 
+	if err := checkVersion(req.ExpectedVersion, existingHomeImagesData.Version, "Home Images", req.GetHomeImagesId()); err != nil {
+		return nil, err
+	}
+
+	if len(newImages) == 0 {
+		// Delete all data with corresponding homeImagesId
+		result := s.DB.Where("home_images_id = ? AND version = ?", req.GetHomeImagesId(), req.ExpectedVersion).Delete(&models.HomeImagesData{})
+		if result.Error != nil {
+			log.Printf("Error deleting Home Images data: %v", result.Error)
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil, versionError(models.ErrVersionConflict, "Home Images", req.GetHomeImagesId())
+		}
+		// No need to proceed further, return here
+		return &pb.UpdateHomeImagesResponse{}, nil
+	}
+
 	// Update the existing HomeImagesData with new values
 	images, err := json.Marshal(req.HomeImg)
 	if err != nil {
@@ -109,9 +119,9 @@ func (s *ChronexAdminService) UpdateHomeImages(ctx context.Context, req *pb.Upda
 	existingHomeImagesData.HomeImg = json.RawMessage(images)
 
 	// Save the updated data back to the database using GORM
-	if err := s.DB.Save(&existingHomeImagesData).Error; err != nil {
+	if err := models.SaveVersioned(s.DB, &existingHomeImagesData, &existingHomeImagesData.Version); err != nil {
 		log.Printf("Error updating Home Images data: %v", err)
-		return nil, err
+		return nil, versionError(err, "Home Images", req.GetHomeImagesId())
 	}
 
 	// Create and return the response
@@ -123,6 +133,7 @@ func (s *ChronexAdminService) UpdateHomeImages(ctx context.Context, req *pb.Upda
 			CreatedAt:    existingHomeImagesData.CreatedAt.Unix(),
 			UpdatedBy:    existingHomeImagesData.UpdatedBy.String(),
 			UpdatedAt:    existingHomeImagesData.UpdatedAt.Unix(),
+			Version:      existingHomeImagesData.GetVersion(),
 		},
 	}
 
@@ -152,6 +163,7 @@ func (s *ChronexAdminService) DeleteHomeImages(ctx context.Context, req *pb.Dele
 			CreatedAt:    existingHomeImagesData.CreatedAt.Unix(),
 			UpdatedBy:    existingHomeImagesData.UpdatedBy.String(),
 			UpdatedAt:    existingHomeImagesData.UpdatedAt.Unix(),
+			Version:      existingHomeImagesData.GetVersion(),
 		},
 	}
 
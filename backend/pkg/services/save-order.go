@@ -7,47 +7,190 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+func orderToPb(data models.OrderData) *pb.OrderData {
+	return &pb.OrderData{
+		OrderId:         data.GetOrderId().String(),
+		OrderNumber:     data.OrderNumber,
+		CustomerId:      data.GetCustomerId(),
+		Customer:        string(data.GetCustomer()),
+		CompleteAddress: string(data.GetCompleteAddress()),
+		Product:         string(data.GetProduct()),
+		Total:           data.GetTotal(),
+		OrderStatus:     data.GetOrderStatus(),
+		TrackingId:      data.GetTrackingId(),
+		Courier:         data.GetCourier(),
+		Channel:         data.Channel,
+		ExternalOrderId: data.ExternalOrderId,
+		StickyNotes:     string(data.GetStickyNotes()),
+		Freebies:        string(data.GetFreebies()),
+		VoucherCode:     data.GetVoucherCode(),
+		VoucherDiscount: data.GetVoucherDiscount(),
+		CreatedBy:       data.CreatedBy.String(),
+		CreatedAt:       data.CreatedAt.Unix(),
+		UpdatedBy:       data.UpdatedBy.String(),
+		UpdatedAt:       data.UpdatedAt.Unix(),
+		ShippingFee:     data.GetShippingFee(),
+		PaymentStatus:   data.GetPaymentStatus(),
+		PaidAt:          data.GetPaidAt(),
+		Version:         data.GetVersion(),
+	}
+}
+
+// orderTotal is what a customer pays for goods worth subtotal: less the
+// voucher discount, which never takes the goods below zero, plus shipping.
+func orderTotal(subtotal float64, voucherDiscount float64, shippingFee float64) float64 {
+	return math.Round((math.Max(subtotal-voucherDiscount, 0)+shippingFee)*100) / 100
+}
+
 func (s *ChronexAdminService) SaveOrder(ctx context.Context, req *pb.SaveOrderRequest) (*pb.SaveOrderResponse, error) {
 
+	lines, err := parseCart(req.Product)
+	if err != nil {
+		return nil, err
+	}
+	customerEmail := parseCustomerEmail(req.Customer)
+
 	// Create a new PDFExtractorData instance
 	orderData := models.OrderData{
 		Customer:        json.RawMessage(req.Customer),
 		CompleteAddress: json.RawMessage(req.CompleteAddress),
-		Product:         json.RawMessage(req.Product),
-		Total:           req.Total,
 		OrderStatus:     req.OrderStatus,
+		PaymentStatus:   "UNP",
 	}
 
-	// Save the data to the database using GORM
-	if err := s.DB.Create(&orderData).Error; err != nil {
+	var payment *models.PaymentData
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		// Link the order to an existing customer or create one
+		customerId, err := matchCustomer(tx, req.Customer, orderData.CompleteAddress)
+		if err != nil {
+			return err
+		}
+		orderData.CustomerId = customerId
+
+		// Price the cart from the catalogue once for the freebies, voucher
+		// and shipping; the total the client sends is only checked against it
+		productById, subtotal, err := priceCart(tx, lines)
+		if err != nil {
+			return err
+		}
+		orderData.Product, err = pricedCart(req.Product, lines, productById)
+		if err != nil {
+			return err
+		}
+
+		// Allocate gift-with-purchase freebies server-side
+		cartFreebies, err := evaluateFreebiesRules(tx, lines, productById, customerEmail, time.Now(), true)
+		if err != nil {
+			return err
+		}
+
+		freebies, err := json.Marshal(cartFreebies)
+		if err != nil {
+			return err
+		}
+		orderData.Freebies = json.RawMessage(freebies)
+
+		// Validate and apply the voucher while holding its row lock
+		var voucher models.VoucherData
+		if req.VoucherCode != "" {
+			var discount float64
+			voucher, discount, err = applyVoucher(tx, req.VoucherCode, lines, productById, customerEmail, time.Now(), true)
+			if err != nil {
+				return err
+			}
+
+			orderData.VoucherCode = voucher.VoucherCode
+			orderData.VoucherDiscount = discount
+		}
+
+		// Charge shipping to the delivery address on top of the goods
+		shipping, err := quoteShipping(tx, lines, productById, subtotal, orderData.CompleteAddress)
+		if err != nil {
+			return err
+		}
+		orderData.ShippingFee = shipping.ShippingFee
+		orderData.Total = orderTotal(subtotal, orderData.VoucherDiscount, orderData.ShippingFee)
+		if req.Total != 0 && math.Abs(req.Total-orderData.Total) > 0.005 {
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("The order total is %.2f, not %.2f; review the cart and try again", orderData.Total, req.Total))
+		}
+
+		// Number the order once the pricing queries are done, since the year's
+		// counter stays locked until commit; only local writes follow
+		orderData.CreatedAt = time.Now()
+		orderData.OrderNumber, err = models.NextOrderNumber(tx, orderData.CreatedAt.Year())
+		if err != nil {
+			return err
+		}
+
+		// Save the data to the database using GORM
+		if err := tx.Create(&orderData).Error; err != nil {
+			return err
+		}
+
+		if req.VoucherCode != "" {
+			if err := recordVoucherRedemption(tx, voucher, orderData, customerEmail); err != nil {
+				return err
+			}
+		}
+
+		for _, cartFreebie := range cartFreebies {
+			allocation := models.FreebiesAllocationData{
+				OrderId:        orderData.OrderId,
+				FreebiesRuleId: uuid.MustParse(cartFreebie.FreebiesRuleId),
+				FreebiesId:     uuid.MustParse(cartFreebie.FreebiesId),
+				CustomerEmail:  customerEmail,
+				Quantity:       cartFreebie.Quantity,
+			}
+			if err := tx.Create(&allocation).Error; err != nil {
+				return err
+			}
+		}
+
+		// Open the order's payment with the chosen method
+		payment, err = s.openOrderPayment(tx, orderData, req.PaymentMethod)
+		if err != nil {
+			return err
+		}
+		if err := refreshOrderPaymentStatus(tx, &orderData); err != nil {
+			return err
+		}
+		if err := recordOrderStatusEvent(tx, "", orderData, "ADMIN"); err != nil {
+			return err
+		}
+
+		return s.queueOrderEmail(tx, OrderEmailConfirmation, orderData)
+	})
+	if err != nil {
 		log.Printf("Error saving Order data: %v", err)
 		return nil, err
 	}
 
+	// Open online payments with the provider now the order is committed
+	if payment != nil && onlinePaymentMethod(payment.PaymentMethod) {
+		if err := s.startProviderPayment(ctx, payment); err != nil {
+			log.Printf("Error saving Payment data: %v", err)
+			return nil, err
+		}
+	}
+
 	// Create and return the response
 	response := &pb.SaveOrderResponse{
-		OrderData: &pb.OrderData{
-			OrderId:         orderData.OrderId.String(),
-			Customer:        string(orderData.Customer),
-			CompleteAddress: string(orderData.CompleteAddress),
-			Product:         string(orderData.Product),
-			Total:           orderData.Total,
-			OrderStatus:     orderData.OrderStatus,
-			TrackingId:      orderData.TrackingId,
-			StickyNotes:     string(orderData.StickyNotes),
-			CreatedBy:       orderData.CreatedBy.String(),
-			CreatedAt:       orderData.CreatedAt.Unix(),
-			UpdatedBy:       orderData.UpdatedBy.String(),
-			UpdatedAt:       orderData.UpdatedAt.Unix(),
-		},
+		OrderData:      orderToPb(orderData),
+		OrderLinkToken: s.signOrderLink(orderData.OrderId.String(), time.Now()),
+	}
+	if payment != nil {
+		response.PaymentData = paymentToPb(*payment)
 	}
 
 	return response, nil
@@ -63,11 +206,42 @@ func convertSortOptionOrder(sortOptionStr string) pb.SortOptionOrder {
 		return pb.SortOptionOrder_ORDER_DATE_HIGH_TO_LOW
 	case "ORDER_DATE_LOW_TO_HIGH":
 		return pb.SortOptionOrder_ORDER_DATE_LOW_TO_HIGH
+	case "RELEVANCE":
+		return pb.SortOptionOrder_ORDER_RELEVANCE
 	default:
 		return pb.SortOptionOrder_ORDER_ATOZ
 	}
 }
 
+// sortOrders orders query by a SortOptionOrder name. Searches without a sort
+// option are ordered by relevance.
+func sortOrders(query *gorm.DB, sortOptionStr string, search string) *gorm.DB {
+	search = strings.TrimSpace(search)
+	if sortOptionStr == "" && search != "" {
+		sortOptionStr = "RELEVANCE"
+	}
+
+	// Convert sort option string to enum value
+	switch convertSortOptionOrder(sortOptionStr) {
+	case pb.SortOptionOrder_ORDER_ATOZ:
+		return query.Order("customer ASC")
+	case pb.SortOptionOrder_ORDER_ZTOA:
+		return query.Order("customer DESC")
+	case pb.SortOptionOrder_ORDER_DATE_HIGH_TO_LOW:
+		return query.Order("created_at DESC")
+	case pb.SortOptionOrder_ORDER_DATE_LOW_TO_HIGH:
+		return query.Order("created_at ASC")
+	case pb.SortOptionOrder_ORDER_RELEVANCE:
+		if search == "" {
+			return query.Order("created_at DESC")
+		}
+		// rankOrders selects search_rank
+		return query.Order("search_rank DESC").Order("created_at DESC")
+	}
+
+	return query
+}
+
 func (s *ChronexAdminService) GetAllOrder(ctx context.Context, req *pb.GetAllOrderRequest) (*pb.GetAllOrderResponse, error) {
 	response := &pb.GetAllOrderResponse{
 		OrderData: []*pb.OrderData{},
@@ -79,31 +253,11 @@ func (s *ChronexAdminService) GetAllOrder(ctx context.Context, req *pb.GetAllOrd
 	// Build your query based on the request parameters
 	query := s.DB.Model(&models.OrderData{})
 
-	// Convert sort option string to enum value
-	sortOption := convertSortOptionOrder(req.SortOptionOrder)
-
 	// Handle sorting
-	switch sortOption {
-	case pb.SortOptionOrder_ORDER_ATOZ:
-		query = query.Order("customer ASC")
-	case pb.SortOptionOrder_ORDER_ZTOA:
-		query = query.Order("customer DESC")
-	case pb.SortOptionOrder_ORDER_DATE_HIGH_TO_LOW:
-		query = query.Order("created_at DESC")
-	case pb.SortOptionOrder_ORDER_DATE_LOW_TO_HIGH:
-		query = query.Order("created_at ASC")
-	}
+	query = sortOrders(query, req.SortOptionOrder, req.Search)
 
 	// Handle searching
-	if req.Search != "" {
-		searchParam := "%" + strings.ToLower(req.Search) + "%"
-		query = query.Where("(lower(customer->>'lastName') ILIKE ? OR "+
-			"lower(customer->>'firstName') ILIKE ? OR "+
-			"lower(customer->>'emailAddress') ILIKE ? OR "+
-			"lower(customer->>'contactNumber') ILIKE ? OR "+
-			"lower(product->>'productName') ILIKE ?)",
-			searchParam, searchParam, searchParam, searchParam, searchParam)
-	}
+	query = rankOrders(searchOrders(query, req.Search), req.Search)
 
 	// Filter by active status
 	query = query.Where("order_status = ?", req.OrderStatus)
@@ -112,193 +266,250 @@ func (s *ChronexAdminService) GetAllOrder(ctx context.Context, req *pb.GetAllOrd
 	query = query.Where("created_at >= ? AND created_at <= ?", twoMonthsAgo, time.Now())
 
 	// Execute the query
-	var orderDataValue []models.OrderData
+	var orderDataValue []orderSearchResult
 	if err := query.Find(&orderDataValue).Error; err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch order data: %v", err))
 	}
 
 	// Map the retrieved data to protobuf message
-	for _, data := range orderDataValue {
-		response.OrderData = append(response.OrderData, &pb.OrderData{
-			OrderId:         data.OrderId.String(),
-			Customer:        string(data.Customer),
-			CompleteAddress: string(data.CompleteAddress),
-			Product:         string(data.Product),
-			Total:           data.Total,
-			OrderStatus:     data.OrderStatus,
-			TrackingId:      data.TrackingId,
-			StickyNotes:     string(data.StickyNotes),
-			CreatedBy:       data.CreatedBy.String(),
-			CreatedAt:       data.CreatedAt.Unix(),
-			UpdatedBy:       data.UpdatedBy.String(),
-			UpdatedAt:       data.UpdatedAt.Unix(),
-		})
+	for _, result := range orderDataValue {
+		orderData := orderToPb(result.OrderData)
+		orderData.SearchRank = result.SearchRank
+		orderData.SearchSnippet = highlightSnippet(result.SearchSnippet)
+		response.OrderData = append(response.OrderData, orderData)
 	}
 
 	return response, nil
 }
 
-func (s *ChronexAdminService) UpdateOrder(ctx context.Context, req *pb.UpdateOrderRequest) (*pb.UpdateOrderResponse, error) {
-	// Retrieve existing OrderData from the database
-	var existingOrderData models.OrderData
-	if err := s.DB.First(&existingOrderData, "order_id = ?", req.GetOrderId()).First(&existingOrderData).Error; err != nil {
-		log.Printf("Error retrieving Order data: %v", err)
-		return nil, err
-	}
-
-	// Update the existing ProductData with new values if they are not nil
-	if req.Customer != "" {
-		existingOrderData.Customer = json.RawMessage(req.Customer)
-	}
-	if req.CompleteAddress != "" {
-		existingOrderData.CompleteAddress = json.RawMessage(req.CompleteAddress)
-	}
-	if req.Product != "" {
-		existingOrderData.Product = json.RawMessage(req.Product)
-	}
-	if req.Total != 0 {
-		existingOrderData.Total = req.Total
-	}
-	if req.Product != "" {
-		// Decode req.Product array
-		var products []struct {
-			Freebies        string  `json:"freebies"`
-			Quantity        int     `json:"quantity"`
-			ProductID       string  `json:"productId"`
-			ProductName     string  `json:"productName"`
-			DiscountedPrice float64 `json:"discountedPrice"`
-		}
-		if err := json.Unmarshal([]byte(req.Product), &products); err != nil {
+// moveOrderStock deducts the order's product, freebies and rule-freebies stock
+// when it ships and puts it back when a shipped order returns to PEN, ACT or
+// CAN. Other transitions leave stock alone.
+func moveOrderStock(tx *gorm.DB, order models.OrderData, orderStatus string) error {
+	// Decode the order's product array
+	var products []struct {
+		Freebies        string  `json:"freebies"`
+		Quantity        int     `json:"quantity"`
+		ProductID       string  `json:"productId"`
+		ProductName     string  `json:"productName"`
+		DiscountedPrice float64 `json:"discountedPrice"`
+	}
+	if len(order.Product) > 0 {
+		if err := json.Unmarshal(order.Product, &products); err != nil {
 			log.Printf("Error decoding product data: %v", err)
-			return nil, err
+			return err
 		}
+	}
 
-		// Deduct product quantity if orderStatus is SHP or DLV
-		if (existingOrderData.OrderStatus == "ACT" || existingOrderData.OrderStatus == "PEN" || existingOrderData.OrderStatus == "CAN") && (req.OrderStatus == "SHP" || req.OrderStatus == "DLV") {
-			for _, product := range products {
-				// Convert productID string to UUID
-				productID, err := uuid.Parse(product.ProductID)
-				if err != nil {
-					log.Printf("Error parsing product ID %s: %v", product.ProductID, err)
-					return nil, err
-				}
+	// Deduct product quantity if orderStatus is SHP or DLV
+	if (order.OrderStatus == "ACT" || order.OrderStatus == "PEN" || order.OrderStatus == "CAN") && (orderStatus == "SHP" || orderStatus == "DLV") {
+		for _, product := range products {
+			// Convert productID string to UUID
+			productID, err := uuid.Parse(product.ProductID)
+			if err != nil {
+				log.Printf("Error parsing product ID %s: %v", product.ProductID, err)
+				return err
+			}
+
+			// Retrieve product data from the database
+			var productData models.ProductData
+			if err := tx.First(&productData, "product_id = ?", productID).Error; err != nil {
+				log.Printf("Error retrieving product data for product ID %s: %v", productID, err)
+				return err
+			}
+
+			// Update product quantity
+			productData.CurrentQuantity -= float64(product.Quantity)
+			productData.Version++
 
+			// Save the updated product data back to the database
+			if err := tx.Save(&productData).Error; err != nil {
+				log.Printf("Error updating product data: %v", err)
+				return err
+			}
+
+			if product.Freebies != "" {
 				// Retrieve product data from the database
-				var productData models.ProductData
-				if err := s.DB.First(&productData, "product_id = ?", productID).Error; err != nil {
-					log.Printf("Error retrieving product data for product ID %s: %v", productID, err)
-					return nil, err
+				var freebiesData models.FreebiesData
+				if err := tx.First(&freebiesData, "freebies_name = ?", product.Freebies).Error; err != nil {
+					log.Printf("Error retrieving freebies data for freebies Name %s: %v", productID, err)
+					return err
 				}
 
-				// Update product quantity
-				productData.CurrentQuantity -= float64(product.Quantity)
+				freebiesData.FreebiesCurrentQuantity -= float64(product.Quantity)
+				freebiesData.Version++
 
 				// Save the updated product data back to the database
-				if err := s.DB.Save(&productData).Error; err != nil {
-					log.Printf("Error updating product data: %v", err)
-					return nil, err
+				if err := tx.Save(&freebiesData).Error; err != nil {
+					log.Printf("Error updating freebies data: %v", err)
+					return err
 				}
+			}
 
-				if product.Freebies != "" {
-					// Retrieve product data from the database
-					var freebiesData models.FreebiesData
-					if err := s.DB.First(&freebiesData, "freebies_name = ?", product.Freebies).Error; err != nil {
-						log.Printf("Error retrieving freebies data for freebies Name %s: %v", productID, err)
-						return nil, err
-					}
-
-					freebiesData.FreebiesCurrentQuantity -= float64(product.Quantity)
-
-					// Save the updated product data back to the database
-					if err := s.DB.Save(&freebiesData).Error; err != nil {
-						log.Printf("Error updating freebies data: %v", err)
-						return nil, err
-					}
-				}
+		}
+
+		if err := adjustRuleFreebiesStock(tx, order.Freebies, -1); err != nil {
+			log.Printf("Error updating freebies data: %v", err)
+			return err
+		}
+	} else if (order.OrderStatus == "SHP" || order.OrderStatus == "DLV") && (orderStatus == "PEN" || orderStatus == "ACT" || orderStatus == "CAN") {
+		// Returned units are restocked by ReceiveReturn, not by undoing delivery
+		if order.OrderStatus == "DLV" {
+			hasReturns, err := hasOpenReturns(tx, order.OrderId)
+			if err != nil {
+				return err
+			}
+			if hasReturns {
+				return status.Error(codes.FailedPrecondition, "Order has returns, use the returns workflow instead of changing its status")
+			}
+		}
 
+		for _, product := range products {
+			// Convert productID string to UUID
+			productID, err := uuid.Parse(product.ProductID)
+			if err != nil {
+				log.Printf("Error parsing product ID %s: %v", product.ProductID, err)
+				return err
+			}
+
+			// Retrieve product data from the database
+			var productData models.ProductData
+			if err := tx.First(&productData, "product_id = ?", productID).Error; err != nil {
+				log.Printf("Error retrieving product data for product ID %s: %v", productID, err)
+				return err
 			}
-		} else if (existingOrderData.OrderStatus == "SHP" || existingOrderData.OrderStatus == "DLV") && (req.OrderStatus == "PEN" || req.OrderStatus == "ACT" || req.OrderStatus == "CAN") {
-			for _, product := range products {
-				// Convert productID string to UUID
-				productID, err := uuid.Parse(product.ProductID)
-				if err != nil {
-					log.Printf("Error parsing product ID %s: %v", product.ProductID, err)
-					return nil, err
-				}
 
+			// Update product quantity
+			productData.CurrentQuantity += float64(product.Quantity)
+			productData.Version++
+
+			// Save the updated product data back to the database
+			if err := tx.Save(&productData).Error; err != nil {
+				log.Printf("Error updating product data: %v", err)
+				return err
+			}
+
+			if product.Freebies != "" {
 				// Retrieve product data from the database
-				var productData models.ProductData
-				if err := s.DB.First(&productData, "product_id = ?", productID).Error; err != nil {
-					log.Printf("Error retrieving product data for product ID %s: %v", productID, err)
-					return nil, err
+				var freebiesData models.FreebiesData
+				if err := tx.First(&freebiesData, "freebies_name = ?", product.Freebies).Error; err != nil {
+					log.Printf("Error retrieving freebies data for freebies Name %s: %v", productID, err)
+					return err
 				}
 
-				// Update product quantity
-				productData.CurrentQuantity += float64(product.Quantity)
+				freebiesData.FreebiesCurrentQuantity += float64(product.Quantity)
+				freebiesData.Version++
 
 				// Save the updated product data back to the database
-				if err := s.DB.Save(&productData).Error; err != nil {
-					log.Printf("Error updating product data: %v", err)
-					return nil, err
-				}
-
-				if product.Freebies != "" {
-					// Retrieve product data from the database
-					var freebiesData models.FreebiesData
-					if err := s.DB.First(&freebiesData, "freebies_name = ?", product.Freebies).Error; err != nil {
-						log.Printf("Error retrieving freebies data for freebies Name %s: %v", productID, err)
-						return nil, err
-					}
-
-					freebiesData.FreebiesCurrentQuantity += float64(product.Quantity)
-
-					// Save the updated product data back to the database
-					if err := s.DB.Save(&freebiesData).Error; err != nil {
-						log.Printf("Error updating freebies data: %v", err)
-						return nil, err
-					}
+				if err := tx.Save(&freebiesData).Error; err != nil {
+					log.Printf("Error updating freebies data: %v", err)
+					return err
 				}
 			}
 		}
 
-		existingOrderData.OrderStatus = req.OrderStatus
+		if err := adjustRuleFreebiesStock(tx, order.Freebies, 1); err != nil {
+			log.Printf("Error updating freebies data: %v", err)
+			return err
+		}
 	}
 
-	if req.TrackingId != "" {
-		existingOrderData.TrackingId = req.TrackingId
+	return nil
+}
+
+func (s *ChronexAdminService) UpdateOrder(ctx context.Context, req *pb.UpdateOrderRequest) (*pb.UpdateOrderResponse, error) {
+	mask, err := newUpdateMask(req.ProtoReflect().Descriptor(), req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+	if req.OrderStatus == "DEL" {
+		return nil, status.Error(codes.InvalidArgument, "Use DeleteOrder to move a order to the trash")
 	}
 
-	if req.StickyNotes != "" {
-		sticky, err := json.Marshal(req.StickyNotes)
-		if err != nil {
-			log.Printf("Error marshaling descrip2: %v", err)
-			return nil, err
+	// Stock moves and the order write succeed or fail together
+	var existingOrderData models.OrderData
+	var previousStatus string
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		// Retrieve existing OrderData from the database
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existingOrderData, "order_id = ?", req.GetOrderId()).Error; err != nil {
+			log.Printf("Error retrieving Order data: %v", err)
+			return err
+		}
+
+		if err := checkVersion(req.ExpectedVersion, existingOrderData.Version, "Order", req.GetOrderId()); err != nil {
+			return err
+		}
+		previousStatus = existingOrderData.OrderStatus
+
+		// Update the existing ProductData with the provided or masked values
+		if mask.has("customer", req.Customer != "") {
+			existingOrderData.Customer = rawJSON(req.Customer)
+		}
+		if mask.has("completeAddress", req.CompleteAddress != "") {
+			existingOrderData.CompleteAddress = rawJSON(req.CompleteAddress)
+		}
+		// Re-link the order when the admin corrects who placed it
+		if mask.has("customer", req.Customer != "") {
+			customerId, err := matchCustomer(tx, string(existingOrderData.Customer), existingOrderData.CompleteAddress)
+			if err != nil {
+				return err
+			}
+			existingOrderData.CustomerId = customerId
+		}
+		if mask.has("product", req.Product != "") {
+			existingOrderData.Product = rawJSON(req.Product)
+		}
+		if mask.has("total", req.Total != 0) {
+			existingOrderData.Total = req.Total
+		}
+		// Without a mask the status is only written together with the product list
+		if mask.has("orderStatus", req.Product != "") {
+			if req.OrderStatus == "" {
+				return status.Error(codes.InvalidArgument, "Order status cannot be empty")
+			}
+
+			if err := moveOrderStock(tx, existingOrderData, req.OrderStatus); err != nil {
+				return err
+			}
+
+			existingOrderData.OrderStatus = req.OrderStatus
 		}
-		existingOrderData.StickyNotes = json.RawMessage(sticky)
-	}
 
-	// Save the updated data back to the database using GORM
-	if err := s.DB.Save(&existingOrderData).Error; err != nil {
-		log.Printf("Error updating Order data: %v", err)
+		if mask.has("trackingId", req.TrackingId != "") {
+			existingOrderData.TrackingId = req.TrackingId
+		}
+
+		if mask.has("stickyNotes", req.StickyNotes != "") {
+			sticky, err := json.Marshal(req.StickyNotes)
+			if err != nil {
+				log.Printf("Error marshaling descrip2: %v", err)
+				return err
+			}
+			existingOrderData.StickyNotes = json.RawMessage(sticky)
+		}
+
+		// Save the updated data back to the database using GORM
+		if err := models.SaveVersioned(tx, &existingOrderData, &existingOrderData.Version); err != nil {
+			log.Printf("Error updating Order data: %v", err)
+			return versionError(err, "Order", req.GetOrderId())
+		}
+
+		if err := syncOrderPayments(tx, previousStatus, &existingOrderData); err != nil {
+			return err
+		}
+		if err := recordOrderStatusEvent(tx, previousStatus, existingOrderData, "ADMIN"); err != nil {
+			return err
+		}
+
+		return s.queueOrderStatusEmail(tx, previousStatus, existingOrderData)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	// Create and return the response
 	response := &pb.UpdateOrderResponse{
-		OrderData: &pb.OrderData{
-			OrderId:         existingOrderData.GetOrderId().String(),
-			Customer:        string(existingOrderData.GetCustomer()),
-			CompleteAddress: string(existingOrderData.GetCompleteAddress()),
-			Product:         string(existingOrderData.GetProduct()),
-			Total:           existingOrderData.GetTotal(),
-			OrderStatus:     existingOrderData.GetOrderStatus(),
-			TrackingId:      existingOrderData.GetTrackingId(),
-			StickyNotes:     string(existingOrderData.GetStickyNotes()),
-			CreatedBy:       existingOrderData.CreatedBy.String(),
-			CreatedAt:       existingOrderData.CreatedAt.Unix(),
-			UpdatedBy:       existingOrderData.UpdatedBy.String(),
-			UpdatedAt:       existingOrderData.UpdatedAt.Unix(),
-		},
+		OrderData: orderToPb(existingOrderData),
 	}
 
 	return response, nil
@@ -312,33 +523,49 @@ func (s *ChronexAdminService) UpdateOrderStatus(ctx context.Context, req *pb.Upd
 		return nil, err
 	}
 
+	if err := checkVersion(req.ExpectedVersion, existingOrderData.Version, "Order", req.GetOrderId()); err != nil {
+		return nil, err
+	}
+
+	// "DEL" from older clients moves the order to the trash
+	if req.OrderStatus == "DEL" {
+		deleted, err := s.DeleteOrder(ctx, &pb.DeleteOrderRequest{OrderId: req.GetOrderId()})
+		if err != nil {
+			return nil, err
+		}
+		return &pb.UpdateOrderStatusResponse{OrderData: deleted.OrderData}, nil
+	}
+
 	// Update the existing FreebiesData with new values if they are not nil
+	previousStatus := existingOrderData.OrderStatus
 	if req.OrderStatus != "" {
 		existingOrderData.OrderStatus = req.OrderStatus
 	}
 
-	// Save the updated data back to the database using GORM
-	if err := s.DB.Save(&existingOrderData).Error; err != nil {
-		log.Printf("Error updating Order data: %v", err)
+	// Save the status and queue its email together
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		// Save the updated data back to the database using GORM
+		if err := models.SaveVersioned(tx, &existingOrderData, &existingOrderData.Version); err != nil {
+			log.Printf("Error updating Order data: %v", err)
+			return versionError(err, "Order", req.GetOrderId())
+		}
+
+		if err := syncOrderPayments(tx, previousStatus, &existingOrderData); err != nil {
+			return err
+		}
+		if err := recordOrderStatusEvent(tx, previousStatus, existingOrderData, "ADMIN"); err != nil {
+			return err
+		}
+
+		return s.queueOrderStatusEmail(tx, previousStatus, existingOrderData)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	// Create and return the response
 	response := &pb.UpdateOrderStatusResponse{
-		OrderData: &pb.OrderData{
-			OrderId:         existingOrderData.GetOrderId().String(),
-			Customer:        string(existingOrderData.GetCustomer()),
-			CompleteAddress: string(existingOrderData.GetCompleteAddress()),
-			Product:         string(existingOrderData.GetProduct()),
-			Total:           existingOrderData.GetTotal(),
-			OrderStatus:     existingOrderData.GetOrderStatus(),
-			TrackingId:      existingOrderData.GetTrackingId(),
-			StickyNotes:     string(existingOrderData.GetStickyNotes()),
-			CreatedBy:       existingOrderData.CreatedBy.String(),
-			CreatedAt:       existingOrderData.CreatedAt.Unix(),
-			UpdatedBy:       existingOrderData.UpdatedBy.String(),
-			UpdatedAt:       existingOrderData.UpdatedAt.Unix(),
-		},
+		OrderData: orderToPb(existingOrderData),
 	}
 
 	return response, nil
@@ -347,23 +574,47 @@ func (s *ChronexAdminService) UpdateOrderStatus(ctx context.Context, req *pb.Upd
 func (s *ChronexAdminService) GetAllOrderRevenue(ctx context.Context, req *pb.GetAllOrderRevenueRequest) (*pb.GetAllOrderRevenueResponse, error) {
 	currentYear, currentMonth, _ := time.Now().Date()
 
-	// Retrieve current month's data with "DLV" status
-	currentData, err := models.GetTotalSalesPerDayWithStatus(s.DB, "DLV", currentYear, currentMonth) // Replace s.DB with your database connection
+	// Revenue is counted from captured payments on the day they were captured
+	currentData, err := models.GetCapturedPaymentsPerDay(s.DB, currentYear, currentMonth)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve previous month's data with "DLV" status
+	// Retrieve previous month's captured payments
 	previousYear, previousMonth := currentYear, currentMonth-1
 	if previousMonth == 0 {
 		previousMonth = 12
 		previousYear--
 	}
-	previousData, err := models.GetTotalSalesPerDayWithStatus(s.DB, "DLV", previousYear, previousMonth)
+	previousData, err := models.GetCapturedPaymentsPerDay(s.DB, previousYear, previousMonth)
 	if err != nil {
 		return nil, err
 	}
 
+	// Gross figures add back the voucher discounts taken off the net totals
+	currentGrossData, err := models.GetCapturedGrossPerDay(s.DB, currentYear, currentMonth)
+	if err != nil {
+		return nil, err
+	}
+	previousGrossData, err := models.GetCapturedGrossPerDay(s.DB, previousYear, previousMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refunds come off the day they were given
+	currentRefunds, err := models.GetRefundsPerDay(s.DB, currentYear, currentMonth)
+	if err != nil {
+		return nil, err
+	}
+	previousRefunds, err := models.GetRefundsPerDay(s.DB, previousYear, previousMonth)
+	if err != nil {
+		return nil, err
+	}
+	currentData = models.NetOfRefunds(currentData, currentRefunds)
+	previousData = models.NetOfRefunds(previousData, previousRefunds)
+	currentGrossData = models.NetOfRefunds(currentGrossData, currentRefunds)
+	previousGrossData = models.NetOfRefunds(previousGrossData, previousRefunds)
+
 	// Convert maps to string representations
 	currentDataString, err := json.Marshal(currentData)
 	if err != nil {
@@ -375,9 +626,21 @@ func (s *ChronexAdminService) GetAllOrderRevenue(ctx context.Context, req *pb.Ge
 		return nil, err
 	}
 
+	currentGrossDataString, err := json.Marshal(currentGrossData)
+	if err != nil {
+		return nil, err
+	}
+
+	previousGrossDataString, err := json.Marshal(previousGrossData)
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.GetAllOrderRevenueResponse{
-		CurrentData:  string(currentDataString),
-		PreviousData: string(previousDataString),
+		CurrentData:       string(currentDataString),
+		PreviousData:      string(previousDataString),
+		CurrentGrossData:  string(currentGrossDataString),
+		PreviousGrossData: string(previousGrossDataString),
 	}, nil
 }
 
@@ -443,3 +706,22 @@ func (s *ChronexAdminService) GetBestSellingProducts(ctx context.Context, req *p
 		BestSellingProducts: string(bestSellingProductsString),
 	}, nil
 }
+
+func (s *ChronexAdminService) DeleteOrder(ctx context.Context, req *pb.DeleteOrderRequest) (*pb.DeleteOrderResponse, error) {
+	// Retrieve existing OrderData from the database
+	var existingOrderData models.OrderData
+	if err := s.DB.First(&existingOrderData, "order_id = ?", req.GetOrderId()).Error; err != nil {
+		log.Printf("Error retrieving Order data: %v", err)
+		return nil, err
+	}
+
+	// Soft delete the order, it stays in the trash until it is restored or purged
+	if err := s.DB.Delete(&existingOrderData).Error; err != nil {
+		log.Printf("Error deleting Order data: %v", err)
+		return nil, err
+	}
+
+	return &pb.DeleteOrderResponse{
+		OrderData: orderToPb(existingOrderData),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package services
+
+import "testing"
+
+func TestOrderTotal(t *testing.T) {
+	tests := []struct {
+		name            string
+		subtotal        float64
+		voucherDiscount float64
+		shippingFee     float64
+		want            float64
+	}{
+		{name: "goods only", subtotal: 1500, want: 1500},
+		{name: "voucher and shipping", subtotal: 1500, voucherDiscount: 150, shippingFee: 120, want: 1470},
+		{name: "voucher never goes below zero", subtotal: 100, voucherDiscount: 250, shippingFee: 80, want: 80},
+		{name: "rounded to cents", subtotal: 0.1, shippingFee: 0.2, want: 0.3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := orderTotal(tt.subtotal, tt.voucherDiscount, tt.shippingFee); got != tt.want {
+				t.Errorf("orderTotal(%v, %v, %v) = %v, want %v", tt.subtotal, tt.voucherDiscount, tt.shippingFee, got, tt.want)
+			}
+		})
+	}
+}
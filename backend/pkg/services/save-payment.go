@@ -0,0 +1,617 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var paymentMethods = map[string]bool{"COD": true, "BANK": true, "EWALLET": true, "CARD": true, "MARKETPLACE": true}
+
+var paymentStatuses = map[string]bool{"PEN": true, "CAP": true, "FAI": true, "VOI": true}
+
+// onlinePaymentMethod reports whether method is paid through the payment provider.
+func onlinePaymentMethod(method string) bool {
+	return method == "CARD" || method == "EWALLET"
+}
+
+func paymentToPb(data models.PaymentData) *pb.PaymentData {
+	return &pb.PaymentData{
+		PaymentId:         data.GetPaymentId().String(),
+		OrderId:           data.OrderId.String(),
+		PaymentMethod:     data.PaymentMethod,
+		Provider:          data.Provider,
+		Amount:            data.Amount,
+		PaymentStatus:     data.PaymentStatus,
+		ProviderReference: data.ProviderReference,
+		CheckoutUrl:       data.CheckoutUrl,
+		FailureReason:     data.FailureReason,
+		CapturedAt:        data.GetCapturedAt(),
+		CreatedBy:         data.CreatedBy.String(),
+		CreatedAt:         data.CreatedAt.Unix(),
+		UpdatedBy:         data.UpdatedBy.String(),
+		UpdatedAt:         data.UpdatedAt.Unix(),
+	}
+}
+
+func refundToPb(data models.RefundData) *pb.RefundData {
+	return &pb.RefundData{
+		RefundId:          data.GetRefundId().String(),
+		OrderId:           data.OrderId.String(),
+		PaymentId:         data.PaymentId.String(),
+		ReturnId:          data.GetReturnId(),
+		Amount:            data.Amount,
+		Reason:            data.Reason,
+		ProviderReference: data.ProviderReference,
+		CreatedBy:         data.CreatedBy.String(),
+		CreatedAt:         data.CreatedAt.Unix(),
+		UpdatedBy:         data.UpdatedBy.String(),
+		UpdatedAt:         data.UpdatedAt.Unix(),
+	}
+}
+
+func parsePaymentMethod(method string) (string, error) {
+	method = strings.ToUpper(strings.TrimSpace(method))
+	if !paymentMethods[method] {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid payment method %s", method))
+	}
+
+	return method, nil
+}
+
+// openOrderPayment records the pending payment for a new order inside its
+// transaction. Card and e-wallet payments are only opened with the payment
+// provider by startProviderPayment once the order is committed, so no
+// external call holds the order's locks. Orders with nothing to pay get no
+// payment.
+func (s *ChronexAdminService) openOrderPayment(tx *gorm.DB, order models.OrderData, method string) (*models.PaymentData, error) {
+	if method == "" {
+		method = "COD"
+	}
+	method, err := parsePaymentMethod(method)
+	if err != nil {
+		return nil, err
+	}
+	if order.Total <= 0 {
+		return nil, nil
+	}
+
+	payment := models.PaymentData{
+		OrderId:       order.OrderId,
+		PaymentMethod: method,
+		Provider:      "manual",
+		Amount:        order.Total,
+		PaymentStatus: "PEN",
+	}
+
+	if onlinePaymentMethod(method) {
+		if s.PaymentProvider == nil {
+			return nil, status.Error(codes.FailedPrecondition, "Online payments are not available")
+		}
+		payment.Provider = s.PaymentProvider.Name()
+	}
+
+	// Save the data to the database using GORM
+	if err := tx.Create(&payment).Error; err != nil {
+		return nil, err
+	}
+
+	return &payment, nil
+}
+
+// startProviderPayment opens a committed online payment with the payment
+// provider and stores its reference and checkout URL. A payment the provider
+// refuses is marked failed, leaving the order in place for another try.
+func (s *ChronexAdminService) startProviderPayment(ctx context.Context, payment *models.PaymentData) error {
+	intent, err := s.PaymentProvider.CreatePayment(ctx, *payment)
+	if err != nil {
+		log.Printf("Error creating %s payment: %v", s.PaymentProvider.Name(), err)
+
+		return s.DB.Transaction(func(tx *gorm.DB) error {
+			orderData, err := lockPayment(tx, payment, "payment_id = ?", payment.PaymentId)
+			if err != nil {
+				return err
+			}
+			if payment.PaymentStatus != "PEN" {
+				return nil
+			}
+			return setPaymentStatus(tx, &orderData, payment, "FAI", 0, "Failed to start the payment")
+		})
+	}
+
+	payment.ProviderReference = intent.Reference
+	payment.CheckoutUrl = intent.CheckoutUrl
+	columns := map[string]interface{}{
+		"provider_reference": payment.ProviderReference,
+		"checkout_url":       payment.CheckoutUrl,
+	}
+	return s.DB.Model(payment).Updates(columns).Error
+}
+
+// capturedAmount is the total captured against an order.
+func capturedAmount(tx *gorm.DB, order models.OrderData) (float64, error) {
+	var captured float64
+	err := tx.Model(&models.PaymentData{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("order_id = ? AND payment_status = ?", order.OrderId, "CAP").
+		Scan(&captured).Error
+
+	return captured, err
+}
+
+// refundedAmount is the total refunded against an order's payments.
+func refundedAmount(tx *gorm.DB, orderId interface{}) (float64, error) {
+	var refunded float64
+	err := tx.Model(&models.RefundData{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("order_id = ?", orderId).
+		Scan(&refunded).Error
+
+	return refunded, err
+}
+
+// paymentRefunded is the total refunded against one payment.
+func paymentRefunded(tx *gorm.DB, paymentId interface{}) (float64, error) {
+	var refunded float64
+	err := tx.Model(&models.RefundData{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("payment_id = ?", paymentId).
+		Scan(&refunded).Error
+
+	return refunded, err
+}
+
+// refreshOrderPaymentStatus recomputes the order's payment status from its
+// captured payments and refunds: UNP with nothing captured, PAR when partly
+// paid and PAI once the total is covered, then PRF after a partial refund and
+// REF once everything captured was given back. The order row and its version
+// are only written when the status changes.
+func refreshOrderPaymentStatus(tx *gorm.DB, order *models.OrderData) error {
+	captured, err := capturedAmount(tx, *order)
+	if err != nil {
+		return err
+	}
+	refunded, err := refundedAmount(tx, order.OrderId)
+	if err != nil {
+		return err
+	}
+
+	paymentStatus := "UNP"
+	switch {
+	case refunded > 0 && refunded >= captured-0.005:
+		paymentStatus = "REF"
+	case refunded > 0:
+		paymentStatus = "PRF"
+	case captured >= order.Total-0.005:
+		paymentStatus = "PAI"
+	case captured > 0:
+		paymentStatus = "PAR"
+	}
+	if paymentStatus == order.GetPaymentStatus() {
+		return nil
+	}
+
+	// Refunds keep the date the order was paid
+	paidAt := order.PaidAt
+	switch paymentStatus {
+	case "PAI":
+		if paidAt == nil {
+			now := time.Now()
+			paidAt = &now
+		}
+	case "UNP", "PAR":
+		paidAt = nil
+	}
+
+	columns := map[string]interface{}{
+		"payment_status": paymentStatus,
+		"paid_at":        paidAt,
+		"version":        gorm.Expr("version + 1"),
+	}
+	if err := tx.Model(&models.OrderData{}).Where("order_id = ?", order.OrderId).Updates(columns).Error; err != nil {
+		return err
+	}
+
+	order.PaymentStatus = paymentStatus
+	order.PaidAt = paidAt
+	order.Version++
+
+	return nil
+}
+
+// syncOrderPayments follows an order status change: delivering an order
+// collects its pending cash-on-delivery payment for the amount still due, and
+// cancelling it voids every pending payment.
+func syncOrderPayments(tx *gorm.DB, previousStatus string, order *models.OrderData) error {
+	if order.OrderStatus == previousStatus {
+		return nil
+	}
+
+	switch order.OrderStatus {
+	case "DLV":
+		var pending []models.PaymentData
+		if err := tx.Where("order_id = ? AND payment_method = ? AND payment_status = ?", order.OrderId, "COD", "PEN").
+			Order("created_at ASC").
+			Find(&pending).Error; err != nil {
+			return err
+		}
+
+		if len(pending) > 0 {
+			captured, err := capturedAmount(tx, *order)
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			columns := map[string]interface{}{
+				"payment_status": "CAP",
+				"captured_at":    now,
+				"amount":         math.Max(order.Total-captured, 0),
+			}
+			if err := tx.Model(&pending[0]).Updates(columns).Error; err != nil {
+				return err
+			}
+		}
+	case "CAN":
+		if err := tx.Model(&models.PaymentData{}).
+			Where("order_id = ? AND payment_status = ?", order.OrderId, "PEN").
+			Update("payment_status", "VOI").Error; err != nil {
+			return err
+		}
+	}
+
+	return refreshOrderPaymentStatus(tx, order)
+}
+
+// lockOrder loads an order for update inside tx.
+func lockOrder(tx *gorm.DB, orderId interface{}) (models.OrderData, error) {
+	var orderData models.OrderData
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&orderData, "order_id = ?", orderId).Error; err != nil {
+		log.Printf("Error retrieving Order data: %v", err)
+		return models.OrderData{}, err
+	}
+
+	return orderData, nil
+}
+
+func (s *ChronexAdminService) GetOrderPayment(ctx context.Context, req *pb.GetOrderPaymentRequest) (*pb.GetOrderPaymentResponse, error) {
+	// Retrieve existing OrderData from the database
+	var orderData models.OrderData
+	if err := s.DB.First(&orderData, "order_id = ?", req.GetOrderId()).Error; err != nil {
+		log.Printf("Error retrieving Order data: %v", err)
+		return nil, err
+	}
+
+	var paymentDataValue []models.PaymentData
+	if err := s.DB.Where("order_id = ?", orderData.OrderId).Order("created_at ASC").Find(&paymentDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch payment data: %v", err))
+	}
+
+	var refundDataValue []models.RefundData
+	if err := s.DB.Where("order_id = ?", orderData.OrderId).Order("created_at ASC").Find(&refundDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch refund data: %v", err))
+	}
+
+	captured, err := capturedAmount(s.DB, orderData)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.GetOrderPaymentResponse{
+		PaymentData:   []*pb.PaymentData{},
+		PaymentStatus: orderData.GetPaymentStatus(),
+		AmountPaid:    captured,
+		RefundData:    []*pb.RefundData{},
+	}
+	for _, data := range paymentDataValue {
+		response.PaymentData = append(response.PaymentData, paymentToPb(data))
+	}
+	for _, data := range refundDataValue {
+		response.RefundData = append(response.RefundData, refundToPb(data))
+		response.AmountRefunded += data.Amount
+	}
+
+	return response, nil
+}
+
+// RecordPayment records a payment taken outside the payment provider, such as
+// a confirmed bank transfer. Payments are captured unless a status is given.
+func (s *ChronexAdminService) RecordPayment(ctx context.Context, req *pb.RecordPaymentRequest) (*pb.RecordPaymentResponse, error) {
+	method, err := parsePaymentMethod(req.PaymentMethod)
+	if err != nil {
+		return nil, err
+	}
+	if req.Amount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Amount must be greater than zero")
+	}
+
+	paymentStatus := strings.ToUpper(req.PaymentStatus)
+	if paymentStatus == "" {
+		paymentStatus = "CAP"
+	}
+	if !paymentStatuses[paymentStatus] {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid payment status %s", req.PaymentStatus))
+	}
+
+	payment := models.PaymentData{
+		PaymentMethod:     method,
+		Provider:          "manual",
+		Amount:            req.Amount,
+		PaymentStatus:     paymentStatus,
+		ProviderReference: req.ProviderReference,
+	}
+	if paymentStatus == "CAP" {
+		now := time.Now()
+		payment.CapturedAt = &now
+	}
+
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		orderData, err := lockOrder(tx, req.GetOrderId())
+		if err != nil {
+			return err
+		}
+		payment.OrderId = orderData.OrderId
+
+		// Save the data to the database using GORM
+		if err := tx.Create(&payment).Error; err != nil {
+			return err
+		}
+
+		return refreshOrderPaymentStatus(tx, &orderData)
+	})
+	if err != nil {
+		log.Printf("Error saving Payment data: %v", err)
+		return nil, err
+	}
+
+	return &pb.RecordPaymentResponse{
+		PaymentData: paymentToPb(payment),
+	}, nil
+}
+
+// lockPayment loads the payment matching query and its order for update,
+// locking the order first like every other order write so the two cannot
+// deadlock.
+func lockPayment(tx *gorm.DB, payment *models.PaymentData, query string, args ...interface{}) (models.OrderData, error) {
+	result := tx.Where(query, args...).Limit(1).Find(payment)
+	if result.Error != nil {
+		return models.OrderData{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return models.OrderData{}, status.Error(codes.NotFound, "Payment not found")
+	}
+
+	orderData, err := lockOrder(tx, payment.OrderId)
+	if err != nil {
+		return models.OrderData{}, err
+	}
+
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(payment, "payment_id = ?", payment.PaymentId).Error; err != nil {
+		return models.OrderData{}, err
+	}
+
+	return orderData, nil
+}
+
+// setPaymentStatus moves a payment to paymentStatus and refreshes its order.
+func setPaymentStatus(tx *gorm.DB, order *models.OrderData, payment *models.PaymentData, paymentStatus string, amount float64, failureReason string) error {
+	payment.PaymentStatus = paymentStatus
+	payment.FailureReason = failureReason
+	if amount > 0 {
+		payment.Amount = amount
+	}
+	if paymentStatus == "CAP" && payment.CapturedAt == nil {
+		now := time.Now()
+		payment.CapturedAt = &now
+	} else if paymentStatus != "CAP" {
+		payment.CapturedAt = nil
+	}
+
+	// Save the updated data back to the database using GORM
+	if err := tx.Save(payment).Error; err != nil {
+		return err
+	}
+
+	return refreshOrderPaymentStatus(tx, order)
+}
+
+func (s *ChronexAdminService) UpdatePaymentStatus(ctx context.Context, req *pb.UpdatePaymentStatusRequest) (*pb.UpdatePaymentStatusResponse, error) {
+	paymentStatus := strings.ToUpper(req.PaymentStatus)
+	if !paymentStatuses[paymentStatus] {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid payment status %s", req.PaymentStatus))
+	}
+
+	var payment models.PaymentData
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		// Retrieve existing PaymentData from the database
+		orderData, err := lockPayment(tx, &payment, "payment_id = ?", req.GetPaymentId())
+		if err != nil {
+			log.Printf("Error retrieving Payment data: %v", err)
+			return err
+		}
+
+		// A refunded payment has to stay captured for its refunds to add up
+		if payment.PaymentStatus == "CAP" && paymentStatus != "CAP" {
+			refunded, err := paymentRefunded(tx, payment.PaymentId)
+			if err != nil {
+				return err
+			}
+			if refunded > 0 {
+				return status.Error(codes.FailedPrecondition, fmt.Sprintf("Payment %s has refunds and must stay captured", req.GetPaymentId()))
+			}
+		}
+
+		if req.ProviderReference != "" {
+			payment.ProviderReference = req.ProviderReference
+		}
+
+		return setPaymentStatus(tx, &orderData, &payment, paymentStatus, 0, req.FailureReason)
+	})
+	if err != nil {
+		log.Printf("Error updating Payment data: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdatePaymentStatusResponse{
+		PaymentData: paymentToPb(payment),
+	}, nil
+}
+
+// HandlePaymentWebhook applies a signed provider notification to its payment.
+// Notifications are idempotent: a payment that is already captured, or
+// already in the reported state, is left as it is.
+func (s *ChronexAdminService) HandlePaymentWebhook(ctx context.Context, req *pb.HandlePaymentWebhookRequest) (*pb.HandlePaymentWebhookResponse, error) {
+	if s.PaymentProvider == nil || s.PaymentProvider.Name() != req.Provider {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("Unknown payment provider %s", req.Provider))
+	}
+
+	event, err := s.PaymentProvider.ParseWebhook(req.Payload, req.Signature)
+	if errors.Is(err, ErrInvalidSignature) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid webhook payload: %v", err))
+	}
+	if event.PaymentStatus == "" || event.Reference == "" {
+		return nil, status.Error(codes.InvalidArgument, "Webhook is missing a payment reference or status")
+	}
+
+	var payment models.PaymentData
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		orderData, err := lockPayment(tx, &payment, "provider = ? AND provider_reference = ?", req.Provider, event.Reference)
+		if err != nil {
+			return err
+		}
+
+		if payment.PaymentStatus == "CAP" || payment.PaymentStatus == event.PaymentStatus {
+			return nil
+		}
+
+		return setPaymentStatus(tx, &orderData, &payment, event.PaymentStatus, event.Amount, event.FailureReason)
+	})
+	if err != nil {
+		log.Printf("Error handling %s webhook: %v", req.Provider, err)
+		return nil, err
+	}
+
+	return &pb.HandlePaymentWebhookResponse{
+		PaymentData: paymentToPb(payment),
+	}, nil
+}
+
+// remainingAmount is what is left of total once used has gone, in cents.
+func remainingAmount(total float64, used float64) float64 {
+	return math.Round((total-used)*100) / 100
+}
+
+// refundAmount settles how much to refund when refundable can still be given
+// back: all of it unless an amount was asked for. ok is false when there is
+// nothing to refund or the amount asked for is more than refundable.
+func refundAmount(requested float64, refundable float64) (float64, bool) {
+	amount := requested
+	if amount == 0 {
+		amount = refundable
+	}
+
+	return amount, amount > 0 && amount <= refundable+0.005
+}
+
+// RefundPayment gives back part or all of a captured payment. Without an
+// amount the rest of the payment is refunded. A refund for a return needs the
+// return to be approved or received, and is capped at the value of its items.
+func (s *ChronexAdminService) RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest) (*pb.RefundPaymentResponse, error) {
+	if req.Amount < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Amount cannot be negative")
+	}
+
+	var refund models.RefundData
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		var payment models.PaymentData
+		orderData, err := lockPayment(tx, &payment, "payment_id = ?", req.GetPaymentId())
+		if err != nil {
+			return err
+		}
+		if payment.PaymentStatus != "CAP" {
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("Payment %s has not been captured", req.GetPaymentId()))
+		}
+
+		refunded, err := paymentRefunded(tx, payment.PaymentId)
+		if err != nil {
+			return err
+		}
+
+		refundable := remainingAmount(payment.Amount, refunded)
+		var returnId *uuid.UUID
+
+		// A refund for a return covers at most the returned items, less what
+		// was already refunded for them
+		if req.ReturnId != "" {
+			var returnData models.ReturnData
+			if err := tx.First(&returnData, "return_id = ?", req.ReturnId).Error; err != nil {
+				log.Printf("Error retrieving Return data: %v", err)
+				return err
+			}
+			if returnData.OrderId != orderData.OrderId {
+				return status.Error(codes.InvalidArgument, fmt.Sprintf("Return %s is for a different order", req.ReturnId))
+			}
+			if returnData.ReturnStatus != "APR" && returnData.ReturnStatus != "RCV" {
+				return status.Error(codes.FailedPrecondition, fmt.Sprintf("Return %s has not been approved", req.ReturnId))
+			}
+
+			value, err := returnValue(returnData)
+			if err != nil {
+				return err
+			}
+			returnRefunded, err := returnRefunds(tx, []uuid.UUID{returnData.ReturnId})
+			if err != nil {
+				return err
+			}
+			refundable = math.Min(refundable, remainingAmount(value, returnRefunded[returnData.ReturnId]))
+			returnId = &returnData.ReturnId
+		}
+
+		amount, ok := refundAmount(req.Amount, refundable)
+		if !ok {
+			if returnId != nil {
+				return status.Error(codes.FailedPrecondition, fmt.Sprintf("Only %.2f can still be refunded for return %s", math.Max(refundable, 0), req.ReturnId))
+			}
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("Only %.2f of payment %s can still be refunded", refundable, req.GetPaymentId()))
+		}
+
+		refund = models.RefundData{
+			OrderId:           orderData.OrderId,
+			PaymentId:         payment.PaymentId,
+			ReturnId:          returnId,
+			Amount:            amount,
+			Reason:            req.Reason,
+			ProviderReference: req.ProviderReference,
+		}
+
+		// Save the data to the database using GORM
+		if err := tx.Create(&refund).Error; err != nil {
+			return err
+		}
+
+		return refreshOrderPaymentStatus(tx, &orderData)
+	})
+	if err != nil {
+		log.Printf("Error saving Refund data: %v", err)
+		return nil, err
+	}
+
+	return &pb.RefundPaymentResponse{
+		RefundData: refundToPb(refund),
+	}, nil
+}
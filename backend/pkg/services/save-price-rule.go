@@ -0,0 +1,301 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func priceRuleToPb(data models.PriceRuleData) *pb.PriceRuleData {
+	response := &pb.PriceRuleData{
+		PriceRuleId: data.GetPriceRuleId().String(),
+		RuleName:    data.RuleName,
+		Category:    data.Category,
+		ProductId:   data.ProductId,
+		SalePrice:   data.SalePrice,
+		PercentOff:  data.PercentOff,
+		StartAt:     data.StartAt.Unix(),
+		EndAt:       data.EndAt.Unix(),
+		RuleStatus:  data.RuleStatus,
+		CreatedBy:   data.CreatedBy.String(),
+		CreatedAt:   data.CreatedAt.Unix(),
+		UpdatedBy:   data.UpdatedBy.String(),
+		UpdatedAt:   data.UpdatedAt.Unix(),
+	}
+	if data.StartedAt != nil {
+		response.StartedAt = data.StartedAt.Unix()
+	}
+	if data.EndedAt != nil {
+		response.EndedAt = data.EndedAt.Unix()
+	}
+
+	return response
+}
+
+func validatePriceRule(rule models.PriceRuleData) error {
+	if rule.SalePrice <= 0 && (rule.PercentOff <= 0 || rule.PercentOff >= 100) {
+		return status.Error(codes.InvalidArgument, "Price rule needs a sale price or a percent off between 0 and 100")
+	}
+	if !rule.EndAt.After(rule.StartAt) {
+		return status.Error(codes.InvalidArgument, "Price rule must end after it starts")
+	}
+
+	return nil
+}
+
+// loadActivePriceRules returns the price rules in effect at the given time.
+func loadActivePriceRules(db *gorm.DB, now time.Time) ([]models.PriceRuleData, error) {
+	var rules []models.PriceRuleData
+	if err := db.Where("rule_status = ? AND start_at <= ? AND end_at > ?", "ACT", now, now).Find(&rules).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch price rule data: %v", err))
+	}
+
+	return rules, nil
+}
+
+// applyEffectivePrice fills the computed price fields of a product response.
+func applyEffectivePrice(productData *pb.ProductData, product models.ProductData, rules []models.PriceRuleData, now time.Time) {
+	price, rule := models.EffectivePrice(product, rules, now)
+	productData.EffectivePrice = price
+	if rule != nil {
+		productData.PriceRuleId = rule.PriceRuleId.String()
+		productData.SaleEndsAt = rule.EndAt.Unix()
+	}
+}
+
+// recordPriceHistory appends the product's current effective price to its
+// price history unless it is unchanged from the latest entry.
+func recordPriceHistory(db *gorm.DB, product models.ProductData, rules []models.PriceRuleData, now time.Time, source string) error {
+	price, rule := models.EffectivePrice(product, rules, now)
+
+	var latest models.ProductPriceHistoryData
+	if err := db.Where("product_id = ?", product.ProductId).Order("effective_from DESC").Limit(1).Find(&latest).Error; err != nil {
+		return err
+	}
+	if latest.ProductId == product.ProductId &&
+		latest.OriginalPrice == product.OriginalPrice &&
+		latest.DiscountedPrice == product.DiscountedPrice &&
+		latest.EffectivePrice == price {
+		return nil
+	}
+
+	history := models.ProductPriceHistoryData{
+		ProductId:       product.ProductId,
+		OriginalPrice:   product.OriginalPrice,
+		DiscountedPrice: product.DiscountedPrice,
+		EffectivePrice:  price,
+		Source:          source,
+		EffectiveFrom:   now,
+	}
+	if rule != nil {
+		history.PriceRuleId = &rule.PriceRuleId
+	}
+
+	return db.Create(&history).Error
+}
+
+func (s *ChronexAdminService) SavePriceRule(ctx context.Context, req *pb.SavePriceRuleRequest) (*pb.SavePriceRuleResponse, error) {
+	ruleStatus := req.RuleStatus
+	if ruleStatus == "" {
+		ruleStatus = "ACT"
+	}
+
+	// Create a new PriceRuleData instance
+	priceRuleData := models.PriceRuleData{
+		RuleName:   req.RuleName,
+		Category:   req.Category,
+		ProductId:  req.ProductId,
+		SalePrice:  req.SalePrice,
+		PercentOff: req.PercentOff,
+		StartAt:    time.Unix(req.StartAt, 0),
+		EndAt:      time.Unix(req.EndAt, 0),
+		RuleStatus: ruleStatus,
+	}
+
+	if err := validatePriceRule(priceRuleData); err != nil {
+		return nil, err
+	}
+
+	// Save the data to the database using GORM
+	if err := s.DB.Create(&priceRuleData).Error; err != nil {
+		log.Printf("Error saving Price Rule data: %v", err)
+		return nil, err
+	}
+
+	return &pb.SavePriceRuleResponse{
+		PriceRuleData: priceRuleToPb(priceRuleData),
+	}, nil
+}
+
+func (s *ChronexAdminService) GetAllPriceRule(ctx context.Context, req *pb.GetAllPriceRuleRequest) (*pb.GetAllPriceRuleResponse, error) {
+	response := &pb.GetAllPriceRuleResponse{
+		PriceRuleData: []*pb.PriceRuleData{},
+	}
+
+	// Build your query based on the request parameters
+	query := s.DB.Model(&models.PriceRuleData{}).Order("start_at DESC")
+
+	// Filter by status
+	if req.RuleStatus != "" {
+		query = query.Where("rule_status = ?", req.RuleStatus)
+	} else {
+		query = query.Where("rule_status != ?", "DEL")
+	}
+
+	// Execute the query
+	var priceRuleDataValue []models.PriceRuleData
+	if err := query.Find(&priceRuleDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch price rule data: %v", err))
+	}
+
+	// Map the retrieved data to protobuf message
+	for _, data := range priceRuleDataValue {
+		response.PriceRuleData = append(response.PriceRuleData, priceRuleToPb(data))
+	}
+
+	return response, nil
+}
+
+func (s *ChronexAdminService) UpdatePriceRule(ctx context.Context, req *pb.UpdatePriceRuleRequest) (*pb.UpdatePriceRuleResponse, error) {
+	mask, err := newUpdateMask(req.ProtoReflect().Descriptor(), req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve existing PriceRuleData from the database
+	var existingPriceRuleData models.PriceRuleData
+	if err := s.DB.First(&existingPriceRuleData, "price_rule_id = ?", req.GetPriceRuleId()).Error; err != nil {
+		log.Printf("Error retrieving Price Rule data: %v", err)
+		return nil, err
+	}
+
+	// Update the existing PriceRuleData with the provided or masked values
+	if mask.has("ruleName", req.RuleName != "") {
+		existingPriceRuleData.RuleName = req.RuleName
+	}
+	if mask.has("category", req.Category != "") {
+		existingPriceRuleData.Category = req.Category
+	}
+	if mask.has("productId", req.ProductId != "") {
+		existingPriceRuleData.ProductId = req.ProductId
+	}
+	if mask.has("salePrice", req.SalePrice != 0) {
+		existingPriceRuleData.SalePrice = req.SalePrice
+	}
+	if mask.has("percentOff", req.PercentOff != 0) {
+		existingPriceRuleData.PercentOff = req.PercentOff
+	}
+	if mask.has("startAt", req.StartAt != 0) {
+		existingPriceRuleData.StartAt = time.Unix(req.StartAt, 0)
+	}
+	if mask.has("endAt", req.EndAt != 0) {
+		existingPriceRuleData.EndAt = time.Unix(req.EndAt, 0)
+	}
+
+	if err := validatePriceRule(existingPriceRuleData); err != nil {
+		return nil, err
+	}
+
+	// Save the updated data back to the database using GORM
+	if err := s.DB.Save(&existingPriceRuleData).Error; err != nil {
+		log.Printf("Error updating Price Rule data: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdatePriceRuleResponse{
+		PriceRuleData: priceRuleToPb(existingPriceRuleData),
+	}, nil
+}
+
+func (s *ChronexAdminService) UpdatePriceRuleStatus(ctx context.Context, req *pb.UpdatePriceRuleStatusRequest) (*pb.UpdatePriceRuleStatusResponse, error) {
+	// Retrieve existing PriceRuleData from the database
+	var existingPriceRuleData models.PriceRuleData
+	if err := s.DB.First(&existingPriceRuleData, "price_rule_id = ?", req.GetPriceRuleId()).Error; err != nil {
+		log.Printf("Error retrieving Price Rule data: %v", err)
+		return nil, err
+	}
+
+	if req.RuleStatus != "" {
+		existingPriceRuleData.RuleStatus = req.RuleStatus
+	}
+
+	// Save the updated data back to the database using GORM
+	if err := s.DB.Save(&existingPriceRuleData).Error; err != nil {
+		log.Printf("Error updating Price Rule data: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdatePriceRuleStatusResponse{
+		PriceRuleData: priceRuleToPb(existingPriceRuleData),
+	}, nil
+}
+
+func (s *ChronexAdminService) DeletePriceRule(ctx context.Context, req *pb.DeletePriceRuleRequest) (*pb.DeletePriceRuleResponse, error) {
+	var existingPriceRuleData models.PriceRuleData
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		// Retrieve existing PriceRuleData from the database
+		if err := tx.First(&existingPriceRuleData, "price_rule_id = ?", req.GetPriceRuleId()).Error; err != nil {
+			log.Printf("Error retrieving Price Rule data: %v", err)
+			return err
+		}
+
+		// Soft delete the rule, it stays in the trash until it is restored or purged
+		if err := tx.Delete(&existingPriceRuleData).Error; err != nil {
+			log.Printf("Error deleting Price Rule data: %v", err)
+			return err
+		}
+
+		// A running rule ends now, so the products it covered go back to
+		// their regular price in the history
+		now := time.Now()
+		if existingPriceRuleData.StartedAt != nil && existingPriceRuleData.EndedAt == nil {
+			if err := transitionPriceRule(tx, existingPriceRuleData, now, priceRuleEnd, "ended_at"); err != nil {
+				log.Printf("Error ending Price Rule data: %v", err)
+				return err
+			}
+			existingPriceRuleData.EndedAt = &now
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeletePriceRuleResponse{
+		PriceRuleData: priceRuleToPb(existingPriceRuleData),
+	}, nil
+}
+
+func (s *ChronexAdminService) GetProductPriceHistory(ctx context.Context, req *pb.GetProductPriceHistoryRequest) (*pb.GetProductPriceHistoryResponse, error) {
+	response := &pb.GetProductPriceHistoryResponse{
+		ProductPriceHistoryData: []*pb.ProductPriceHistoryData{},
+	}
+
+	var historyDataValue []models.ProductPriceHistoryData
+	if err := s.DB.Where("product_id = ?", req.ProductId).Order("effective_from DESC").Find(&historyDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch price history data: %v", err))
+	}
+
+	for _, data := range historyDataValue {
+		response.ProductPriceHistoryData = append(response.ProductPriceHistoryData, &pb.ProductPriceHistoryData{
+			PriceHistoryId:  data.PriceHistoryId.String(),
+			ProductId:       data.ProductId.String(),
+			OriginalPrice:   data.OriginalPrice,
+			DiscountedPrice: data.DiscountedPrice,
+			EffectivePrice:  data.EffectivePrice,
+			PriceRuleId:     data.GetPriceRuleId(),
+			Source:          data.Source,
+			EffectiveFrom:   data.EffectiveFrom.Unix(),
+		})
+	}
+
+	return response, nil
+}
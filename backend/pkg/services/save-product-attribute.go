@@ -0,0 +1,563 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// attributeTypes are the kinds of value a product attribute holds.
+var attributeTypes = map[string]bool{"TEXT": true, "NUMBER": true, "BOOLEAN": true, "OPTION": true}
+
+// attributeCodePattern is what attribute codes look like, such as
+// "case_diameter". Codes key the values of every product, so they cannot be
+// changed once saved.
+var attributeCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,39}$`)
+
+// maxAttributeTextLength caps TEXT values and option names.
+const maxAttributeTextLength = 200
+
+func productAttributeToPb(data models.ProductAttributeData) *pb.ProductAttributeData {
+	return &pb.ProductAttributeData{
+		AttributeId:     data.GetAttributeId().String(),
+		AttributeCode:   data.AttributeCode,
+		AttributeName:   data.AttributeName,
+		AttributeType:   data.AttributeType,
+		Unit:            data.Unit,
+		Options:         string(data.Options),
+		Categories:      string(data.Categories),
+		IsRequired:      data.IsRequired,
+		SortOrder:       data.SortOrder,
+		AttributeStatus: data.AttributeStatus,
+		CreatedBy:       data.CreatedBy.String(),
+		CreatedAt:       data.CreatedAt.Unix(),
+		UpdatedBy:       data.UpdatedBy.String(),
+		UpdatedAt:       data.UpdatedAt.Unix(),
+	}
+}
+
+// parseTextList decodes a JSON array of names, dropping blanks and duplicates
+// regardless of case.
+func parseTextList(value string, field string) (json.RawMessage, error) {
+	var names []string
+	if strings.TrimSpace(value) != "" {
+		if err := json.Unmarshal([]byte(value), &names); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid %s: %v", field, err))
+		}
+	}
+
+	seen := map[string]bool{}
+	cleaned := []string{}
+	for _, name := range names {
+		name = plainText(name, maxAttributeTextLength, false)
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+		cleaned = append(cleaned, name)
+	}
+
+	encoded, err := json.Marshal(cleaned)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(encoded), nil
+}
+
+func validateProductAttribute(attribute models.ProductAttributeData) error {
+	if !attributeCodePattern.MatchString(attribute.AttributeCode) {
+		return status.Error(codes.InvalidArgument, "Attribute codes are lowercase letters, digits and underscores, starting with a letter")
+	}
+	if attribute.AttributeName == "" {
+		return status.Error(codes.InvalidArgument, "Attribute name is required")
+	}
+	if !attributeTypes[attribute.AttributeType] {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid attribute type %s", attribute.AttributeType))
+	}
+	if attribute.AttributeType == "OPTION" && len(attribute.GetOptions()) == 0 {
+		return status.Error(codes.InvalidArgument, "Option attributes need at least one option")
+	}
+	if attribute.AttributeType != "OPTION" && len(attribute.GetOptions()) > 0 {
+		return status.Error(codes.InvalidArgument, "Only option attributes have options")
+	}
+
+	return nil
+}
+
+// checkAttributeInUse refuses to change the type or options of an attribute
+// in a way that would leave the values products already have invalid.
+func checkAttributeInUse(db *gorm.DB, existing models.ProductAttributeData, updated models.ProductAttributeData) error {
+	products := func() *gorm.DB {
+		return db.Model(&models.ProductData{}).Where("attributes->(?::text) IS NOT NULL", existing.AttributeCode)
+	}
+
+	var count int64
+	if updated.AttributeType != existing.AttributeType {
+		if err := products().Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("%d products have a value for %s; clear them before changing its type", count, existing.AttributeName))
+		}
+	}
+
+	if updated.AttributeType == "OPTION" {
+		options := []string{}
+		for _, option := range updated.GetOptions() {
+			options = append(options, strings.ToLower(option))
+		}
+		if err := products().Where("lower(attributes->>(?::text)) NOT IN ?", existing.AttributeCode, options).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("%d products use an option of %s that would be removed", count, existing.AttributeName))
+		}
+	}
+
+	return nil
+}
+
+// loadProductAttributes returns the attributes that are not deleted, in the
+// order they are shown.
+func loadProductAttributes(db *gorm.DB) ([]models.ProductAttributeData, error) {
+	var attributes []models.ProductAttributeData
+	if err := db.Order("sort_order ASC, attribute_name ASC").Find(&attributes).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch product attribute data: %v", err))
+	}
+	return attributes, nil
+}
+
+// attributeValue converts one value given for attribute to its stored type.
+// Numbers and yes/no values are also accepted as text, as spreadsheets send
+// them.
+func attributeValue(attribute models.ProductAttributeData, value interface{}) (interface{}, error) {
+	invalid := func(expected string) error {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("%s must be %s", attribute.AttributeName, expected))
+	}
+	text, isText := value.(string)
+	text = strings.TrimSpace(text)
+
+	switch attribute.AttributeType {
+	case "NUMBER":
+		if number, ok := value.(float64); ok {
+			return number, nil
+		}
+		if number, err := strconv.ParseFloat(text, 64); isText && err == nil {
+			return number, nil
+		}
+		return nil, invalid("a number")
+	case "BOOLEAN":
+		if flag, ok := value.(bool); ok {
+			return flag, nil
+		}
+		switch strings.ToLower(text) {
+		case "true", "yes":
+			return true, nil
+		case "false", "no":
+			return false, nil
+		}
+		return nil, invalid("true or false")
+	case "OPTION":
+		for _, option := range attribute.GetOptions() {
+			if isText && strings.EqualFold(option, text) {
+				return option, nil
+			}
+		}
+		return nil, invalid("one of " + strings.Join(attribute.GetOptions(), ", "))
+	default:
+		if !isText {
+			return nil, invalid("text")
+		}
+		return plainText(text, maxAttributeTextLength, false), nil
+	}
+}
+
+// checkProductAttributes validates the attribute values of a product in
+// category against the stored attributes; see checkAttributeValues.
+func checkProductAttributes(db *gorm.DB, category string, value string) (json.RawMessage, error) {
+	attributes, err := loadProductAttributes(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkAttributeValues(attributes, category, value)
+}
+
+// checkAttributeValues validates the attribute values of a product in
+// category, given as a JSON object keyed by attribute code, and returns them
+// converted to their types. Blank values are dropped. Values of inactive
+// attributes are kept as they are.
+func checkAttributeValues(attributes []models.ProductAttributeData, category string, value string) (json.RawMessage, error) {
+	values := map[string]interface{}{}
+	if strings.TrimSpace(value) != "" {
+		if err := json.Unmarshal([]byte(value), &values); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid attributes: %v", err))
+		}
+	}
+
+	var err error
+	byCode := map[string]models.ProductAttributeData{}
+	for _, attribute := range attributes {
+		byCode[attribute.AttributeCode] = attribute
+	}
+
+	checked := map[string]interface{}{}
+	for code, value := range values {
+		attribute, ok := byCode[code]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Unknown attribute %s", code))
+		}
+		if text, isText := value.(string); value == nil || (isText && strings.TrimSpace(text) == "") {
+			continue
+		}
+		if attribute.AttributeStatus != "ACT" {
+			checked[code] = value
+			continue
+		}
+		if !attribute.AppliesTo(category) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s does not apply to %s products", attribute.AttributeName, category))
+		}
+
+		if checked[code], err = attributeValue(attribute, value); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, attribute := range attributes {
+		if _, ok := checked[attribute.AttributeCode]; !ok && attribute.IsRequired && attribute.AttributeStatus == "ACT" && attribute.AppliesTo(category) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s is required for %s products", attribute.AttributeName, category))
+		}
+	}
+
+	encoded, err := json.Marshal(checked)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(encoded), nil
+}
+
+// attributeFilter narrows products to those whose attribute is one of Values,
+// is Flag, or, for numbers, lies between Min and Max.
+type attributeFilter struct {
+	Attribute models.ProductAttributeData
+	Values    []string
+	Flag      *bool
+	Min       *float64
+	Max       *float64
+}
+
+// attributeFacet is the facet name of an attribute's filter.
+func attributeFacet(code string) string {
+	return "attribute:" + code
+}
+
+// attributeNumberSQL is a product's value of an attribute when it is a number.
+const attributeNumberSQL = "(CASE WHEN jsonb_typeof(attributes->(?::text)) = 'number' THEN (attributes->>(?::text))::numeric END)"
+
+// parseAttributeFilters decodes the attribute filters of a product listing, a
+// JSON object keyed by attribute code. A value can be one value or a list of
+// values to match any of, true or false, or {"min": n, "max": n} for numbers.
+func parseAttributeFilters(db *gorm.DB, value string) ([]attributeFilter, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(value), &raw); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid attribute filters: %v", err))
+	}
+
+	attributes, err := loadProductAttributes(db)
+	if err != nil {
+		return nil, err
+	}
+	byCode := map[string]models.ProductAttributeData{}
+	for _, attribute := range attributes {
+		byCode[attribute.AttributeCode] = attribute
+	}
+
+	filters := []attributeFilter{}
+	for code, value := range raw {
+		attribute, ok := byCode[code]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Unknown attribute %s", code))
+		}
+		filter := attributeFilter{Attribute: attribute}
+		invalid := status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid filter for %s", attribute.AttributeName))
+
+		switch attribute.AttributeType {
+		case "NUMBER":
+			var bounds struct {
+				Min *float64 `json:"min"`
+				Max *float64 `json:"max"`
+			}
+			if err := json.Unmarshal(value, &bounds); err != nil {
+				var exact float64
+				if err := json.Unmarshal(value, &exact); err != nil {
+					return nil, invalid
+				}
+				bounds.Min, bounds.Max = &exact, &exact
+			}
+			filter.Min, filter.Max = bounds.Min, bounds.Max
+		case "BOOLEAN":
+			var flag bool
+			if err := json.Unmarshal(value, &flag); err != nil {
+				return nil, invalid
+			}
+			filter.Flag = &flag
+		default:
+			var values []string
+			if err := json.Unmarshal(value, &values); err != nil {
+				var one string
+				if err := json.Unmarshal(value, &one); err != nil {
+					return nil, invalid
+				}
+				values = []string{one}
+			}
+			for _, value := range values {
+				filter.Values = append(filter.Values, strings.ToLower(strings.TrimSpace(value)))
+			}
+		}
+		filters = append(filters, filter)
+	}
+
+	// Keep the SQL the same for the same filters
+	sort.Slice(filters, func(i, j int) bool {
+		return filters[i].Attribute.AttributeCode < filters[j].Attribute.AttributeCode
+	})
+	return filters, nil
+}
+
+// filterAttributes narrows query by filters, leaving out the filter of the
+// facet named skip.
+func filterAttributes(query *gorm.DB, filters []attributeFilter, skip string) *gorm.DB {
+	for _, filter := range filters {
+		code := filter.Attribute.AttributeCode
+		if attributeFacet(code) == skip {
+			continue
+		}
+
+		switch {
+		case filter.Flag != nil:
+			query = query.Where("attributes->(?::text) = ?::jsonb", code, strconv.FormatBool(*filter.Flag))
+		case filter.Min != nil || filter.Max != nil:
+			if filter.Min != nil {
+				query = query.Where(attributeNumberSQL+" >= ?", code, code, *filter.Min)
+			}
+			if filter.Max != nil {
+				query = query.Where(attributeNumberSQL+" <= ?", code, code, *filter.Max)
+			}
+		case len(filter.Values) > 0:
+			query = query.Where("lower(attributes->>(?::text)) IN ?", code, filter.Values)
+		}
+	}
+	return query
+}
+
+func (s *ChronexAdminService) SaveProductAttribute(ctx context.Context, req *pb.SaveProductAttributeRequest) (*pb.SaveProductAttributeResponse, error) {
+	options, err := parseTextList(req.Options, "options")
+	if err != nil {
+		return nil, err
+	}
+	categories, err := parseTextList(req.Categories, "categories")
+	if err != nil {
+		return nil, err
+	}
+
+	attributeStatus := req.AttributeStatus
+	if attributeStatus == "" {
+		attributeStatus = "ACT"
+	}
+
+	// Create a new ProductAttributeData instance
+	productAttributeData := models.ProductAttributeData{
+		AttributeCode:   strings.TrimSpace(req.AttributeCode),
+		AttributeName:   plainText(req.AttributeName, maxAttributeTextLength, false),
+		AttributeType:   strings.ToUpper(req.AttributeType),
+		Unit:            plainText(req.Unit, 20, false),
+		Options:         options,
+		Categories:      categories,
+		IsRequired:      req.IsRequired,
+		SortOrder:       req.SortOrder,
+		AttributeStatus: attributeStatus,
+	}
+	if err := validateProductAttribute(productAttributeData); err != nil {
+		return nil, err
+	}
+
+	var count int64
+	if err := s.DB.Model(&models.ProductAttributeData{}).Where("attribute_code = ?", productAttributeData.AttributeCode).Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, status.Error(codes.AlreadyExists, fmt.Sprintf("Attribute code %s is already in use", productAttributeData.AttributeCode))
+	}
+
+	// Save the data to the database using GORM
+	if err := s.DB.Create(&productAttributeData).Error; err != nil {
+		log.Printf("Error saving Product Attribute data: %v", err)
+		return nil, err
+	}
+
+	return &pb.SaveProductAttributeResponse{
+		ProductAttributeData: productAttributeToPb(productAttributeData),
+	}, nil
+}
+
+func (s *ChronexAdminService) GetAllProductAttribute(ctx context.Context, req *pb.GetAllProductAttributeRequest) (*pb.GetAllProductAttributeResponse, error) {
+	response := &pb.GetAllProductAttributeResponse{
+		ProductAttributeData: []*pb.ProductAttributeData{},
+	}
+
+	// Build your query based on the request parameters
+	query := s.DB.Model(&models.ProductAttributeData{}).Order("sort_order ASC, attribute_name ASC")
+
+	// Handle searching
+	if req.Search != "" {
+		searchParam := "%" + req.Search + "%"
+		query = query.Where("(attribute_name ILIKE ? OR attribute_code ILIKE ?)", searchParam, searchParam)
+	}
+
+	// Filter by status
+	if req.AttributeStatus != "" {
+		query = query.Where("attribute_status = ?", req.AttributeStatus)
+	}
+
+	// Execute the query
+	var productAttributeDataValue []models.ProductAttributeData
+	if err := query.Find(&productAttributeDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch product attribute data: %v", err))
+	}
+
+	// Map the retrieved data to protobuf message, keeping the attributes of
+	// the category when one was asked for
+	for _, data := range productAttributeDataValue {
+		if req.Category != "" && !data.AppliesTo(req.Category) {
+			continue
+		}
+		response.ProductAttributeData = append(response.ProductAttributeData, productAttributeToPb(data))
+	}
+
+	return response, nil
+}
+
+func (s *ChronexAdminService) UpdateProductAttribute(ctx context.Context, req *pb.UpdateProductAttributeRequest) (*pb.UpdateProductAttributeResponse, error) {
+	mask, err := newUpdateMask(req.ProtoReflect().Descriptor(), req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve existing ProductAttributeData from the database
+	var existingProductAttributeData models.ProductAttributeData
+	if err := s.DB.First(&existingProductAttributeData, "attribute_id = ?", req.GetAttributeId()).Error; err != nil {
+		log.Printf("Error retrieving Product Attribute data: %v", err)
+		return nil, err
+	}
+	before := existingProductAttributeData
+
+	// Update the existing ProductAttributeData with the provided or masked values
+	if mask.has("attributeName", req.AttributeName != "") {
+		existingProductAttributeData.AttributeName = plainText(req.AttributeName, maxAttributeTextLength, false)
+	}
+	if mask.has("attributeType", req.AttributeType != "") {
+		existingProductAttributeData.AttributeType = strings.ToUpper(req.AttributeType)
+	}
+	if mask.has("unit", req.Unit != "") {
+		existingProductAttributeData.Unit = plainText(req.Unit, 20, false)
+	}
+	if mask.has("options", req.Options != "") {
+		options, err := parseTextList(req.Options, "options")
+		if err != nil {
+			return nil, err
+		}
+		existingProductAttributeData.Options = options
+	}
+	if mask.has("categories", req.Categories != "") {
+		categories, err := parseTextList(req.Categories, "categories")
+		if err != nil {
+			return nil, err
+		}
+		existingProductAttributeData.Categories = categories
+	}
+	if mask.has("isRequired", req.IsRequired) {
+		existingProductAttributeData.IsRequired = req.IsRequired
+	}
+	if mask.has("sortOrder", req.SortOrder != 0) {
+		existingProductAttributeData.SortOrder = req.SortOrder
+	}
+
+	if err := validateProductAttribute(existingProductAttributeData); err != nil {
+		return nil, err
+	}
+	if err := checkAttributeInUse(s.DB, before, existingProductAttributeData); err != nil {
+		return nil, err
+	}
+
+	// Save the updated data back to the database using GORM
+	if err := s.DB.Save(&existingProductAttributeData).Error; err != nil {
+		log.Printf("Error updating Product Attribute data: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdateProductAttributeResponse{
+		ProductAttributeData: productAttributeToPb(existingProductAttributeData),
+	}, nil
+}
+
+func (s *ChronexAdminService) UpdateProductAttributeStatus(ctx context.Context, req *pb.UpdateProductAttributeStatusRequest) (*pb.UpdateProductAttributeStatusResponse, error) {
+	if req.AttributeStatus == "DEL" {
+		return nil, status.Error(codes.InvalidArgument, "Use DeleteProductAttribute to move an attribute to the trash")
+	}
+
+	// Retrieve existing ProductAttributeData from the database
+	var existingProductAttributeData models.ProductAttributeData
+	if err := s.DB.First(&existingProductAttributeData, "attribute_id = ?", req.GetAttributeId()).Error; err != nil {
+		log.Printf("Error retrieving Product Attribute data: %v", err)
+		return nil, err
+	}
+
+	if req.AttributeStatus != "" {
+		existingProductAttributeData.AttributeStatus = req.AttributeStatus
+	}
+
+	// Save the updated data back to the database using GORM
+	if err := s.DB.Save(&existingProductAttributeData).Error; err != nil {
+		log.Printf("Error updating Product Attribute data: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdateProductAttributeStatusResponse{
+		ProductAttributeData: productAttributeToPb(existingProductAttributeData),
+	}, nil
+}
+
+func (s *ChronexAdminService) DeleteProductAttribute(ctx context.Context, req *pb.DeleteProductAttributeRequest) (*pb.DeleteProductAttributeResponse, error) {
+	// Retrieve existing ProductAttributeData from the database
+	var existingProductAttributeData models.ProductAttributeData
+	if err := s.DB.First(&existingProductAttributeData, "attribute_id = ?", req.GetAttributeId()).Error; err != nil {
+		log.Printf("Error retrieving Product Attribute data: %v", err)
+		return nil, err
+	}
+
+	// Soft delete the attribute, it stays in the trash until it is restored or purged
+	if err := s.DB.Delete(&existingProductAttributeData).Error; err != nil {
+		log.Printf("Error deleting Product Attribute data: %v", err)
+		return nil, err
+	}
+
+	return &pb.DeleteProductAttributeResponse{
+		ProductAttributeData: productAttributeToPb(existingProductAttributeData),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"api/pkg/models"
+	"encoding/json"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckAttributeValues(t *testing.T) {
+	attributes := []models.ProductAttributeData{
+		{AttributeCode: "case_diameter", AttributeName: "Case diameter", AttributeType: "NUMBER", Categories: json.RawMessage(`["Watches"]`), IsRequired: true, AttributeStatus: "ACT"},
+		{AttributeCode: "water_resistant", AttributeName: "Water resistant", AttributeType: "BOOLEAN", AttributeStatus: "ACT"},
+		{AttributeCode: "movement", AttributeName: "Movement", AttributeType: "OPTION", Options: json.RawMessage(`["Automatic","Quartz"]`), AttributeStatus: "ACT"},
+		{AttributeCode: "material", AttributeName: "Material", AttributeType: "TEXT", AttributeStatus: "ACT"},
+		{AttributeCode: "strap_width", AttributeName: "Strap width", AttributeType: "NUMBER", Categories: json.RawMessage(`["Straps"]`), IsRequired: true, AttributeStatus: "ACT"},
+		{AttributeCode: "old_code", AttributeName: "Old code", AttributeType: "NUMBER", IsRequired: true, AttributeStatus: "INA"},
+	}
+
+	tests := []struct {
+		name     string
+		category string
+		value    string
+		want     string
+		code     codes.Code
+	}{
+		{
+			name:     "values converted to their types",
+			category: "Watches",
+			value:    `{"case_diameter":"42","water_resistant":"yes","movement":"automatic","material":"  Steel  "}`,
+			want:     `{"case_diameter":42,"material":"Steel","movement":"Automatic","water_resistant":true}`,
+		},
+		{name: "typed values kept", category: "Watches", value: `{"case_diameter":40.5,"water_resistant":false}`, want: `{"case_diameter":40.5,"water_resistant":false}`},
+		{name: "category in any case", category: " watches ", value: `{"case_diameter":42}`, want: `{"case_diameter":42}`},
+		{name: "blank values dropped", category: "Watches", value: `{"case_diameter":42,"material":"  ","movement":null}`, want: `{"case_diameter":42}`},
+		{name: "inactive attribute kept as it is", category: "Watches", value: `{"case_diameter":42,"old_code":"x"}`, want: `{"case_diameter":42,"old_code":"x"}`},
+		{name: "no attributes for an uncovered category", category: "Boxes", value: "", want: `{}`},
+		{name: "not JSON", category: "Watches", value: `{"case_diameter"`, code: codes.InvalidArgument},
+		{name: "unknown attribute", category: "Watches", value: `{"case_diameter":42,"colour":"red"}`, code: codes.InvalidArgument},
+		{name: "attribute of another category", category: "Watches", value: `{"case_diameter":42,"strap_width":20}`, code: codes.InvalidArgument},
+		{name: "required attribute missing", category: "Watches", value: `{"material":"Steel"}`, code: codes.InvalidArgument},
+		{name: "required attribute blank", category: "Straps", value: `{"strap_width":""}`, code: codes.InvalidArgument},
+		{name: "not a number", category: "Watches", value: `{"case_diameter":"big"}`, code: codes.InvalidArgument},
+		{name: "not a flag", category: "Watches", value: `{"case_diameter":42,"water_resistant":"maybe"}`, code: codes.InvalidArgument},
+		{name: "not an option", category: "Watches", value: `{"case_diameter":42,"movement":"Solar"}`, code: codes.InvalidArgument},
+		{name: "text that is not text", category: "Watches", value: `{"case_diameter":42,"material":7}`, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checkAttributeValues(attributes, tt.category, tt.value)
+			if status.Code(err) != tt.code {
+				t.Fatalf("checkAttributeValues(%s) error = %v, want code %v", tt.value, err, tt.code)
+			}
+			if err == nil && string(got) != tt.want {
+				t.Errorf("checkAttributeValues(%s) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
@@ -18,12 +20,92 @@ import (
 type ChronexAdminService struct {
 	pb.UnimplementedChronexAdminProtoServiceServer
 	DB *gorm.DB
+	// OrderLinkSecret signs the order links customers use to look up and
+	// cancel their orders. Token lookups are disabled while it is empty.
+	OrderLinkSecret []byte
+	// StorefrontURL is the public shop address used for links in emails.
+	StorefrontURL string
+	// Mailer delivers the email outbox. OrderEmailEnabled switches the order
+	// lifecycle emails on or off by event.
+	Mailer            Mailer
+	OrderEmailEnabled map[string]bool
+	// ContactRecipient is the inbox contact form messages go to. Captcha,
+	// when set, verifies the token sent with every contact form.
+	ContactRecipient string
+	Captcha          CaptchaVerifier
+	// PaymentProvider opens card and e-wallet payments and verifies their
+	// webhooks.
+	PaymentProvider PaymentProvider
+	// Courier books shipments and reports their tracking.
+	Courier Courier
+	// Seller is printed on invoices and labels. VATRate is the VAT included
+	// in prices, broken out on invoices; 0 prints them as VAT-exempt.
+	Seller  SellerInfo
+	VATRate float64
+	// Blobs stores uploaded images. Uploads are refused while it is nil.
+	Blobs BlobStore
 }
 
 func InitChronexService(db *gorm.DB) *ChronexAdminService {
 	return &ChronexAdminService{DB: db}
 }
 
+func productToPb(data models.ProductData) *pb.ProductData {
+	return &pb.ProductData{
+		ProductId:        data.GetProductId().String(),
+		ProductName:      data.GetProductName(),
+		Img:              string(data.GetImg()),
+		Discount:         data.GetDiscount(),
+		SupplierPrice:    data.GetSupplierPrice(),
+		OriginalPrice:    data.GetOriginalPrice(),
+		DiscountedPrice:  data.GetDiscountedPrice(),
+		Description1:     data.GetDescription1(),
+		Description2:     string(data.GetDescription2()),
+		OriginalQuantity: data.GetOriginalQuantity(),
+		CurrentQuantity:  data.GetCurrentQuantity(),
+		ProductStatus:    data.GetProductStatus(),
+		ProductSold:      data.GetProductSold(),
+		ProductFreebies:  string(data.GetProductFreebies()),
+		Category:         data.GetCategory(),
+		WeightGrams:      data.WeightGrams,
+		LengthCm:         data.LengthCm,
+		WidthCm:          data.WidthCm,
+		HeightCm:         data.HeightCm,
+		Sku:              data.Sku,
+		Attributes:       data.GetAttributes(),
+		CreatedBy:        data.CreatedBy.String(),
+		CreatedAt:        data.CreatedAt.Unix(),
+		UpdatedBy:        data.UpdatedBy.String(),
+		UpdatedAt:        data.UpdatedAt.Unix(),
+		Version:          data.GetVersion(),
+	}
+}
+
+const maxSkuLength = 64
+
+// checkSku cleans up a SKU and makes sure no other row of table uses it. id is
+// the row being saved, or uuid.Nil for a new one. An empty SKU is allowed.
+func checkSku(db *gorm.DB, table interface{}, idColumn string, id uuid.UUID, sku string) (string, error) {
+	sku = plainText(sku, maxSkuLength, false)
+	if sku == "" {
+		return "", nil
+	}
+
+	var count int64
+	query := db.Model(table).Where("sku = ?", sku)
+	if id != uuid.Nil {
+		query = query.Where(idColumn+" <> ?", id)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return "", err
+	}
+	if count > 0 {
+		return "", status.Error(codes.AlreadyExists, fmt.Sprintf("SKU %s is already in use", sku))
+	}
+
+	return sku, nil
+}
+
 func (s *ChronexAdminService) SaveProduct(ctx context.Context, req *pb.SaveProductRequest) (*pb.SaveProductResponse, error) {
 
 	images, err := json.Marshal(req.Img)
@@ -44,6 +126,16 @@ func (s *ChronexAdminService) SaveProduct(ctx context.Context, req *pb.SaveProdu
 		return nil, err
 	}
 
+	sku, err := checkSku(s.DB, &models.ProductData{}, "product_id", uuid.Nil, req.Sku)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := checkProductAttributes(s.DB, req.Category, req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create a new PDFExtractorData instance
 	productData := models.ProductData{
 		ProductName:      req.ProductName,
@@ -59,6 +151,13 @@ func (s *ChronexAdminService) SaveProduct(ctx context.Context, req *pb.SaveProdu
 		ProductStatus:    req.ProductStatus,
 		ProductSold:      req.ProductSold,
 		ProductFreebies:  json.RawMessage(productFreebies),
+		Category:         req.Category,
+		WeightGrams:      req.WeightGrams,
+		LengthCm:         req.LengthCm,
+		WidthCm:          req.WidthCm,
+		HeightCm:         req.HeightCm,
+		Sku:              sku,
+		Attributes:       attributes,
 	}
 
 	// Save the data to the database using GORM
@@ -67,26 +166,15 @@ func (s *ChronexAdminService) SaveProduct(ctx context.Context, req *pb.SaveProdu
 		return nil, err
 	}
 
+	// Start the price history of the new product
+	if err := recordPriceHistory(s.DB, productData, nil, time.Now(), "MANUAL"); err != nil {
+		log.Printf("Error saving Product Price History data: %v", err)
+		return nil, err
+	}
+
 	// Create and return the response
 	response := &pb.SaveProductResponse{
-		ProductData: &pb.ProductData{
-			ProductName:      productData.ProductName,
-			Img:              string(productData.Img),
-			Discount:         productData.Discount,
-			OriginalPrice:    productData.OriginalPrice,
-			DiscountedPrice:  productData.DiscountedPrice,
-			Description1:     productData.Description1,
-			Description2:     string(productData.Description2),
-			OriginalQuantity: productData.OriginalQuantity,
-			CurrentQuantity:  productData.CurrentQuantity,
-			ProductStatus:    productData.ProductStatus,
-			ProductSold:      productData.ProductSold,
-			ProductFreebies:  string(productData.ProductFreebies),
-			CreatedBy:        productData.CreatedBy.String(),
-			CreatedAt:        productData.CreatedAt.Unix(),
-			UpdatedBy:        productData.UpdatedBy.String(),
-			UpdatedAt:        productData.UpdatedAt.Unix(),
-		},
+		ProductData: productToPb(productData),
 	}
 
 	return response, nil
@@ -110,6 +198,8 @@ func convertSortOptionProduct(sortOptionStr string) pb.SortOptionProduct {
 		return pb.SortOptionProduct_PRODUCT_SUPPLIER_HIGH_TO_LOW
 	case "PRODUCT_SUPPLIER_LOW_TO_HIGH":
 		return pb.SortOptionProduct_PRODUCT_SUPPLIER_LOW_TO_HIGH
+	case "RELEVANCE":
+		return pb.SortOptionProduct_PRODUCT_RELEVANCE
 	default:
 		return pb.SortOptionProduct_PRODUCT_ATOZ
 	}
@@ -119,12 +209,24 @@ func (s *ChronexAdminService) GetAllProduct(ctx context.Context, req *pb.GetAllP
 	response := &pb.GetAllProductResponse{
 		ProductData: []*pb.ProductData{},
 	}
+	if err := checkProductFilters(req); err != nil {
+		return nil, err
+	}
+	attributes, err := parseAttributeFilters(s.DB, req.Attributes)
+	if err != nil {
+		return nil, err
+	}
 
 	// Build your query based on the request parameters
-	query := s.DB.Model(&models.ProductData{})
+	query := filterProducts(s.DB.Model(&models.ProductData{}), req, attributes, "")
 
-	// Convert sort option string to enum value
+	// Convert sort option string to enum value; searches without one are
+	// ordered by relevance
+	search := strings.TrimSpace(req.Search)
 	sortOption := convertSortOptionProduct(req.SortOptionProduct)
+	if req.SortOptionProduct == "" && search != "" {
+		sortOption = pb.SortOptionProduct_PRODUCT_RELEVANCE
+	}
 
 	// Handle sorting
 	switch sortOption {
@@ -144,44 +246,66 @@ func (s *ChronexAdminService) GetAllProduct(ctx context.Context, req *pb.GetAllP
 		query = query.Order("supplier_price DESC")
 	case pb.SortOptionProduct_PRODUCT_SUPPLIER_LOW_TO_HIGH:
 		query = query.Order("supplier_price ASC")
+	case pb.SortOptionProduct_PRODUCT_RELEVANCE:
+		if search != "" {
+			query = query.Order("search_rank DESC")
+		}
+		query = query.Order("product_name ASC")
 	}
 
-	// Handle searching
-	if req.Search != "" {
-		query = query.Where("product_name ILIKE ?", "%"+req.Search+"%")
+	// Count every match before paging, and the facets when asked for
+	if err := filterProducts(s.DB.Model(&models.ProductData{}), req, attributes, "").Count(&response.Total).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to count product data: %v", err))
+	}
+	if req.IncludeFacets {
+		facets, err := s.productFacets(req, attributes)
+		if err != nil {
+			return nil, err
+		}
+		response.Facets = facets
 	}
 
-	// Filter by active status
-	query = query.Where("product_status != ?", "DEL")
+	// Handle searching
+	query = rankProducts(query, search)
+
+	// Handle paging; the id keeps pages stable between equal sort values
+	if req.Limit > 0 {
+		query = query.Order("product_id ASC").Limit(int(req.Limit)).Offset(int(req.Offset))
+	}
 
 	// Execute the query
-	var productDataValue []models.ProductData
+	var productDataValue []productSearchResult
 	if err := query.Find(&productDataValue).Error; err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch product data: %v", err))
 	}
 
+	productIds := make([]string, len(productDataValue))
+	for i, result := range productDataValue {
+		productIds[i] = result.ProductId.String()
+	}
+	ratings, err := loadProductRatings(s.DB, productIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load the price rules running right now for the effective prices
+	now := time.Now()
+	priceRules, err := loadActivePriceRules(s.DB, now)
+	if err != nil {
+		return nil, err
+	}
+
 	// Map the retrieved data to protobuf message
-	for _, data := range productDataValue {
-		response.ProductData = append(response.ProductData, &pb.ProductData{
-			ProductId:        data.ProductId.String(),
-			ProductName:      data.ProductName,
-			Img:              string(data.Img),
-			Discount:         data.Discount,
-			SupplierPrice:    data.SupplierPrice,
-			OriginalPrice:    data.OriginalPrice,
-			DiscountedPrice:  data.DiscountedPrice,
-			Description1:     data.Description1,
-			Description2:     string(data.Description2),
-			OriginalQuantity: data.OriginalQuantity,
-			CurrentQuantity:  data.CurrentQuantity,
-			ProductStatus:    data.ProductStatus,
-			ProductSold:      data.ProductSold,
-			ProductFreebies:  string(data.ProductFreebies),
-			CreatedBy:        data.CreatedBy.String(),
-			CreatedAt:        data.CreatedAt.Unix(),
-			UpdatedBy:        data.UpdatedBy.String(),
-			UpdatedAt:        data.UpdatedAt.Unix(),
-		})
+	for _, result := range productDataValue {
+		data := result.ProductData
+		productData := productToPb(data)
+		productData.SearchRank = result.SearchRank
+		productData.HighlightedName = highlightSnippet(result.HighlightedName)
+		productData.SearchSnippet = highlightSnippet(result.SearchSnippet)
+		productData.AverageRating = ratings[data.ProductId.String()].Average
+		productData.ReviewCount = ratings[data.ProductId.String()].Count
+		applyEffectivePrice(productData, data, priceRules, now)
+		response.ProductData = append(response.ProductData, productData)
 	}
 
 	return response, nil
@@ -194,39 +318,42 @@ func (s *ChronexAdminService) GetAllProductById(ctx context.Context, req *pb.Get
 
 	// Fetch the freebie by its ID and status
 	var product models.ProductData
-	if err := s.DB.Where("product_id = ? AND product_status != ?", req.ProductId, "DEL").Find(&product).Error; err != nil {
+	if err := s.DB.Where("product_id = ?", req.ProductId).Find(&product).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, status.Error(codes.NotFound, fmt.Sprintf("Product with ID %s not found", req.ProductId))
 		}
 		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch product data: %v", err))
 	}
 
+	now := time.Now()
+	priceRules, err := loadActivePriceRules(s.DB, now)
+	if err != nil {
+		return nil, err
+	}
+	ratings, err := loadProductRatings(s.DB, []string{product.ProductId.String()})
+	if err != nil {
+		return nil, err
+	}
+
 	// Map the fetched freebie to protobuf message
-	response.ProductData = append(response.ProductData, &pb.ProductData{
-		ProductId:        product.ProductId.String(),
-		ProductName:      product.ProductName,
-		Img:              string(product.Img),
-		Discount:         product.Discount,
-		SupplierPrice:    product.SupplierPrice,
-		OriginalPrice:    product.OriginalPrice,
-		DiscountedPrice:  product.DiscountedPrice,
-		Description1:     product.Description1,
-		Description2:     string(product.Description2),
-		OriginalQuantity: product.OriginalQuantity,
-		CurrentQuantity:  product.CurrentQuantity,
-		ProductStatus:    product.ProductStatus,
-		ProductSold:      product.ProductSold,
-		ProductFreebies:  string(product.ProductFreebies),
-		CreatedBy:        product.CreatedBy.String(),
-		CreatedAt:        product.CreatedAt.Unix(),
-		UpdatedBy:        product.UpdatedBy.String(),
-		UpdatedAt:        product.UpdatedAt.Unix(),
-	})
+	productData := productToPb(product)
+	productData.AverageRating = ratings[product.ProductId.String()].Average
+	productData.ReviewCount = ratings[product.ProductId.String()].Count
+	applyEffectivePrice(productData, product, priceRules, now)
+	response.ProductData = append(response.ProductData, productData)
 
 	return response, nil
 }
 
 func (s *ChronexAdminService) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
+	mask, err := newUpdateMask(req.ProtoReflect().Descriptor(), req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+	if req.ProductStatus == "DEL" {
+		return nil, status.Error(codes.InvalidArgument, "Use DeleteProduct to move a product to the trash")
+	}
+
 	// Retrieve existing ProductData from the database
 	var existingProductData models.ProductData
 	if err := s.DB.First(&existingProductData, "product_id = ?", req.GetProductId()).First(&existingProductData).Error; err != nil {
@@ -234,11 +361,22 @@ func (s *ChronexAdminService) UpdateProduct(ctx context.Context, req *pb.UpdateP
 		return nil, err
 	}
 
-	// Update the existing ProductData with new values if they are not nil
-	if req.ProductName != "" {
+	if err := checkVersion(req.ExpectedVersion, existingProductData.Version, "Product", req.GetProductId()); err != nil {
+		return nil, err
+	}
+
+	// Update the existing ProductData with the provided or masked values
+	if mask.has("productName", req.ProductName != "") {
 		existingProductData.ProductName = req.ProductName
 	}
-	if req.Img != "" {
+	if mask.has("sku", req.Sku != "") {
+		sku, err := checkSku(s.DB, &models.ProductData{}, "product_id", existingProductData.ProductId, req.Sku)
+		if err != nil {
+			return nil, err
+		}
+		existingProductData.Sku = sku
+	}
+	if mask.has("img", req.Img != "") {
 		images, err := json.Marshal(req.Img)
 		if err != nil {
 			log.Printf("Error marshaling descrip2: %v", err)
@@ -246,22 +384,22 @@ func (s *ChronexAdminService) UpdateProduct(ctx context.Context, req *pb.UpdateP
 		}
 		existingProductData.Img = json.RawMessage(images)
 	}
-	if req.Discount != 0 {
+	if mask.has("discount", req.Discount != 0) {
 		existingProductData.Discount = req.Discount
 	}
-	if req.SupplierPrice != 0 {
+	if mask.has("supplierPrice", req.SupplierPrice != 0) {
 		existingProductData.SupplierPrice = req.SupplierPrice
 	}
-	if req.OriginalPrice != 0 {
+	if mask.has("originalPrice", req.OriginalPrice != 0) {
 		existingProductData.OriginalPrice = req.OriginalPrice
 	}
-	if req.DiscountedPrice != 0 {
+	if mask.has("discountedPrice", req.DiscountedPrice != 0) {
 		existingProductData.DiscountedPrice = req.DiscountedPrice
 	}
-	if req.Description1 != "" {
+	if mask.has("description1", req.Description1 != "") {
 		existingProductData.Description1 = req.Description1
 	}
-	if req.Description2 != "" {
+	if mask.has("description2", req.Description2 != "") {
 		descrip2, err := json.Marshal(req.Description2)
 		if err != nil {
 			log.Printf("Error marshaling descrip2: %v", err)
@@ -269,10 +407,10 @@ func (s *ChronexAdminService) UpdateProduct(ctx context.Context, req *pb.UpdateP
 		}
 		existingProductData.Description2 = json.RawMessage(descrip2)
 	}
-	if req.ProductSold != 0 {
+	if mask.has("productSold", req.ProductSold != 0) {
 		existingProductData.ProductSold = req.ProductSold
 	}
-	if req.ProductFreebies != "" {
+	if mask.has("productFreebies", req.ProductFreebies != "") {
 		productFreebies, err := json.Marshal(req.ProductFreebies)
 		if err != nil {
 			log.Printf("Error marshaling descrip2: %v", err)
@@ -280,44 +418,72 @@ func (s *ChronexAdminService) UpdateProduct(ctx context.Context, req *pb.UpdateP
 		}
 		existingProductData.ProductFreebies = json.RawMessage(productFreebies)
 	}
-	if req.ProductStatus != "" {
+	if mask.has("productStatus", req.ProductStatus != "") {
 		existingProductData.ProductStatus = req.ProductStatus
 	}
+	if mask.has("category", req.Category != "") {
+		existingProductData.Category = req.Category
+	}
+	if mask.has("weightGrams", req.WeightGrams != 0) {
+		existingProductData.WeightGrams = req.WeightGrams
+	}
+	if mask.has("lengthCm", req.LengthCm != 0) {
+		existingProductData.LengthCm = req.LengthCm
+	}
+	if mask.has("widthCm", req.WidthCm != 0) {
+		existingProductData.WidthCm = req.WidthCm
+	}
+	if mask.has("heightCm", req.HeightCm != 0) {
+		existingProductData.HeightCm = req.HeightCm
+	}
+
+	// Check the attributes again when the category changes, as it decides
+	// which of them apply
+	if mask.has("attributes", req.Attributes != "") || mask.has("category", req.Category != "") {
+		attributes := existingProductData.GetAttributes()
+		if mask.has("attributes", req.Attributes != "") {
+			attributes = req.Attributes
+		}
+		checked, err := checkProductAttributes(s.DB, existingProductData.Category, attributes)
+		if err != nil {
+			return nil, err
+		}
+		existingProductData.Attributes = checked
+	}
 
 	// Save the updated data back to the database using GORM
-	if err := s.DB.Save(&existingProductData).Error; err != nil {
+	if err := models.SaveVersioned(s.DB, &existingProductData, &existingProductData.Version); err != nil {
 		log.Printf("Error updating Product data: %v", err)
-		return nil, err
+		return nil, versionError(err, "Product", req.GetProductId())
+	}
+
+	// Record the new price when it was changed by hand
+	if mask.has("originalPrice", req.OriginalPrice != 0) || mask.has("discountedPrice", req.DiscountedPrice != 0) {
+		now := time.Now()
+		priceRules, err := loadActivePriceRules(s.DB, now)
+		if err != nil {
+			return nil, err
+		}
+		if err := recordPriceHistory(s.DB, existingProductData, priceRules, now, "MANUAL"); err != nil {
+			log.Printf("Error saving Product Price History data: %v", err)
+			return nil, err
+		}
 	}
 
 	// Create and return the response
 	response := &pb.UpdateProductResponse{
-		ProductData: &pb.ProductData{
-			ProductId:        existingProductData.GetProductId().String(),
-			ProductName:      existingProductData.GetProductName(),
-			Img:              string(existingProductData.GetImg()),
-			Discount:         existingProductData.GetDiscount(),
-			SupplierPrice:    existingProductData.GetSupplierPrice(),
-			OriginalPrice:    existingProductData.GetOriginalPrice(),
-			DiscountedPrice:  existingProductData.GetDiscountedPrice(),
-			Description1:     existingProductData.GetDescription1(),
-			Description2:     string(existingProductData.GetDescription2()),
-			OriginalQuantity: existingProductData.GetOriginalQuantity(),
-			CurrentQuantity:  existingProductData.GetCurrentQuantity(),
-			ProductStatus:    existingProductData.GetProductStatus(),
-			ProductSold:      existingProductData.GetProductSold(),
-			ProductFreebies:  string(existingProductData.GetProductFreebies()),
-			CreatedBy:        existingProductData.CreatedBy.String(),
-			CreatedAt:        existingProductData.CreatedAt.Unix(),
-			UpdatedBy:        existingProductData.UpdatedBy.String(),
-			UpdatedAt:        existingProductData.UpdatedAt.Unix(),
-		},
+		ProductData: productToPb(existingProductData),
 	}
 
 	return response, nil
 }
 
 func (s *ChronexAdminService) UpdateProductQuantity(ctx context.Context, req *pb.UpdateProductQuantityRequest) (*pb.UpdateProductQuantityResponse, error) {
+	mask, err := newUpdateMask(req.ProtoReflect().Descriptor(), req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+
 	// Retrieve existing ProductData from the database
 	var existingProductData models.ProductData
 	if err := s.DB.First(&existingProductData, "product_id = ?", req.GetProductId()).First(&existingProductData).Error; err != nil {
@@ -325,42 +491,27 @@ func (s *ChronexAdminService) UpdateProductQuantity(ctx context.Context, req *pb
 		return nil, err
 	}
 
-	// Update the existing ProductData with new values if they are not nil
-	if req.OriginalQuantity != 0 {
+	if err := checkVersion(req.ExpectedVersion, existingProductData.Version, "Product", req.GetProductId()); err != nil {
+		return nil, err
+	}
+
+	// Update the existing ProductData with the provided or masked values
+	if mask.has("originalQuantity", req.OriginalQuantity != 0) {
 		existingProductData.OriginalQuantity = req.OriginalQuantity
 	}
-	if req.CurrentQuantity != 0 {
+	if mask.has("currentQuantity", req.CurrentQuantity != 0) {
 		existingProductData.CurrentQuantity = req.CurrentQuantity
 	}
 
 	// Save the updated data back to the database using GORM
-	if err := s.DB.Save(&existingProductData).Error; err != nil {
+	if err := models.SaveVersioned(s.DB, &existingProductData, &existingProductData.Version); err != nil {
 		log.Printf("Error updating Product data: %v", err)
-		return nil, err
+		return nil, versionError(err, "Product", req.GetProductId())
 	}
 
 	// Create and return the response
 	response := &pb.UpdateProductQuantityResponse{
-		ProductData: &pb.ProductData{
-			ProductId:        existingProductData.GetProductId().String(),
-			ProductName:      existingProductData.GetProductName(),
-			Img:              string(existingProductData.GetImg()),
-			Discount:         existingProductData.GetDiscount(),
-			SupplierPrice:    existingProductData.GetSupplierPrice(),
-			OriginalPrice:    existingProductData.GetOriginalPrice(),
-			DiscountedPrice:  existingProductData.GetDiscountedPrice(),
-			Description1:     existingProductData.GetDescription1(),
-			Description2:     string(existingProductData.GetDescription2()),
-			OriginalQuantity: existingProductData.GetOriginalQuantity(),
-			CurrentQuantity:  existingProductData.GetCurrentQuantity(),
-			ProductStatus:    existingProductData.GetProductStatus(),
-			ProductSold:      existingProductData.GetProductSold(),
-			ProductFreebies:  string(existingProductData.GetProductFreebies()),
-			CreatedBy:        existingProductData.CreatedBy.String(),
-			CreatedAt:        existingProductData.CreatedAt.Unix(),
-			UpdatedBy:        existingProductData.UpdatedBy.String(),
-			UpdatedAt:        existingProductData.UpdatedAt.Unix(),
-		},
+		ProductData: productToPb(existingProductData),
 	}
 
 	return response, nil
@@ -374,40 +525,53 @@ func (s *ChronexAdminService) UpdateProductStatus(ctx context.Context, req *pb.U
 		return nil, err
 	}
 
+	if err := checkVersion(req.ExpectedVersion, existingProductData.Version, "Product", req.GetProductId()); err != nil {
+		return nil, err
+	}
+
+	// "DEL" from older clients moves the product to the trash
+	if req.ProductStatus == "DEL" {
+		deleted, err := s.DeleteProduct(ctx, &pb.DeleteProductRequest{ProductId: req.GetProductId()})
+		if err != nil {
+			return nil, err
+		}
+		return &pb.UpdateProductStatusResponse{ProductData: deleted.ProductData}, nil
+	}
+
 	// Update the existing ProductData with new values if they are not nil
 	if req.ProductStatus != "" {
 		existingProductData.ProductStatus = req.ProductStatus
 	}
 
 	// Save the updated data back to the database using GORM
-	if err := s.DB.Save(&existingProductData).Error; err != nil {
+	if err := models.SaveVersioned(s.DB, &existingProductData, &existingProductData.Version); err != nil {
 		log.Printf("Error updating Product data: %v", err)
-		return nil, err
+		return nil, versionError(err, "Product", req.GetProductId())
 	}
 
 	// Create and return the response
 	response := &pb.UpdateProductStatusResponse{
-		ProductData: &pb.ProductData{
-			ProductId:        existingProductData.GetProductId().String(),
-			ProductName:      existingProductData.GetProductName(),
-			Img:              string(existingProductData.GetImg()),
-			Discount:         existingProductData.GetDiscount(),
-			SupplierPrice:    existingProductData.GetSupplierPrice(),
-			OriginalPrice:    existingProductData.GetOriginalPrice(),
-			DiscountedPrice:  existingProductData.GetDiscountedPrice(),
-			Description1:     existingProductData.GetDescription1(),
-			Description2:     string(existingProductData.GetDescription2()),
-			OriginalQuantity: existingProductData.GetOriginalQuantity(),
-			CurrentQuantity:  existingProductData.GetCurrentQuantity(),
-			ProductStatus:    existingProductData.GetProductStatus(),
-			ProductSold:      existingProductData.GetProductSold(),
-			ProductFreebies:  string(existingProductData.GetProductFreebies()),
-			CreatedBy:        existingProductData.CreatedBy.String(),
-			CreatedAt:        existingProductData.CreatedAt.Unix(),
-			UpdatedBy:        existingProductData.UpdatedBy.String(),
-			UpdatedAt:        existingProductData.UpdatedAt.Unix(),
-		},
+		ProductData: productToPb(existingProductData),
 	}
 
 	return response, nil
 }
+
+func (s *ChronexAdminService) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	// Retrieve existing ProductData from the database
+	var existingProductData models.ProductData
+	if err := s.DB.First(&existingProductData, "product_id = ?", req.GetProductId()).Error; err != nil {
+		log.Printf("Error retrieving Product data: %v", err)
+		return nil, err
+	}
+
+	// Soft delete the product, it stays in the trash until it is restored or purged
+	if err := s.DB.Delete(&existingProductData).Error; err != nil {
+		log.Printf("Error deleting Product data: %v", err)
+		return nil, err
+	}
+
+	return &pb.DeleteProductResponse{
+		ProductData: productToPb(existingProductData),
+	}, nil
+}
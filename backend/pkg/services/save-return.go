@@ -0,0 +1,486 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxReturnReasonLength caps the reasons customers type into a return request.
+const maxReturnReasonLength = 1000
+
+// returnItem is one order line in a return. ResellableQuantity and
+// DamagedQuantity are filled in when the items are received back.
+type returnItem struct {
+	ProductID          string  `json:"productId"`
+	ProductName        string  `json:"productName"`
+	Quantity           int     `json:"quantity"`
+	UnitPrice          float64 `json:"unitPrice"`
+	Reason             string  `json:"reason"`
+	ResellableQuantity int     `json:"resellableQuantity"`
+	DamagedQuantity    int     `json:"damagedQuantity"`
+}
+
+func returnToPb(data models.ReturnData, refunded float64) *pb.ReturnData {
+	return &pb.ReturnData{
+		ReturnId:       data.GetReturnId().String(),
+		OrderId:        data.OrderId.String(),
+		ReturnStatus:   data.ReturnStatus,
+		Items:          string(data.Items),
+		Reason:         data.Reason,
+		Note:           data.Note,
+		ReceivedAt:     data.GetReceivedAt(),
+		AmountRefunded: refunded,
+		CreatedBy:      data.CreatedBy.String(),
+		CreatedAt:      data.CreatedAt.Unix(),
+		UpdatedBy:      data.UpdatedBy.String(),
+		UpdatedAt:      data.UpdatedAt.Unix(),
+		Version:        data.GetVersion(),
+	}
+}
+
+func parseReturnItems(items string) ([]returnItem, error) {
+	var lines []returnItem
+	if strings.TrimSpace(items) == "" {
+		return lines, nil
+	}
+	if err := json.Unmarshal([]byte(items), &lines); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid return items: %v", err))
+	}
+
+	return lines, nil
+}
+
+// returnableLines returns the order's lines keyed by product with Quantity
+// reduced by what other open or finished returns already cover. Rejected
+// returns give their quantities back.
+func returnableLines(tx *gorm.DB, order models.OrderData) (map[string]returnItem, error) {
+	orderLines, err := parseCart(string(order.Product))
+	if err != nil {
+		return nil, err
+	}
+
+	returnable := make(map[string]returnItem, len(orderLines))
+	for _, line := range orderLines {
+		item := returnable[line.ProductID]
+		item.ProductID = line.ProductID
+		item.ProductName = line.ProductName
+		item.UnitPrice = line.DiscountedPrice
+		item.Quantity += line.Quantity
+		returnable[line.ProductID] = item
+	}
+
+	var existingReturns []models.ReturnData
+	if err := tx.Where("order_id = ? AND return_status <> ?", order.OrderId, "REJ").Find(&existingReturns).Error; err != nil {
+		return nil, err
+	}
+	for _, existingReturn := range existingReturns {
+		var items []returnItem
+		if err := json.Unmarshal(existingReturn.Items, &items); err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			line := returnable[item.ProductID]
+			line.Quantity -= item.Quantity
+			returnable[item.ProductID] = line
+		}
+	}
+
+	return returnable, nil
+}
+
+// createReturn opens a return request for a delivered order. The caller holds
+// the order's row lock so two requests cannot return the same units.
+func createReturn(tx *gorm.DB, order models.OrderData, items string, reason string) (models.ReturnData, error) {
+	if order.OrderStatus != "DLV" {
+		return models.ReturnData{}, status.Error(codes.FailedPrecondition, fmt.Sprintf("Only delivered orders can be returned, order is %s", order.OrderStatus))
+	}
+
+	lines, err := parseReturnItems(items)
+	if err != nil {
+		return models.ReturnData{}, err
+	}
+	if len(lines) == 0 {
+		return models.ReturnData{}, status.Error(codes.InvalidArgument, "At least one item is required")
+	}
+
+	returnable, err := returnableLines(tx, order)
+	if err != nil {
+		return models.ReturnData{}, err
+	}
+
+	reason = plainText(reason, maxReturnReasonLength, true)
+	requested := make([]returnItem, 0, len(lines))
+	for _, line := range lines {
+		orderLine, ok := returnable[line.ProductID]
+		if !ok {
+			return models.ReturnData{}, status.Error(codes.InvalidArgument, fmt.Sprintf("Product %s is not in this order", line.ProductID))
+		}
+		if line.Quantity <= 0 {
+			return models.ReturnData{}, status.Error(codes.InvalidArgument, fmt.Sprintf("Quantity for %s must be greater than zero", orderLine.ProductName))
+		}
+		if line.Quantity > orderLine.Quantity {
+			return models.ReturnData{}, status.Error(codes.FailedPrecondition, fmt.Sprintf("Only %d of %s can still be returned", orderLine.Quantity, orderLine.ProductName))
+		}
+
+		itemReason := plainText(line.Reason, maxReturnReasonLength, true)
+		if itemReason == "" && reason == "" {
+			return models.ReturnData{}, status.Error(codes.InvalidArgument, fmt.Sprintf("A reason is required for %s", orderLine.ProductName))
+		}
+
+		// Keep the units of a product the same line across the request
+		orderLine.Quantity -= line.Quantity
+		returnable[line.ProductID] = orderLine
+
+		requested = append(requested, returnItem{
+			ProductID:   orderLine.ProductID,
+			ProductName: orderLine.ProductName,
+			Quantity:    line.Quantity,
+			UnitPrice:   orderLine.UnitPrice,
+			Reason:      itemReason,
+		})
+	}
+
+	itemsJSON, err := json.Marshal(requested)
+	if err != nil {
+		return models.ReturnData{}, err
+	}
+
+	returnData := models.ReturnData{
+		OrderId:      order.OrderId,
+		ReturnStatus: "REQ",
+		Items:        json.RawMessage(itemsJSON),
+		Reason:       reason,
+	}
+
+	// Save the data to the database using GORM
+	if err := tx.Create(&returnData).Error; err != nil {
+		return models.ReturnData{}, err
+	}
+
+	return returnData, nil
+}
+
+// returnValue is what the returned items were sold for, the most that can be
+// refunded against the return.
+func returnValue(returnData models.ReturnData) (float64, error) {
+	var items []returnItem
+	if err := json.Unmarshal(returnData.Items, &items); err != nil {
+		return 0, err
+	}
+
+	var value float64
+	for _, item := range items {
+		value += item.UnitPrice * float64(item.Quantity)
+	}
+	return math.Round(value*100) / 100, nil
+}
+
+// returnRefunds totals the refunds given for each return.
+func returnRefunds(db *gorm.DB, returnIds []uuid.UUID) (map[uuid.UUID]float64, error) {
+	refunded := make(map[uuid.UUID]float64, len(returnIds))
+	if len(returnIds) == 0 {
+		return refunded, nil
+	}
+
+	var results []struct {
+		ReturnId uuid.UUID
+		Total    float64
+	}
+	if err := db.Model(&models.RefundData{}).
+		Select("return_id, SUM(amount) AS total").
+		Where("return_id IN ?", returnIds).
+		Group("return_id").
+		Scan(&results).Error; err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		refunded[result.ReturnId] = result.Total
+	}
+
+	return refunded, nil
+}
+
+// hasOpenReturns reports whether an order has returns that were not rejected.
+func hasOpenReturns(tx *gorm.DB, orderId uuid.UUID) (bool, error) {
+	var count int64
+	err := tx.Model(&models.ReturnData{}).
+		Where("order_id = ? AND return_status <> ?", orderId, "REJ").
+		Count(&count).Error
+
+	return count > 0, err
+}
+
+func (s *ChronexAdminService) SaveReturn(ctx context.Context, req *pb.SaveReturnRequest) (*pb.SaveReturnResponse, error) {
+	var returnData models.ReturnData
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		orderData, err := lockOrder(tx, req.GetOrderId())
+		if err != nil {
+			return err
+		}
+
+		returnData, err = createReturn(tx, orderData, req.Items, req.Reason)
+		return err
+	})
+	if err != nil {
+		log.Printf("Error saving Return data: %v", err)
+		return nil, err
+	}
+
+	return &pb.SaveReturnResponse{
+		ReturnData: returnToPb(returnData, 0),
+	}, nil
+}
+
+// RequestReturn lets a customer ask to return items from their own delivered
+// order, proving ownership the same way as LookupOrder.
+func (s *ChronexAdminService) RequestReturn(ctx context.Context, req *pb.RequestReturnRequest) (*pb.RequestReturnResponse, error) {
+	var returnData models.ReturnData
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		orderData, err := s.findCustomerOrder(tx.Clauses(clause.Locking{Strength: "UPDATE"}), req.OrderId, req.EmailAddress, req.Token)
+		if err != nil {
+			return err
+		}
+
+		returnData, err = createReturn(tx, orderData, req.Items, req.Reason)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RequestReturnResponse{
+		ReturnData: returnToPb(returnData, 0),
+	}, nil
+}
+
+func (s *ChronexAdminService) GetAllReturn(ctx context.Context, req *pb.GetAllReturnRequest) (*pb.GetAllReturnResponse, error) {
+	response := &pb.GetAllReturnResponse{
+		ReturnData: []*pb.ReturnData{},
+	}
+
+	// Build your query based on the request parameters
+	query := s.DB.Model(&models.ReturnData{}).Order("created_at DESC")
+
+	// Handle searching
+	if req.Search != "" {
+		searchParam := "%" + req.Search + "%"
+		query = query.Where("(reason ILIKE ? OR note ILIKE ? OR items::text ILIKE ? OR order_id::text ILIKE ?)", searchParam, searchParam, searchParam, searchParam)
+	}
+
+	// Filter by status and order
+	if req.ReturnStatus != "" {
+		query = query.Where("return_status = ?", strings.ToUpper(req.ReturnStatus))
+	}
+	if req.OrderId != "" {
+		query = query.Where("order_id = ?", req.OrderId)
+	}
+
+	// Execute the query
+	var returnDataValue []models.ReturnData
+	if err := query.Find(&returnDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch return data: %v", err))
+	}
+
+	returnIds := make([]uuid.UUID, 0, len(returnDataValue))
+	for _, data := range returnDataValue {
+		returnIds = append(returnIds, data.ReturnId)
+	}
+	refunded, err := returnRefunds(s.DB, returnIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch refund data: %v", err))
+	}
+
+	// Map the retrieved data to protobuf message
+	for _, data := range returnDataValue {
+		response.ReturnData = append(response.ReturnData, returnToPb(data, refunded[data.ReturnId]))
+	}
+
+	return response, nil
+}
+
+func (s *ChronexAdminService) GetReturnById(ctx context.Context, req *pb.GetReturnByIdRequest) (*pb.GetReturnByIdResponse, error) {
+	// Retrieve existing ReturnData from the database
+	var returnData models.ReturnData
+	if err := s.DB.First(&returnData, "return_id = ?", req.GetReturnId()).Error; err != nil {
+		log.Printf("Error retrieving Return data: %v", err)
+		return nil, err
+	}
+
+	var refundDataValue []models.RefundData
+	if err := s.DB.Where("return_id = ?", returnData.ReturnId).Order("created_at ASC").Find(&refundDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch refund data: %v", err))
+	}
+
+	response := &pb.GetReturnByIdResponse{
+		RefundData: []*pb.RefundData{},
+	}
+	var refunded float64
+	for _, data := range refundDataValue {
+		response.RefundData = append(response.RefundData, refundToPb(data))
+		refunded += data.Amount
+	}
+	response.ReturnData = returnToPb(returnData, refunded)
+
+	return response, nil
+}
+
+// UpdateReturnStatus approves or rejects a requested return.
+func (s *ChronexAdminService) UpdateReturnStatus(ctx context.Context, req *pb.UpdateReturnStatusRequest) (*pb.UpdateReturnStatusResponse, error) {
+	returnStatus := strings.ToUpper(req.ReturnStatus)
+	if returnStatus != "APR" && returnStatus != "REJ" {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid return status %s, use APR or REJ", req.ReturnStatus))
+	}
+
+	// Retrieve existing ReturnData from the database
+	var existingReturnData models.ReturnData
+	if err := s.DB.First(&existingReturnData, "return_id = ?", req.GetReturnId()).Error; err != nil {
+		log.Printf("Error retrieving Return data: %v", err)
+		return nil, err
+	}
+
+	if err := checkVersion(req.ExpectedVersion, existingReturnData.Version, "Return", req.GetReturnId()); err != nil {
+		return nil, err
+	}
+	if existingReturnData.ReturnStatus != "REQ" {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Return %s was already decided (%s)", req.GetReturnId(), existingReturnData.ReturnStatus))
+	}
+
+	existingReturnData.ReturnStatus = returnStatus
+	if req.Note != "" {
+		existingReturnData.Note = req.Note
+	}
+
+	// Save the updated data back to the database using GORM
+	if err := models.SaveVersioned(s.DB, &existingReturnData, &existingReturnData.Version); err != nil {
+		log.Printf("Error updating Return data: %v", err)
+		return nil, versionError(err, "Return", req.GetReturnId())
+	}
+
+	return &pb.UpdateReturnStatusResponse{
+		ReturnData: returnToPb(existingReturnData, 0),
+	}, nil
+}
+
+// ReceiveReturn records an approved return arriving back. Each line is split
+// into resellable units, which go back into the product's stock, and damaged
+// units, which are written off and stay out of it. Lines left out of the
+// request count as not received.
+func (s *ChronexAdminService) ReceiveReturn(ctx context.Context, req *pb.ReceiveReturnRequest) (*pb.ReceiveReturnResponse, error) {
+	received, err := parseReturnItems(req.Items)
+	if err != nil {
+		return nil, err
+	}
+	receivedByProduct := make(map[string]returnItem, len(received))
+	for _, item := range received {
+		if item.ResellableQuantity < 0 || item.DamagedQuantity < 0 {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Received quantities for %s cannot be negative", item.ProductID))
+		}
+		receivedByProduct[item.ProductID] = item
+	}
+
+	var existingReturnData models.ReturnData
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		// Retrieve existing ReturnData from the database
+		if err := tx.First(&existingReturnData, "return_id = ?", req.GetReturnId()).Error; err != nil {
+			log.Printf("Error retrieving Return data: %v", err)
+			return err
+		}
+
+		// Lock the order before the return, like every other order write
+		if _, err := lockOrder(tx, existingReturnData.OrderId); err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existingReturnData, "return_id = ?", req.GetReturnId()).Error; err != nil {
+			return err
+		}
+
+		if err := checkVersion(req.ExpectedVersion, existingReturnData.Version, "Return", req.GetReturnId()); err != nil {
+			return err
+		}
+		if existingReturnData.ReturnStatus != "APR" {
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("Only approved returns can be received, return is %s", existingReturnData.ReturnStatus))
+		}
+
+		var items []returnItem
+		if err := json.Unmarshal(existingReturnData.Items, &items); err != nil {
+			return err
+		}
+
+		for i, item := range items {
+			receivedItem, ok := receivedByProduct[item.ProductID]
+			if !ok {
+				continue
+			}
+			delete(receivedByProduct, item.ProductID)
+
+			if receivedItem.ResellableQuantity+receivedItem.DamagedQuantity > item.Quantity {
+				return status.Error(codes.InvalidArgument, fmt.Sprintf("Only %d of %s were returned", item.Quantity, item.ProductName))
+			}
+			items[i].ResellableQuantity = receivedItem.ResellableQuantity
+			items[i].DamagedQuantity = receivedItem.DamagedQuantity
+
+			if receivedItem.ResellableQuantity == 0 {
+				continue
+			}
+
+			// Resellable units go back into stock
+			columns := map[string]interface{}{
+				"current_quantity": gorm.Expr("current_quantity + ?", receivedItem.ResellableQuantity),
+				"version":          gorm.Expr("version + 1"),
+			}
+			if err := tx.Model(&models.ProductData{}).Where("product_id = ?", item.ProductID).Updates(columns).Error; err != nil {
+				log.Printf("Error updating product data: %v", err)
+				return err
+			}
+		}
+		for productId := range receivedByProduct {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("Product %s is not in this return", productId))
+		}
+
+		itemsJSON, err := json.Marshal(items)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		existingReturnData.Items = json.RawMessage(itemsJSON)
+		existingReturnData.ReturnStatus = "RCV"
+		existingReturnData.ReceivedAt = &now
+		if req.Note != "" {
+			existingReturnData.Note = req.Note
+		}
+
+		// Save the updated data back to the database using GORM
+		if err := models.SaveVersioned(tx, &existingReturnData, &existingReturnData.Version); err != nil {
+			log.Printf("Error updating Return data: %v", err)
+			return versionError(err, "Return", req.GetReturnId())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refunded, err := returnRefunds(s.DB, []uuid.UUID{existingReturnData.ReturnId})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReceiveReturnResponse{
+		ReturnData: returnToPb(existingReturnData, refunded[existingReturnData.ReturnId]),
+	}, nil
+}
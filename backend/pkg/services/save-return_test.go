@@ -0,0 +1,74 @@
+package services
+
+import (
+	"api/pkg/models"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestReturnValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		items   string
+		want    float64
+		wantErr bool
+	}{
+		{name: "no items", items: `[]`, want: 0},
+		{name: "one item", items: `[{"productId":"a","quantity":2,"unitPrice":250}]`, want: 500},
+		{name: "several items", items: `[{"productId":"a","quantity":1,"unitPrice":1499.99},{"productId":"b","quantity":3,"unitPrice":0.1}]`, want: 1500.29},
+		{name: "not JSON", items: `{`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := returnValue(models.ReturnData{Items: json.RawMessage(tt.items)})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("returnValue() error = %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("returnValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRefundCap(t *testing.T) {
+	tests := []struct {
+		name            string
+		paymentAmount   float64
+		paymentRefunded float64
+		forReturn       bool
+		returnValue     float64
+		returnRefunded  float64
+		requested       float64
+		wantAmount      float64
+		wantOk          bool
+	}{
+		{name: "whole payment", paymentAmount: 2000, requested: 0, wantAmount: 2000, wantOk: true},
+		{name: "part of the payment", paymentAmount: 2000, requested: 500, wantAmount: 500, wantOk: true},
+		{name: "more than the payment", paymentAmount: 2000, requested: 2000.01, wantAmount: 2000.01},
+		{name: "rest after a refund", paymentAmount: 2000, paymentRefunded: 1200, wantAmount: 800, wantOk: true},
+		{name: "fully refunded payment", paymentAmount: 2000, paymentRefunded: 2000, wantAmount: 0},
+		{name: "return defaults to its value", paymentAmount: 2000, forReturn: true, returnValue: 500, wantAmount: 500, wantOk: true},
+		{name: "return caps the amount", paymentAmount: 2000, forReturn: true, returnValue: 500, requested: 600, wantAmount: 600},
+		{name: "return already partly refunded", paymentAmount: 2000, forReturn: true, returnValue: 500, returnRefunded: 300, requested: 200, wantAmount: 200, wantOk: true},
+		{name: "return fully refunded", paymentAmount: 2000, forReturn: true, returnValue: 500, returnRefunded: 500, wantAmount: 0},
+		{name: "payment left is below the return value", paymentAmount: 2000, paymentRefunded: 1800, forReturn: true, returnValue: 500, wantAmount: 200, wantOk: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The same steps RefundPayment takes
+			refundable := remainingAmount(tt.paymentAmount, tt.paymentRefunded)
+			if tt.forReturn {
+				refundable = math.Min(refundable, remainingAmount(tt.returnValue, tt.returnRefunded))
+			}
+
+			amount, ok := refundAmount(tt.requested, refundable)
+			if amount != tt.wantAmount || ok != tt.wantOk {
+				t.Errorf("refundAmount(%v, %v) = %v, %v, want %v, %v", tt.requested, refundable, amount, ok, tt.wantAmount, tt.wantOk)
+			}
+		})
+	}
+}
@@ -15,6 +15,23 @@ import (
 	"gorm.io/gorm"
 )
 
+func reviewsToPb(data models.ReviewsData) *pb.ReviewsData {
+	return &pb.ReviewsData{
+		ReviewsId:         data.ReviewsId.String(),
+		ProductId:         data.ProductId,
+		ReviewsName:       data.ReviewsName,
+		ReviewsSubject:    data.ReviewsSubject,
+		ReviewsMessage:    data.ReviewsMessage,
+		ReviewsStarRating: data.ReviewsStarRating,
+		ReviewsStatus:     data.ReviewsStatus,
+		CreatedBy:         data.CreatedBy.String(),
+		CreatedAt:         data.CreatedAt.Unix(),
+		UpdatedBy:         data.UpdatedBy.String(),
+		UpdatedAt:         data.UpdatedAt.Unix(),
+		Version:           data.GetVersion(),
+	}
+}
+
 func (s *ChronexAdminService) SaveReviews(ctx context.Context, req *pb.SaveReviewsRequest) (*pb.SaveReviewsResponse, error) {
 
 	// Create a new FreebiesData instance
@@ -47,6 +64,7 @@ func (s *ChronexAdminService) SaveReviews(ctx context.Context, req *pb.SaveRevie
 			CreatedAt:         reviewsData.CreatedAt.Unix(),
 			UpdatedBy:         reviewsData.UpdatedBy.String(),
 			UpdatedAt:         reviewsData.UpdatedAt.Unix(),
+			Version:           reviewsData.GetVersion(),
 		},
 	}
 
@@ -104,9 +122,6 @@ func (s *ChronexAdminService) GetAllReviews(ctx context.Context, req *pb.GetAllR
 		query = query.Where("reviews_name ILIKE ?", "%"+req.Search+"%")
 	}
 
-	// Filter by active status
-	query = query.Where("reviews_status != ?", "DEL")
-
 	// Execute the query
 	var reviewsDataValue []models.ReviewsData
 	if err := query.Find(&reviewsDataValue).Error; err != nil {
@@ -127,6 +142,7 @@ func (s *ChronexAdminService) GetAllReviews(ctx context.Context, req *pb.GetAllR
 			CreatedAt:         data.CreatedAt.Unix(),
 			UpdatedBy:         data.UpdatedBy.String(),
 			UpdatedAt:         data.UpdatedAt.Unix(),
+			Version:           data.GetVersion(),
 		})
 	}
 
@@ -134,6 +150,11 @@ func (s *ChronexAdminService) GetAllReviews(ctx context.Context, req *pb.GetAllR
 }
 
 func (s *ChronexAdminService) UpdateReviews(ctx context.Context, req *pb.UpdateReviewsRequest) (*pb.UpdateReviewsResponse, error) {
+	mask, err := newUpdateMask(req.ProtoReflect().Descriptor(), req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+
 	// Retrieve existing FreebiesData from the database
 	var existingReviewsData models.ReviewsData
 	if err := s.DB.First(&existingReviewsData, "reviews_id = ?", req.GetReviewsId()).First(&existingReviewsData).Error; err != nil {
@@ -141,27 +162,31 @@ func (s *ChronexAdminService) UpdateReviews(ctx context.Context, req *pb.UpdateR
 		return nil, err
 	}
 
-	// Update the existing ReviewsData with new values if they are not nil
-	if req.ProductId != "" {
+	if err := checkVersion(req.ExpectedVersion, existingReviewsData.Version, "Reviews", req.GetReviewsId()); err != nil {
+		return nil, err
+	}
+
+	// Update the existing ReviewsData with the provided or masked values
+	if mask.has("productId", req.ProductId != "") {
 		existingReviewsData.ProductId = req.ProductId
 	}
-	if req.ReviewsName != "" {
+	if mask.has("reviewsName", req.ReviewsName != "") {
 		existingReviewsData.ReviewsName = req.ReviewsName
 	}
-	if req.ReviewsSubject != "" {
+	if mask.has("reviewsSubject", req.ReviewsSubject != "") {
 		existingReviewsData.ReviewsSubject = req.ReviewsSubject
 	}
-	if req.ReviewsMessage != "" {
+	if mask.has("reviewsMessage", req.ReviewsMessage != "") {
 		existingReviewsData.ReviewsMessage = req.ReviewsMessage
 	}
-	if req.ReviewsStarRating != 0 {
+	if mask.has("reviewsStarRating", req.ReviewsStarRating != 0) {
 		existingReviewsData.ReviewsStarRating = req.ReviewsStarRating
 	}
 
 	// Save the updated data back to the database using GORM
-	if err := s.DB.Save(&existingReviewsData).Error; err != nil {
+	if err := models.SaveVersioned(s.DB, &existingReviewsData, &existingReviewsData.Version); err != nil {
 		log.Printf("Error updating Reviews data: %v", err)
-		return nil, err
+		return nil, versionError(err, "Reviews", req.GetReviewsId())
 	}
 
 	// Create and return the response
@@ -178,6 +203,7 @@ func (s *ChronexAdminService) UpdateReviews(ctx context.Context, req *pb.UpdateR
 			CreatedAt:         existingReviewsData.CreatedAt.Unix(),
 			UpdatedBy:         existingReviewsData.UpdatedBy.String(),
 			UpdatedAt:         existingReviewsData.UpdatedAt.Unix(),
+			Version:           existingReviewsData.GetVersion(),
 		},
 	}
 
@@ -192,15 +218,28 @@ func (s *ChronexAdminService) UpdateReviewsStatus(ctx context.Context, req *pb.U
 		return nil, err
 	}
 
+	if err := checkVersion(req.ExpectedVersion, existingReviewsData.Version, "Reviews", req.GetReviewsId()); err != nil {
+		return nil, err
+	}
+
+	// "DEL" from older clients moves the reviews to the trash
+	if req.ReviewsStatus == "DEL" {
+		deleted, err := s.DeleteReviews(ctx, &pb.DeleteReviewsRequest{ReviewsId: req.GetReviewsId()})
+		if err != nil {
+			return nil, err
+		}
+		return &pb.UpdateReviewsStatusResponse{ReviewsData: deleted.ReviewsData}, nil
+	}
+
 	// Update the existing FreebiesData with new values if they are not nil
 	if req.ReviewsStatus != "" {
 		existingReviewsData.ReviewsStatus = req.ReviewsStatus
 	}
 
 	// Save the updated data back to the database using GORM
-	if err := s.DB.Save(&existingReviewsData).Error; err != nil {
+	if err := models.SaveVersioned(s.DB, &existingReviewsData, &existingReviewsData.Version); err != nil {
 		log.Printf("Error updating Reviews data: %v", err)
-		return nil, err
+		return nil, versionError(err, "Reviews", req.GetReviewsId())
 	}
 
 	// Create and return the response
@@ -217,6 +256,7 @@ func (s *ChronexAdminService) UpdateReviewsStatus(ctx context.Context, req *pb.U
 			CreatedAt:         existingReviewsData.CreatedAt.Unix(),
 			UpdatedBy:         existingReviewsData.UpdatedBy.String(),
 			UpdatedAt:         existingReviewsData.UpdatedAt.Unix(),
+			Version:           existingReviewsData.GetVersion(),
 		},
 	}
 
@@ -230,7 +270,7 @@ func (s *ChronexAdminService) GetAllReviewsById(ctx context.Context, req *pb.Get
 
 	// Fetch all reviews for the given product_id and reviews_status
 	var reviews []models.ReviewsData
-	if err := s.DB.Where("product_id = ? AND reviews_status != ?", req.ReviewsId, "DEL").Find(&reviews).Error; err != nil {
+	if err := s.DB.Where("product_id = ?", req.ReviewsId).Find(&reviews).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, status.Error(codes.NotFound, fmt.Sprintf("Reviews with ID %s not found", req.ReviewsId))
 		}
@@ -256,8 +296,28 @@ func (s *ChronexAdminService) GetAllReviewsById(ctx context.Context, req *pb.Get
 			CreatedAt:         review.CreatedAt.Unix(),
 			UpdatedBy:         review.UpdatedBy.String(),
 			UpdatedAt:         review.UpdatedAt.Unix(),
+			Version:           review.GetVersion(),
 		})
 	}
 
 	return response, nil
 }
+
+func (s *ChronexAdminService) DeleteReviews(ctx context.Context, req *pb.DeleteReviewsRequest) (*pb.DeleteReviewsResponse, error) {
+	// Retrieve existing ReviewsData from the database
+	var existingReviewsData models.ReviewsData
+	if err := s.DB.First(&existingReviewsData, "reviews_id = ?", req.GetReviewsId()).Error; err != nil {
+		log.Printf("Error retrieving Reviews data: %v", err)
+		return nil, err
+	}
+
+	// Soft delete the reviews, it stays in the trash until it is restored or purged
+	if err := s.DB.Delete(&existingReviewsData).Error; err != nil {
+		log.Printf("Error deleting Reviews data: %v", err)
+		return nil, err
+	}
+
+	return &pb.DeleteReviewsResponse{
+		ReviewsData: reviewsToPb(existingReviewsData),
+	}, nil
+}
@@ -0,0 +1,480 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// volumetricDivisor turns a parcel's length x width x height in centimetres
+// into its volumetric weight in kilograms, as couriers bill bulky items.
+const volumetricDivisor = 5000
+
+// shippingQuote is the shipping charge worked out for a cart and address.
+type shippingQuote struct {
+	Zone        models.ShippingZoneData
+	WeightGrams float64
+	Subtotal    float64
+	ShippingFee float64
+}
+
+func shippingZoneToPb(data models.ShippingZoneData) *pb.ShippingZoneData {
+	return &pb.ShippingZoneData{
+		ShippingZoneId:        data.GetShippingZoneId().String(),
+		ZoneName:              data.ZoneName,
+		Provinces:             string(data.Provinces),
+		Cities:                string(data.Cities),
+		IsDefault:             data.IsDefault,
+		RateType:              data.RateType,
+		FlatFee:               data.FlatFee,
+		WeightTiers:           string(data.WeightTiers),
+		ExtraFeePerKg:         data.ExtraFeePerKg,
+		FreeShippingThreshold: data.FreeShippingThreshold,
+		ZoneStatus:            data.ZoneStatus,
+		CreatedBy:             data.CreatedBy.String(),
+		CreatedAt:             data.CreatedAt.Unix(),
+		UpdatedBy:             data.UpdatedBy.String(),
+		UpdatedAt:             data.UpdatedAt.Unix(),
+	}
+}
+
+// normalizePlace makes province and city names comparable regardless of case
+// and spacing.
+func normalizePlace(place string) string {
+	return strings.ToLower(strings.Join(strings.Fields(place), " "))
+}
+
+// parsePlaces decodes a JSON array of place names, dropping blanks and
+// duplicates.
+func parsePlaces(places string, field string) (json.RawMessage, error) {
+	var names []string
+	if strings.TrimSpace(places) != "" {
+		if err := json.Unmarshal([]byte(places), &names); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid %s: %v", field, err))
+		}
+	}
+
+	seen := map[string]bool{}
+	cleaned := []string{}
+	for _, name := range names {
+		name = strings.Join(strings.Fields(name), " ")
+		if name == "" || seen[normalizePlace(name)] {
+			continue
+		}
+		seen[normalizePlace(name)] = true
+		cleaned = append(cleaned, name)
+	}
+
+	encoded, err := json.Marshal(cleaned)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(encoded), nil
+}
+
+func parseWeightTiers(weightTiers string) (json.RawMessage, error) {
+	var tiers []models.WeightTier
+	if strings.TrimSpace(weightTiers) != "" {
+		if err := json.Unmarshal([]byte(weightTiers), &tiers); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid weight tiers: %v", err))
+		}
+	}
+	if tiers == nil {
+		tiers = []models.WeightTier{}
+	}
+
+	encoded, err := json.Marshal(tiers)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(encoded), nil
+}
+
+func validateShippingZone(zone models.ShippingZoneData) error {
+	if strings.TrimSpace(zone.ZoneName) == "" {
+		return status.Error(codes.InvalidArgument, "Zone name is required")
+	}
+	if zone.FlatFee < 0 || zone.ExtraFeePerKg < 0 || zone.FreeShippingThreshold < 0 {
+		return status.Error(codes.InvalidArgument, "Shipping fees and thresholds cannot be negative")
+	}
+
+	var provinces, cities []string
+	if err := json.Unmarshal(zone.Provinces, &provinces); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(zone.Cities, &cities); err != nil {
+		return err
+	}
+	if !zone.IsDefault && len(provinces) == 0 && len(cities) == 0 {
+		return status.Error(codes.InvalidArgument, "A zone needs at least one province or city unless it is the default zone")
+	}
+
+	switch zone.RateType {
+	case "FLAT":
+	case "WEIGHT":
+		tiers, err := zone.GetWeightTiers()
+		if err != nil {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid weight tiers: %v", err))
+		}
+		if len(tiers) == 0 {
+			return status.Error(codes.InvalidArgument, "Weight rates need at least one tier")
+		}
+		for i, tier := range tiers {
+			if tier.MaxWeightGrams <= 0 || tier.Fee < 0 {
+				return status.Error(codes.InvalidArgument, "Weight tiers need a positive weight and a fee of at least 0")
+			}
+			if i > 0 && tier.MaxWeightGrams <= tiers[i-1].MaxWeightGrams {
+				return status.Error(codes.InvalidArgument, "Weight tiers must be listed from lightest to heaviest")
+			}
+		}
+	default:
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid rate type %s", zone.RateType))
+	}
+
+	return nil
+}
+
+// matchShippingZone picks the active zone for an address.
+func matchShippingZone(db *gorm.DB, completeAddress json.RawMessage) (models.ShippingZoneData, error) {
+	var zones []models.ShippingZoneData
+	if err := db.Where("zone_status = ?", "ACT").Order("created_at ASC").Find(&zones).Error; err != nil {
+		return models.ShippingZoneData{}, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch shipping zone data: %v", err))
+	}
+
+	return pickShippingZone(zones, completeAddress)
+}
+
+// pickShippingZone picks the zone for an address out of zones, oldest first:
+// a zone listing its city wins over one listing its province, and the default
+// zone covers the rest. An address no zone covers cannot be shipped to, so it
+// fails rather than shipping free.
+func pickShippingZone(zones []models.ShippingZoneData, completeAddress json.RawMessage) (models.ShippingZoneData, error) {
+	var address struct {
+		City     string `json:"city"`
+		Province string `json:"province"`
+	}
+	if len(completeAddress) > 0 {
+		if err := json.Unmarshal(completeAddress, &address); err != nil {
+			return models.ShippingZoneData{}, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid address: %v", err))
+		}
+	}
+	city := normalizePlace(address.City)
+	province := normalizePlace(address.Province)
+
+	var provinceZone, defaultZone *models.ShippingZoneData
+	for i := range zones {
+		var provinces, cities []string
+		json.Unmarshal(zones[i].Provinces, &provinces)
+		json.Unmarshal(zones[i].Cities, &cities)
+
+		for _, name := range cities {
+			if city != "" && normalizePlace(name) == city {
+				return zones[i], nil
+			}
+		}
+		for _, name := range provinces {
+			if provinceZone == nil && province != "" && normalizePlace(name) == province {
+				provinceZone = &zones[i]
+			}
+		}
+		if defaultZone == nil && zones[i].IsDefault {
+			defaultZone = &zones[i]
+		}
+	}
+
+	switch {
+	case provinceZone != nil:
+		return *provinceZone, nil
+	case defaultZone != nil:
+		return *defaultZone, nil
+	}
+
+	return models.ShippingZoneData{}, status.Error(codes.FailedPrecondition, fmt.Sprintf("We do not ship to %s, %s yet", address.City, address.Province))
+}
+
+// cartWeight is the billable weight of a cart in grams. Each item counts at
+// its actual or volumetric weight, whichever is heavier.
+func cartWeight(lines []cartLine, productById map[string]models.ProductData) float64 {
+	var weight float64
+	for _, line := range lines {
+		product, ok := productById[line.ProductID]
+		if !ok {
+			continue
+		}
+
+		itemWeight := product.WeightGrams
+		volumetric := product.LengthCm * product.WidthCm * product.HeightCm / volumetricDivisor * 1000
+		if volumetric > itemWeight {
+			itemWeight = volumetric
+		}
+		weight += itemWeight * float64(line.Quantity)
+	}
+
+	return weight
+}
+
+// quoteShipping prices shipping to an address for a cart priced by priceCart.
+// The free shipping threshold is checked against the cart subtotal before
+// vouchers.
+func quoteShipping(db *gorm.DB, lines []cartLine, productById map[string]models.ProductData, subtotal float64, completeAddress json.RawMessage) (shippingQuote, error) {
+	quote := shippingQuote{
+		WeightGrams: cartWeight(lines, productById),
+		Subtotal:    subtotal,
+	}
+
+	zone, err := matchShippingZone(db, completeAddress)
+	if err != nil {
+		return quote, err
+	}
+	quote.Zone = zone
+
+	fee, err := zone.FeeFor(quote.WeightGrams, subtotal)
+	if err != nil {
+		return quote, status.Error(codes.Internal, fmt.Sprintf("Invalid rates for shipping zone %s: %v", zone.ZoneName, err))
+	}
+	quote.ShippingFee = math.Round(fee*100) / 100
+
+	return quote, nil
+}
+
+// QuoteShipping prices shipping for a cart and delivery address at checkout.
+func (s *ChronexAdminService) QuoteShipping(ctx context.Context, req *pb.QuoteShippingRequest) (*pb.QuoteShippingResponse, error) {
+	lines, err := parseCart(req.Product)
+	if err != nil {
+		return nil, err
+	}
+
+	productById, subtotal, err := priceCart(s.DB, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := quoteShipping(s.DB, lines, productById, subtotal, rawJSON(req.CompleteAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.QuoteShippingResponse{
+		ShippingZoneId:        quote.Zone.ShippingZoneId.String(),
+		ZoneName:              quote.Zone.ZoneName,
+		ShippingFee:           quote.ShippingFee,
+		WeightGrams:           quote.WeightGrams,
+		Subtotal:              quote.Subtotal,
+		FreeShippingThreshold: quote.Zone.FreeShippingThreshold,
+	}
+	if quote.Zone.FreeShippingThreshold > quote.Subtotal {
+		response.AmountToFreeShipping = quote.Zone.FreeShippingThreshold - quote.Subtotal
+	}
+
+	return response, nil
+}
+
+func (s *ChronexAdminService) SaveShippingZone(ctx context.Context, req *pb.SaveShippingZoneRequest) (*pb.SaveShippingZoneResponse, error) {
+	provinces, err := parsePlaces(req.Provinces, "provinces")
+	if err != nil {
+		return nil, err
+	}
+	cities, err := parsePlaces(req.Cities, "cities")
+	if err != nil {
+		return nil, err
+	}
+	weightTiers, err := parseWeightTiers(req.WeightTiers)
+	if err != nil {
+		return nil, err
+	}
+
+	rateType := strings.ToUpper(req.RateType)
+	if rateType == "" {
+		rateType = "FLAT"
+	}
+	zoneStatus := req.ZoneStatus
+	if zoneStatus == "" {
+		zoneStatus = "ACT"
+	}
+
+	// Create a new ShippingZoneData instance
+	shippingZoneData := models.ShippingZoneData{
+		ZoneName:              strings.TrimSpace(req.ZoneName),
+		Provinces:             provinces,
+		Cities:                cities,
+		IsDefault:             req.IsDefault,
+		RateType:              rateType,
+		FlatFee:               req.FlatFee,
+		WeightTiers:           weightTiers,
+		ExtraFeePerKg:         req.ExtraFeePerKg,
+		FreeShippingThreshold: req.FreeShippingThreshold,
+		ZoneStatus:            zoneStatus,
+	}
+	if err := validateShippingZone(shippingZoneData); err != nil {
+		return nil, err
+	}
+
+	// Save the data to the database using GORM
+	if err := s.DB.Create(&shippingZoneData).Error; err != nil {
+		log.Printf("Error saving Shipping Zone data: %v", err)
+		return nil, err
+	}
+
+	return &pb.SaveShippingZoneResponse{
+		ShippingZoneData: shippingZoneToPb(shippingZoneData),
+	}, nil
+}
+
+func (s *ChronexAdminService) GetAllShippingZone(ctx context.Context, req *pb.GetAllShippingZoneRequest) (*pb.GetAllShippingZoneResponse, error) {
+	response := &pb.GetAllShippingZoneResponse{
+		ShippingZoneData: []*pb.ShippingZoneData{},
+	}
+
+	// Build your query based on the request parameters
+	query := s.DB.Model(&models.ShippingZoneData{}).Order("zone_name ASC")
+
+	// Handle searching
+	if req.Search != "" {
+		searchParam := "%" + req.Search + "%"
+		query = query.Where("(zone_name ILIKE ? OR provinces::text ILIKE ? OR cities::text ILIKE ?)", searchParam, searchParam, searchParam)
+	}
+
+	// Filter by status
+	if req.ZoneStatus != "" {
+		query = query.Where("zone_status = ?", req.ZoneStatus)
+	}
+
+	// Execute the query
+	var shippingZoneDataValue []models.ShippingZoneData
+	if err := query.Find(&shippingZoneDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch shipping zone data: %v", err))
+	}
+
+	// Map the retrieved data to protobuf message
+	for _, data := range shippingZoneDataValue {
+		response.ShippingZoneData = append(response.ShippingZoneData, shippingZoneToPb(data))
+	}
+
+	return response, nil
+}
+
+func (s *ChronexAdminService) UpdateShippingZone(ctx context.Context, req *pb.UpdateShippingZoneRequest) (*pb.UpdateShippingZoneResponse, error) {
+	mask, err := newUpdateMask(req.ProtoReflect().Descriptor(), req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve existing ShippingZoneData from the database
+	var existingShippingZoneData models.ShippingZoneData
+	if err := s.DB.First(&existingShippingZoneData, "shipping_zone_id = ?", req.GetShippingZoneId()).Error; err != nil {
+		log.Printf("Error retrieving Shipping Zone data: %v", err)
+		return nil, err
+	}
+
+	// Update the existing ShippingZoneData with the provided or masked values
+	if mask.has("zoneName", req.ZoneName != "") {
+		existingShippingZoneData.ZoneName = strings.TrimSpace(req.ZoneName)
+	}
+	if mask.has("provinces", req.Provinces != "") {
+		provinces, err := parsePlaces(req.Provinces, "provinces")
+		if err != nil {
+			return nil, err
+		}
+		existingShippingZoneData.Provinces = provinces
+	}
+	if mask.has("cities", req.Cities != "") {
+		cities, err := parsePlaces(req.Cities, "cities")
+		if err != nil {
+			return nil, err
+		}
+		existingShippingZoneData.Cities = cities
+	}
+	if mask.has("isDefault", req.IsDefault) {
+		existingShippingZoneData.IsDefault = req.IsDefault
+	}
+	if mask.has("rateType", req.RateType != "") {
+		existingShippingZoneData.RateType = strings.ToUpper(req.RateType)
+	}
+	if mask.has("flatFee", req.FlatFee != 0) {
+		existingShippingZoneData.FlatFee = req.FlatFee
+	}
+	if mask.has("weightTiers", req.WeightTiers != "") {
+		weightTiers, err := parseWeightTiers(req.WeightTiers)
+		if err != nil {
+			return nil, err
+		}
+		existingShippingZoneData.WeightTiers = weightTiers
+	}
+	if mask.has("extraFeePerKg", req.ExtraFeePerKg != 0) {
+		existingShippingZoneData.ExtraFeePerKg = req.ExtraFeePerKg
+	}
+	if mask.has("freeShippingThreshold", req.FreeShippingThreshold != 0) {
+		existingShippingZoneData.FreeShippingThreshold = req.FreeShippingThreshold
+	}
+
+	if err := validateShippingZone(existingShippingZoneData); err != nil {
+		return nil, err
+	}
+
+	// Save the updated data back to the database using GORM
+	if err := s.DB.Save(&existingShippingZoneData).Error; err != nil {
+		log.Printf("Error updating Shipping Zone data: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdateShippingZoneResponse{
+		ShippingZoneData: shippingZoneToPb(existingShippingZoneData),
+	}, nil
+}
+
+func (s *ChronexAdminService) UpdateShippingZoneStatus(ctx context.Context, req *pb.UpdateShippingZoneStatusRequest) (*pb.UpdateShippingZoneStatusResponse, error) {
+	if req.ZoneStatus == "DEL" {
+		return nil, status.Error(codes.InvalidArgument, "Use DeleteShippingZone to move a shipping zone to the trash")
+	}
+
+	// Retrieve existing ShippingZoneData from the database
+	var existingShippingZoneData models.ShippingZoneData
+	if err := s.DB.First(&existingShippingZoneData, "shipping_zone_id = ?", req.GetShippingZoneId()).Error; err != nil {
+		log.Printf("Error retrieving Shipping Zone data: %v", err)
+		return nil, err
+	}
+
+	if req.ZoneStatus != "" {
+		existingShippingZoneData.ZoneStatus = req.ZoneStatus
+	}
+
+	// Save the updated data back to the database using GORM
+	if err := s.DB.Save(&existingShippingZoneData).Error; err != nil {
+		log.Printf("Error updating Shipping Zone data: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdateShippingZoneStatusResponse{
+		ShippingZoneData: shippingZoneToPb(existingShippingZoneData),
+	}, nil
+}
+
+func (s *ChronexAdminService) DeleteShippingZone(ctx context.Context, req *pb.DeleteShippingZoneRequest) (*pb.DeleteShippingZoneResponse, error) {
+	// Retrieve existing ShippingZoneData from the database
+	var existingShippingZoneData models.ShippingZoneData
+	if err := s.DB.First(&existingShippingZoneData, "shipping_zone_id = ?", req.GetShippingZoneId()).Error; err != nil {
+		log.Printf("Error retrieving Shipping Zone data: %v", err)
+		return nil, err
+	}
+
+	// Soft delete the zone, it stays in the trash until it is restored or purged
+	if err := s.DB.Delete(&existingShippingZoneData).Error; err != nil {
+		log.Printf("Error deleting Shipping Zone data: %v", err)
+		return nil, err
+	}
+
+	return &pb.DeleteShippingZoneResponse{
+		ShippingZoneData: shippingZoneToPb(existingShippingZoneData),
+	}, nil
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"api/pkg/models"
+	"encoding/json"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParsePlaces(t *testing.T) {
+	tests := []struct {
+		name   string
+		places string
+		want   string
+		code   codes.Code
+	}{
+		{name: "empty", places: "", want: `[]`},
+		{name: "spacing cleaned", places: `["  Metro   Manila ", "Cebu"]`, want: `["Metro Manila","Cebu"]`},
+		{name: "blanks and duplicates dropped", places: `["Cebu", " ", "cebu", "CEBU "]`, want: `["Cebu"]`},
+		{name: "not a list", places: `"Cebu"`, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePlaces(tt.places, "provinces")
+			if status.Code(err) != tt.code {
+				t.Fatalf("parsePlaces(%q) error = %v, want code %v", tt.places, err, tt.code)
+			}
+			if err == nil && string(got) != tt.want {
+				t.Errorf("parsePlaces(%q) = %s, want %s", tt.places, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPickShippingZone(t *testing.T) {
+	metro := models.ShippingZoneData{ZoneName: "Metro Manila", Provinces: json.RawMessage(`["Metro Manila"]`)}
+	makati := models.ShippingZoneData{ZoneName: "Makati", Cities: json.RawMessage(`["Makati City"]`)}
+	luzon := models.ShippingZoneData{ZoneName: "Luzon", IsDefault: true}
+
+	tests := []struct {
+		name    string
+		zones   []models.ShippingZoneData
+		address string
+		want    string
+		code    codes.Code
+	}{
+		{name: "city beats province", zones: []models.ShippingZoneData{metro, makati, luzon}, address: `{"city":"Makati City","province":"Metro Manila"}`, want: "Makati"},
+		{name: "province", zones: []models.ShippingZoneData{metro, makati, luzon}, address: `{"city":"Pasig","province":"Metro Manila"}`, want: "Metro Manila"},
+		{name: "names compared loosely", zones: []models.ShippingZoneData{metro, makati}, address: `{"city":" makati  city ","province":""}`, want: "Makati"},
+		{name: "default covers the rest", zones: []models.ShippingZoneData{metro, makati, luzon}, address: `{"city":"Baguio","province":"Benguet"}`, want: "Luzon"},
+		{name: "no address uses the default", zones: []models.ShippingZoneData{metro, luzon}, address: ``, want: "Luzon"},
+		{name: "not covered", zones: []models.ShippingZoneData{metro, makati}, address: `{"city":"Cebu City","province":"Cebu"}`, code: codes.FailedPrecondition},
+		{name: "no zones set up", zones: nil, address: `{"city":"Cebu City","province":"Cebu"}`, code: codes.FailedPrecondition},
+		{name: "broken address", zones: []models.ShippingZoneData{luzon}, address: `{`, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zone, err := pickShippingZone(tt.zones, json.RawMessage(tt.address))
+			if status.Code(err) != tt.code {
+				t.Fatalf("pickShippingZone() error = %v, want code %v", err, tt.code)
+			}
+			if zone.ZoneName != tt.want {
+				t.Errorf("pickShippingZone() = %q, want %q", zone.ZoneName, tt.want)
+			}
+		})
+	}
+}
+
+func TestCartWeight(t *testing.T) {
+	productById := map[string]models.ProductData{
+		// 120g actual, 10 x 10 x 5 cm is 100g volumetric
+		"watch": {WeightGrams: 120, LengthCm: 10, WidthCm: 10, HeightCm: 5},
+		// 300g actual, 30 x 20 x 10 cm is 1200g volumetric
+		"box": {WeightGrams: 300, LengthCm: 30, WidthCm: 20, HeightCm: 10},
+	}
+
+	tests := []struct {
+		name  string
+		lines []cartLine
+		want  float64
+	}{
+		{name: "empty cart", lines: nil, want: 0},
+		{name: "actual weight", lines: []cartLine{{ProductID: "watch", Quantity: 2}}, want: 240},
+		{name: "volumetric weight", lines: []cartLine{{ProductID: "box", Quantity: 1}}, want: 1200},
+		{name: "mixed", lines: []cartLine{{ProductID: "watch", Quantity: 1}, {ProductID: "box", Quantity: 2}}, want: 2520},
+		{name: "unknown product", lines: []cartLine{{ProductID: "gone", Quantity: 1}}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cartWeight(tt.lines, productById); got != tt.want {
+				t.Errorf("cartWeight() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// trashEntity describes how a soft-deleted table is listed in the trash.
+// Versioned tables have a version column that a restore bumps; restore lists
+// any other columns a restore resets, such as the schedule stamps of a price
+// rule so the scheduler starts it again.
+type trashEntity struct {
+	table     string
+	idColumn  string
+	label     string
+	versioned bool
+	restore   map[string]interface{}
+}
+
+var trashEntities = map[string]trashEntity{
+	"product":           {table: models.ProductData{}.TableName(), idColumn: "product_id", label: "product_name", versioned: true},
+	"freebies":          {table: models.FreebiesData{}.TableName(), idColumn: "freebies_id", label: "freebies_name", versioned: true},
+	"reviews":           {table: models.ReviewsData{}.TableName(), idColumn: "reviews_id", label: "reviews_subject", versioned: true},
+	"order":             {table: models.OrderData{}.TableName(), idColumn: "order_id", label: "concat_ws(' ', customer->>'firstName', customer->>'lastName')", versioned: true},
+	"home-images":       {table: models.HomeImagesData{}.TableName(), idColumn: "home_images_id", label: "''", versioned: true},
+	"product-attribute": {table: models.ProductAttributeData{}.TableName(), idColumn: "attribute_id", label: "attribute_name"},
+	"shipping-zone":     {table: models.ShippingZoneData{}.TableName(), idColumn: "shipping_zone_id", label: "zone_name"},
+	"price-rule":        {table: models.PriceRuleData{}.TableName(), idColumn: "price_rule_id", label: "rule_name", restore: map[string]interface{}{"started_at": nil, "ended_at": nil}},
+}
+
+// trashDependents are the tables whose rows only make sense with a row of the
+// entity, found by the entity's id column. Purging the row deletes them too;
+// a table added for orders or products belongs here.
+var trashDependents = map[string][]interface{}{
+	"order": {
+		&models.FreebiesAllocationData{},
+		&models.VoucherRedemptionData{},
+		&models.PaymentData{},
+		&models.ReturnData{},
+		&models.OrderEventData{},
+		&models.EmailOutboxData{},
+	},
+	"product": {
+		&models.ProductPriceHistoryData{},
+	},
+}
+
+// trashBlocker is a table whose rows, found by the entity's id column, keep
+// the entity from being purged: money taken for an order, or rules and
+// reviews that still point at a product. Where narrows the rows that count.
+type trashBlocker struct {
+	model interface{}
+	label string
+	where string
+	args  []interface{}
+}
+
+var trashBlockers = map[string][]trashBlocker{
+	"order": {
+		{model: &models.PaymentData{}, label: "captured payments", where: "payment_status = ?", args: []interface{}{"CAP"}},
+		{model: &models.RefundData{}, label: "refunds"},
+	},
+	"product": {
+		{model: &models.ReviewsData{}, label: "reviews"},
+		{model: &models.FreebiesRuleData{}, label: "freebies rules"},
+		{model: &models.PriceRuleData{}, label: "price rules"},
+		{model: &models.VoucherData{}, label: "vouchers"},
+	},
+}
+
+type trashRow struct {
+	Id        string
+	Label     string
+	DeletedAt time.Time
+}
+
+func getTrashEntity(entity string) (trashEntity, error) {
+	trash, ok := trashEntities[entity]
+	if !ok {
+		return trashEntity{}, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid trash entity %s", entity))
+	}
+
+	return trash, nil
+}
+
+func trashQuery(db *gorm.DB, trash trashEntity) *gorm.DB {
+	return db.Table(trash.table).
+		Select(fmt.Sprintf("%s::text AS id, %s AS label, deleted_at", trash.idColumn, trash.label)).
+		Where("deleted_at IS NOT NULL")
+}
+
+// findTrash loads one soft-deleted row, failing with NotFound when the row is
+// missing or not in the trash.
+func findTrash(db *gorm.DB, entity string, trash trashEntity, id string) (*pb.TrashData, error) {
+	var rows []trashRow
+	if err := trashQuery(db, trash).Where(trash.idColumn+" = ?", id).Limit(1).Scan(&rows).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch trash data: %v", err))
+	}
+	if len(rows) == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("No %s with ID %s in the trash", entity, id))
+	}
+
+	return trashRowToPb(entity, rows[0]), nil
+}
+
+func trashRowToPb(entity string, row trashRow) *pb.TrashData {
+	return &pb.TrashData{
+		Entity:    entity,
+		Id:        row.Id,
+		Label:     row.Label,
+		DeletedAt: row.DeletedAt.Unix(),
+	}
+}
+
+// restoreColumns are the columns a restore of trash sets. It clears
+// deleted_at and bumps the version so stale edits made before the delete fail.
+func restoreColumns(trash trashEntity) map[string]interface{} {
+	columns := map[string]interface{}{
+		"deleted_at": nil,
+	}
+	if trash.versioned {
+		columns["version"] = gorm.Expr("version + 1")
+	}
+	for column, value := range trash.restore {
+		columns[column] = value
+	}
+	return columns
+}
+
+func (s *ChronexAdminService) GetAllTrash(ctx context.Context, req *pb.GetAllTrashRequest) (*pb.GetAllTrashResponse, error) {
+	response := &pb.GetAllTrashResponse{
+		TrashData: []*pb.TrashData{},
+	}
+
+	trash, err := getTrashEntity(req.Entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []trashRow
+	if err := trashQuery(s.DB, trash).Order("deleted_at DESC").Scan(&rows).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch trash data: %v", err))
+	}
+
+	for _, row := range rows {
+		response.TrashData = append(response.TrashData, trashRowToPb(req.Entity, row))
+	}
+
+	return response, nil
+}
+
+func (s *ChronexAdminService) RestoreTrash(ctx context.Context, req *pb.RestoreTrashRequest) (*pb.RestoreTrashResponse, error) {
+	trash, err := getTrashEntity(req.Entity)
+	if err != nil {
+		return nil, err
+	}
+
+	trashData, err := findTrash(s.DB, req.Entity, trash, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.Table(trash.table).Where(trash.idColumn+" = ?", req.Id).Updates(restoreColumns(trash)).Error; err != nil {
+		log.Printf("Error restoring %s data: %v", req.Entity, err)
+		return nil, err
+	}
+
+	return &pb.RestoreTrashResponse{
+		TrashData: trashData,
+	}, nil
+}
+
+func (s *ChronexAdminService) PurgeTrash(ctx context.Context, req *pb.PurgeTrashRequest) (*pb.PurgeTrashResponse, error) {
+	trash, err := getTrashEntity(req.Entity)
+	if err != nil {
+		return nil, err
+	}
+
+	trashData, err := findTrash(s.DB, req.Entity, trash, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		// Trashed rows still count, as restoring them would bring the reference back
+		for _, blocker := range trashBlockers[req.Entity] {
+			query := tx.Unscoped().Model(blocker.model).Where(trash.idColumn+" = ?", req.Id)
+			if blocker.where != "" {
+				query = query.Where(blocker.where, blocker.args...)
+			}
+
+			var count int64
+			if err := query.Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return status.Error(codes.FailedPrecondition, fmt.Sprintf("Cannot purge %s %s, it still has %d %s", req.Entity, req.Id, count, blocker.label))
+			}
+		}
+
+		// Rows that only make sense with the purged row go with it
+		for _, dependent := range trashDependents[req.Entity] {
+			if err := tx.Unscoped().Where(trash.idColumn+" = ?", req.Id).Delete(dependent).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ? AND deleted_at IS NOT NULL", trash.table, trash.idColumn), req.Id).Error
+	})
+	if err != nil {
+		log.Printf("Error purging %s data: %v", req.Entity, err)
+		return nil, err
+	}
+
+	return &pb.PurgeTrashResponse{
+		TrashData: trashData,
+	}, nil
+}
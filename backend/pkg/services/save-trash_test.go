@@ -0,0 +1,105 @@
+package services
+
+import (
+	"api/pkg/models"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// trashModels are the models behind each trash entity.
+var trashModels = map[string]interface{}{
+	"product":           models.ProductData{},
+	"freebies":          models.FreebiesData{},
+	"reviews":           models.ReviewsData{},
+	"order":             models.OrderData{},
+	"home-images":       models.HomeImagesData{},
+	"product-attribute": models.ProductAttributeData{},
+	"shipping-zone":     models.ShippingZoneData{},
+	"price-rule":        models.PriceRuleData{},
+}
+
+func TestGetTrashEntity(t *testing.T) {
+	for entity, model := range trashModels {
+		t.Run(entity, func(t *testing.T) {
+			trash, err := getTrashEntity(entity)
+			if err != nil {
+				t.Fatalf("getTrashEntity(%q) error = %v", entity, err)
+			}
+			// A restore bumps the version of exactly the tables that have one
+			_, hasVersion := reflect.TypeOf(model).FieldByName("Version")
+			if trash.versioned != hasVersion {
+				t.Errorf("getTrashEntity(%q).versioned = %v, but the model has a version: %v", entity, trash.versioned, hasVersion)
+			}
+		})
+	}
+
+	if len(trashEntities) != len(trashModels) {
+		t.Errorf("%d trash entities, want %d", len(trashEntities), len(trashModels))
+	}
+	if _, err := getTrashEntity("customer"); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("getTrashEntity(%q) error = %v, want code %v", "customer", err, codes.InvalidArgument)
+	}
+}
+
+func TestRestoreColumns(t *testing.T) {
+	tests := []struct {
+		entity string
+		want   []string
+	}{
+		{entity: "product", want: []string{"deleted_at", "version"}},
+		{entity: "shipping-zone", want: []string{"deleted_at"}},
+		{entity: "price-rule", want: []string{"deleted_at", "started_at", "ended_at"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.entity, func(t *testing.T) {
+			trash, _ := getTrashEntity(tt.entity)
+			columns := restoreColumns(trash)
+			if len(columns) != len(tt.want) {
+				t.Errorf("restoreColumns(%q) = %v, want %v", tt.entity, columns, tt.want)
+			}
+			for _, column := range tt.want {
+				value, ok := columns[column]
+				if !ok {
+					t.Errorf("restoreColumns(%q) does not set %s", tt.entity, column)
+				}
+				var want interface{}
+				if column == "version" {
+					want = gorm.Expr("version + 1")
+				}
+				if !reflect.DeepEqual(value, want) {
+					t.Errorf("restoreColumns(%q) sets %s to %v, want %v", tt.entity, column, value, want)
+				}
+			}
+		})
+	}
+}
+
+func TestTrashBlockersAreNotDependents(t *testing.T) {
+	for entity, blockers := range trashBlockers {
+		if _, ok := trashEntities[entity]; !ok {
+			t.Errorf("blockers for unknown trash entity %s", entity)
+		}
+		for _, blocker := range blockers {
+			// A blocker narrowed by where, like captured payments, leaves the
+			// other rows of its table to be purged
+			if blocker.where != "" {
+				continue
+			}
+			for _, dependent := range trashDependents[entity] {
+				if reflect.TypeOf(blocker.model) == reflect.TypeOf(dependent) {
+					t.Errorf("%T both blocks and is purged with %s", dependent, entity)
+				}
+			}
+		}
+	}
+	for entity := range trashDependents {
+		if _, ok := trashEntities[entity]; !ok {
+			t.Errorf("dependents for unknown trash entity %s", entity)
+		}
+	}
+}
@@ -0,0 +1,368 @@
+package services
+
+import (
+	"api/pkg/models"
+	"api/pkg/pb"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func normalizeVoucherCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func validateVoucherDiscount(discountType string, discountValue float64) error {
+	switch discountType {
+	case "PCT":
+		if discountValue <= 0 || discountValue > 100 {
+			return status.Error(codes.InvalidArgument, "Percentage voucher value must be between 0 and 100")
+		}
+	case "FIX":
+		if discountValue <= 0 {
+			return status.Error(codes.InvalidArgument, "Fixed voucher value must be greater than 0")
+		}
+	default:
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid discount type %s", discountType))
+	}
+
+	return nil
+}
+
+func voucherToPb(data models.VoucherData) *pb.VoucherData {
+	return &pb.VoucherData{
+		VoucherId:        data.GetVoucherId().String(),
+		VoucherCode:      data.VoucherCode,
+		DiscountType:     data.DiscountType,
+		DiscountValue:    data.DiscountValue,
+		MaxDiscount:      data.MaxDiscount,
+		MinSpend:         data.MinSpend,
+		Category:         data.Category,
+		ProductId:        data.ProductId,
+		UsageLimit:       data.UsageLimit,
+		PerCustomerLimit: data.PerCustomerLimit,
+		UsageCount:       data.UsageCount,
+		StartAt:          data.GetStartAt(),
+		EndAt:            data.GetEndAt(),
+		VoucherStatus:    data.VoucherStatus,
+		CreatedBy:        data.CreatedBy.String(),
+		CreatedAt:        data.CreatedAt.Unix(),
+		UpdatedBy:        data.UpdatedBy.String(),
+		UpdatedAt:        data.UpdatedAt.Unix(),
+	}
+}
+
+// voucherUsage counts the redemptions of each voucher on orders that were not
+// cancelled or purged, limited to one customer's when customerEmail is set.
+func voucherUsage(db *gorm.DB, voucherIds []uuid.UUID, customerEmail string) (map[uuid.UUID]int64, error) {
+	usage := make(map[uuid.UUID]int64, len(voucherIds))
+	if len(voucherIds) == 0 {
+		return usage, nil
+	}
+
+	query := db.Model(&models.VoucherRedemptionData{}).
+		Select("chronex_voucher_redemption.voucher_id, count(*) AS used").
+		Joins("JOIN chronex_product_order ON chronex_product_order.order_id = chronex_voucher_redemption.order_id").
+		Where("chronex_voucher_redemption.voucher_id IN ?", voucherIds).
+		Where("chronex_product_order.order_status != ? AND chronex_product_order.deleted_at IS NULL", "CAN")
+	if customerEmail != "" {
+		query = query.Where("chronex_voucher_redemption.customer_email = ?", customerEmail)
+	}
+
+	var results []struct {
+		VoucherId uuid.UUID
+		Used      int64
+	}
+	if err := query.Group("chronex_voucher_redemption.voucher_id").Scan(&results).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to count voucher redemption: %v", err))
+	}
+	for _, result := range results {
+		usage[result.VoucherId] = result.Used
+	}
+
+	return usage, nil
+}
+
+// withVoucherUsage fills in the UsageCount of each voucher.
+func withVoucherUsage(db *gorm.DB, vouchers ...*models.VoucherData) error {
+	voucherIds := make([]uuid.UUID, len(vouchers))
+	for i, voucher := range vouchers {
+		voucherIds[i] = voucher.VoucherId
+	}
+
+	usage, err := voucherUsage(db, voucherIds, "")
+	if err != nil {
+		return err
+	}
+	for _, voucher := range vouchers {
+		voucher.UsageCount = usage[voucher.VoucherId]
+	}
+
+	return nil
+}
+
+// applyVoucher validates a voucher code against a priced cart and returns the
+// voucher and the discount it gives. With lock set the voucher row is locked
+// for the rest of the transaction so the usage checks hold until redemption.
+func applyVoucher(db *gorm.DB, code string, lines []cartLine, productById map[string]models.ProductData, customerEmail string, now time.Time, lock bool) (models.VoucherData, float64, error) {
+	var voucher models.VoucherData
+
+	query := db.Where("upper(voucher_code) = ?", normalizeVoucherCode(code))
+	if lock {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	if err := query.First(&voucher).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return voucher, 0, status.Error(codes.InvalidArgument, fmt.Sprintf("Voucher %s not found", code))
+		}
+		return voucher, 0, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch voucher data: %v", err))
+	}
+
+	if err := withVoucherUsage(db, &voucher); err != nil {
+		return voucher, 0, err
+	}
+	var customerUsed int64
+	if voucher.PerCustomerLimit > 0 && customerEmail != "" {
+		used, err := voucherUsage(db, []uuid.UUID{voucher.VoucherId}, customerEmail)
+		if err != nil {
+			return voucher, 0, err
+		}
+		customerUsed = used[voucher.VoucherId]
+	}
+
+	discount, err := voucherDiscount(voucher, code, customerEmail, customerUsed, lines, productById, now)
+	return voucher, discount, err
+}
+
+// voucherDiscount checks a voucher, with its UsageCount filled in, against a
+// priced cart and returns the discount it gives. customerUsed is how often
+// customerEmail has already redeemed it; code is the code as the customer
+// typed it, for the error messages.
+func voucherDiscount(voucher models.VoucherData, code string, customerEmail string, customerUsed int64, lines []cartLine, productById map[string]models.ProductData, now time.Time) (float64, error) {
+	if voucher.VoucherStatus != "ACT" {
+		return 0, status.Error(codes.FailedPrecondition, fmt.Sprintf("Voucher %s is not active", code))
+	}
+	if voucher.StartAt != nil && now.Before(*voucher.StartAt) {
+		return 0, status.Error(codes.FailedPrecondition, fmt.Sprintf("Voucher %s is not valid yet", code))
+	}
+	if voucher.EndAt != nil && now.After(*voucher.EndAt) {
+		return 0, status.Error(codes.FailedPrecondition, fmt.Sprintf("Voucher %s has expired", code))
+	}
+	if voucher.UsageLimit > 0 && voucher.UsageCount >= voucher.UsageLimit {
+		return 0, status.Error(codes.FailedPrecondition, fmt.Sprintf("Voucher %s has been fully redeemed", code))
+	}
+
+	if voucher.PerCustomerLimit > 0 {
+		if customerEmail == "" {
+			return 0, status.Error(codes.InvalidArgument, "Customer email is required for this voucher")
+		}
+		if customerUsed >= voucher.PerCustomerLimit {
+			return 0, status.Error(codes.FailedPrecondition, fmt.Sprintf("Voucher %s has already been used", code))
+		}
+	}
+
+	spend, _, inScope := scopedCartTotals(lines, productById, voucher.Category, voucher.ProductId)
+	if !inScope {
+		return 0, status.Error(codes.FailedPrecondition, fmt.Sprintf("Voucher %s does not apply to these products", code))
+	}
+	if voucher.MinSpend > 0 && spend < voucher.MinSpend {
+		return 0, status.Error(codes.FailedPrecondition, fmt.Sprintf("Voucher %s requires a minimum spend of %.2f", code, voucher.MinSpend))
+	}
+
+	return math.Round(voucher.DiscountFor(spend)*100) / 100, nil
+}
+
+// recordVoucherRedemption counts a voucher use against a saved order. It must
+// run in the same transaction as the locking applyVoucher call. The use is
+// given back when the order is cancelled or purged.
+func recordVoucherRedemption(tx *gorm.DB, voucher models.VoucherData, orderData models.OrderData, customerEmail string) error {
+	redemption := models.VoucherRedemptionData{
+		VoucherId:      voucher.VoucherId,
+		OrderId:        orderData.OrderId,
+		CustomerEmail:  customerEmail,
+		DiscountAmount: orderData.VoucherDiscount,
+	}
+
+	return tx.Create(&redemption).Error
+}
+
+func (s *ChronexAdminService) SaveVoucher(ctx context.Context, req *pb.SaveVoucherRequest) (*pb.SaveVoucherResponse, error) {
+	if normalizeVoucherCode(req.VoucherCode) == "" {
+		return nil, status.Error(codes.InvalidArgument, "Voucher code is required")
+	}
+	if err := validateVoucherDiscount(req.DiscountType, req.DiscountValue); err != nil {
+		return nil, err
+	}
+
+	voucherStatus := req.VoucherStatus
+	if voucherStatus == "" {
+		voucherStatus = "ACT"
+	}
+
+	// Create a new VoucherData instance
+	voucherData := models.VoucherData{
+		VoucherCode:      normalizeVoucherCode(req.VoucherCode),
+		DiscountType:     req.DiscountType,
+		DiscountValue:    req.DiscountValue,
+		MaxDiscount:      req.MaxDiscount,
+		MinSpend:         req.MinSpend,
+		Category:         req.Category,
+		ProductId:        req.ProductId,
+		UsageLimit:       req.UsageLimit,
+		PerCustomerLimit: req.PerCustomerLimit,
+		StartAt:          unixToTime(req.StartAt),
+		EndAt:            unixToTime(req.EndAt),
+		VoucherStatus:    voucherStatus,
+	}
+
+	// Save the data to the database using GORM
+	if err := s.DB.Create(&voucherData).Error; err != nil {
+		log.Printf("Error saving Voucher data: %v", err)
+		return nil, err
+	}
+
+	return &pb.SaveVoucherResponse{
+		VoucherData: voucherToPb(voucherData),
+	}, nil
+}
+
+func (s *ChronexAdminService) GetAllVoucher(ctx context.Context, req *pb.GetAllVoucherRequest) (*pb.GetAllVoucherResponse, error) {
+	response := &pb.GetAllVoucherResponse{
+		VoucherData: []*pb.VoucherData{},
+	}
+
+	// Build your query based on the request parameters
+	query := s.DB.Model(&models.VoucherData{}).Order("created_at DESC")
+
+	// Handle searching
+	if req.Search != "" {
+		query = query.Where("voucher_code ILIKE ?", "%"+req.Search+"%")
+	}
+
+	// Filter by status
+	if req.VoucherStatus != "" {
+		query = query.Where("voucher_status = ?", req.VoucherStatus)
+	} else {
+		query = query.Where("voucher_status != ?", "DEL")
+	}
+
+	// Execute the query
+	var voucherDataValue []models.VoucherData
+	if err := query.Find(&voucherDataValue).Error; err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to fetch voucher data: %v", err))
+	}
+
+	vouchers := make([]*models.VoucherData, len(voucherDataValue))
+	for i := range voucherDataValue {
+		vouchers[i] = &voucherDataValue[i]
+	}
+	if err := withVoucherUsage(s.DB, vouchers...); err != nil {
+		return nil, err
+	}
+
+	// Map the retrieved data to protobuf message
+	for _, data := range voucherDataValue {
+		response.VoucherData = append(response.VoucherData, voucherToPb(data))
+	}
+
+	return response, nil
+}
+
+func (s *ChronexAdminService) UpdateVoucher(ctx context.Context, req *pb.UpdateVoucherRequest) (*pb.UpdateVoucherResponse, error) {
+	mask, err := newUpdateMask(req.ProtoReflect().Descriptor(), req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve existing VoucherData from the database
+	var existingVoucherData models.VoucherData
+	if err := s.DB.First(&existingVoucherData, "voucher_id = ?", req.GetVoucherId()).Error; err != nil {
+		log.Printf("Error retrieving Voucher data: %v", err)
+		return nil, err
+	}
+
+	// Update the existing VoucherData with the provided or masked values
+	if mask.has("discountType", req.DiscountType != "") {
+		existingVoucherData.DiscountType = req.DiscountType
+	}
+	if mask.has("discountValue", req.DiscountValue != 0) {
+		existingVoucherData.DiscountValue = req.DiscountValue
+	}
+	if mask.has("maxDiscount", req.MaxDiscount != 0) {
+		existingVoucherData.MaxDiscount = req.MaxDiscount
+	}
+	if mask.has("minSpend", req.MinSpend != 0) {
+		existingVoucherData.MinSpend = req.MinSpend
+	}
+	if mask.has("category", req.Category != "") {
+		existingVoucherData.Category = req.Category
+	}
+	if mask.has("productId", req.ProductId != "") {
+		existingVoucherData.ProductId = req.ProductId
+	}
+	if mask.has("usageLimit", req.UsageLimit != 0) {
+		existingVoucherData.UsageLimit = req.UsageLimit
+	}
+	if mask.has("perCustomerLimit", req.PerCustomerLimit != 0) {
+		existingVoucherData.PerCustomerLimit = req.PerCustomerLimit
+	}
+	if mask.has("startAt", req.StartAt != 0) {
+		existingVoucherData.StartAt = unixToTime(req.StartAt)
+	}
+	if mask.has("endAt", req.EndAt != 0) {
+		existingVoucherData.EndAt = unixToTime(req.EndAt)
+	}
+
+	if err := validateVoucherDiscount(existingVoucherData.DiscountType, existingVoucherData.DiscountValue); err != nil {
+		return nil, err
+	}
+
+	// Save the updated data back to the database using GORM
+	if err := s.DB.Save(&existingVoucherData).Error; err != nil {
+		log.Printf("Error updating Voucher data: %v", err)
+		return nil, err
+	}
+	if err := withVoucherUsage(s.DB, &existingVoucherData); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateVoucherResponse{
+		VoucherData: voucherToPb(existingVoucherData),
+	}, nil
+}
+
+func (s *ChronexAdminService) UpdateVoucherStatus(ctx context.Context, req *pb.UpdateVoucherStatusRequest) (*pb.UpdateVoucherStatusResponse, error) {
+	// Retrieve existing VoucherData from the database
+	var existingVoucherData models.VoucherData
+	if err := s.DB.First(&existingVoucherData, "voucher_id = ?", req.GetVoucherId()).Error; err != nil {
+		log.Printf("Error retrieving Voucher data: %v", err)
+		return nil, err
+	}
+
+	if req.VoucherStatus != "" {
+		existingVoucherData.VoucherStatus = req.VoucherStatus
+	}
+
+	// Save the updated data back to the database using GORM
+	if err := s.DB.Save(&existingVoucherData).Error; err != nil {
+		log.Printf("Error updating Voucher data: %v", err)
+		return nil, err
+	}
+	if err := withVoucherUsage(s.DB, &existingVoucherData); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateVoucherStatusResponse{
+		VoucherData: voucherToPb(existingVoucherData),
+	}, nil
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"api/pkg/models"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateVoucherDiscount(t *testing.T) {
+	tests := []struct {
+		name          string
+		discountType  string
+		discountValue float64
+		code          codes.Code
+	}{
+		{name: "percentage", discountType: "PCT", discountValue: 15},
+		{name: "full percentage", discountType: "PCT", discountValue: 100},
+		{name: "percentage over 100", discountType: "PCT", discountValue: 101, code: codes.InvalidArgument},
+		{name: "zero percentage", discountType: "PCT", discountValue: 0, code: codes.InvalidArgument},
+		{name: "fixed", discountType: "FIX", discountValue: 200},
+		{name: "negative fixed", discountType: "FIX", discountValue: -5, code: codes.InvalidArgument},
+		{name: "unknown type", discountType: "BOGO", discountValue: 1, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateVoucherDiscount(tt.discountType, tt.discountValue)
+			if got := status.Code(err); got != tt.code {
+				t.Errorf("validateVoucherDiscount(%s, %v) code = %v, want %v", tt.discountType, tt.discountValue, got, tt.code)
+			}
+		})
+	}
+}
+
+func TestVoucherDiscount(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	yesterday := now.AddDate(0, 0, -1)
+	tomorrow := now.AddDate(0, 0, 1)
+	// A 1500 watch and two 250 straps
+	lines := []cartLine{
+		{ProductID: watchId.String(), Quantity: 1},
+		{ProductID: strapId.String(), Quantity: 2},
+	}
+
+	tests := []struct {
+		name          string
+		voucher       models.VoucherData
+		customerEmail string
+		customerUsed  int64
+		want          float64
+		code          codes.Code
+	}{
+		{name: "percentage of the cart", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "PCT", DiscountValue: 10}, want: 200},
+		{name: "percentage capped", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "PCT", DiscountValue: 10, MaxDiscount: 150}, want: 150},
+		{name: "percentage rounded to cents", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "PCT", DiscountValue: 3.333}, want: 66.66},
+		{name: "fixed", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "FIX", DiscountValue: 300}, want: 300},
+		{name: "fixed capped at the scoped spend", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "FIX", DiscountValue: 800, Category: "Straps"}, want: 500},
+		{name: "percentage of the scope only", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "PCT", DiscountValue: 10, ProductId: watchId.String()}, want: 150},
+		{name: "inactive", voucher: models.VoucherData{VoucherStatus: "INA", DiscountType: "FIX", DiscountValue: 100}, code: codes.FailedPrecondition},
+		{name: "not valid yet", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "FIX", DiscountValue: 100, StartAt: &tomorrow}, code: codes.FailedPrecondition},
+		{name: "expired", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "FIX", DiscountValue: 100, EndAt: &yesterday}, code: codes.FailedPrecondition},
+		{name: "under the usage limit", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "FIX", DiscountValue: 100, UsageLimit: 5, UsageCount: 4}, want: 100},
+		{name: "usage limit reached", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "FIX", DiscountValue: 100, UsageLimit: 5, UsageCount: 5}, code: codes.FailedPrecondition},
+		{name: "per-customer limit needs an email", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "FIX", DiscountValue: 100, PerCustomerLimit: 1}, code: codes.InvalidArgument},
+		{name: "under the per-customer limit", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "FIX", DiscountValue: 100, PerCustomerLimit: 2}, customerEmail: "ana@example.com", customerUsed: 1, want: 100},
+		{name: "per-customer limit reached", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "FIX", DiscountValue: 100, PerCustomerLimit: 2}, customerEmail: "ana@example.com", customerUsed: 2, code: codes.FailedPrecondition},
+		{name: "scope not in cart", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "FIX", DiscountValue: 100, Category: "Boxes"}, code: codes.FailedPrecondition},
+		{name: "min spend met", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "FIX", DiscountValue: 100, MinSpend: 2000}, want: 100},
+		{name: "min spend missed in scope", voucher: models.VoucherData{VoucherStatus: "ACT", DiscountType: "FIX", DiscountValue: 100, Category: "Straps", MinSpend: 600}, code: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := voucherDiscount(tt.voucher, "SAVE", tt.customerEmail, tt.customerUsed, lines, testProducts, now)
+			if status.Code(err) != tt.code {
+				t.Fatalf("voucherDiscount() error = %v, want code %v", err, tt.code)
+			}
+			if got != tt.want {
+				t.Errorf("voucherDiscount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"api/pkg/models"
+	"html"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Product and order search combines Postgres full-text search over the
+// search_vector columns with pg_trgm word similarity, so prefixes and typos
+// still match. A name or order whose words are at least minWordSimilarity
+// similar to the search matches even when no full word does.
+
+// minWordSimilarity is the pg_trgm word similarity a typo or prefix needs to
+// match.
+const minWordSimilarity = 0.4
+
+// Matches in snippets are marked with control characters that cannot occur
+// in stored text, and turned into <mark> tags by highlightSnippet once the
+// rest of the snippet has been escaped.
+const (
+	snippetStart = "\x01"
+	snippetStop  = "\x02"
+)
+
+// headlineOptions are the ts_headline options for a highlighted name and for
+// a snippet of a longer text.
+var (
+	headlineNameOptions    = `StartSel="` + snippetStart + `", StopSel="` + snippetStop + `", HighlightAll=true`
+	headlineSnippetOptions = `StartSel="` + snippetStart + `", StopSel="` + snippetStop + `", MaxFragments=2, MaxWords=20, MinWords=8, FragmentDelimiter=" … "`
+)
+
+// escapeLike escapes the LIKE wildcards in search, so a % or _ typed by the
+// user matches itself.
+func escapeLike(search string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(search)
+}
+
+// highlightSnippet escapes a ts_headline result for HTML and wraps its
+// matches in <mark> tags.
+func highlightSnippet(snippet string) string {
+	escaped := html.EscapeString(snippet)
+	escaped = strings.ReplaceAll(escaped, snippetStart, "<mark>")
+	return strings.ReplaceAll(escaped, snippetStop, "</mark>")
+}
+
+// searchProducts narrows query to products whose name, SKU, category or
+// descriptions match search.
+func searchProducts(query *gorm.DB, search string) *gorm.DB {
+	search = strings.TrimSpace(search)
+	if search == "" {
+		return query
+	}
+
+	return query.Where("(search_vector @@ websearch_to_tsquery('english', ?) OR word_similarity(?, product_name) >= ? OR sku ILIKE ?)",
+		search, search, minWordSimilarity, "%"+escapeLike(search)+"%")
+}
+
+// productRankSQL is the relevance of a product to a search.
+const productRankSQL = "ts_rank_cd(search_vector, websearch_to_tsquery('english', ?)) + word_similarity(?, product_name)"
+
+// rankProducts selects the relevance of each product to search as
+// search_rank, with its name and a description snippet highlighted, so
+// results can be ordered by "search_rank DESC". Without
+// a search it selects the products alone, as gorm would otherwise look for
+// the relevance columns in the table.
+func rankProducts(query *gorm.DB, search string) *gorm.DB {
+	search = strings.TrimSpace(search)
+	if search == "" {
+		return query.Select("chronex_product_data.*")
+	}
+	return query.Select("chronex_product_data.*, "+productRankSQL+" AS search_rank, "+
+		"ts_headline('english', product_name, websearch_to_tsquery('english', ?), ?) AS highlighted_name, "+
+		"ts_headline('english', description1, websearch_to_tsquery('english', ?), ?) AS search_snippet",
+		search, search, search, headlineNameOptions, search, headlineSnippetOptions)
+}
+
+// productSearchResult is a product with the relevance columns rankProducts
+// selects, which are empty when nothing was searched.
+type productSearchResult struct {
+	models.ProductData
+	SearchRank      float64
+	HighlightedName string
+	SearchSnippet   string
+}
+
+// orderSearchResult is an order with the relevance columns rankOrders
+// selects.
+type orderSearchResult struct {
+	models.OrderData
+	SearchRank    float64
+	SearchSnippet string
+}
+
+// searchOrders narrows query to orders whose customer, products or order
+// number match search.
+func searchOrders(query *gorm.DB, search string) *gorm.DB {
+	search = strings.TrimSpace(search)
+	if search == "" {
+		return query
+	}
+
+	return query.Where("(search_vector @@ websearch_to_tsquery('simple', ?) OR word_similarity(?, search_text) >= ? OR search_text ILIKE ?)",
+		search, search, minWordSimilarity, "%"+escapeLike(search)+"%")
+}
+
+// orderRankSQL is the relevance of an order to a search.
+const orderRankSQL = "ts_rank_cd(search_vector, websearch_to_tsquery('simple', ?)) + word_similarity(?, search_text)"
+
+// rankOrders selects the relevance of each order to search as search_rank,
+// with a snippet of the customer and products it matched. Like rankProducts,
+// it selects the orders alone without a search.
+func rankOrders(query *gorm.DB, search string) *gorm.DB {
+	search = strings.TrimSpace(search)
+	if search == "" {
+		return query.Select("chronex_product_order.*")
+	}
+	return query.Select("chronex_product_order.*, "+orderRankSQL+" AS search_rank, "+
+		"ts_headline('simple', search_text, websearch_to_tsquery('simple', ?), ?) AS search_snippet",
+		search, search, search, headlineSnippetOptions)
+}
@@ -0,0 +1,46 @@
+package services
+
+import "testing"
+
+func TestHighlightSnippet(t *testing.T) {
+	tests := []struct {
+		name    string
+		snippet string
+		want    string
+	}{
+		{name: "plain", snippet: "Automatic diver", want: "Automatic diver"},
+		{name: "matches marked", snippet: "An \x01automatic\x02 \x01diver\x02 watch", want: "An <mark>automatic</mark> <mark>diver</mark> watch"},
+		{name: "stored markup escaped", snippet: "<b>\x01Seiko\x02</b> & co", want: "&lt;b&gt;<mark>Seiko</mark>&lt;/b&gt; &amp; co"},
+		{name: "empty", snippet: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := highlightSnippet(tt.snippet); got != tt.want {
+				t.Errorf("highlightSnippet(%q) = %q, want %q", tt.snippet, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscapeLike(t *testing.T) {
+	tests := []struct {
+		name   string
+		search string
+		want   string
+	}{
+		{name: "plain", search: "SKU-100", want: "SKU-100"},
+		{name: "percent", search: "50%", want: `50\%`},
+		{name: "underscore", search: "strap_black", want: `strap\_black`},
+		{name: "backslash", search: `a\b`, want: `a\\b`},
+		{name: "all together", search: `%_\`, want: `\%\_\\`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapeLike(tt.search); got != tt.want {
+				t.Errorf("escapeLike(%q) = %q, want %q", tt.search, got, tt.want)
+			}
+		})
+	}
+}
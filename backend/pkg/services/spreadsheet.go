@@ -0,0 +1,203 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/tealeg/xlsx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSpreadsheetBytes caps uploaded CSV and XLSX files.
+const maxSpreadsheetBytes = 10 << 20
+
+// readSpreadsheet decodes an uploaded CSV or XLSX file, picked by its
+// extension, into rows of trimmed cell text, so rows[i] is line i+1 of the
+// file. Only the first XLSX sheet is read.
+func readSpreadsheet(fileName string, data []byte) ([][]string, error) {
+	if len(data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "The uploaded file is empty")
+	}
+	if len(data) > maxSpreadsheetBytes {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("The uploaded file is larger than %d MB", maxSpreadsheetBytes>>20))
+	}
+
+	var rows [][]string
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+		reader.FieldsPerRecord = -1
+		reader.LazyQuotes = true
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid CSV file: %v", err))
+			}
+			// Keep blank lines so row numbers match the file
+			line, _ := reader.FieldPos(0)
+			for len(rows) < line-1 {
+				rows = append(rows, []string{})
+			}
+			rows = append(rows, record)
+		}
+	case ".xlsx":
+		file, err := xlsx.OpenBinary(data)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid XLSX file: %v", err))
+		}
+		if len(file.Sheets) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "The XLSX file has no sheets")
+		}
+		for _, row := range file.Sheets[0].Rows {
+			cells := []string{}
+			if row != nil {
+				for _, cell := range row.Cells {
+					cells = append(cells, cell.String())
+				}
+			}
+			rows = append(rows, cells)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Upload a .csv or .xlsx file")
+	}
+
+	for _, row := range rows {
+		for i := range row {
+			row[i] = strings.TrimSpace(row[i])
+		}
+	}
+
+	return rows, nil
+}
+
+// normalizeHeader folds a column heading for matching, so "Tracking No.",
+// "tracking_no" and "TRACKING NO" are the same column.
+func normalizeHeader(header string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, header)
+}
+
+// findColumn returns the index of the first header matching one of names, or
+// -1.
+func findColumn(header []string, names ...string) int {
+	for i, cell := range header {
+		cell = normalizeHeader(cell)
+		for _, name := range names {
+			if cell == normalizeHeader(name) {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// cellAt returns row[index], or "" for a missing cell.
+func cellAt(row []string, index int) string {
+	if index < 0 || index >= len(row) {
+		return ""
+	}
+	return row[index]
+}
+
+// blankRow reports whether every cell of row is empty.
+func blankRow(row []string) bool {
+	for _, cell := range row {
+		if cell != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Spreadsheet formats for exports.
+const (
+	SpreadsheetCSV  = "csv"
+	SpreadsheetXLSX = "xlsx"
+)
+
+// tableWriter streams rows of a one-sheet export.
+type tableWriter interface {
+	Write(cells []string) error
+	Close() error
+}
+
+type csvTableWriter struct {
+	writer *csv.Writer
+}
+
+func (w csvTableWriter) Write(cells []string) error {
+	if err := w.writer.Write(cells); err != nil {
+		return err
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func (w csvTableWriter) Close() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type xlsxTableWriter struct {
+	file *xlsx.StreamFile
+}
+
+func (w xlsxTableWriter) Write(cells []string) error {
+	return w.file.Write(cells)
+}
+
+func (w xlsxTableWriter) Close() error {
+	return w.file.Close()
+}
+
+// newTableWriter starts a CSV or XLSX export on out with the given header
+// row. Rows are flushed as they are written, so large exports are never held
+// in memory.
+func newTableWriter(format string, out io.Writer, sheetName string, header []string) (tableWriter, error) {
+	switch format {
+	case SpreadsheetCSV:
+		writer := csv.NewWriter(out)
+		if err := writer.Write(header); err != nil {
+			return nil, err
+		}
+		return csvTableWriter{writer: writer}, nil
+	case SpreadsheetXLSX:
+		builder := xlsx.NewStreamFileBuilder(out)
+		if err := builder.AddSheet(sheetName, header, nil); err != nil {
+			return nil, err
+		}
+		file, err := builder.Build()
+		if err != nil {
+			return nil, err
+		}
+		return xlsxTableWriter{file: file}, nil
+	}
+
+	return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid export format %s", format))
+}
+
+// ParseSpreadsheetFormat checks an export format, defaulting to CSV.
+func ParseSpreadsheetFormat(format string) (string, error) {
+	switch format = strings.ToLower(strings.TrimSpace(format)); format {
+	case "":
+		return SpreadsheetCSV, nil
+	case SpreadsheetCSV, SpreadsheetXLSX:
+		return format, nil
+	}
+
+	return "", status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid export format %s", format))
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// updateMask is the set of fields named in an Update* request's updateMask.
+// A nil mask keeps the old behaviour of writing only the non-zero fields.
+type updateMask map[string]bool
+
+// newUpdateMask validates the mask paths against the request message. Paths
+// may be given as camelCase field names or snake_case FieldMask paths; the id
+// field, the mask itself and the expected version cannot be updated.
+func newUpdateMask(desc protoreflect.MessageDescriptor, paths []string) (updateMask, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	mask := updateMask{}
+	for _, path := range paths {
+		name := snakeToCamel(strings.TrimSpace(path))
+		field := desc.Fields().ByName(protoreflect.Name(name))
+		if field == nil || field.Number() == 1 || field.IsList() || name == "expectedVersion" {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid update mask field %s", path))
+		}
+		mask[name] = true
+	}
+
+	return mask, nil
+}
+
+// has reports whether a field should be written. Without a mask a field is
+// written when it was provided, meaning it is not its zero value.
+func (m updateMask) has(field string, provided bool) bool {
+	if m == nil {
+		return provided
+	}
+
+	return m[field]
+}
+
+func snakeToCamel(path string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range path {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// rawJSON stores an empty JSON field as NULL instead of an invalid empty value.
+func rawJSON(value string) json.RawMessage {
+	if value == "" {
+		return nil
+	}
+
+	return json.RawMessage(value)
+}
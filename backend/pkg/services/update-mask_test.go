@@ -0,0 +1,71 @@
+package services
+
+import (
+	"api/pkg/pb"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewUpdateMask(t *testing.T) {
+	desc := (&pb.UpdateProductRequest{}).ProtoReflect().Descriptor()
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  updateMask
+		code  codes.Code
+	}{
+		{name: "no paths", paths: nil, want: nil},
+		{name: "camelCase", paths: []string{"discount", "productName"}, want: updateMask{"discount": true, "productName": true}},
+		{name: "snake_case", paths: []string{"original_price", " weight_grams "}, want: updateMask{"originalPrice": true, "weightGrams": true}},
+		{name: "unknown field", paths: []string{"price"}, code: codes.InvalidArgument},
+		{name: "id field", paths: []string{"productId"}, code: codes.InvalidArgument},
+		{name: "mask itself", paths: []string{"update_mask"}, code: codes.InvalidArgument},
+		{name: "expected version", paths: []string{"expectedVersion"}, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mask, err := newUpdateMask(desc, tt.paths)
+			if status.Code(err) != tt.code {
+				t.Fatalf("newUpdateMask(%v) error = %v, want code %v", tt.paths, err, tt.code)
+			}
+			if err != nil {
+				return
+			}
+			if len(mask) != len(tt.want) || (mask == nil) != (tt.want == nil) {
+				t.Fatalf("newUpdateMask(%v) = %v, want %v", tt.paths, mask, tt.want)
+			}
+			for field := range tt.want {
+				if !mask[field] {
+					t.Errorf("newUpdateMask(%v) is missing %s", tt.paths, field)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateMaskHas(t *testing.T) {
+	tests := []struct {
+		name     string
+		mask     updateMask
+		field    string
+		provided bool
+		want     bool
+	}{
+		{name: "no mask, provided", mask: nil, field: "discount", provided: true, want: true},
+		{name: "no mask, zero value", mask: nil, field: "discount", provided: false, want: false},
+		{name: "masked zero value", mask: updateMask{"discount": true}, field: "discount", provided: false, want: true},
+		{name: "unmasked value", mask: updateMask{"discount": true}, field: "productName", provided: true, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mask.has(tt.field, tt.provided); got != tt.want {
+				t.Errorf("has(%q, %v) = %v, want %v", tt.field, tt.provided, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"api/pkg/models"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkVersion rejects an update that does not name the version it was based
+// on, or that was based on an older version than the stored row.
+func checkVersion(expected int64, current int64, entity string, id string) error {
+	if expected == 0 {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("Expected version is required to update %s %s", entity, id))
+	}
+	if expected != current {
+		return status.Error(codes.Aborted, fmt.Sprintf("%s %s was changed by someone else (version %d, expected %d)", entity, id, current, expected))
+	}
+
+	return nil
+}
+
+// versionError turns a lost SaveVersioned race into an Aborted error.
+func versionError(err error, entity string, id string) error {
+	if errors.Is(err, models.ErrVersionConflict) {
+		return status.Error(codes.Aborted, fmt.Sprintf("%s %s was changed by someone else", entity, id))
+	}
+
+	return err
+}
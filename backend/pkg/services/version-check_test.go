@@ -0,0 +1,53 @@
+package services
+
+import (
+	"api/pkg/models"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected int64
+		current  int64
+		code     codes.Code
+	}{
+		{name: "matching version", expected: 3, current: 3, code: codes.OK},
+		{name: "missing version", expected: 0, current: 3, code: codes.InvalidArgument},
+		{name: "stale version", expected: 2, current: 3, code: codes.Aborted},
+		{name: "future version", expected: 4, current: 3, code: codes.Aborted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkVersion(tt.expected, tt.current, "Product", "p1")
+			if got := status.Code(err); got != tt.code {
+				t.Errorf("checkVersion(%d, %d) code = %v, want %v", tt.expected, tt.current, got, tt.code)
+			}
+		})
+	}
+}
+
+func TestVersionError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "lost race", err: models.ErrVersionConflict, code: codes.Aborted},
+		{name: "wrapped lost race", err: fmt.Errorf("saving: %w", models.ErrVersionConflict), code: codes.Aborted},
+		{name: "other error", err: status.Error(codes.Internal, "boom"), code: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(versionError(tt.err, "Product", "p1")); got != tt.code {
+				t.Errorf("versionError(%v) code = %v, want %v", tt.err, got, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+create table if not exists
+public.chronex_freebies_rule (
+    freebies_rule_id uuid not null default gen_random_uuid(),
+    rule_name text null,
+    min_spend double precision null,
+    min_quantity integer null,
+    category text null,
+    product_id text null,
+    freebies_id uuid null,
+    alternate_freebies_id uuid null,
+    freebies_quantity integer null,
+    per_customer_limit integer null,
+    priority integer null,
+    exclusive boolean null,
+    start_at timestamp with time zone null,
+    end_at timestamp with time zone null,
+    rule_status text null,
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_freebies_rule_pkey primary key (freebies_rule_id)
+) tablespace pg_default;
+
+create table if not exists
+public.chronex_freebies_allocation (
+    freebies_allocation_id uuid not null default gen_random_uuid(),
+    order_id uuid null,
+    freebies_rule_id uuid null,
+    freebies_id uuid null,
+    customer_email text null,
+    quantity integer null,
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_freebies_allocation_pkey primary key (freebies_allocation_id)
+) tablespace pg_default;
+
+create index if not exists chronex_freebies_allocation_rule_email_idx
+on public.chronex_freebies_allocation (freebies_rule_id, customer_email);
+
+ALTER TABLE public.chronex_product_order
+ADD COLUMN IF NOT EXISTS freebies JSONB NULL;
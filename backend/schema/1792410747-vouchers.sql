@@ -0,0 +1,48 @@
+create table if not exists
+public.chronex_voucher (
+    voucher_id uuid not null default gen_random_uuid(),
+    voucher_code text not null,
+    discount_type text null,
+    discount_value double precision null,
+    max_discount double precision null,
+    min_spend double precision null,
+    category text null,
+    product_id text null,
+    usage_limit integer null,
+    per_customer_limit integer null,
+    usage_count integer not null default 0,
+    start_at timestamp with time zone null,
+    end_at timestamp with time zone null,
+    voucher_status text null,
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_voucher_pkey primary key (voucher_id)
+) tablespace pg_default;
+
+create unique index if not exists chronex_voucher_code_idx
+on public.chronex_voucher (upper(voucher_code)) where deleted_at is null;
+
+create table if not exists
+public.chronex_voucher_redemption (
+    voucher_redemption_id uuid not null default gen_random_uuid(),
+    voucher_id uuid null,
+    order_id uuid null,
+    customer_email text null,
+    discount_amount double precision null,
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_voucher_redemption_pkey primary key (voucher_redemption_id)
+) tablespace pg_default;
+
+create index if not exists chronex_voucher_redemption_voucher_email_idx
+on public.chronex_voucher_redemption (voucher_id, customer_email);
+
+ALTER TABLE public.chronex_product_order
+ADD COLUMN IF NOT EXISTS voucher_code text NULL,
+ADD COLUMN IF NOT EXISTS voucher_discount double precision NULL;
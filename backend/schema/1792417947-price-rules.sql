@@ -0,0 +1,51 @@
+create table if not exists
+public.chronex_price_rule (
+    price_rule_id uuid not null default gen_random_uuid(),
+    rule_name text null,
+    category text null,
+    product_id text null,
+    sale_price double precision null,
+    percent_off double precision null,
+    start_at timestamp with time zone not null,
+    end_at timestamp with time zone not null,
+    started_at timestamp with time zone null,
+    ended_at timestamp with time zone null,
+    rule_status text null,
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_price_rule_pkey primary key (price_rule_id)
+) tablespace pg_default;
+
+create index if not exists chronex_price_rule_window_idx
+on public.chronex_price_rule (rule_status, start_at, end_at);
+
+create table if not exists
+public.chronex_product_price_history (
+    price_history_id uuid not null default gen_random_uuid(),
+    product_id uuid not null,
+    original_price double precision null,
+    discounted_price double precision null,
+    effective_price double precision null,
+    price_rule_id uuid null,
+    source text null,
+    effective_from timestamp with time zone not null,
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_product_price_history_pkey primary key (price_history_id)
+) tablespace pg_default;
+
+create index if not exists chronex_product_price_history_product_idx
+on public.chronex_product_price_history (product_id, effective_from desc);
+
+-- Seed the history with the prices in effect today
+insert into public.chronex_product_price_history
+    (product_id, original_price, discounted_price, effective_price, source, effective_from, created_at, updated_at)
+select product_id, original_price, discounted_price, discounted_price, 'MANUAL', coalesce(updated_at, now()), now(), now()
+from public.chronex_product_data
+where deleted_at is null;
@@ -0,0 +1,14 @@
+ALTER TABLE public.chronex_product_data
+ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE public.chronex_product_freebies
+ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE public.chronex_product_reviews
+ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE public.chronex_product_order
+ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE public.chronex_product_home_images
+ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
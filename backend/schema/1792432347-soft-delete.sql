@@ -0,0 +1,30 @@
+-- Rows "deleted" with the old DEL status move to the trash instead. Their
+-- status falls back to inactive (cancelled for orders) so a restore does not
+-- bring back a DEL status nothing understands anymore.
+UPDATE public.chronex_product_data
+SET deleted_at = COALESCE(updated_at, now()), product_status = 'INA'
+WHERE product_status = 'DEL' AND deleted_at IS NULL;
+
+UPDATE public.chronex_product_freebies
+SET deleted_at = COALESCE(updated_at, now()), freebies_status = 'INA'
+WHERE freebies_status = 'DEL' AND deleted_at IS NULL;
+
+UPDATE public.chronex_product_reviews
+SET deleted_at = COALESCE(updated_at, now()), reviews_status = 'INA'
+WHERE reviews_status = 'DEL' AND deleted_at IS NULL;
+
+UPDATE public.chronex_product_order
+SET deleted_at = COALESCE(updated_at, now()), order_status = 'CAN'
+WHERE order_status = 'DEL' AND deleted_at IS NULL;
+
+create index if not exists chronex_product_data_deleted_at_idx
+on public.chronex_product_data (deleted_at);
+
+create index if not exists chronex_product_freebies_deleted_at_idx
+on public.chronex_product_freebies (deleted_at);
+
+create index if not exists chronex_product_reviews_deleted_at_idx
+on public.chronex_product_reviews (deleted_at);
+
+create index if not exists chronex_product_order_deleted_at_idx
+on public.chronex_product_order (deleted_at);
@@ -0,0 +1,119 @@
+create table if not exists
+public.chronex_customer (
+    customer_id uuid not null default gen_random_uuid(),
+    first_name text null,
+    last_name text null,
+    email_address text not null default '',
+    contact_number text not null default '',
+    addresses jsonb null,
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_customer_pkey primary key (customer_id)
+) tablespace pg_default;
+
+create unique index if not exists chronex_customer_email_idx
+on public.chronex_customer (email_address) where email_address <> '' and deleted_at is null;
+
+create unique index if not exists chronex_customer_contact_number_idx
+on public.chronex_customer (contact_number) where contact_number <> '' and deleted_at is null;
+
+ALTER TABLE public.chronex_product_order
+ADD COLUMN IF NOT EXISTS customer_id uuid NULL;
+
+create index if not exists chronex_product_order_customer_idx
+on public.chronex_product_order (customer_id);
+
+-- Backfill customers from existing orders using the same normalization as SaveOrder:
+-- lower-cased email, and digits-only contact numbers with +63/63/9XX mobiles written as 09XX.
+create temporary table order_contacts as
+select
+    o.order_id,
+    o.created_at,
+    o.customer,
+    o.complete_address,
+    lower(trim(coalesce(o.customer->>'emailAddress', ''))) as email_address,
+    case
+        when d.digits ~ '^639[0-9]{9}$' then '0' || substr(d.digits, 3)
+        when d.digits ~ '^9[0-9]{9}$' then '0' || d.digits
+        else d.digits
+    end as contact_number
+from public.chronex_product_order o
+cross join lateral (
+    select regexp_replace(coalesce(o.customer->>'contactNumber', ''), '[^0-9]', '', 'g') as digits
+) d;
+
+-- One customer per email, named after their latest order
+insert into public.chronex_customer (first_name, last_name, email_address, contact_number, addresses, created_at, updated_at)
+select distinct on (email_address)
+    trim(customer->>'firstName'),
+    trim(customer->>'lastName'),
+    email_address,
+    '',
+    '[]'::jsonb,
+    now(),
+    now()
+from order_contacts
+where email_address <> ''
+order by email_address, created_at desc
+on conflict do nothing;
+
+-- Attach contact numbers to email customers where the number is not shared
+update public.chronex_customer c
+set contact_number = n.contact_number
+from (
+    select email_address, min(contact_number) as contact_number
+    from order_contacts
+    where email_address <> '' and contact_number <> ''
+    group by email_address
+) n
+where c.email_address = n.email_address
+and c.contact_number = ''
+and not exists (
+    select 1 from public.chronex_customer other where other.contact_number = n.contact_number
+)
+and n.contact_number not in (
+    select contact_number from order_contacts
+    where contact_number <> '' and email_address <> ''
+    group by contact_number
+    having count(distinct email_address) > 1
+);
+
+-- Orders without an email dedupe on the contact number alone
+insert into public.chronex_customer (first_name, last_name, email_address, contact_number, addresses, created_at, updated_at)
+select distinct on (contact_number)
+    trim(customer->>'firstName'),
+    trim(customer->>'lastName'),
+    '',
+    contact_number,
+    '[]'::jsonb,
+    now(),
+    now()
+from order_contacts
+where email_address = '' and contact_number <> ''
+order by contact_number, created_at desc
+on conflict do nothing;
+
+update public.chronex_product_order o
+set customer_id = c.customer_id
+from order_contacts oc
+join public.chronex_customer c
+    on (oc.email_address <> '' and c.email_address = oc.email_address)
+    or (oc.email_address = '' and c.contact_number = oc.contact_number)
+where o.order_id = oc.order_id
+and o.customer_id is null;
+
+-- Every distinct delivery address a customer has ordered to
+update public.chronex_customer c
+set addresses = a.addresses
+from (
+    select o.customer_id, jsonb_agg(distinct o.complete_address) as addresses
+    from public.chronex_product_order o
+    where o.customer_id is not null and o.complete_address is not null
+    group by o.customer_id
+) a
+where c.customer_id = a.customer_id;
+
+drop table if exists order_contacts;
@@ -0,0 +1,26 @@
+create table if not exists
+public.chronex_email_outbox (
+    email_outbox_id uuid not null default gen_random_uuid(),
+    email_event text null,
+    order_id uuid null,
+    recipient text not null,
+    subject text null,
+    html_body text null,
+    email_status text not null default 'PEN',
+    attempts integer not null default 0,
+    next_attempt_at timestamp with time zone not null default now(),
+    last_error text null,
+    sent_at timestamp with time zone null,
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_email_outbox_pkey primary key (email_outbox_id)
+) tablespace pg_default;
+
+create index if not exists chronex_email_outbox_due_idx
+on public.chronex_email_outbox (next_attempt_at) where email_status = 'PEN' and deleted_at is null;
+
+create index if not exists chronex_email_outbox_order_idx
+on public.chronex_email_outbox (order_id);
@@ -0,0 +1,51 @@
+create table if not exists
+public.chronex_payment (
+    payment_id uuid not null default gen_random_uuid(),
+    order_id uuid not null,
+    payment_method text not null default 'COD',
+    provider text not null default 'manual',
+    amount numeric(10, 2) not null default 0,
+    payment_status text not null default 'PEN',
+    provider_reference text null,
+    checkout_url text null,
+    failure_reason text null,
+    captured_at timestamp with time zone null,
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_payment_pkey primary key (payment_id)
+) tablespace pg_default;
+
+create index if not exists chronex_payment_order_idx
+on public.chronex_payment (order_id);
+
+create unique index if not exists chronex_payment_reference_idx
+on public.chronex_payment (provider, provider_reference) where provider_reference is not null and provider_reference <> '';
+
+create index if not exists chronex_payment_captured_idx
+on public.chronex_payment (captured_at) where payment_status = 'CAP' and deleted_at is null;
+
+alter table public.chronex_product_order
+add column if not exists payment_status text not null default 'UNP',
+add column if not exists paid_at timestamp with time zone null;
+
+-- Delivered orders were paid cash on delivery; keep them in revenue on the
+-- day they were placed, as the old DLV-based reports counted them.
+insert into public.chronex_payment (order_id, payment_method, provider, amount, payment_status, captured_at, created_at, updated_at)
+select order_id, 'COD', 'manual', total, 'CAP', created_at, created_at, now()
+from public.chronex_product_order
+where order_status = 'DLV' and total > 0
+and not exists (select 1 from public.chronex_payment p where p.order_id = chronex_product_order.order_id);
+
+update public.chronex_product_order
+set payment_status = 'PAI', paid_at = created_at
+where order_status = 'DLV' and total > 0;
+
+-- Open orders are still waiting for their cash on delivery.
+insert into public.chronex_payment (order_id, payment_method, provider, amount, payment_status, created_at, updated_at)
+select order_id, 'COD', 'manual', total, 'PEN', created_at, now()
+from public.chronex_product_order
+where order_status not in ('DLV', 'CAN') and total > 0 and deleted_at is null
+and not exists (select 1 from public.chronex_payment p where p.order_id = chronex_product_order.order_id);
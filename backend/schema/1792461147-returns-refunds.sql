@@ -0,0 +1,50 @@
+create table if not exists
+public.chronex_return (
+    return_id uuid not null default gen_random_uuid(),
+    order_id uuid not null,
+    return_status text not null default 'REQ',
+    items jsonb not null default '[]'::jsonb,
+    reason text null,
+    note text null,
+    received_at timestamp with time zone null,
+    version bigint not null default 1,
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_return_pkey primary key (return_id)
+) tablespace pg_default;
+
+create index if not exists chronex_return_order_idx
+on public.chronex_return (order_id);
+
+create index if not exists chronex_return_status_idx
+on public.chronex_return (return_status) where deleted_at is null;
+
+create table if not exists
+public.chronex_refund (
+    refund_id uuid not null default gen_random_uuid(),
+    order_id uuid not null,
+    payment_id uuid not null,
+    return_id uuid null,
+    amount numeric(10, 2) not null,
+    reason text null,
+    provider_reference text null,
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_refund_pkey primary key (refund_id),
+    constraint chronex_refund_amount_check check (amount > 0)
+) tablespace pg_default;
+
+create index if not exists chronex_refund_order_idx
+on public.chronex_refund (order_id);
+
+create index if not exists chronex_refund_payment_idx
+on public.chronex_refund (payment_id);
+
+create index if not exists chronex_refund_return_idx
+on public.chronex_refund (return_id) where return_id is not null;
@@ -0,0 +1,32 @@
+alter table public.chronex_product_data
+add column if not exists weight_grams numeric(10, 2) not null default 0,
+add column if not exists length_cm numeric(10, 2) not null default 0,
+add column if not exists width_cm numeric(10, 2) not null default 0,
+add column if not exists height_cm numeric(10, 2) not null default 0;
+
+alter table public.chronex_product_order
+add column if not exists shipping_fee numeric(10, 2) not null default 0;
+
+create table if not exists
+public.chronex_shipping_zone (
+    shipping_zone_id uuid not null default gen_random_uuid(),
+    zone_name text not null,
+    provinces jsonb not null default '[]'::jsonb,
+    cities jsonb not null default '[]'::jsonb,
+    is_default boolean not null default false,
+    rate_type text not null default 'FLAT',
+    flat_fee numeric(10, 2) not null default 0,
+    weight_tiers jsonb not null default '[]'::jsonb,
+    extra_fee_per_kg numeric(10, 2) not null default 0,
+    free_shipping_threshold numeric(10, 2) not null default 0,
+    zone_status text not null default 'ACT',
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_shipping_zone_pkey primary key (shipping_zone_id)
+) tablespace pg_default;
+
+create index if not exists chronex_shipping_zone_status_idx
+on public.chronex_shipping_zone (zone_status) where deleted_at is null;
@@ -0,0 +1,30 @@
+alter table public.chronex_product_order
+add column if not exists courier text not null default '';
+
+create index if not exists chronex_product_order_tracking_idx
+on public.chronex_product_order (courier, tracking_id) where courier <> '';
+
+create table if not exists
+public.chronex_order_event (
+    order_event_id uuid not null default gen_random_uuid(),
+    order_id uuid not null,
+    event_type text not null,
+    event_status text not null,
+    description text not null default '',
+    location text not null default '',
+    source text not null default '',
+    occurred_at timestamp with time zone not null,
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_order_event_pkey primary key (order_event_id)
+) tablespace pg_default;
+
+create index if not exists chronex_order_event_order_idx
+on public.chronex_order_event (order_id, occurred_at);
+
+-- A courier scan is recorded once however often it is pushed or polled
+create unique index if not exists chronex_order_event_tracking_key
+on public.chronex_order_event (order_id, event_type, event_status, occurred_at) where event_type = 'TRACKING';
@@ -0,0 +1,31 @@
+alter table public.chronex_product_order
+add column if not exists order_number text null;
+
+create table if not exists
+public.chronex_order_sequence (
+    sequence_year integer not null,
+    last_number bigint not null default 0,
+    constraint chronex_order_sequence_pkey primary key (sequence_year)
+) tablespace pg_default;
+
+-- Number existing orders per year in the order they were placed
+with numbered as (
+    select order_id,
+        extract(year from created_at)::integer as sequence_year,
+        row_number() over (partition by extract(year from created_at) order by created_at, order_id) as number
+    from public.chronex_product_order
+    where order_number is null
+)
+update public.chronex_product_order o
+set order_number = 'CHX-' || numbered.sequence_year || '-' || lpad(numbered.number::text, 6, '0')
+from numbered
+where o.order_id = numbered.order_id;
+
+insert into public.chronex_order_sequence (sequence_year, last_number)
+select extract(year from created_at)::integer, count(*)
+from public.chronex_product_order
+group by extract(year from created_at)
+on conflict (sequence_year) do update set last_number = excluded.last_number;
+
+create unique index if not exists chronex_product_order_number_key
+on public.chronex_product_order (order_number);
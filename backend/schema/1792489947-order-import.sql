@@ -0,0 +1,8 @@
+alter table public.chronex_product_order
+add column if not exists channel text not null default '',
+add column if not exists external_order_id text not null default '';
+
+-- A marketplace order can only be imported once per channel
+create unique index if not exists chronex_product_order_external_key
+on public.chronex_product_order (channel, external_order_id)
+where external_order_id <> '' and deleted_at is null;
@@ -0,0 +1,14 @@
+alter table public.chronex_product_data
+add column if not exists sku text not null default '';
+
+alter table public.chronex_product_freebies
+add column if not exists sku text not null default '';
+
+-- Catalogue imports match rows to products and freebies by SKU
+create unique index if not exists chronex_product_data_sku_key
+on public.chronex_product_data (sku)
+where sku <> '' and deleted_at is null;
+
+create unique index if not exists chronex_product_freebies_sku_key
+on public.chronex_product_freebies (sku)
+where sku <> '' and deleted_at is null;
@@ -0,0 +1,4 @@
+-- Freebies images move from freebies_img to the blob store; the service
+-- moves existing images over on start and empties freebies_img
+alter table public.chronex_product_freebies
+add column if not exists freebies_img_key text not null default '';
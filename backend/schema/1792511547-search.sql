@@ -0,0 +1,47 @@
+create extension if not exists pg_trgm;
+
+-- Products are searched by name and SKU first, then category and descriptions
+alter table public.chronex_product_data
+add column if not exists search_vector tsvector generated always as (
+    setweight(to_tsvector('english', coalesce(product_name, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(sku, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(category, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(description1, '')), 'C') ||
+    setweight(to_tsvector('english', coalesce(description2, '{}'::jsonb)), 'D')
+) stored;
+
+create index if not exists chronex_product_data_search_idx
+on public.chronex_product_data using gin (search_vector);
+
+create index if not exists chronex_product_data_name_trgm_idx
+on public.chronex_product_data using gin (product_name gin_trgm_ops);
+
+create index if not exists chronex_product_data_sku_trgm_idx
+on public.chronex_product_data using gin (sku gin_trgm_ops);
+
+-- Orders are searched by order number, customer and the products ordered;
+-- names and emails are not stemmed
+alter table public.chronex_product_order
+add column if not exists search_text text generated always as (
+    coalesce(order_number, '') || ' ' ||
+    coalesce(customer->>'firstName', '') || ' ' ||
+    coalesce(customer->>'lastName', '') || ' ' ||
+    coalesce(customer->>'emailAddress', '') || ' ' ||
+    coalesce(customer->>'contactNumber', '') || ' ' ||
+    coalesce(external_order_id, '') || ' ' ||
+    coalesce(translate(jsonb_path_query_array(product, '$[*].productName')::text, '[]"', ''), '')
+) stored;
+
+alter table public.chronex_product_order
+add column if not exists search_vector tsvector generated always as (
+    setweight(to_tsvector('simple', coalesce(order_number, '') || ' ' || coalesce(external_order_id, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(customer->>'firstName', '') || ' ' || coalesce(customer->>'lastName', '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(customer->>'emailAddress', '') || ' ' || coalesce(customer->>'contactNumber', '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(jsonb_path_query_array(product, '$[*].productName'), '[]'::jsonb)), 'C')
+) stored;
+
+create index if not exists chronex_product_order_search_idx
+on public.chronex_product_order using gin (search_vector);
+
+create index if not exists chronex_product_order_search_trgm_idx
+on public.chronex_product_order using gin (search_text gin_trgm_ops);
@@ -0,0 +1,9 @@
+-- Product listings filter and count by category, and by the average rating
+-- of each product's reviews
+create index if not exists chronex_product_data_category_idx
+on public.chronex_product_data (category)
+where deleted_at is null;
+
+create index if not exists chronex_product_reviews_product_id_idx
+on public.chronex_product_reviews (product_id)
+where deleted_at is null;
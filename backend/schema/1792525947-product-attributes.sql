@@ -0,0 +1,29 @@
+create table if not exists
+public.chronex_product_attribute (
+    attribute_id uuid not null default gen_random_uuid(),
+    attribute_code text not null,
+    attribute_name text not null,
+    attribute_type text not null default 'TEXT',
+    unit text not null default '',
+    options jsonb not null default '[]'::jsonb,
+    categories jsonb not null default '[]'::jsonb,
+    is_required boolean not null default false,
+    sort_order integer not null default 0,
+    attribute_status text not null default 'ACT',
+    created_by uuid null,
+    created_at timestamp with time zone null,
+    updated_by uuid null,
+    updated_at timestamp with time zone null,
+    deleted_at timestamp with time zone null,
+    constraint chronex_product_attribute_pkey primary key (attribute_id)
+) tablespace pg_default;
+
+create unique index if not exists chronex_product_attribute_code_idx
+on public.chronex_product_attribute (attribute_code)
+where deleted_at is null;
+
+alter table public.chronex_product_data
+add column if not exists attributes jsonb not null default '{}'::jsonb;
+
+create index if not exists chronex_product_data_attributes_idx
+on public.chronex_product_data using gin (attributes);
@@ -0,0 +1,2 @@
+alter table public.chronex_voucher
+drop column if exists usage_count;
@@ -0,0 +1,6 @@
+-- Addresses no shipping zone covers can no longer be ordered to. Stores that
+-- never set up zones shipped everything free, so they get a free default zone
+-- to keep doing that until real rates are configured.
+INSERT INTO public.chronex_shipping_zone (zone_name, is_default, rate_type, flat_fee, zone_status, created_at, updated_at)
+SELECT 'Everywhere else', true, 'FLAT', 0, 'ACT', now(), now()
+WHERE NOT EXISTS (SELECT 1 FROM public.chronex_shipping_zone WHERE deleted_at IS NULL);